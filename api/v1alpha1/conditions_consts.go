@@ -28,5 +28,17 @@ const (
 	// UnableToFindFloatingIPNetworkReason is used when the floating ip network is not found.
 	UnableToFindNetwork = "UnableToFindNetwork"
 
+	// OpenStackCredentialRotationReadyCondition reports on whether the Secret of an OpenStackCredentialRotation contains an
+	// application credential which is not due to be replaced.
+	OpenStackCredentialRotationReadyCondition = "Ready"
+
+	// InvalidCredentialsSecretReason is used when the Secret of an OpenStackCredentialRotation does not contain the cloud to
+	// update, or the Secret of an OpenStackClusterIdentity does not contain a valid `clouds.yaml` file.
+	InvalidCredentialsSecretReason = "InvalidCredentialsSecret"
+
+	// OpenStackClusterIdentityReadyCondition reports on whether authentication succeeded with every cloud in the Secret of an
+	// OpenStackClusterIdentity.
+	OpenStackClusterIdentityReadyCondition = "Ready"
+
 	CreateServerError ServerStatusError = "CreateError"
 )
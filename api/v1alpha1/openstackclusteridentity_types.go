@@ -40,18 +40,127 @@ type OpenStackClusterIdentitySpec struct {
 	// NamespaceSelector limits which namespaces may use this identity. If nil, all namespaces are allowed.
 	// +optional
 	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// RateLimit limits the rate and concurrency of OpenStack API calls made
+	// with this identity. Limits which are not set default to the limits
+	// passed to the manager on the command line.
+	// +optional
+	RateLimit *OpenStackAPIRateLimit `json:"rateLimit,omitempty"`
+}
+
+// OpenStackAPIRateLimit limits the OpenStack API calls made with a set of
+// credentials.
+//
+// Limits are enforced by the manager for each combination of identity
+// endpoint and project, so all identities which authenticate to the same
+// project share a single budget. If they specify different limits, the limits
+// of the identity which was used most recently apply.
+type OpenStackAPIRateLimit struct {
+	// QPS is the sustained number of API calls per second.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	QPS *int32 `json:"qps,omitempty"`
+
+	// Burst is the maximum number of API calls which may be made at once
+	// after a period without calls. If QPS is set and Burst is not, Burst is
+	// equal to QPS.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Burst *int32 `json:"burst,omitempty"`
+
+	// MaxInFlight is the maximum number of concurrent API calls. Further
+	// calls wait until an earlier call has completed.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxInFlight *int32 `json:"maxInFlight,omitempty"`
+}
+
+// OpenStackClusterIdentityStatus defines the observed state of an OpenStackClusterIdentity.
+type OpenStackClusterIdentityStatus struct {
+	// Clouds is the result of authenticating with each cloud in the `clouds.yaml` file of the referenced Secret.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Clouds []OpenStackClusterIdentityCloudStatus `json:"clouds,omitempty"`
+
+	// LastCheckTime is when the credentials were last checked.
+	// +optional
+	LastCheckTime *metav1.Time `json:"lastCheckTime,omitempty"`
+
+	// Conditions defines current service state of the OpenStackClusterIdentity.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// OpenStackClusterIdentityCloudStatus is the result of authenticating with a cloud in a `clouds.yaml` file.
+type OpenStackClusterIdentityCloudStatus struct {
+	// Name is the name of the cloud in the `clouds.yaml` file.
+	Name string `json:"name"`
+
+	// Error is the reason authentication with the cloud failed. It is not set if authentication succeeded.
+	// +optional
+	Error string `json:"error,omitempty"`
+
+	// ProjectID is the ID of the project the credentials are scoped to.
+	// +optional
+	ProjectID string `json:"projectID,omitempty"`
+
+	// UserID is the ID of the user the credentials belong to.
+	// +optional
+	UserID string `json:"userID,omitempty"`
+
+	// Roles are the names of the roles of the credentials in the project.
+	// +listType=set
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+
+	// TokenExpiresAt is when the token obtained with the credentials expires.
+	// +optional
+	TokenExpiresAt *metav1.Time `json:"tokenExpiresAt,omitempty"`
+
+	// Endpoints are the endpoints in the service catalog for the region and interface of the cloud.
+	// +listType=atomic
+	// +optional
+	Endpoints []OpenStackServiceEndpoint `json:"endpoints,omitempty"`
+}
+
+// OpenStackServiceEndpoint is an endpoint in the Keystone service catalog.
+type OpenStackServiceEndpoint struct {
+	// Type is the type of the service, for example `compute`.
+	Type string `json:"type"`
+
+	// Name is the name of the service, for example `nova`.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Region is the region of the endpoint.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// Interface is the interface of the endpoint: `public`, `internal` or `admin`.
+	// +optional
+	Interface string `json:"interface,omitempty"`
+
+	// URL is the URL of the endpoint.
+	URL string `json:"url"`
 }
 
 // +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:path=openstackclusteridentities,scope=Cluster,categories=cluster-api,shortName=osci
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Authentication with every cloud succeeded"
+// +kubebuilder:printcolumn:name="Last Check",type="date",JSONPath=".status.lastCheckTime",description="Time the credentials were last checked"
 
 // OpenStackClusterIdentity is a cluster-scoped identity that centralizes OpenStack credentials.
 type OpenStackClusterIdentity struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec OpenStackClusterIdentitySpec `json:"spec,omitempty"`
+	Spec   OpenStackClusterIdentitySpec   `json:"spec,omitempty"`
+	Status OpenStackClusterIdentityStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -63,6 +172,16 @@ type OpenStackClusterIdentityList struct {
 	Items           []OpenStackClusterIdentity `json:"items"`
 }
 
+// GetConditions returns the observations of the operational state of the OpenStackClusterIdentity resource.
+func (r *OpenStackClusterIdentity) GetConditions() []metav1.Condition {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the OpenStackClusterIdentity to the predescribed clusterv1.Conditions.
+func (r *OpenStackClusterIdentity) SetConditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
+
 func init() {
 	objectTypes = append(objectTypes, &OpenStackClusterIdentity{}, &OpenStackClusterIdentityList{})
 }
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+)
+
+const (
+	// OpenStackCredentialRotationFinalizer allows ReconcileOpenStackCredentialRotation to delete the application
+	// credentials it created before removing it from the apiserver.
+	OpenStackCredentialRotationFinalizer = "openstackcredentialrotation.infrastructure.cluster.x-k8s.io"
+)
+
+// OpenStackCredentialRotationSpec defines the desired state of OpenStackCredentialRotation.
+type OpenStackCredentialRotationSpec struct {
+	// SecretName is the name of the Secret containing the credentials to update, in the namespace of the
+	// OpenStackCredentialRotation. This is the Secret referenced by the identityRef of clusters using the credentials,
+	// or by the secretRef of an OpenStackClusterIdentity.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	SecretName string `json:"secretName"`
+
+	// CloudName is the name of the entry in the `clouds.yaml` file to update. The entry must exist and contain an
+	// `auth_url`. Its credentials are replaced by an application credential, and its other settings are kept.
+	// If not set, the Secret is one referenced by an identityRef of type ApplicationCredential, and its
+	// `applicationCredentialID` and `applicationCredentialSecret` are replaced.
+	// +optional
+	CloudName string `json:"cloudName,omitempty"`
+
+	// BootstrapIdentityRef is a reference to the credentials used to create the application credentials. The
+	// application credentials belong to the user of these credentials. If they are themselves an application
+	// credential it must be unrestricted. They may refer to a different entry of the Secret being updated.
+	// +kubebuilder:validation:Required
+	BootstrapIdentityRef infrav1.OpenStackIdentityReference `json:"bootstrapIdentityRef"`
+
+	// RotationInterval is how often a new application credential is created. Defaults to 720h (30 days).
+	// +optional
+	RotationInterval *metav1.Duration `json:"rotationInterval,omitempty"`
+
+	// GracePeriod is how long a replaced application credential remains valid before it is deleted, to allow all
+	// users of the Secret to observe the new credential. Defaults to 24h.
+	// Application credentials expire after RotationInterval plus GracePeriod, so they become invalid even if they are
+	// not rotated.
+	// +optional
+	GracePeriod *metav1.Duration `json:"gracePeriod,omitempty"`
+
+	// Roles are the names of the roles of the application credentials. If not set, the application credentials have
+	// all the roles of the bootstrap credentials.
+	// +listType=set
+	// +optional
+	Roles []string `json:"roles,omitempty"`
+
+	// AccessRules restrict the API calls which may be made with the application credentials. If not set, the
+	// application credentials may make any API call permitted by their roles.
+	// +listType=atomic
+	// +optional
+	AccessRules []ApplicationCredentialAccessRule `json:"accessRules,omitempty"`
+
+	// Unrestricted allows the application credentials to create and delete other application credentials and
+	// trusts. This is required if the Secret being updated is also the bootstrap credentials.
+	// +optional
+	Unrestricted bool `json:"unrestricted,omitempty"`
+}
+
+// ApplicationCredentialAccessRule permits an API call with an application credential.
+type ApplicationCredentialAccessRule struct {
+	// Service is the type of the service the API call is made to, for example `compute`.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Service string `json:"service"`
+
+	// Method is the HTTP method of the API call.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=HEAD;GET;POST;PUT;PATCH;DELETE
+	Method string `json:"method"`
+
+	// Path is the path of the API call, which may contain `*` to match a single path segment and `**` to match any
+	// number of path segments, for example `/v2.1/servers/**`.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Path string `json:"path"`
+}
+
+// OpenStackCredentialRotationStatus defines the observed state of OpenStackCredentialRotation.
+type OpenStackCredentialRotationStatus struct {
+	// ApplicationCredentialID is the ID of the application credential currently in the Secret.
+	// +optional
+	ApplicationCredentialID string `json:"applicationCredentialID,omitempty"`
+
+	// LastRotationTime is when the application credential in the Secret was last replaced.
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+
+	// NextRotationTime is when the application credential in the Secret will next be replaced.
+	// +optional
+	NextRotationTime *metav1.Time `json:"nextRotationTime,omitempty"`
+
+	// PendingRevocations are the application credentials which have been replaced and will be deleted at the end of
+	// their grace period.
+	// +listType=map
+	// +listMapKey=id
+	// +optional
+	PendingRevocations []PendingApplicationCredentialRevocation `json:"pendingRevocations,omitempty"`
+
+	// Conditions defines current service state of the OpenStackCredentialRotation.
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// PendingApplicationCredentialRevocation is an application credential which will be deleted.
+type PendingApplicationCredentialRevocation struct {
+	// ID is the ID of the application credential.
+	ID string `json:"id"`
+
+	// RevokeAfter is when the application credential will be deleted.
+	RevokeAfter metav1.Time `json:"revokeAfter"`
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=openstackcredentialrotations,scope=Namespaced,categories=cluster-api,shortName=oscr
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".spec.secretName",description="Secret containing the rotated credentials"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Credentials are up to date"
+// +kubebuilder:printcolumn:name="Next Rotation",type="date",JSONPath=".status.nextRotationTime",description="Time of the next rotation"
+
+// OpenStackCredentialRotation periodically replaces the credentials in a Secret with a new Keystone
+// application credential, and deletes the replaced application credentials after a grace period.
+// When it is deleted, the application credentials it created are deleted except for the one still in the Secret,
+// which remains valid until it expires.
+type OpenStackCredentialRotation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OpenStackCredentialRotationSpec   `json:"spec,omitempty"`
+	Status OpenStackCredentialRotationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OpenStackCredentialRotationList contains a list of OpenStackCredentialRotation.
+type OpenStackCredentialRotationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OpenStackCredentialRotation `json:"items"`
+}
+
+// GetConditions returns the observations of the operational state of the OpenStackCredentialRotation resource.
+func (r *OpenStackCredentialRotation) GetConditions() []metav1.Condition {
+	return r.Status.Conditions
+}
+
+// SetConditions sets the underlying service state of the OpenStackCredentialRotation to the predescribed clusterv1.Conditions.
+func (r *OpenStackCredentialRotation) SetConditions(conditions []metav1.Condition) {
+	r.Status.Conditions = conditions
+}
+
+var _ infrav1.IdentityRefProvider = &OpenStackCredentialRotation{}
+
+// GetIdentityRef returns the OpenStackCredentialRotation's namespace and BootstrapIdentityRef.
+func (r *OpenStackCredentialRotation) GetIdentityRef() (*string, *infrav1.OpenStackIdentityReference) {
+	return &r.Namespace, &r.Spec.BootstrapIdentityRef
+}
+
+func init() {
+	objectTypes = append(objectTypes, &OpenStackCredentialRotation{}, &OpenStackCredentialRotationList{})
+}
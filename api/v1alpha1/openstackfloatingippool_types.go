@@ -22,6 +22,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/optional"
 )
 
 const (
@@ -64,6 +65,14 @@ type OpenStackFloatingIPPoolSpec struct {
 	// +optional
 	FloatingIPNetwork *infrav1.NetworkParam `json:"floatingIPNetwork"`
 
+	// DNSDomain is the DNS domain set on the floating ips allocated by this pool.
+	// When set and the Neutron dns-integration extension is available, each
+	// floating ip is given a unique DNS name derived from the pool name, so that
+	// an external DNS service such as Designate publishes a record for it.
+	// The domain must be a fully qualified domain name ending with a dot.
+	// +optional
+	DNSDomain optional.String `json:"dnsDomain,omitempty"`
+
 	// The stratergy to use for reclaiming floating ips when they are released from a machine
 	// +kubebuilder:validation:Enum=Retain;Delete
 	ReclaimPolicy ReclaimPolicy `json:"reclaimPolicy"`
@@ -27,12 +27,58 @@ import (
 	"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationCredentialAccessRule) DeepCopyInto(out *ApplicationCredentialAccessRule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationCredentialAccessRule.
+func (in *ApplicationCredentialAccessRule) DeepCopy() *ApplicationCredentialAccessRule {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationCredentialAccessRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackAPIRateLimit) DeepCopyInto(out *OpenStackAPIRateLimit) {
+	*out = *in
+	if in.QPS != nil {
+		in, out := &in.QPS, &out.QPS
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxInFlight != nil {
+		in, out := &in.MaxInFlight, &out.MaxInFlight
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackAPIRateLimit.
+func (in *OpenStackAPIRateLimit) DeepCopy() *OpenStackAPIRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackAPIRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenStackClusterIdentity) DeepCopyInto(out *OpenStackClusterIdentity) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackClusterIdentity.
@@ -53,6 +99,35 @@ func (in *OpenStackClusterIdentity) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackClusterIdentityCloudStatus) DeepCopyInto(out *OpenStackClusterIdentityCloudStatus) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TokenExpiresAt != nil {
+		in, out := &in.TokenExpiresAt, &out.TokenExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]OpenStackServiceEndpoint, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackClusterIdentityCloudStatus.
+func (in *OpenStackClusterIdentityCloudStatus) DeepCopy() *OpenStackClusterIdentityCloudStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackClusterIdentityCloudStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenStackClusterIdentityList) DeepCopyInto(out *OpenStackClusterIdentityList) {
 	*out = *in
@@ -94,6 +169,11 @@ func (in *OpenStackClusterIdentitySpec) DeepCopyInto(out *OpenStackClusterIdenti
 		*out = new(v1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(OpenStackAPIRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackClusterIdentitySpec.
@@ -106,6 +186,171 @@ func (in *OpenStackClusterIdentitySpec) DeepCopy() *OpenStackClusterIdentitySpec
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackClusterIdentityStatus) DeepCopyInto(out *OpenStackClusterIdentityStatus) {
+	*out = *in
+	if in.Clouds != nil {
+		in, out := &in.Clouds, &out.Clouds
+		*out = make([]OpenStackClusterIdentityCloudStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastCheckTime != nil {
+		in, out := &in.LastCheckTime, &out.LastCheckTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackClusterIdentityStatus.
+func (in *OpenStackClusterIdentityStatus) DeepCopy() *OpenStackClusterIdentityStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackClusterIdentityStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackCredentialRotation) DeepCopyInto(out *OpenStackCredentialRotation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackCredentialRotation.
+func (in *OpenStackCredentialRotation) DeepCopy() *OpenStackCredentialRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackCredentialRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackCredentialRotation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackCredentialRotationList) DeepCopyInto(out *OpenStackCredentialRotationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackCredentialRotation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackCredentialRotationList.
+func (in *OpenStackCredentialRotationList) DeepCopy() *OpenStackCredentialRotationList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackCredentialRotationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackCredentialRotationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackCredentialRotationSpec) DeepCopyInto(out *OpenStackCredentialRotationSpec) {
+	*out = *in
+	out.BootstrapIdentityRef = in.BootstrapIdentityRef
+	if in.RotationInterval != nil {
+		in, out := &in.RotationInterval, &out.RotationInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.GracePeriod != nil {
+		in, out := &in.GracePeriod, &out.GracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AccessRules != nil {
+		in, out := &in.AccessRules, &out.AccessRules
+		*out = make([]ApplicationCredentialAccessRule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackCredentialRotationSpec.
+func (in *OpenStackCredentialRotationSpec) DeepCopy() *OpenStackCredentialRotationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackCredentialRotationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackCredentialRotationStatus) DeepCopyInto(out *OpenStackCredentialRotationStatus) {
+	*out = *in
+	if in.LastRotationTime != nil {
+		in, out := &in.LastRotationTime, &out.LastRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRotationTime != nil {
+		in, out := &in.NextRotationTime, &out.NextRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PendingRevocations != nil {
+		in, out := &in.PendingRevocations, &out.PendingRevocations
+		*out = make([]PendingApplicationCredentialRevocation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackCredentialRotationStatus.
+func (in *OpenStackCredentialRotationStatus) DeepCopy() *OpenStackCredentialRotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackCredentialRotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenStackCredentialSecretReference) DeepCopyInto(out *OpenStackCredentialSecretReference) {
 	*out = *in
@@ -199,6 +444,11 @@ func (in *OpenStackFloatingIPPoolSpec) DeepCopyInto(out *OpenStackFloatingIPPool
 		*out = new(v1beta2.NetworkParam)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DNSDomain != nil {
+		in, out := &in.DNSDomain, &out.DNSDomain
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackFloatingIPPoolSpec.
@@ -459,6 +709,37 @@ func (in *OpenStackServerStatus) DeepCopy() *OpenStackServerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackServiceEndpoint) DeepCopyInto(out *OpenStackServiceEndpoint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackServiceEndpoint.
+func (in *OpenStackServiceEndpoint) DeepCopy() *OpenStackServiceEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackServiceEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingApplicationCredentialRevocation) DeepCopyInto(out *PendingApplicationCredentialRevocation) {
+	*out = *in
+	in.RevokeAfter.DeepCopyInto(&out.RevokeAfter)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingApplicationCredentialRevocation.
+func (in *PendingApplicationCredentialRevocation) DeepCopy() *PendingApplicationCredentialRevocation {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingApplicationCredentialRevocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResolvedServerSpec) DeepCopyInto(out *ResolvedServerSpec) {
 	*out = *in
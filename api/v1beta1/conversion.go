@@ -45,6 +45,18 @@ func (src *OpenStackCluster) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	for i := range dst.Status.Conditions {
 		dst.Status.Conditions[i].ObservedGeneration = src.Generation
 	}
+
+	// Restore hub-only fields stashed on the spoke by ConvertFrom.
+	restored := &infrav1.OpenStackCluster{}
+	ok, err := utilconversion.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+	if ok {
+		restoreOpenStackClusterSpec(&restored.Spec, &dst.Spec)
+		restoreOpenStackClusterStatus(&restored.Status, &dst.Status)
+	}
+
 	return utilconversion.MarshalData(src, dst)
 }
 
@@ -56,8 +68,15 @@ func (dst *OpenStackCluster) ConvertFrom(srcRaw ctrlconversion.Hub) error {
 	if err := Convert_v1beta2_OpenStackCluster_To_v1beta1_OpenStackCluster(src, dst, nil); err != nil {
 		return err
 	}
-	_, err := utilconversion.UnmarshalData(src, dst)
-	return err
+
+	// Restore spoke-only fields stashed on the hub by ConvertTo.
+	if _, err := utilconversion.UnmarshalData(src, dst); err != nil {
+		return err
+	}
+
+	// Stash the hub object on the spoke so hub-only fields survive the
+	// round trip.
+	return utilconversion.MarshalData(src, dst)
 }
 
 // ConvertTo converts this OpenStackMachine to the Hub version (v1beta2).
@@ -69,6 +88,18 @@ func (src *OpenStackMachine) ConvertTo(dstRaw ctrlconversion.Hub) error {
 	for i := range dst.Status.Conditions {
 		dst.Status.Conditions[i].ObservedGeneration = src.Generation
 	}
+
+	// Restore hub-only fields stashed on the spoke by ConvertFrom.
+	restored := &infrav1.OpenStackMachine{}
+	ok, err := utilconversion.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+	if ok {
+		restoreOpenStackMachineSpec(&restored.Spec, &dst.Spec)
+		restoreOpenStackMachineStatus(&restored.Status, &dst.Status)
+	}
+
 	return utilconversion.MarshalData(src, dst)
 }
 
@@ -80,8 +111,15 @@ func (dst *OpenStackMachine) ConvertFrom(srcRaw ctrlconversion.Hub) error {
 	if err := Convert_v1beta2_OpenStackMachine_To_v1beta1_OpenStackMachine(src, dst, nil); err != nil {
 		return err
 	}
-	_, err := utilconversion.UnmarshalData(src, dst)
-	return err
+
+	// Restore spoke-only fields stashed on the hub by ConvertTo.
+	if _, err := utilconversion.UnmarshalData(src, dst); err != nil {
+		return err
+	}
+
+	// Stash the hub object on the spoke so hub-only fields survive the
+	// round trip.
+	return utilconversion.MarshalData(src, dst)
 }
 
 // ConvertTo converts this OpenStackClusterTemplate to the Hub version (v1beta2).
@@ -91,7 +129,7 @@ func (src *OpenStackClusterTemplate) ConvertTo(dstRaw ctrlconversion.Hub) error
 		return err
 	}
 
-	// Restore hub-only fields (spec.template.metadata) stashed on the
+	// Restore hub-only fields (e.g. spec.template.metadata) stashed on the
 	// spoke by ConvertFrom. UnmarshalData also deletes the annotation,
 	// so it can't leak into the stash below.
 	restored := &infrav1.OpenStackClusterTemplate{}
@@ -101,6 +139,7 @@ func (src *OpenStackClusterTemplate) ConvertTo(dstRaw ctrlconversion.Hub) error
 	}
 	if ok {
 		dst.Spec.Template.ObjectMeta = restored.Spec.Template.ObjectMeta
+		restoreOpenStackClusterSpec(&restored.Spec.Template.Spec, &dst.Spec.Template.Spec)
 	}
 
 	// Stash the v1beta1 object on the hub to preserve spoke-only fields
@@ -123,7 +162,7 @@ func (dst *OpenStackClusterTemplate) ConvertFrom(srcRaw ctrlconversion.Hub) erro
 	}
 
 	// Stash the hub object on the spoke so hub-only fields
-	// (spec.template.metadata) survive the round trip.
+	// (e.g. spec.template.metadata) survive the round trip.
 	return utilconversion.MarshalData(src, dst)
 }
 
@@ -133,6 +172,17 @@ func (src *OpenStackMachineTemplate) ConvertTo(dstRaw ctrlconversion.Hub) error
 	if err := Convert_v1beta1_OpenStackMachineTemplate_To_v1beta2_OpenStackMachineTemplate(src, dst, nil); err != nil {
 		return err
 	}
+
+	// Restore hub-only fields stashed on the spoke by ConvertFrom.
+	restored := &infrav1.OpenStackMachineTemplate{}
+	ok, err := utilconversion.UnmarshalData(src, restored)
+	if err != nil {
+		return err
+	}
+	if ok {
+		restoreOpenStackMachineSpec(&restored.Spec.Template.Spec, &dst.Spec.Template.Spec)
+	}
+
 	return utilconversion.MarshalData(src, dst)
 }
 
@@ -144,8 +194,15 @@ func (dst *OpenStackMachineTemplate) ConvertFrom(srcRaw ctrlconversion.Hub) erro
 	if err := Convert_v1beta2_OpenStackMachineTemplate_To_v1beta1_OpenStackMachineTemplate(src, dst, nil); err != nil {
 		return err
 	}
-	_, err := utilconversion.UnmarshalData(src, dst)
-	return err
+
+	// Restore spoke-only fields stashed on the hub by ConvertTo.
+	if _, err := utilconversion.UnmarshalData(src, dst); err != nil {
+		return err
+	}
+
+	// Stash the hub object on the spoke so hub-only fields survive the
+	// round trip.
+	return utilconversion.MarshalData(src, dst)
 }
 
 // ConvertTo converts this OpenStackClusterList to the Hub version (v1beta2).
@@ -204,20 +261,131 @@ func (dst *OpenStackMachineTemplateList) ConvertFrom(srcRaw ctrlconversion.Hub)
 	return Convert_v1beta2_OpenStackMachineTemplateList_To_v1beta1_OpenStackMachineTemplateList(src, dst, nil)
 }
 
+// The restore* functions copy fields which only exist in v1beta2 from the
+// hub object stashed on the spoke by ConvertFrom. Fields are only restored
+// where the enclosing object still exists after conversion, so removing it
+// from the v1beta1 object also removes its hub-only fields. The API server
+// and managed network are recreated when a hub-only field is all they hold.
+
+func restoreOpenStackClusterSpec(previous, dst *infrav1.OpenStackClusterSpec) {
+	if previous.APIServer != nil && previous.APIServer.VirtualIP != nil && dst.APIServer == nil {
+		dst.APIServer = &infrav1.APIServer{}
+	}
+	if previous.APIServer != nil && dst.APIServer != nil {
+		dst.APIServer.VirtualIP = previous.APIServer.VirtualIP
+		dst.APIServer.FloatingIPDNS = previous.APIServer.FloatingIPDNS
+		if previous.APIServer.ManagedLoadBalancer != nil && dst.APIServer.ManagedLoadBalancer != nil {
+			restoreAPIServerLoadBalancer(previous.APIServer.ManagedLoadBalancer, dst.APIServer.ManagedLoadBalancer)
+		}
+	}
+	dst.AdditionalLoadBalancers = previous.AdditionalLoadBalancers
+	if len(previous.ManagedSubnets) == len(dst.ManagedSubnets) {
+		for i := range dst.ManagedSubnets {
+			dst.ManagedSubnets[i].SubnetPool = previous.ManagedSubnets[i].SubnetPool
+			dst.ManagedSubnets[i].PrefixLength = previous.ManagedSubnets[i].PrefixLength
+			dst.ManagedSubnets[i].IPv6AddressMode = previous.ManagedSubnets[i].IPv6AddressMode
+			dst.ManagedSubnets[i].IPv6RAMode = previous.ManagedSubnets[i].IPv6RAMode
+		}
+	}
+	if previous.ManagedNetwork != nil && previous.ManagedNetwork.QoSPolicy != nil {
+		if dst.ManagedNetwork == nil {
+			dst.ManagedNetwork = &infrav1.ManagedNetwork{}
+		}
+		dst.ManagedNetwork.QoSPolicy = previous.ManagedNetwork.QoSPolicy
+	}
+	if previous.Bastion != nil && dst.Bastion != nil {
+		dst.Bastion.FloatingIPDNS = previous.Bastion.FloatingIPDNS
+		if previous.Bastion.Spec != nil && dst.Bastion.Spec != nil {
+			restoreOpenStackMachineSpec(previous.Bastion.Spec, dst.Bastion.Spec)
+		}
+	}
+}
+
+func restoreAPIServerLoadBalancer(previous, dst *infrav1.APIServerLoadBalancer) {
+	if previous.Monitor != nil && dst.Monitor != nil {
+		dst.Monitor.Type = previous.Monitor.Type
+		dst.Monitor.URLPath = previous.Monitor.URLPath
+		dst.Monitor.ExpectedCodes = previous.Monitor.ExpectedCodes
+	}
+	dst.TLS = previous.TLS
+	dst.Zones = previous.Zones
+	dst.MemberDrain = previous.MemberDrain
+	dst.LoadBalancer = previous.LoadBalancer
+	dst.PoolID = previous.PoolID
+	dst.Listener = previous.Listener
+}
+
+func restoreOpenStackClusterStatus(previous, dst *infrav1.OpenStackClusterStatus) {
+	dst.APIServerLoadBalancerReplacement = previous.APIServerLoadBalancerReplacement
+	dst.APIServerVirtualIP = previous.APIServerVirtualIP
+	dst.AdditionalLoadBalancers = previous.AdditionalLoadBalancers
+	if previous.APIServerManagedLoadBalancer != nil && dst.APIServerManagedLoadBalancer != nil {
+		dst.APIServerManagedLoadBalancer.Zones = previous.APIServerManagedLoadBalancer.Zones
+		dst.APIServerManagedLoadBalancer.Addresses = previous.APIServerManagedLoadBalancer.Addresses
+		dst.APIServerManagedLoadBalancer.ProvisioningStatus = previous.APIServerManagedLoadBalancer.ProvisioningStatus
+		dst.APIServerManagedLoadBalancer.OperatingStatus = previous.APIServerManagedLoadBalancer.OperatingStatus
+		dst.APIServerManagedLoadBalancer.Listeners = previous.APIServerManagedLoadBalancer.Listeners
+	}
+	if previous.Bastion != nil && dst.Bastion != nil && previous.Bastion.Resolved != nil && dst.Bastion.Resolved != nil {
+		restoreResolvedPortSpecs(previous.Bastion.Resolved.Ports, dst.Bastion.Resolved.Ports)
+	}
+}
+
+func restoreOpenStackMachineSpec(previous, dst *infrav1.OpenStackMachineSpec) {
+	if len(previous.Ports) != len(dst.Ports) {
+		return
+	}
+	for i := range dst.Ports {
+		dst.Ports[i].QoSPolicy = previous.Ports[i].QoSPolicy
+		restoreResolvedPortSpecFields(&previous.Ports[i].ResolvedPortSpecFields, &dst.Ports[i].ResolvedPortSpecFields)
+	}
+}
+
+func restoreOpenStackMachineStatus(previous, dst *infrav1.OpenStackMachineStatus) {
+	if previous.Resolved != nil && dst.Resolved != nil {
+		restoreResolvedPortSpecs(previous.Resolved.Ports, dst.Resolved.Ports)
+	}
+}
+
+func restoreResolvedPortSpecs(previous, dst []infrav1.ResolvedPortSpec) {
+	if len(previous) != len(dst) {
+		return
+	}
+	for i := range dst {
+		dst[i].QoSPolicyID = previous[i].QoSPolicyID
+		restoreResolvedPortSpecFields(&previous[i].ResolvedPortSpecFields, &dst[i].ResolvedPortSpecFields)
+	}
+}
+
+func restoreResolvedPortSpecFields(previous, dst *infrav1.ResolvedPortSpecFields) {
+	dst.DNSName = previous.DNSName
+	dst.DNSDomain = previous.DNSDomain
+}
+
 // Manual conversion functions for Status types that conversion-gen cannot
 // auto-generate due to FailureDomains (map↔slice), Conditions (CAPI↔metav1),
 // and deprecated fields (Ready, FailureReason, FailureMessage).
 
-func Convert_v1beta1_OpenStackClusterStatus_To_v1beta2_OpenStackClusterStatus(in *OpenStackClusterStatus, out *infrav1.OpenStackClusterStatus, _ apiconversion.Scope) error {
+func Convert_v1beta1_OpenStackClusterStatus_To_v1beta2_OpenStackClusterStatus(in *OpenStackClusterStatus, out *infrav1.OpenStackClusterStatus, s apiconversion.Scope) error {
 	out.Initialization = (*infrav1.ClusterInitialization)(unsafe.Pointer(in.Initialization))
 	out.Network = (*infrav1.NetworkStatusWithSubnets)(unsafe.Pointer(in.Network))
 	out.ExternalNetwork = (*infrav1.NetworkStatus)(unsafe.Pointer(in.ExternalNetwork))
 	out.Router = (*infrav1.Router)(unsafe.Pointer(in.Router))
-	out.APIServerManagedLoadBalancer = (*infrav1.LoadBalancer)(unsafe.Pointer(in.APIServerLoadBalancer))
+	if in.APIServerLoadBalancer != nil {
+		out.APIServerManagedLoadBalancer = &infrav1.LoadBalancer{}
+		if err := Convert_v1beta1_LoadBalancer_To_v1beta2_LoadBalancer(in.APIServerLoadBalancer, out.APIServerManagedLoadBalancer, s); err != nil {
+			return err
+		}
+	}
 	out.ControlPlaneSecurityGroup = (*infrav1.SecurityGroupStatus)(unsafe.Pointer(in.ControlPlaneSecurityGroup))
 	out.WorkerSecurityGroup = (*infrav1.SecurityGroupStatus)(unsafe.Pointer(in.WorkerSecurityGroup))
 	out.BastionSecurityGroup = (*infrav1.SecurityGroupStatus)(unsafe.Pointer(in.BastionSecurityGroup))
-	out.Bastion = (*infrav1.BastionStatus)(unsafe.Pointer(in.Bastion))
+	if in.Bastion != nil {
+		out.Bastion = &infrav1.BastionStatus{}
+		if err := Convert_v1beta1_BastionStatus_To_v1beta2_BastionStatus(in.Bastion, out.Bastion, s); err != nil {
+			return err
+		}
+	}
 
 	if len(in.FailureDomains) > 0 {
 		out.FailureDomains = make([]clusterv1.FailureDomain, 0, len(in.FailureDomains))
@@ -238,16 +406,26 @@ func Convert_v1beta1_OpenStackClusterStatus_To_v1beta2_OpenStackClusterStatus(in
 	return nil
 }
 
-func Convert_v1beta2_OpenStackClusterStatus_To_v1beta1_OpenStackClusterStatus(in *infrav1.OpenStackClusterStatus, out *OpenStackClusterStatus, _ apiconversion.Scope) error {
+func Convert_v1beta2_OpenStackClusterStatus_To_v1beta1_OpenStackClusterStatus(in *infrav1.OpenStackClusterStatus, out *OpenStackClusterStatus, s apiconversion.Scope) error {
 	out.Initialization = (*ClusterInitialization)(unsafe.Pointer(in.Initialization))
 	out.Network = (*NetworkStatusWithSubnets)(unsafe.Pointer(in.Network))
 	out.ExternalNetwork = (*NetworkStatus)(unsafe.Pointer(in.ExternalNetwork))
 	out.Router = (*Router)(unsafe.Pointer(in.Router))
-	out.APIServerLoadBalancer = (*LoadBalancer)(unsafe.Pointer(in.APIServerManagedLoadBalancer))
+	if in.APIServerManagedLoadBalancer != nil {
+		out.APIServerLoadBalancer = &LoadBalancer{}
+		if err := Convert_v1beta2_LoadBalancer_To_v1beta1_LoadBalancer(in.APIServerManagedLoadBalancer, out.APIServerLoadBalancer, s); err != nil {
+			return err
+		}
+	}
 	out.ControlPlaneSecurityGroup = (*SecurityGroupStatus)(unsafe.Pointer(in.ControlPlaneSecurityGroup))
 	out.WorkerSecurityGroup = (*SecurityGroupStatus)(unsafe.Pointer(in.WorkerSecurityGroup))
 	out.BastionSecurityGroup = (*SecurityGroupStatus)(unsafe.Pointer(in.BastionSecurityGroup))
-	out.Bastion = (*BastionStatus)(unsafe.Pointer(in.Bastion))
+	if in.Bastion != nil {
+		out.Bastion = &BastionStatus{}
+		if err := Convert_v1beta2_BastionStatus_To_v1beta1_BastionStatus(in.Bastion, out.Bastion, s); err != nil {
+			return err
+		}
+	}
 
 	if len(in.FailureDomains) > 0 {
 		out.FailureDomains = make(clusterv1beta1.FailureDomains, len(in.FailureDomains))
@@ -265,12 +443,17 @@ func Convert_v1beta2_OpenStackClusterStatus_To_v1beta1_OpenStackClusterStatus(in
 	return nil
 }
 
-func Convert_v1beta1_OpenStackMachineStatus_To_v1beta2_OpenStackMachineStatus(in *OpenStackMachineStatus, out *infrav1.OpenStackMachineStatus, _ apiconversion.Scope) error {
+func Convert_v1beta1_OpenStackMachineStatus_To_v1beta2_OpenStackMachineStatus(in *OpenStackMachineStatus, out *infrav1.OpenStackMachineStatus, s apiconversion.Scope) error {
 	out.Initialization = (*infrav1.MachineInitialization)(unsafe.Pointer(in.Initialization))
 	out.InstanceID = in.InstanceID
 	out.Addresses = in.Addresses
 	out.InstanceState = (*infrav1.InstanceState)(unsafe.Pointer(in.InstanceState))
-	out.Resolved = (*infrav1.ResolvedMachineSpec)(unsafe.Pointer(in.Resolved))
+	if in.Resolved != nil {
+		out.Resolved = &infrav1.ResolvedMachineSpec{}
+		if err := Convert_v1beta1_ResolvedMachineSpec_To_v1beta2_ResolvedMachineSpec(in.Resolved, out.Resolved, s); err != nil {
+			return err
+		}
+	}
 	out.Resources = (*infrav1.MachineResources)(unsafe.Pointer(in.Resources))
 
 	out.Conditions = infrav1.ConvertConditionsToV1Beta2(in.Conditions, 0)
@@ -278,12 +461,17 @@ func Convert_v1beta1_OpenStackMachineStatus_To_v1beta2_OpenStackMachineStatus(in
 	return nil
 }
 
-func Convert_v1beta2_OpenStackMachineStatus_To_v1beta1_OpenStackMachineStatus(in *infrav1.OpenStackMachineStatus, out *OpenStackMachineStatus, _ apiconversion.Scope) error {
+func Convert_v1beta2_OpenStackMachineStatus_To_v1beta1_OpenStackMachineStatus(in *infrav1.OpenStackMachineStatus, out *OpenStackMachineStatus, s apiconversion.Scope) error {
 	out.Initialization = (*MachineInitialization)(unsafe.Pointer(in.Initialization))
 	out.InstanceID = in.InstanceID
 	out.Addresses = in.Addresses
 	out.InstanceState = (*InstanceState)(unsafe.Pointer(in.InstanceState))
-	out.Resolved = (*ResolvedMachineSpec)(unsafe.Pointer(in.Resolved))
+	if in.Resolved != nil {
+		out.Resolved = &ResolvedMachineSpec{}
+		if err := Convert_v1beta2_ResolvedMachineSpec_To_v1beta1_ResolvedMachineSpec(in.Resolved, out.Resolved, s); err != nil {
+			return err
+		}
+	}
 	out.Resources = (*MachineResources)(unsafe.Pointer(in.Resources))
 
 	out.Conditions = infrav1.ConvertConditionsFromV1Beta2(in.Conditions)
@@ -681,6 +869,47 @@ func Convert_v1beta2_ResolvedPortSpecFields_To_v1beta1_ResolvedPortSpecFields(in
 	return nil
 }
 
+func Convert_v1beta2_APIServerLoadBalancer_To_v1beta1_APIServerLoadBalancer(in *infrav1.APIServerLoadBalancer, out *APIServerLoadBalancer, s apiconversion.Scope) error {
+	// in.TLS, in.Zones, in.MemberDrain, in.LoadBalancer, in.PoolID and
+	// in.Listener are hub-only and are restored from the conversion-data
+	// annotation instead.
+	return autoConvert_v1beta2_APIServerLoadBalancer_To_v1beta1_APIServerLoadBalancer(in, out, s)
+}
+
+func Convert_v1beta2_APIServerLoadBalancerMonitor_To_v1beta1_APIServerLoadBalancerMonitor(in *infrav1.APIServerLoadBalancerMonitor, out *APIServerLoadBalancerMonitor, s apiconversion.Scope) error {
+	// in.Type, in.URLPath and in.ExpectedCodes are hub-only and are restored
+	// from the conversion-data annotation instead.
+	return autoConvert_v1beta2_APIServerLoadBalancerMonitor_To_v1beta1_APIServerLoadBalancerMonitor(in, out, s)
+}
+
+func Convert_v1beta2_LoadBalancer_To_v1beta1_LoadBalancer(in *infrav1.LoadBalancer, out *LoadBalancer, s apiconversion.Scope) error {
+	// in.Zones, in.Addresses, in.ProvisioningStatus, in.OperatingStatus and
+	// in.Listeners are hub-only and are restored from the conversion-data
+	// annotation instead.
+	return autoConvert_v1beta2_LoadBalancer_To_v1beta1_LoadBalancer(in, out, s)
+}
+
+func Convert_v1beta2_SubnetSpec_To_v1beta1_SubnetSpec(in *infrav1.SubnetSpec, out *SubnetSpec, s apiconversion.Scope) error {
+	// in.SubnetPool, in.PrefixLength, in.IPv6AddressMode and in.IPv6RAMode are hub-only
+	// and are restored from the conversion-data annotation instead.
+	return autoConvert_v1beta2_SubnetSpec_To_v1beta1_SubnetSpec(in, out, s)
+}
+
+func Convert_v1beta2_PortOpts_To_v1beta1_PortOpts(in *infrav1.PortOpts, out *PortOpts, s apiconversion.Scope) error {
+	// in.QoSPolicy is hub-only and is restored from the conversion-data annotation instead.
+	return autoConvert_v1beta2_PortOpts_To_v1beta1_PortOpts(in, out, s)
+}
+
+func Convert_v1beta2_ResolvedPortSpec_To_v1beta1_ResolvedPortSpec(in *infrav1.ResolvedPortSpec, out *ResolvedPortSpec, s apiconversion.Scope) error {
+	// in.QoSPolicyID is hub-only and is restored from the conversion-data annotation instead.
+	return autoConvert_v1beta2_ResolvedPortSpec_To_v1beta1_ResolvedPortSpec(in, out, s)
+}
+
+func Convert_v1beta2_Bastion_To_v1beta1_Bastion(in *infrav1.Bastion, out *Bastion, s apiconversion.Scope) error {
+	// in.FloatingIPDNS is hub-only and is restored from the conversion-data annotation instead.
+	return autoConvert_v1beta2_Bastion_To_v1beta1_Bastion(in, out, s)
+}
+
 func Convert_v1beta2_OpenStackClusterTemplateResource_To_v1beta1_OpenStackClusterTemplateResource(in *infrav1.OpenStackClusterTemplateResource, out *OpenStackClusterTemplateResource, s apiconversion.Scope) error {
 	// in.ObjectMeta is dropped here and preserved via the conversion-data annotation instead.
 	return autoConvert_v1beta2_OpenStackClusterTemplateResource_To_v1beta1_OpenStackClusterTemplateResource(in, out, s)
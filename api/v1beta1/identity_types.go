@@ -19,25 +19,33 @@ package v1beta1
 // OpenStackIdentityReference is a reference to an infrastructure
 // provider identity to be used to provision cluster resources.
 // +kubebuilder:validation:XValidation:rule="(!has(self.region) && !has(oldSelf.region)) || self.region == oldSelf.region",message="region is immutable"
+// +kubebuilder:validation:XValidation:rule="(has(self.type) && self.type in ['ApplicationCredential', 'Token', 'Federated']) || has(self.cloudName)",message="cloudName is required for identity types Secret and ClusterIdentity"
 type OpenStackIdentityReference struct {
 	// Type specifies the identity reference type. Defaults to Secret for backward compatibility.
-	// +kubebuilder:validation:Enum=Secret;ClusterIdentity
+	// +kubebuilder:validation:Enum=Secret;ClusterIdentity;ApplicationCredential;Token;Federated
 	// +kubebuilder:default=Secret
 	// +kubebuilder:validation:Required
 	Type string `json:"type,omitempty"`
 
-	// Name is the name of a Secret (type=Secret) in the same namespace as the resource being provisioned,
-	// or the name of an OpenStackClusterIdentity (type=ClusterIdentity).
-	// The Secret must contain a key named `clouds.yaml` which contains an OpenStack clouds.yaml file.
+	// Name is the name of a Secret (type=Secret, ApplicationCredential, Token or Federated) in the same namespace as
+	// the resource being provisioned, or the name of an OpenStackClusterIdentity (type=ClusterIdentity).
+	// For type=Secret the Secret must contain a key named `clouds.yaml` which contains an OpenStack clouds.yaml file.
+	// For type=ApplicationCredential the Secret must contain the keys `authURL` and `applicationCredentialSecret`,
+	// and either `applicationCredentialID`, or `applicationCredentialName` together with `userID` or with
+	// `userName` and `userDomainName`.
+	// For type=Token the Secret must contain the keys `authURL` and `token`.
+	// For type=Federated the Secret must contain the keys `authURL`, `identityProvider` and `protocol`, and either
+	// `trustID`, `projectID`, or `projectName` together with `projectDomainName` or `projectDomainID`.
 	// The Secret may optionally contain a key named `cacert` containing a PEM-encoded CA certificate.
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:MinLength=1
 	Name string `json:"name"`
 
 	// CloudName specifies the name of the entry in the clouds.yaml file to use.
-	// +kubebuilder:validation:Required
+	// It is required for type=Secret and type=ClusterIdentity, and ignored otherwise.
 	// +kubebuilder:validation:MinLength=1
-	CloudName string `json:"cloudName"`
+	// +optional
+	CloudName string `json:"cloudName,omitempty"`
 
 	// Region specifies an OpenStack region to use. If specified, it overrides
 	// any value in clouds.yaml. If specified for an OpenStackMachine, its
@@ -46,21 +46,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.APIServerLoadBalancer)(nil), (*APIServerLoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_APIServerLoadBalancer_To_v1beta1_APIServerLoadBalancer(a.(*v1beta2.APIServerLoadBalancer), b.(*APIServerLoadBalancer), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*APIServerLoadBalancerMonitor)(nil), (*v1beta2.APIServerLoadBalancerMonitor)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_APIServerLoadBalancerMonitor_To_v1beta2_APIServerLoadBalancerMonitor(a.(*APIServerLoadBalancerMonitor), b.(*v1beta2.APIServerLoadBalancerMonitor), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.APIServerLoadBalancerMonitor)(nil), (*APIServerLoadBalancerMonitor)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_APIServerLoadBalancerMonitor_To_v1beta1_APIServerLoadBalancerMonitor(a.(*v1beta2.APIServerLoadBalancerMonitor), b.(*APIServerLoadBalancerMonitor), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*AdditionalBlockDevice)(nil), (*v1beta2.AdditionalBlockDevice)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AdditionalBlockDevice_To_v1beta2_AdditionalBlockDevice(a.(*AdditionalBlockDevice), b.(*v1beta2.AdditionalBlockDevice), scope)
 	}); err != nil {
@@ -96,11 +86,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.Bastion)(nil), (*Bastion)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_Bastion_To_v1beta1_Bastion(a.(*v1beta2.Bastion), b.(*Bastion), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*BastionStatus)(nil), (*v1beta2.BastionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_BastionStatus_To_v1beta2_BastionStatus(a.(*BastionStatus), b.(*v1beta2.BastionStatus), scope)
 	}); err != nil {
@@ -206,11 +191,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.LoadBalancer)(nil), (*LoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_LoadBalancer_To_v1beta1_LoadBalancer(a.(*v1beta2.LoadBalancer), b.(*LoadBalancer), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*MachineInitialization)(nil), (*v1beta2.MachineInitialization)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MachineInitialization_To_v1beta2_MachineInitialization(a.(*MachineInitialization), b.(*v1beta2.MachineInitialization), scope)
 	}); err != nil {
@@ -421,11 +401,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.PortOpts)(nil), (*PortOpts)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_PortOpts_To_v1beta1_PortOpts(a.(*v1beta2.PortOpts), b.(*PortOpts), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*PortStatus)(nil), (*v1beta2.PortStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_PortStatus_To_v1beta2_PortStatus(a.(*PortStatus), b.(*v1beta2.PortStatus), scope)
 	}); err != nil {
@@ -461,11 +436,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.ResolvedPortSpec)(nil), (*ResolvedPortSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_ResolvedPortSpec_To_v1beta1_ResolvedPortSpec(a.(*v1beta2.ResolvedPortSpec), b.(*ResolvedPortSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ResourceReference)(nil), (*v1beta2.ResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ResourceReference_To_v1beta2_ResourceReference(a.(*ResourceReference), b.(*v1beta2.ResourceReference), scope)
 	}); err != nil {
@@ -641,11 +611,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta2.SubnetSpec)(nil), (*SubnetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta2_SubnetSpec_To_v1beta1_SubnetSpec(a.(*v1beta2.SubnetSpec), b.(*SubnetSpec), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*ValueSpec)(nil), (*v1beta2.ValueSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ValueSpec_To_v1beta2_ValueSpec(a.(*ValueSpec), b.(*v1beta2.ValueSpec), scope)
 	}); err != nil {
@@ -706,6 +671,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.APIServerLoadBalancerMonitor)(nil), (*APIServerLoadBalancerMonitor)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_APIServerLoadBalancerMonitor_To_v1beta1_APIServerLoadBalancerMonitor(a.(*v1beta2.APIServerLoadBalancerMonitor), b.(*APIServerLoadBalancerMonitor), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.APIServerLoadBalancer)(nil), (*APIServerLoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_APIServerLoadBalancer_To_v1beta1_APIServerLoadBalancer(a.(*v1beta2.APIServerLoadBalancer), b.(*APIServerLoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.Bastion)(nil), (*Bastion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_Bastion_To_v1beta1_Bastion(a.(*v1beta2.Bastion), b.(*Bastion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.LoadBalancer)(nil), (*LoadBalancer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_LoadBalancer_To_v1beta1_LoadBalancer(a.(*v1beta2.LoadBalancer), b.(*LoadBalancer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.ManagedSecurityGroups)(nil), (*ManagedSecurityGroups)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_ManagedSecurityGroups_To_v1beta1_ManagedSecurityGroups(a.(*v1beta2.ManagedSecurityGroups), b.(*ManagedSecurityGroups), scope)
 	}); err != nil {
@@ -736,11 +721,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.PortOpts)(nil), (*PortOpts)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_PortOpts_To_v1beta1_PortOpts(a.(*v1beta2.PortOpts), b.(*PortOpts), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*v1beta2.ResolvedPortSpecFields)(nil), (*ResolvedPortSpecFields)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta2_ResolvedPortSpecFields_To_v1beta1_ResolvedPortSpecFields(a.(*v1beta2.ResolvedPortSpecFields), b.(*ResolvedPortSpecFields), scope)
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta2.ResolvedPortSpec)(nil), (*ResolvedPortSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_ResolvedPortSpec_To_v1beta1_ResolvedPortSpec(a.(*v1beta2.ResolvedPortSpec), b.(*ResolvedPortSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*v1beta2.SubnetSpec)(nil), (*SubnetSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta2_SubnetSpec_To_v1beta1_SubnetSpec(a.(*v1beta2.SubnetSpec), b.(*SubnetSpec), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -824,14 +824,15 @@ func autoConvert_v1beta2_APIServerLoadBalancer_To_v1beta1_APIServerLoadBalancer(
 	} else {
 		out.Monitor = nil
 	}
+	// WARNING: in.TLS requires manual conversion: does not exist in peer-type
+	// WARNING: in.Zones requires manual conversion: does not exist in peer-type
+	// WARNING: in.MemberDrain requires manual conversion: does not exist in peer-type
+	// WARNING: in.LoadBalancer requires manual conversion: does not exist in peer-type
+	// WARNING: in.PoolID requires manual conversion: does not exist in peer-type
+	// WARNING: in.Listener requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_APIServerLoadBalancer_To_v1beta1_APIServerLoadBalancer is an autogenerated conversion function.
-func Convert_v1beta2_APIServerLoadBalancer_To_v1beta1_APIServerLoadBalancer(in *v1beta2.APIServerLoadBalancer, out *APIServerLoadBalancer, s conversion.Scope) error {
-	return autoConvert_v1beta2_APIServerLoadBalancer_To_v1beta1_APIServerLoadBalancer(in, out, s)
-}
-
 func autoConvert_v1beta1_APIServerLoadBalancerMonitor_To_v1beta2_APIServerLoadBalancerMonitor(in *APIServerLoadBalancerMonitor, out *v1beta2.APIServerLoadBalancerMonitor, s conversion.Scope) error {
 	out.Delay = int32(in.Delay)
 	out.Timeout = int32(in.Timeout)
@@ -850,14 +851,12 @@ func autoConvert_v1beta2_APIServerLoadBalancerMonitor_To_v1beta1_APIServerLoadBa
 	out.Timeout = int(in.Timeout)
 	out.MaxRetries = int(in.MaxRetries)
 	out.MaxRetriesDown = int(in.MaxRetriesDown)
+	// WARNING: in.Type requires manual conversion: does not exist in peer-type
+	// WARNING: in.URLPath requires manual conversion: does not exist in peer-type
+	// WARNING: in.ExpectedCodes requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_APIServerLoadBalancerMonitor_To_v1beta1_APIServerLoadBalancerMonitor is an autogenerated conversion function.
-func Convert_v1beta2_APIServerLoadBalancerMonitor_To_v1beta1_APIServerLoadBalancerMonitor(in *v1beta2.APIServerLoadBalancerMonitor, out *APIServerLoadBalancerMonitor, s conversion.Scope) error {
-	return autoConvert_v1beta2_APIServerLoadBalancerMonitor_To_v1beta1_APIServerLoadBalancerMonitor(in, out, s)
-}
-
 func autoConvert_v1beta1_AdditionalBlockDevice_To_v1beta2_AdditionalBlockDevice(in *AdditionalBlockDevice, out *v1beta2.AdditionalBlockDevice, s conversion.Scope) error {
 	out.Name = in.Name
 	out.SizeGiB = int32(in.SizeGiB)
@@ -964,14 +963,10 @@ func autoConvert_v1beta2_Bastion_To_v1beta1_Bastion(in *v1beta2.Bastion, out *Ba
 	}
 	out.AvailabilityZone = (optional.String)(unsafe.Pointer(in.AvailabilityZone))
 	out.FloatingIP = (optional.String)(unsafe.Pointer(in.FloatingIP))
+	// WARNING: in.FloatingIPDNS requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_Bastion_To_v1beta1_Bastion is an autogenerated conversion function.
-func Convert_v1beta2_Bastion_To_v1beta1_Bastion(in *v1beta2.Bastion, out *Bastion, s conversion.Scope) error {
-	return autoConvert_v1beta2_Bastion_To_v1beta1_Bastion(in, out, s)
-}
-
 func autoConvert_v1beta1_BastionStatus_To_v1beta2_BastionStatus(in *BastionStatus, out *v1beta2.BastionStatus, s conversion.Scope) error {
 	out.ID = in.ID
 	out.Name = in.Name
@@ -1268,14 +1263,14 @@ func autoConvert_v1beta2_LoadBalancer_To_v1beta1_LoadBalancer(in *v1beta2.LoadBa
 	out.AllowedCIDRs = *(*[]string)(unsafe.Pointer(&in.AllowedCIDRs))
 	out.Tags = *(*[]string)(unsafe.Pointer(&in.Tags))
 	out.LoadBalancerNetwork = (*NetworkStatusWithSubnets)(unsafe.Pointer(in.LoadBalancerNetwork))
+	// WARNING: in.Zones requires manual conversion: does not exist in peer-type
+	// WARNING: in.Addresses requires manual conversion: does not exist in peer-type
+	// WARNING: in.ProvisioningStatus requires manual conversion: does not exist in peer-type
+	// WARNING: in.OperatingStatus requires manual conversion: does not exist in peer-type
+	// WARNING: in.Listeners requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_LoadBalancer_To_v1beta1_LoadBalancer is an autogenerated conversion function.
-func Convert_v1beta2_LoadBalancer_To_v1beta1_LoadBalancer(in *v1beta2.LoadBalancer, out *LoadBalancer, s conversion.Scope) error {
-	return autoConvert_v1beta2_LoadBalancer_To_v1beta1_LoadBalancer(in, out, s)
-}
-
 func autoConvert_v1beta1_MachineInitialization_To_v1beta2_MachineInitialization(in *MachineInitialization, out *v1beta2.MachineInitialization, s conversion.Scope) error {
 	out.Provisioned = in.Provisioned
 	return nil
@@ -1569,7 +1564,17 @@ func Convert_v1beta2_OpenStackClusterList_To_v1beta1_OpenStackClusterList(in *v1
 }
 
 func autoConvert_v1beta1_OpenStackClusterSpec_To_v1beta2_OpenStackClusterSpec(in *OpenStackClusterSpec, out *v1beta2.OpenStackClusterSpec, s conversion.Scope) error {
-	out.ManagedSubnets = *(*[]v1beta2.SubnetSpec)(unsafe.Pointer(&in.ManagedSubnets))
+	if in.ManagedSubnets != nil {
+		in, out := &in.ManagedSubnets, &out.ManagedSubnets
+		*out = make([]v1beta2.SubnetSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_SubnetSpec_To_v1beta2_SubnetSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ManagedSubnets = nil
+	}
 	out.Router = (*v1beta2.RouterParam)(unsafe.Pointer(in.Router))
 	out.Network = (*v1beta2.NetworkParam)(unsafe.Pointer(in.Network))
 	if in.Subnets != nil {
@@ -1631,7 +1636,17 @@ func autoConvert_v1beta1_OpenStackClusterSpec_To_v1beta2_OpenStackClusterSpec(in
 }
 
 func autoConvert_v1beta2_OpenStackClusterSpec_To_v1beta1_OpenStackClusterSpec(in *v1beta2.OpenStackClusterSpec, out *OpenStackClusterSpec, s conversion.Scope) error {
-	out.ManagedSubnets = *(*[]SubnetSpec)(unsafe.Pointer(&in.ManagedSubnets))
+	if in.ManagedSubnets != nil {
+		in, out := &in.ManagedSubnets, &out.ManagedSubnets
+		*out = make([]SubnetSpec, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta2_SubnetSpec_To_v1beta1_SubnetSpec(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ManagedSubnets = nil
+	}
 	if in.Subnets != nil {
 		in, out := &in.Subnets, &out.Subnets
 		*out = make([]SubnetParam, len(*in))
@@ -1659,6 +1674,7 @@ func autoConvert_v1beta2_OpenStackClusterSpec_To_v1beta1_OpenStackClusterSpec(in
 	out.ExternalNetwork = (*NetworkParam)(unsafe.Pointer(in.ExternalNetwork))
 	// WARNING: in.EnableExternalNetwork requires manual conversion: does not exist in peer-type
 	// WARNING: in.APIServer requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalLoadBalancers requires manual conversion: does not exist in peer-type
 	if in.ManagedSecurityGroups != nil {
 		in, out := &in.ManagedSecurityGroups, &out.ManagedSecurityGroups
 		*out = new(ManagedSecurityGroups)
@@ -1740,6 +1756,9 @@ func autoConvert_v1beta2_OpenStackClusterStatus_To_v1beta1_OpenStackClusterStatu
 	out.ExternalNetwork = (*NetworkStatus)(unsafe.Pointer(in.ExternalNetwork))
 	out.Router = (*Router)(unsafe.Pointer(in.Router))
 	// WARNING: in.APIServerManagedLoadBalancer requires manual conversion: does not exist in peer-type
+	// WARNING: in.APIServerLoadBalancerReplacement requires manual conversion: does not exist in peer-type
+	// WARNING: in.APIServerVirtualIP requires manual conversion: does not exist in peer-type
+	// WARNING: in.AdditionalLoadBalancers requires manual conversion: does not exist in peer-type
 	// WARNING: in.FailureDomains requires manual conversion: inconvertible types ([]sigs.k8s.io/cluster-api/api/core/v1beta2.FailureDomain vs sigs.k8s.io/cluster-api/api/core/v1beta1.FailureDomains)
 	out.ControlPlaneSecurityGroup = (*SecurityGroupStatus)(unsafe.Pointer(in.ControlPlaneSecurityGroup))
 	out.WorkerSecurityGroup = (*SecurityGroupStatus)(unsafe.Pointer(in.WorkerSecurityGroup))
@@ -2365,6 +2384,7 @@ func autoConvert_v1beta2_PortOpts_To_v1beta1_PortOpts(in *v1beta2.PortOpts, out
 		out.FixedIPs = nil
 	}
 	out.SecurityGroups = *(*[]SecurityGroupParam)(unsafe.Pointer(&in.SecurityGroups))
+	// WARNING: in.QoSPolicy requires manual conversion: does not exist in peer-type
 	out.Tags = *(*[]string)(unsafe.Pointer(&in.Tags))
 	out.Trunk = (*bool)(unsafe.Pointer(in.Trunk))
 	if err := Convert_v1beta2_ResolvedPortSpecFields_To_v1beta1_ResolvedPortSpecFields(&in.ResolvedPortSpecFields, &out.ResolvedPortSpecFields, s); err != nil {
@@ -2373,11 +2393,6 @@ func autoConvert_v1beta2_PortOpts_To_v1beta1_PortOpts(in *v1beta2.PortOpts, out
 	return nil
 }
 
-// Convert_v1beta2_PortOpts_To_v1beta1_PortOpts is an autogenerated conversion function.
-func Convert_v1beta2_PortOpts_To_v1beta1_PortOpts(in *v1beta2.PortOpts, out *PortOpts, s conversion.Scope) error {
-	return autoConvert_v1beta2_PortOpts_To_v1beta1_PortOpts(in, out, s)
-}
-
 func autoConvert_v1beta1_PortStatus_To_v1beta2_PortStatus(in *PortStatus, out *v1beta2.PortStatus, s conversion.Scope) error {
 	out.ID = in.ID
 	return nil
@@ -2493,17 +2508,13 @@ func autoConvert_v1beta2_ResolvedPortSpec_To_v1beta1_ResolvedPortSpec(in *v1beta
 	out.Trunk = (optional.Bool)(unsafe.Pointer(in.Trunk))
 	out.FixedIPs = *(*[]ResolvedFixedIP)(unsafe.Pointer(&in.FixedIPs))
 	out.SecurityGroups = *(*[]string)(unsafe.Pointer(&in.SecurityGroups))
+	// WARNING: in.QoSPolicyID requires manual conversion: does not exist in peer-type
 	if err := Convert_v1beta2_ResolvedPortSpecFields_To_v1beta1_ResolvedPortSpecFields(&in.ResolvedPortSpecFields, &out.ResolvedPortSpecFields, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1beta2_ResolvedPortSpec_To_v1beta1_ResolvedPortSpec is an autogenerated conversion function.
-func Convert_v1beta2_ResolvedPortSpec_To_v1beta1_ResolvedPortSpec(in *v1beta2.ResolvedPortSpec, out *ResolvedPortSpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_ResolvedPortSpec_To_v1beta1_ResolvedPortSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_ResolvedPortSpecFields_To_v1beta2_ResolvedPortSpecFields(in *ResolvedPortSpecFields, out *v1beta2.ResolvedPortSpecFields, s conversion.Scope) error {
 	out.AdminStateUp = (*bool)(unsafe.Pointer(in.AdminStateUp))
 	out.MACAddress = (optional.String)(unsafe.Pointer(in.MACAddress))
@@ -2527,6 +2538,8 @@ func autoConvert_v1beta2_ResolvedPortSpecFields_To_v1beta1_ResolvedPortSpecField
 	// WARNING: in.EnablePortSecurity requires manual conversion: does not exist in peer-type
 	out.PropagateUplinkStatus = (*bool)(unsafe.Pointer(in.PropagateUplinkStatus))
 	out.ValueSpecs = *(*[]ValueSpec)(unsafe.Pointer(&in.ValueSpecs))
+	// WARNING: in.DNSName requires manual conversion: does not exist in peer-type
+	// WARNING: in.DNSDomain requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -3036,16 +3049,15 @@ func Convert_v1beta1_SubnetSpec_To_v1beta2_SubnetSpec(in *SubnetSpec, out *v1bet
 
 func autoConvert_v1beta2_SubnetSpec_To_v1beta1_SubnetSpec(in *v1beta2.SubnetSpec, out *SubnetSpec, s conversion.Scope) error {
 	out.CIDR = in.CIDR
+	// WARNING: in.SubnetPool requires manual conversion: does not exist in peer-type
+	// WARNING: in.PrefixLength requires manual conversion: does not exist in peer-type
 	out.DNSNameservers = *(*[]string)(unsafe.Pointer(&in.DNSNameservers))
 	out.AllocationPools = *(*[]AllocationPool)(unsafe.Pointer(&in.AllocationPools))
+	// WARNING: in.IPv6AddressMode requires manual conversion: does not exist in peer-type
+	// WARNING: in.IPv6RAMode requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta2_SubnetSpec_To_v1beta1_SubnetSpec is an autogenerated conversion function.
-func Convert_v1beta2_SubnetSpec_To_v1beta1_SubnetSpec(in *v1beta2.SubnetSpec, out *SubnetSpec, s conversion.Scope) error {
-	return autoConvert_v1beta2_SubnetSpec_To_v1beta1_SubnetSpec(in, out, s)
-}
-
 func autoConvert_v1beta1_ValueSpec_To_v1beta2_ValueSpec(in *ValueSpec, out *v1beta2.ValueSpec, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Key = in.Key
@@ -28,6 +28,8 @@ const (
 	InvalidMachineSpecReason = "InvalidMachineSpec"
 	// InstanceCreateFailedReason used when creating the instance failed.
 	InstanceCreateFailedReason = "InstanceCreateFailed"
+	// QuotaExceededReason used when creating the instance or its ports would exceed a quota of the project.
+	QuotaExceededReason = "QuotaExceeded"
 	// InstanceNotFoundReason used when the instance couldn't be retrieved.
 	InstanceNotFoundReason = "InstanceNotFound"
 	// InstanceStateErrorReason used when the instance is in error state.
@@ -55,6 +57,21 @@ const (
 	LoadBalancerMemberErrorReason = "LoadBalancerMemberError"
 	// FloatingIPErrorReason used when the floating ip could not be created or attached.
 	FloatingIPErrorReason = "FloatingIPError"
+	// VirtualIPErrorReason used when the instance could not be allowed to hold the virtual IP of the API server.
+	VirtualIPErrorReason = "VirtualIPError"
+
+	// APIServerLoadBalancerMemberDrainedCondition reports on the draining of the API server load balancer members of a Control Plane machine which is being deleted.
+	APIServerLoadBalancerMemberDrainedCondition string = "APIServerLoadBalancerMemberDrained"
+
+	// LoadBalancerMemberDrainingReason used while the members of the instance receive no new connections and the drain period has not elapsed.
+	LoadBalancerMemberDrainingReason = "Draining"
+	// LoadBalancerMemberDrainedReason used when the instance has no members to drain.
+	LoadBalancerMemberDrainedReason = "Drained"
+	// LoadBalancerMemberDrainPeriodElapsedReason used when the drain period elapsed after the members of the instance stopped receiving new connections.
+	LoadBalancerMemberDrainPeriodElapsedReason = "DrainPeriodElapsed"
+
+	// AdditionalLoadBalancerMembersReadyCondition reports on whether the instance is a member of the additional load balancers which select it.
+	AdditionalLoadBalancerMembersReadyCondition string = "AdditionalLoadBalancerMembersReady"
 )
 
 const (
@@ -76,6 +93,19 @@ const (
 
 	// OpenStackAuthenticationFailedReason is used when the controller fails to authenticate with OpenStack.
 	OpenStackAuthenticationFailedReason = "OpenStackAuthenticationFailed"
+
+	// CredentialsValidCondition reports on the expiry of the application credential or token referenced by an
+	// OpenStackCluster's identityRef. The message of the condition contains the time at which the credential expires.
+	CredentialsValidCondition string = "CredentialsValid"
+
+	// CredentialsExpiringReason is used when the credential expires within the configured expiry window.
+	CredentialsExpiringReason = "CredentialsExpiring"
+	// CredentialsRotationFailedReason is used when an OpenStackCredentialRotation could not replace an application
+	// credential.
+	CredentialsRotationFailedReason = "CredentialsRotationFailed"
+	// CredentialsExpiryUnknownReason is used when the expiry of the credential could not be determined, for
+	// example because access rules do not permit the application credential to read itself.
+	CredentialsExpiryUnknownReason = "CredentialsExpiryUnknown"
 )
 
 const (
@@ -107,4 +137,34 @@ const (
 	SecurityGroupReconcileFailedReason = "SecurityGroupCreateFailed"
 	// APIEndpointConfigFailedReason is used when API endpoint configuration fails.
 	APIEndpointConfigFailedReason = "APIEndpointConfigFailed"
+
+	// AdditionalLoadBalancersReadyCondition reports on the current status of the additional load balancers of the cluster.
+	// Ready indicates that the load balancers in spec.additionalLoadBalancers have been successfully provisioned.
+	AdditionalLoadBalancersReadyCondition string = "AdditionalLoadBalancersReady"
+	// AdditionalLoadBalancersReconcileFailedReason is used when reconciling the additional load balancers fails.
+	AdditionalLoadBalancersReconcileFailedReason = "AdditionalLoadBalancersReconcileFailed"
+
+	// LoadBalancerProviderCompatibleCondition reports on whether the Octavia provider of the API server load balancer
+	// supports all features requested in spec.apiServer.managedLoadBalancer. Features the provider does not support are
+	// not configured on the load balancer.
+	LoadBalancerProviderCompatibleCondition string = "LoadBalancerProviderCompatible"
+	// LoadBalancerFeaturesUnsupportedReason is used when the Octavia provider does not support some of the requested features.
+	LoadBalancerFeaturesUnsupportedReason = "LoadBalancerFeaturesUnsupported"
+
+	// APIServerLoadBalancerDegradedCondition reports on the health of the API server load balancer reported by Octavia.
+	// True indicates that some of its members are OFFLINE or ERROR, or that some of its listeners or pools are in ERROR.
+	APIServerLoadBalancerDegradedCondition string = "APIServerLoadBalancerDegraded"
+	// LoadBalancerMembersUnhealthyReason is used when some members of the API server load balancer are OFFLINE or ERROR.
+	LoadBalancerMembersUnhealthyReason = "MembersUnhealthy"
+	// LoadBalancerProvisioningErrorReason is used when some listeners or pools of the API server load balancer are in ERROR.
+	LoadBalancerProvisioningErrorReason = "ProvisioningError"
+	// LoadBalancerNotDegradedReason is used when all members of the API server load balancer are healthy.
+	LoadBalancerNotDegradedReason = "NotDegraded"
+
+	// APIServerLoadBalancerUpToDateCondition reports on whether the API server load balancer has the properties in
+	// spec.apiServer.managedLoadBalancer which Octavia can only change by replacing it.
+	APIServerLoadBalancerUpToDateCondition string = "APIServerLoadBalancerUpToDate"
+	// LoadBalancerReplacementNotPossibleReason is used when the API server load balancer must be replaced, which is
+	// only possible if the API server uses a floating IP.
+	LoadBalancerReplacementNotPossibleReason = "LoadBalancerReplacementNotPossible"
 )
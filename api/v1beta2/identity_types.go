@@ -16,26 +16,60 @@ limitations under the License.
 
 package v1beta2
 
+// Identity reference types.
+const (
+	// IdentityTypeSecret references a Secret containing a clouds.yaml file.
+	IdentityTypeSecret = "Secret"
+	// IdentityTypeClusterIdentity references an OpenStackClusterIdentity.
+	IdentityTypeClusterIdentity = "ClusterIdentity"
+	// IdentityTypeApplicationCredential references a Secret containing a Keystone application credential.
+	IdentityTypeApplicationCredential = "ApplicationCredential"
+	// IdentityTypeToken references a Secret containing a pre-issued Keystone token.
+	IdentityTypeToken = "Token"
+	// IdentityTypeFederated references a Secret describing how to exchange the manager's Kubernetes service account
+	// token for a Keystone token through federation.
+	IdentityTypeFederated = "Federated"
+)
+
 // OpenStackIdentityReference is a reference to an infrastructure
 // provider identity to be used to provision cluster resources.
 // +kubebuilder:validation:XValidation:rule="(!has(self.region) && !has(oldSelf.region)) || self.region == oldSelf.region",message="region is immutable"
+// +kubebuilder:validation:XValidation:rule="(has(self.type) && self.type in ['ApplicationCredential', 'Token', 'Federated']) || has(self.cloudName)",message="cloudName is required for identity types Secret and ClusterIdentity"
 type OpenStackIdentityReference struct {
 	// type specifies the identity reference type. Defaults to Secret for backward compatibility.
-	// +kubebuilder:validation:Enum=Secret;ClusterIdentity
+	// +kubebuilder:validation:Enum=Secret;ClusterIdentity;ApplicationCredential;Token;Federated
 	// +kubebuilder:default=Secret
 	// +optional
 	Type string `json:"type,omitempty"`
 
-	// name is the name of a Secret (type=Secret) in the same namespace as the resource being provisioned,
-	// or the name of an OpenStackClusterIdentity (type=ClusterIdentity).
-	// The Secret must contain a key named `clouds.yaml` which contains an OpenStack clouds.yaml file.
-	// The Secret may optionally contain a key named `cacert` containing a PEM-encoded CA certificate.
+	// name is the name of a Secret (type=Secret, ApplicationCredential, Token or Federated) in the same namespace as
+	// the resource being provisioned, or the name of an OpenStackClusterIdentity (type=ClusterIdentity).
+	//
+	// For type=Secret the Secret must contain a key named `clouds.yaml` which contains an OpenStack clouds.yaml file.
+	//
+	// For type=ApplicationCredential the Secret must contain the keys `authURL` and `applicationCredentialSecret`,
+	// and either `applicationCredentialID`, or `applicationCredentialName` together with `userID` or with
+	// `userName` and `userDomainName`. The application credential may be restricted by access rules.
+	//
+	// For type=Token the Secret must contain the keys `authURL` and `token`, a project-scoped token which is
+	// used until it expires. The token is not renewed by the controllers, so the Secret must be updated with a
+	// new token before the current one expires.
+	//
+	// For type=Federated the Secret must contain the keys `authURL`, `identityProvider` and `protocol`, and either
+	// `trustID`, `projectID`, or `projectName` together with `projectDomainName` or `projectDomainID`. The
+	// manager's projected service account token is exchanged for an unscoped token with the federated identity
+	// provider and protocol, which is then scoped to the trust or project. No long-lived credentials are stored.
+	//
+	// For type=ApplicationCredential, type=Token and type=Federated the Secret may optionally contain the keys
+	// `regionName` and `interface`. For all types the Secret may optionally contain a key named `cacert` containing
+	// a PEM-encoded CA certificate.
 	// +required
 	// +kubebuilder:validation:MinLength=1
 	Name string `json:"name,omitempty"`
 
 	// cloudName specifies the name of the entry in the clouds.yaml file to use.
-	// +required
+	// It is required for type=Secret and type=ClusterIdentity, and ignored otherwise.
+	// +optional
 	// +kubebuilder:validation:MinLength=1
 	CloudName string `json:"cloudName,omitempty"`
 
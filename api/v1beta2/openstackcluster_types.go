@@ -34,9 +34,11 @@ const (
 // +kubebuilder:validation:XValidation:rule="has(self.enableExternalNetwork) && !self.enableExternalNetwork ? has(self.apiServer) && has(self.apiServer.enableFloatingIP) && !self.apiServer.enableFloatingIP : true",message="apiServer.enableFloatingIP cannot be true when enableExternalNetwork is false"
 type OpenStackClusterSpec struct {
 	// managedSubnets describe OpenStack Subnets to be created. Cluster actuator will create a network,
-	// subnets with the defined CIDR, and a router connected to these subnets. Currently only one IPv4
-	// subnet is supported. If you leave this empty, no network will be created.
-	// +kubebuilder:validation:MaxItems=1
+	// subnets with the defined CIDR, and a router connected to these subnets. At most one IPv4 and one
+	// IPv6 subnet are supported, which creates a dual-stack network. If you leave this empty, no network
+	// will be created.
+	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:XValidation:rule="self.filter(s, has(s.cidr) && s.cidr.contains(':')).size() <= 1 && self.filter(s, has(s.cidr) && !s.cidr.contains(':')).size() <= 1",message="managedSubnets can contain at most one IPv4 and one IPv6 subnet"
 	// +listType=atomic
 	// +optional
 	ManagedSubnets []SubnetSpec `json:"managedSubnets,omitempty"`
@@ -107,6 +109,16 @@ type OpenStackClusterSpec struct {
 	// +optional
 	APIServer *APIServer `json:"apiServer,omitempty"`
 
+	// additionalLoadBalancers are Octavia load balancers managed by the
+	// cluster in addition to the API server load balancer, for example for
+	// ingress. Their members are selected from all machines of the cluster.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems:=16
+	// +kubebuilder:validation:XValidation:rule="self.all(lb, lb.name != 'kubeapi')",message="kubeapi is reserved for the API server load balancer"
+	// +optional
+	AdditionalLoadBalancers []AdditionalLoadBalancer `json:"additionalLoadBalancers,omitempty"`
+
 	// managedSecurityGroups determines whether OpenStack security groups for the cluster
 	// will be managed by the OpenStack provider or whether pre-existing security groups will
 	// be specified as part of the configuration.
@@ -159,6 +171,7 @@ type OpenStackClusterSpec struct {
 	IdentityRef OpenStackIdentityReference `json:"identityRef,omitzero"`
 }
 
+// +kubebuilder:validation:XValidation:rule="!has(self.virtualIP) || !has(self.managedLoadBalancer) || (has(self.managedLoadBalancer.enabled) && !self.managedLoadBalancer.enabled)",message="virtualIP cannot be used together with an enabled managedLoadBalancer"
 type APIServer struct {
 	// port is the port on which the API server listener will be created.
 	// If specified, it must be an integer between 0 and 65535.
@@ -187,10 +200,26 @@ type APIServer struct {
 	// +optional
 	EnableFloatingIP optional.Bool `json:"enableFloatingIP,omitempty"`
 
+	// floatingIPDNS sets the DNS name and domain of the floating IP of the
+	// API server load balancer or, without a load balancer, of the floating
+	// IP attached to a control plane machine. It is not applied to the
+	// floating IPs of zonal or additional load balancers or of the API server
+	// virtual IP, which would otherwise claim the same DNS name.
+	// This field is not used if EnableFloatingIP is set to false.
+	// +optional
+	FloatingIPDNS *FloatingIPDNS `json:"floatingIPDNS,omitempty"`
+
 	// managedLoadBalancer configures the optional LoadBalancer for the API server.
 	// If not specified, no load balancer will be created.
 	// +optional
 	ManagedLoadBalancer *APIServerLoadBalancer `json:"managedLoadBalancer,omitempty"`
+
+	// virtualIP configures a virtual IP for the API server which is held by
+	// one of the control plane machines at a time. It is an alternative to
+	// a managed load balancer on clouds without Octavia, and cannot be used
+	// together with an enabled managed load balancer.
+	// +optional
+	VirtualIP *APIServerVirtualIP `json:"virtualIP,omitempty"`
 }
 
 // ClusterInitialization represents the initialization status of the cluster.
@@ -232,6 +261,23 @@ type OpenStackClusterStatus struct {
 	// +optional
 	APIServerManagedLoadBalancer *LoadBalancer `json:"apiServerManagedLoadBalancer,omitempty"`
 
+	// apiServerLoadBalancerReplacement describes the replacement of the api
+	// server load balancer while one is in progress.
+	// +optional
+	APIServerLoadBalancerReplacement *APIServerLoadBalancerReplacement `json:"apiServerLoadBalancerReplacement,omitempty"`
+
+	// apiServerVirtualIP describes the virtual IP of the api server if one
+	// exists
+	// +optional
+	APIServerVirtualIP *VirtualIPStatus `json:"apiServerVirtualIP,omitempty"`
+
+	// additionalLoadBalancers describes the load balancers in
+	// spec.additionalLoadBalancers.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	AdditionalLoadBalancers []AdditionalLoadBalancerStatus `json:"additionalLoadBalancers,omitempty"`
+
 	// failureDomains represent OpenStack availability zones
 	// +listType=map
 	// +listMapKey=name
@@ -320,6 +366,13 @@ type ManagedNetwork struct {
 	// If left empty, the network will have port security setting enabled.
 	// +optional
 	EnablePortSecurity optional.Bool `json:"enablePortSecurity,omitempty"`
+
+	// qosPolicy is the Neutron QoS policy to apply to the network created
+	// for the Kubernetes cluster. It applies to every port of the network
+	// which does not set its own QoS policy.
+	// This value will be used only if the Cluster actuator creates the network.
+	// +optional
+	QoSPolicy *QoSPolicyParam `json:"qosPolicy,omitempty"`
 }
 
 // ManagedSecurityGroups defines the desired state of security groups and rules for the cluster.
@@ -396,6 +449,13 @@ func (a *APIServer) GetFloatingIP() *string {
 	return a.FloatingIP
 }
 
+func (a *APIServer) GetFloatingIPDNS() *FloatingIPDNS {
+	if a == nil {
+		return nil
+	}
+	return a.FloatingIPDNS
+}
+
 func (a *APIServer) GetFixedIP() *string {
 	if a == nil {
 		return nil
@@ -403,6 +463,13 @@ func (a *APIServer) GetFixedIP() *string {
 	return a.FixedIP
 }
 
+func (a *APIServer) GetVirtualIP() *APIServerVirtualIP {
+	if a == nil {
+		return nil
+	}
+	return a.VirtualIP
+}
+
 func (a *APIServer) GetPort() *uint16 {
 	if a == nil {
 		return nil
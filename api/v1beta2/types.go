@@ -19,6 +19,7 @@ package v1beta2
 import (
 	"strings"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/optional"
@@ -237,6 +238,46 @@ func (networkFilter *NetworkFilter) IsZero() bool {
 		networkFilter.FilterByNeutronTags.IsZero()
 }
 
+// QoSPolicyParam specifies an OpenStack QoS policy. It may be specified by either ID or filter, but not both.
+// +kubebuilder:validation:MaxProperties:=1
+// +kubebuilder:validation:MinProperties:=1
+type QoSPolicyParam struct {
+	// id is the ID of the QoS policy to use. If ID is provided, the other filters cannot be provided. Must be in UUID format.
+	// +kubebuilder:validation:Format:=uuid
+	// +optional
+	ID optional.String `json:"id,omitempty"`
+
+	// filter specifies a filter to select an OpenStack QoS policy. If provided, cannot be empty.
+	// +optional
+	Filter *QoSPolicyFilter `json:"filter,omitempty"`
+}
+
+// QoSPolicyFilter specifies a query to select an OpenStack QoS policy. At least one property must be set.
+// +kubebuilder:validation:MinProperties:=1
+type QoSPolicyFilter struct {
+	// name filters QoS policies by name.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// description filters QoS policies by description.
+	// +optional
+	Description string `json:"description,omitempty"`
+	// projectID filters QoS policies by project ID.
+	// +optional
+	ProjectID string `json:"projectID,omitempty"`
+
+	FilterByNeutronTags `json:",inline"`
+}
+
+func (f *QoSPolicyFilter) IsZero() bool {
+	if f == nil {
+		return true
+	}
+	return f.Name == "" &&
+		f.Description == "" &&
+		f.ProjectID == "" &&
+		f.FilterByNeutronTags.IsZero()
+}
+
 // SubnetParam specifies an OpenStack subnet to use. It may be specified by either ID or filter, but not both.
 // +kubebuilder:validation:MaxProperties:=1
 // +kubebuilder:validation:MinProperties:=1
@@ -337,13 +378,120 @@ func (f *RouterFilter) IsZero() bool {
 		f.FilterByNeutronTags.IsZero()
 }
 
+// LoadBalancerParam specifies an Octavia load balancer. It may be specified by ID or filter, but not both.
+// +kubebuilder:validation:MaxProperties:=1
+// +kubebuilder:validation:MinProperties:=1
+type LoadBalancerParam struct {
+	// id is the ID of the load balancer to use. If ID is provided, the other filters cannot be provided. Must be in UUID format.
+	// +kubebuilder:validation:Format:=uuid
+	// +optional
+	ID optional.String `json:"id,omitempty"`
+
+	// filter specifies a filter to select an Octavia load balancer. If provided, cannot be empty.
+	// +optional
+	Filter *LoadBalancerFilter `json:"filter,omitempty"`
+}
+
+// LoadBalancerFilter specifies a query to select an Octavia load balancer. At least one property must be set.
+// +kubebuilder:validation:MinProperties:=1
+type LoadBalancerFilter struct {
+	// name filters load balancers by name.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// description filters load balancers by description.
+	// +optional
+	Description string `json:"description,omitempty"`
+	// projectID filters load balancers by project ID.
+	// +optional
+	ProjectID string `json:"projectID,omitempty"`
+
+	FilterByNeutronTags `json:",inline"`
+}
+
+func (f *LoadBalancerFilter) IsZero() bool {
+	if f == nil {
+		return true
+	}
+	return f.Name == "" &&
+		f.Description == "" &&
+		f.ProjectID == "" &&
+		f.FilterByNeutronTags.IsZero()
+}
+
+// SubnetPoolParam specifies an OpenStack subnet pool. It may be specified by either ID or filter, but not both.
+// +kubebuilder:validation:MaxProperties:=1
+// +kubebuilder:validation:MinProperties:=1
+type SubnetPoolParam struct {
+	// id is the ID of the subnet pool to use. If ID is provided, the other filters cannot be provided. Must be in UUID format.
+	// +kubebuilder:validation:Format:=uuid
+	// +optional
+	ID optional.String `json:"id,omitempty"`
+
+	// filter specifies a filter to select an OpenStack subnet pool. If provided, cannot be empty.
+	// +optional
+	Filter *SubnetPoolFilter `json:"filter,omitempty"`
+}
+
+// SubnetPoolFilter specifies a query to select an OpenStack subnet pool. At least one property must be set.
+// +kubebuilder:validation:MinProperties:=1
+type SubnetPoolFilter struct {
+	// name filters subnet pools by name.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// description filters subnet pools by description.
+	// +optional
+	Description string `json:"description,omitempty"`
+	// projectID filters subnet pools by project ID.
+	// +optional
+	ProjectID string `json:"projectID,omitempty"`
+	// ipVersion filters subnet pools by IP version.
+	// +kubebuilder:validation:Enum=4;6
+	// +optional
+	IPVersion int32 `json:"ipVersion,omitempty"`
+	// addressScopeID filters subnet pools by the ID of their address scope.
+	// +optional
+	AddressScopeID string `json:"addressScopeID,omitempty"`
+
+	FilterByNeutronTags `json:",inline"`
+}
+
+func (f *SubnetPoolFilter) IsZero() bool {
+	if f == nil {
+		return true
+	}
+	return f.Name == "" &&
+		f.Description == "" &&
+		f.ProjectID == "" &&
+		f.IPVersion == 0 &&
+		f.AddressScopeID == "" &&
+		f.FilterByNeutronTags.IsZero()
+}
+
+// +kubebuilder:validation:XValidation:rule="has(self.cidr) != has(self.subnetPool)",message="exactly one of cidr and subnetPool must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.prefixLength) || has(self.subnetPool)",message="prefixLength requires subnetPool"
+// +kubebuilder:validation:XValidation:rule="!has(self.ipv6AddressMode) && !has(self.ipv6RAMode) || !has(self.cidr) || self.cidr.contains(':')",message="ipv6AddressMode and ipv6RAMode require an IPv6 cidr"
 type SubnetSpec struct {
 	// cidr is representing the IP address range used to create the subnet, e.g. 10.0.0.0/24.
-	// This field is required when defining a subnet.
-	// +required
+	// Either cidr or subnetPool must be set.
 	// +kubebuilder:validation:MinLength=1
+	// +optional
 	CIDR string `json:"cidr,omitempty"`
 
+	// subnetPool is the Neutron subnet pool from which the CIDR of the subnet
+	// is allocated, instead of specifying cidr. The allocated CIDR is
+	// reported in the status of the cluster network. The subnet is in the
+	// address scope of the subnet pool, if it has one.
+	// +optional
+	SubnetPool *SubnetPoolParam `json:"subnetPool,omitempty"`
+
+	// prefixLength is the prefix length of the CIDR allocated from
+	// subnetPool. If not set, the default prefix length of the subnet pool
+	// is used.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	PrefixLength *int32 `json:"prefixLength,omitempty"`
+
 	// dnsNameservers holds a list of DNS server addresses that will be provided when creating
 	// the subnet. These addresses need to have the same IP version as CIDR.
 	// +listType=atomic
@@ -356,6 +504,22 @@ type SubnetSpec struct {
 	// +listType=atomic
 	// +optional
 	AllocationPools []AllocationPool `json:"allocationPools,omitempty"`
+
+	// ipv6AddressMode specifies how IPv6 addresses are assigned to ports on
+	// the subnet. It can only be set on an IPv6 subnet, or on a subnet
+	// allocated from an IPv6 subnet pool. If not set, ports
+	// get addresses from the OpenStack IPAM.
+	// +kubebuilder:validation:Enum=slaac;dhcpv6-stateful;dhcpv6-stateless
+	// +optional
+	IPv6AddressMode string `json:"ipv6AddressMode,omitempty"`
+
+	// ipv6RAMode specifies how router advertisements are sent on the subnet
+	// by the router it is attached to. It can only be set on an IPv6 subnet,
+	// or on a subnet allocated from an IPv6 subnet pool. If not set,
+	// OpenStack does not send router advertisements.
+	// +kubebuilder:validation:Enum=slaac;dhcpv6-stateful;dhcpv6-stateless
+	// +optional
+	IPv6RAMode string `json:"ipv6RAMode,omitempty"`
 }
 
 type AllocationPool struct {
@@ -394,6 +558,11 @@ type PortOpts struct {
 	// +listType=atomic
 	SecurityGroups []SecurityGroupParam `json:"securityGroups,omitempty"`
 
+	// qosPolicy is the Neutron QoS policy to apply to the port. If not
+	// specified, the port uses the QoS policy of its network, if any.
+	// +optional
+	QoSPolicy *QoSPolicyParam `json:"qosPolicy,omitempty"`
+
 	// tags applied to the port (and corresponding trunk, if a trunk is configured.)
 	// These tags are applied in addition to the instance's tags, which will also be applied to the port.
 	// +listType=set
@@ -471,6 +640,19 @@ type ResolvedPortSpecFields struct {
 	// +listType=map
 	// +listMapKey=name
 	ValueSpecs []ValueSpec `json:"valueSpecs,omitempty"`
+
+	// dnsName is the DNS name of the port. It is only applied when the
+	// Neutron dns-integration extension is available. If not specified and
+	// dnsDomain is set, it defaults to the hostname Nova derives from the
+	// name of the server the port is attached to.
+	// +optional
+	DNSName optional.String `json:"dnsName,omitempty"`
+
+	// dnsDomain is the DNS domain in which records for the port will be
+	// published by the external DNS service. It is only applied when the
+	// Neutron dns-integration extension is available.
+	// +optional
+	DNSDomain optional.String `json:"dnsDomain,omitempty"`
 }
 
 // ResolvedPortSpec is a PortOpts with all contained references fully resolved.
@@ -508,6 +690,10 @@ type ResolvedPortSpec struct {
 	// +listType=atomic
 	SecurityGroups []string `json:"securityGroups,omitempty"`
 
+	// qosPolicyID is the ID of the QoS policy to apply to the port.
+	// +optional
+	QoSPolicyID optional.String `json:"qosPolicyID,omitempty"`
+
 	ResolvedPortSpecFields `json:",inline"`
 }
 
@@ -843,6 +1029,171 @@ type LoadBalancer struct {
 	// subnet in the list is taken into account.
 	// +optional
 	LoadBalancerNetwork *NetworkStatusWithSubnets `json:"loadBalancerNetwork,omitempty"`
+	// zones describes the zonal load balancers of the API server.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Zones []LoadBalancerZone `json:"zones,omitempty"`
+	// addresses are the addresses at which the API server can be reached
+	// through the load balancer and its zonal load balancers. These are their
+	// floating IPs if the API server uses a floating IP, and their VIPs
+	// otherwise.
+	// +listType=set
+	// +optional
+	Addresses []string `json:"addresses,omitempty"`
+	// provisioningStatus is the provisioning status of the load balancer
+	// reported by Octavia.
+	// +optional
+	ProvisioningStatus string `json:"provisioningStatus,omitempty"`
+	// operatingStatus is the operating status of the load balancer reported
+	// by Octavia.
+	// +optional
+	OperatingStatus string `json:"operatingStatus,omitempty"`
+	// listeners describes the listeners of the load balancer used by the API
+	// server, with their pools and members, as reported by the status tree of
+	// the load balancer.
+	// +listType=map
+	// +listMapKey=id
+	// +optional
+	Listeners []LoadBalancerListenerStatus `json:"listeners,omitempty"`
+}
+
+// LoadBalancerListenerStatus describes the status of a listener of the API
+// server load balancer.
+type LoadBalancerListenerStatus struct {
+	// id is the ID of the listener.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	ID string `json:"id,omitempty"`
+	// name is the name of the listener.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// provisioningStatus is the provisioning status of the listener.
+	// +optional
+	ProvisioningStatus string `json:"provisioningStatus,omitempty"`
+	// operatingStatus is the operating status of the listener.
+	// +optional
+	OperatingStatus string `json:"operatingStatus,omitempty"`
+	// pools describes the pools of the listener.
+	// +listType=map
+	// +listMapKey=id
+	// +optional
+	Pools []LoadBalancerPoolStatus `json:"pools,omitempty"`
+}
+
+// LoadBalancerPoolStatus describes the status of a pool of the API server
+// load balancer.
+type LoadBalancerPoolStatus struct {
+	// id is the ID of the pool.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	ID string `json:"id,omitempty"`
+	// name is the name of the pool.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// provisioningStatus is the provisioning status of the pool.
+	// +optional
+	ProvisioningStatus string `json:"provisioningStatus,omitempty"`
+	// operatingStatus is the operating status of the pool.
+	// +optional
+	OperatingStatus string `json:"operatingStatus,omitempty"`
+	// members describes the members of the pool.
+	// +listType=map
+	// +listMapKey=id
+	// +optional
+	Members []LoadBalancerMemberStatus `json:"members,omitempty"`
+}
+
+// LoadBalancerMemberStatus describes the status of a member of a pool of the
+// API server load balancer.
+type LoadBalancerMemberStatus struct {
+	// id is the ID of the member.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	ID string `json:"id,omitempty"`
+	// name is the name of the member.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// address is the IP address of the member.
+	// +optional
+	Address string `json:"address,omitempty"`
+	// provisioningStatus is the provisioning status of the member.
+	// +optional
+	ProvisioningStatus string `json:"provisioningStatus,omitempty"`
+	// operatingStatus is the operating status of the member: ONLINE,
+	// OFFLINE, DRAINING, ERROR or NO_MONITOR if the pool has no health
+	// monitor.
+	// +optional
+	OperatingStatus string `json:"operatingStatus,omitempty"`
+}
+
+// LoadBalancerZone describes a zonal load balancer of the API server.
+type LoadBalancerZone struct {
+	// name is the name of the availability zone.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name,omitempty"`
+	// id is the ID of the zonal load balancer.
+	// +optional
+	ID string `json:"id,omitempty"`
+	// internalIP is the VIP address of the zonal load balancer.
+	// +optional
+	InternalIP string `json:"internalIP,omitempty"`
+	// ip is the floating IP address associated with the VIP of the zonal
+	// load balancer.
+	// +optional
+	IP string `json:"ip,omitempty"`
+}
+
+// APIServerLoadBalancerReplacementPhase is the phase of the replacement of
+// the API server load balancer.
+// +kubebuilder:validation:Enum:=Provisioning;SwitchingOver;DeletingPrevious
+type APIServerLoadBalancerReplacementPhase string
+
+const (
+	// APIServerLoadBalancerReplacementProvisioning means that the replacement
+	// load balancer and its listeners, pools, health monitors and members are
+	// being created.
+	APIServerLoadBalancerReplacementProvisioning APIServerLoadBalancerReplacementPhase = "Provisioning"
+	// APIServerLoadBalancerReplacementSwitchingOver means that the floating
+	// IP of the API server is being moved to the replacement load balancer.
+	APIServerLoadBalancerReplacementSwitchingOver APIServerLoadBalancerReplacementPhase = "SwitchingOver"
+	// APIServerLoadBalancerReplacementDeletingPrevious means that the
+	// previous load balancer is being deleted.
+	APIServerLoadBalancerReplacementDeletingPrevious APIServerLoadBalancerReplacementPhase = "DeletingPrevious"
+)
+
+// APIServerLoadBalancerReplacement describes the replacement of the API
+// server load balancer after a property which cannot be changed on an
+// existing load balancer, such as its provider, was changed.
+type APIServerLoadBalancerReplacement struct {
+	// phase is the current phase of the replacement.
+	// +required
+	Phase APIServerLoadBalancerReplacementPhase `json:"phase,omitempty"`
+
+	// changes lists the properties of the load balancer which changed.
+	// +listType=atomic
+	// +optional
+	Changes []string `json:"changes,omitempty"`
+
+	// previousID is the ID of the load balancer being replaced.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	PreviousID string `json:"previousID,omitempty"`
+
+	// name is the name of the replacement load balancer. It is renamed to
+	// the name of the previous load balancer once that has been deleted.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name,omitempty"`
+
+	// id is the ID of the replacement load balancer.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// internalIP is the VIP address of the replacement load balancer.
+	// +optional
+	InternalIP string `json:"internalIP,omitempty"`
 }
 
 // SecurityGroupStatus represents the basic information of the associated
@@ -987,6 +1338,11 @@ type Bastion struct {
 	// +optional
 	//+kubebuilder:validation:Format:=ipv4
 	FloatingIP optional.String `json:"floatingIP,omitempty"`
+
+	// floatingIPDNS sets the DNS name and domain of the floating IP created
+	// for the bastion.
+	// +optional
+	FloatingIPDNS *FloatingIPDNS `json:"floatingIPDNS,omitempty"`
 }
 
 func (b *Bastion) IsEnabled() bool {
@@ -996,6 +1352,31 @@ func (b *Bastion) IsEnabled() bool {
 	return b.Enabled == nil || *b.Enabled
 }
 
+// FloatingIPDNS is the DNS configuration of a floating IP. It is only applied
+// when the Neutron dns-integration extension is available, in which case an
+// external DNS service such as Designate will publish a record for the
+// floating IP. Neutron requires both dnsName and dnsDomain to be set.
+type FloatingIPDNS struct {
+	// dnsName is the DNS name of the floating IP.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +required
+	DNSName string `json:"dnsName"`
+
+	// dnsDomain is the DNS domain in which the record for the floating IP
+	// will be published. It must be a fully qualified domain name ending
+	// with a dot.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	// +required
+	DNSDomain string `json:"dnsDomain"`
+}
+
+// +kubebuilder:validation:XValidation:rule="!has(self.poolID) || has(self.loadBalancer)",message="poolID requires loadBalancer"
+// +kubebuilder:validation:XValidation:rule="!has(self.poolID) || !has(self.additionalPorts)",message="additionalPorts cannot be used with poolID"
+// +kubebuilder:validation:XValidation:rule="!has(self.loadBalancer) || (!has(self.provider) && !has(self.flavor) && !has(self.availabilityZone) && !has(self.network) && !has(self.subnets))",message="provider, flavor, availabilityZone, network and subnets cannot be used with an existing loadBalancer"
+// +kubebuilder:validation:XValidation:rule="!has(self.poolID) || !has(self.listener)",message="listener cannot be used with poolID"
+// +kubebuilder:validation:XValidation:rule="!has(self.listener) || !has(self.listener.insertHeaders) || (has(self.tls) && self.tls.mode == 'Terminated')",message="listener.insertHeaders requires tls.mode Terminated"
 type APIServerLoadBalancer struct {
 	// enabled defines whether a load balancer should be created. This value
 	// defaults to true if an APIServerLoadBalancer is given.
@@ -1047,6 +1428,137 @@ type APIServerLoadBalancer struct {
 	// monitor contains configuration for the load balancer health monitor.
 	// +optional
 	Monitor *APIServerLoadBalancerMonitor `json:"monitor,omitempty"`
+
+	// tls configures TLS on the listener for the API server port. If it is
+	// not specified the listener forwards TCP connections to the API server.
+	// Additional ports are not affected.
+	// +optional
+	TLS *APIServerLoadBalancerTLS `json:"tls,omitempty"`
+
+	// zones creates a zonal load balancer for the API server in each of the
+	// given availability zones, in addition to the API server load balancer.
+	// The members of a zonal load balancer are the control plane machines in
+	// its zone. Each zonal load balancer has its own VIP, and its own
+	// floating IP if the API server uses a floating IP, so the API server
+	// remains reachable through the other zones if a zone fails. The
+	// addresses of all load balancers are listed in the addresses of the API
+	// server load balancer status, for example to publish them as DNS records
+	// of the control plane endpoint.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	Zones []APIServerLoadBalancerZone `json:"zones,omitempty"`
+
+	// memberDrain drains the load balancer members of a control plane
+	// machine before the machine is deleted. The members first stop
+	// receiving new connections, and are deleted once the drain period has
+	// elapsed. If it is not specified the members are deleted immediately.
+	// +optional
+	MemberDrain *APIServerLoadBalancerMemberDrain `json:"memberDrain,omitempty"`
+
+	// loadBalancer is an existing load balancer to use as the API server
+	// load balancer instead of creating one, for example a load balancer
+	// shared with other clusters. The listeners, pools and health monitors
+	// of the API server are created on it unless poolID is specified. The
+	// load balancer is never deleted, only the objects created on it.
+	// +optional
+	LoadBalancer *LoadBalancerParam `json:"loadBalancer,omitempty"`
+
+	// poolID is the ID of an existing pool of loadBalancer. The control
+	// plane machines are added to it as members, and no listener, pool or
+	// health monitor is created. The listener of the pool is expected to
+	// listen on the API server port.
+	// +kubebuilder:validation:Format:=uuid
+	// +optional
+	PoolID optional.String `json:"poolID,omitempty"`
+
+	// listener configures the timeouts, connection limit and inserted
+	// headers of the listeners of the API server load balancer. Changes are
+	// applied to the existing listeners. Options which are not specified
+	// keep the value of the listener, which is the Octavia default for a
+	// new listener.
+	// +optional
+	Listener *APIServerLoadBalancerListener `json:"listener,omitempty"`
+}
+
+// APIServerLoadBalancerListener configures the listeners of the API server
+// load balancer.
+// +kubebuilder:validation:MinProperties=1
+type APIServerLoadBalancerListener struct {
+	// timeoutClientData is the client inactivity timeout in milliseconds.
+	// Long-running requests such as watches and kubectl exec sessions are
+	// closed when they are idle for longer. The Octavia default is 50000.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2073600000
+	// +optional
+	TimeoutClientData *int32 `json:"timeoutClientData,omitempty"`
+
+	// timeoutMemberConnect is the timeout in milliseconds for connecting to
+	// a member. The Octavia default is 5000.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2073600000
+	// +optional
+	TimeoutMemberConnect *int32 `json:"timeoutMemberConnect,omitempty"`
+
+	// timeoutMemberData is the member inactivity timeout in milliseconds.
+	// The Octavia default is 50000.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2073600000
+	// +optional
+	TimeoutMemberData *int32 `json:"timeoutMemberData,omitempty"`
+
+	// timeoutTCPInspect is the time in milliseconds to wait for additional
+	// TCP packets for content inspection. The Octavia default is 0.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=2073600000
+	// +optional
+	TimeoutTCPInspect *int32 `json:"timeoutTCPInspect,omitempty"`
+
+	// connectionLimit is the maximum number of connections of each
+	// listener. -1 means unlimited, which is the Octavia default.
+	// +kubebuilder:validation:Minimum=-1
+	// +optional
+	ConnectionLimit *int32 `json:"connectionLimit,omitempty"`
+
+	// insertHeaders are the headers inserted into the requests forwarded to
+	// the API servers, for example X-Forwarded-For, with the value "true"
+	// or "false". They can only be inserted by the listener of the API
+	// server port, and only if it terminates TLS.
+	// +kubebuilder:validation:MaxProperties=16
+	// +kubebuilder:validation:XValidation:rule="self.all(k, self[k] == 'true' || self[k] == 'false')",message="insertHeaders values must be true or false"
+	// +optional
+	InsertHeaders map[string]string `json:"insertHeaders,omitempty"`
+}
+
+// APIServerLoadBalancerMemberDrain configures the draining of the load
+// balancer members of a control plane machine which is being deleted.
+type APIServerLoadBalancerMemberDrain struct {
+	// periodSeconds is the time in seconds during which the members of a
+	// deleted machine receive no new connections before they are deleted.
+	// The period is always waited in full, as Octavia does not report the
+	// active connections of a single member.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	// +required
+	PeriodSeconds int32 `json:"periodSeconds"`
+}
+
+// APIServerLoadBalancerZone is an availability zone with a zonal load
+// balancer for the API server.
+type APIServerLoadBalancerZone struct {
+	// name is the name of the compute availability zone. The control plane
+	// machines in this zone are the members of the zonal load balancer.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Name string `json:"name,omitempty"`
+
+	// availabilityZone is the Octavia availability zone in which the zonal
+	// load balancer is created. It defaults to name. It is ignored if the
+	// Octavia provider does not support availability zones.
+	// +optional
+	AvailabilityZone optional.String `json:"availabilityZone,omitempty"`
 }
 
 // APIServerLoadBalancerMonitor contains configuration for the load balancer health monitor.
@@ -1076,6 +1588,291 @@ type APIServerLoadBalancerMonitor struct {
 	// +kubebuilder:validation:Maximum=10
 	// +kubebuilder:default:3
 	MaxRetriesDown int32 `json:"maxRetriesDown,omitempty"`
+
+	// type is the type of the health monitor of the API server port. TCP
+	// only checks that the API server accepts connections. HTTPS requests
+	// urlPath from the API server and checks the response code. Health
+	// monitors of additional ports always use TCP.
+	// +optional
+	// +kubebuilder:default:=TCP
+	Type APIServerLoadBalancerMonitorType `json:"type,omitempty"`
+
+	// urlPath is the path requested by an HTTPS health monitor. It defaults
+	// to /readyz.
+	// +optional
+	// +kubebuilder:validation:Pattern:="^/"
+	// +kubebuilder:validation:MaxLength:=255
+	URLPath string `json:"urlPath,omitempty"`
+
+	// expectedCodes are the HTTP status codes an HTTPS health monitor expects
+	// from a healthy API server. It is a single code, a comma separated list
+	// of codes or a range, for example 200, 200,202 or 200-204. It defaults
+	// to 200.
+	// +optional
+	// +kubebuilder:validation:Pattern:="^[1-5][0-9]{2}((,[1-5][0-9]{2})*|-[1-5][0-9]{2})$"
+	ExpectedCodes string `json:"expectedCodes,omitempty"`
+}
+
+// APIServerLoadBalancerMonitorType is the type of the health monitor of the
+// API server load balancer.
+// +kubebuilder:validation:Enum=TCP;HTTPS
+type APIServerLoadBalancerMonitorType string
+
+const (
+	// APIServerLoadBalancerMonitorTCP checks that members accept TCP connections.
+	APIServerLoadBalancerMonitorTCP APIServerLoadBalancerMonitorType = "TCP"
+
+	// APIServerLoadBalancerMonitorHTTPS checks the response of members to an
+	// HTTPS request.
+	APIServerLoadBalancerMonitorHTTPS APIServerLoadBalancerMonitorType = "HTTPS"
+)
+
+// APIServerLoadBalancerTLSMode defines how the API server load balancer
+// handles TLS connections.
+// +kubebuilder:validation:Enum=Passthrough;Terminated
+type APIServerLoadBalancerTLSMode string
+
+const (
+	// APIServerLoadBalancerTLSPassthrough forwards TLS connections to the API
+	// server without decrypting them.
+	APIServerLoadBalancerTLSPassthrough APIServerLoadBalancerTLSMode = "Passthrough"
+
+	// APIServerLoadBalancerTLSTerminated terminates TLS connections on the
+	// load balancer and opens a new TLS connection to the API server.
+	APIServerLoadBalancerTLSTerminated APIServerLoadBalancerTLSMode = "Terminated"
+)
+
+// APIServerLoadBalancerTLS configures TLS on the API server listener.
+// +kubebuilder:validation:XValidation:rule="self.mode == 'Terminated' ? has(self.certificateSecretName) : !has(self.certificateSecretName)",message="certificateSecretName is required when mode is Terminated, and not allowed otherwise"
+type APIServerLoadBalancerTLS struct {
+	// mode defines how the load balancer handles TLS. Passthrough forwards
+	// TLS connections to the API server. Terminated terminates TLS with the
+	// certificate in certificateSecretName and re-encrypts the connection to
+	// the API server. As the load balancer terminates TLS, clients cannot
+	// authenticate to the API server with client certificates in Terminated
+	// mode.
+	// +required
+	Mode APIServerLoadBalancerTLSMode `json:"mode"`
+
+	// certificateSecretName is the name of a Secret of type kubernetes.io/tls
+	// in the namespace of the OpenStackCluster containing the certificate
+	// and private key served by the load balancer. It is stored in Barbican.
+	// It is required when mode is Terminated.
+	// +optional
+	// +kubebuilder:validation:MinLength:=1
+	CertificateSecretName *string `json:"certificateSecretName,omitempty"`
+}
+
+// APIServerVirtualIPProvider is the software which holds the virtual IP of
+// the API server on the control plane machines.
+// +kubebuilder:validation:Enum=KubeVIP;Keepalived
+type APIServerVirtualIPProvider string
+
+const (
+	// APIServerVirtualIPProviderKubeVIP holds the virtual IP with kube-vip,
+	// which runs as a static pod and elects a leader through the API server.
+	APIServerVirtualIPProviderKubeVIP APIServerVirtualIPProvider = "KubeVIP"
+
+	// APIServerVirtualIPProviderKeepalived holds the virtual IP with
+	// keepalived, which elects a master with VRRP.
+	APIServerVirtualIPProviderKeepalived APIServerVirtualIPProvider = "Keepalived"
+)
+
+// APIServerVirtualIP configures a virtual IP for the API server. A Neutron
+// port is created to reserve the virtual IP, and the virtual IP is added to
+// the allowed address pairs of the ports of the control plane machines. The
+// configuration of the provider which moves the virtual IP between the
+// control plane machines is written to a Secret for the bootstrap provider.
+type APIServerVirtualIP struct {
+	// provider is the software which holds the virtual IP on the control
+	// plane machines.
+	// +kubebuilder:default=KubeVIP
+	// +optional
+	Provider APIServerVirtualIPProvider `json:"provider,omitempty"`
+
+	// interface is the name of the network interface of the control plane
+	// machines on which the virtual IP is held. It defaults to eth0.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=15
+	// +optional
+	Interface *string `json:"interface,omitempty"`
+
+	// virtualRouterID is the VRRP virtual router ID used by keepalived. It
+	// must be unique among the VRRP routers on the network of the cluster.
+	// It defaults to 51.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=255
+	// +optional
+	VirtualRouterID *int32 `json:"virtualRouterID,omitempty"`
+
+	// image is the kube-vip container image. It defaults to a kube-vip
+	// release known to work with this version of CAPO.
+	// +kubebuilder:validation:MinLength=1
+	// +optional
+	Image *string `json:"image,omitempty"`
+}
+
+// VirtualIPStatus describes the virtual IP of the API server.
+type VirtualIPStatus struct {
+	// portID is the ID of the Neutron port which reserves the virtual IP.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	PortID string `json:"portID,omitempty"`
+
+	// ip is the virtual IP address.
+	// +optional
+	IP string `json:"ip,omitempty"`
+
+	// floatingIP is the floating IP address associated with the virtual IP.
+	// +optional
+	FloatingIP string `json:"floatingIP,omitempty"`
+
+	// configSecretName is the name of the Secret in the namespace of the
+	// OpenStackCluster containing the configuration of the provider for the
+	// control plane machines.
+	// +optional
+	ConfigSecretName string `json:"configSecretName,omitempty"`
+}
+
+// AdditionalLoadBalancer is an Octavia load balancer managed by the
+// OpenStackCluster in addition to the API server load balancer, for example
+// for ingress.
+type AdditionalLoadBalancer struct {
+	// name identifies the load balancer in the cluster. It is part of the
+	// names of the OpenStack resources of the load balancer.
+	// +required
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=32
+	// +kubebuilder:validation:Pattern:="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	Name string `json:"name"`
+
+	// network is the network the VIP of the load balancer is allocated on.
+	// If neither network nor subnet are specified, the VIP is allocated on
+	// the primary subnet of the cluster.
+	// +optional
+	Network *NetworkParam `json:"network,omitempty"`
+
+	// subnet is the subnet the VIP of the load balancer is allocated on. If
+	// network is also specified, the subnet must be in it.
+	// +optional
+	Subnet *SubnetParam `json:"subnet,omitempty"`
+
+	// provider specifies name of a specific Octavia provider to use for the
+	// load balancer. The Octavia default will be used if it is not
+	// specified.
+	// +optional
+	Provider optional.String `json:"provider,omitempty"`
+
+	// flavor is the flavor name that will be used to create the load balancer.
+	// +optional
+	Flavor optional.String `json:"flavor,omitempty"`
+
+	// availabilityZone is the failure domain that will be used to create the load balancer.
+	// +optional
+	AvailabilityZone optional.String `json:"availabilityZone,omitempty"`
+
+	// enableFloatingIP determines whether to associate a floating IP from
+	// the external network of the cluster with the VIP of the load balancer.
+	// +optional
+	EnableFloatingIP optional.Bool `json:"enableFloatingIP,omitempty"`
+
+	// listeners are the listeners of the load balancer.
+	// +required
+	// +listType=map
+	// +listMapKey=port
+	// +kubebuilder:validation:MinItems:=1
+	// +kubebuilder:validation:MaxItems:=16
+	Listeners []AdditionalLoadBalancerListener `json:"listeners"`
+
+	// members selects the machines of the cluster which are members of the
+	// pools of the listeners.
+	// +required
+	Members AdditionalLoadBalancerMembers `json:"members"`
+}
+
+// AdditionalLoadBalancerProtocol is the protocol of a listener of an
+// additional load balancer.
+// +kubebuilder:validation:Enum=TCP;UDP
+type AdditionalLoadBalancerProtocol string
+
+const (
+	// AdditionalLoadBalancerProtocolTCP forwards TCP connections.
+	AdditionalLoadBalancerProtocolTCP AdditionalLoadBalancerProtocol = "TCP"
+
+	// AdditionalLoadBalancerProtocolUDP forwards UDP datagrams.
+	AdditionalLoadBalancerProtocolUDP AdditionalLoadBalancerProtocol = "UDP"
+)
+
+// AdditionalLoadBalancerListener is a listener of an additional load
+// balancer, and the pool of members it forwards connections to.
+// +kubebuilder:validation:XValidation:rule="!has(self.protocol) || self.protocol != 'UDP' || !has(self.monitor) || !has(self.monitor.type) || self.monitor.type == 'TCP'",message="UDP listeners do not support HTTPS health monitors"
+type AdditionalLoadBalancerListener struct {
+	// port is the port the listener listens on.
+	// +required
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=65535
+	Port int32 `json:"port"`
+
+	// protocol is the protocol of the listener and its pool. It defaults to TCP.
+	// +optional
+	// +kubebuilder:default:=TCP
+	Protocol AdditionalLoadBalancerProtocol `json:"protocol,omitempty"`
+
+	// memberPort is the port members receive connections on. It defaults
+	// to port.
+	// +optional
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=65535
+	MemberPort int32 `json:"memberPort,omitempty"`
+
+	// allowedCIDRs restrict access to the listener to the given address CIDRs.
+	// +optional
+	// +listType=set
+	AllowedCIDRs []string `json:"allowedCIDRs,omitempty"`
+
+	// monitor contains configuration for the health monitor of the pool.
+	// The health monitor of a UDP listener always uses UDP-CONNECT.
+	// +optional
+	Monitor *APIServerLoadBalancerMonitor `json:"monitor,omitempty"`
+}
+
+// AdditionalLoadBalancerMembers selects the machines which are members of an
+// additional load balancer. A machine is a member if it matches
+// machineSelector or belongs to one of machineDeployments.
+// +kubebuilder:validation:XValidation:rule="has(self.machineSelector) || has(self.machineDeployments)",message="at least one of machineSelector or machineDeployments must be specified"
+type AdditionalLoadBalancerMembers struct {
+	// machineSelector selects Machines of the cluster by their labels. The
+	// control plane machines are selected by the label
+	// cluster.x-k8s.io/control-plane.
+	// +optional
+	MachineSelector *metav1.LabelSelector `json:"machineSelector,omitempty"`
+
+	// machineDeployments are the names of MachineDeployments whose Machines
+	// are members.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems:=1
+	MachineDeployments []string `json:"machineDeployments,omitempty"`
+}
+
+// AdditionalLoadBalancerStatus describes an additional load balancer.
+type AdditionalLoadBalancerStatus struct {
+	// name is the name of the load balancer in spec.additionalLoadBalancers.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// id is the unique identifier of the load balancer.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// internalIP is the VIP address of the load balancer.
+	// +optional
+	InternalIP string `json:"internalIP,omitempty"`
+
+	// ip is the floating IP address associated with the VIP of the load balancer.
+	// +optional
+	IP string `json:"ip,omitempty"`
 }
 
 func (s *APIServerLoadBalancer) IsZero() bool {
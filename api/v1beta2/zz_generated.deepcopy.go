@@ -50,11 +50,21 @@ func (in *APIServer) DeepCopyInto(out *APIServer) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.FloatingIPDNS != nil {
+		in, out := &in.FloatingIPDNS, &out.FloatingIPDNS
+		*out = new(FloatingIPDNS)
+		**out = **in
+	}
 	if in.ManagedLoadBalancer != nil {
 		in, out := &in.ManagedLoadBalancer, &out.ManagedLoadBalancer
 		*out = new(APIServerLoadBalancer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VirtualIP != nil {
+		in, out := &in.VirtualIP, &out.VirtualIP
+		*out = new(APIServerVirtualIP)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServer.
@@ -117,6 +127,38 @@ func (in *APIServerLoadBalancer) DeepCopyInto(out *APIServerLoadBalancer) {
 		*out = new(APIServerLoadBalancerMonitor)
 		**out = **in
 	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(APIServerLoadBalancerTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]APIServerLoadBalancerZone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MemberDrain != nil {
+		in, out := &in.MemberDrain, &out.MemberDrain
+		*out = new(APIServerLoadBalancerMemberDrain)
+		**out = **in
+	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerParam)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PoolID != nil {
+		in, out := &in.PoolID, &out.PoolID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Listener != nil {
+		in, out := &in.Listener, &out.Listener
+		*out = new(APIServerLoadBalancerListener)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerLoadBalancer.
@@ -129,6 +171,68 @@ func (in *APIServerLoadBalancer) DeepCopy() *APIServerLoadBalancer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerLoadBalancerListener) DeepCopyInto(out *APIServerLoadBalancerListener) {
+	*out = *in
+	if in.TimeoutClientData != nil {
+		in, out := &in.TimeoutClientData, &out.TimeoutClientData
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutMemberConnect != nil {
+		in, out := &in.TimeoutMemberConnect, &out.TimeoutMemberConnect
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutMemberData != nil {
+		in, out := &in.TimeoutMemberData, &out.TimeoutMemberData
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TimeoutTCPInspect != nil {
+		in, out := &in.TimeoutTCPInspect, &out.TimeoutTCPInspect
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConnectionLimit != nil {
+		in, out := &in.ConnectionLimit, &out.ConnectionLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.InsertHeaders != nil {
+		in, out := &in.InsertHeaders, &out.InsertHeaders
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerLoadBalancerListener.
+func (in *APIServerLoadBalancerListener) DeepCopy() *APIServerLoadBalancerListener {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerLoadBalancerListener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerLoadBalancerMemberDrain) DeepCopyInto(out *APIServerLoadBalancerMemberDrain) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerLoadBalancerMemberDrain.
+func (in *APIServerLoadBalancerMemberDrain) DeepCopy() *APIServerLoadBalancerMemberDrain {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerLoadBalancerMemberDrain)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIServerLoadBalancerMonitor) DeepCopyInto(out *APIServerLoadBalancerMonitor) {
 	*out = *in
@@ -144,6 +248,96 @@ func (in *APIServerLoadBalancerMonitor) DeepCopy() *APIServerLoadBalancerMonitor
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerLoadBalancerReplacement) DeepCopyInto(out *APIServerLoadBalancerReplacement) {
+	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerLoadBalancerReplacement.
+func (in *APIServerLoadBalancerReplacement) DeepCopy() *APIServerLoadBalancerReplacement {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerLoadBalancerReplacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerLoadBalancerTLS) DeepCopyInto(out *APIServerLoadBalancerTLS) {
+	*out = *in
+	if in.CertificateSecretName != nil {
+		in, out := &in.CertificateSecretName, &out.CertificateSecretName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerLoadBalancerTLS.
+func (in *APIServerLoadBalancerTLS) DeepCopy() *APIServerLoadBalancerTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerLoadBalancerTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerLoadBalancerZone) DeepCopyInto(out *APIServerLoadBalancerZone) {
+	*out = *in
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerLoadBalancerZone.
+func (in *APIServerLoadBalancerZone) DeepCopy() *APIServerLoadBalancerZone {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerLoadBalancerZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIServerVirtualIP) DeepCopyInto(out *APIServerVirtualIP) {
+	*out = *in
+	if in.Interface != nil {
+		in, out := &in.Interface, &out.Interface
+		*out = new(string)
+		**out = **in
+	}
+	if in.VirtualRouterID != nil {
+		in, out := &in.VirtualRouterID, &out.VirtualRouterID
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIServerVirtualIP.
+func (in *APIServerVirtualIP) DeepCopy() *APIServerVirtualIP {
+	if in == nil {
+		return nil
+	}
+	out := new(APIServerVirtualIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AdditionalBlockDevice) DeepCopyInto(out *AdditionalBlockDevice) {
 	*out = *in
@@ -160,6 +354,124 @@ func (in *AdditionalBlockDevice) DeepCopy() *AdditionalBlockDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalLoadBalancer) DeepCopyInto(out *AdditionalLoadBalancer) {
+	*out = *in
+	if in.Network != nil {
+		in, out := &in.Network, &out.Network
+		*out = new(NetworkParam)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Subnet != nil {
+		in, out := &in.Subnet, &out.Subnet
+		*out = new(SubnetParam)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Provider != nil {
+		in, out := &in.Provider, &out.Provider
+		*out = new(string)
+		**out = **in
+	}
+	if in.Flavor != nil {
+		in, out := &in.Flavor, &out.Flavor
+		*out = new(string)
+		**out = **in
+	}
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.EnableFloatingIP != nil {
+		in, out := &in.EnableFloatingIP, &out.EnableFloatingIP
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]AdditionalLoadBalancerListener, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Members.DeepCopyInto(&out.Members)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalLoadBalancer.
+func (in *AdditionalLoadBalancer) DeepCopy() *AdditionalLoadBalancer {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalLoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalLoadBalancerListener) DeepCopyInto(out *AdditionalLoadBalancerListener) {
+	*out = *in
+	if in.AllowedCIDRs != nil {
+		in, out := &in.AllowedCIDRs, &out.AllowedCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Monitor != nil {
+		in, out := &in.Monitor, &out.Monitor
+		*out = new(APIServerLoadBalancerMonitor)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalLoadBalancerListener.
+func (in *AdditionalLoadBalancerListener) DeepCopy() *AdditionalLoadBalancerListener {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalLoadBalancerListener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalLoadBalancerMembers) DeepCopyInto(out *AdditionalLoadBalancerMembers) {
+	*out = *in
+	if in.MachineSelector != nil {
+		in, out := &in.MachineSelector, &out.MachineSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MachineDeployments != nil {
+		in, out := &in.MachineDeployments, &out.MachineDeployments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalLoadBalancerMembers.
+func (in *AdditionalLoadBalancerMembers) DeepCopy() *AdditionalLoadBalancerMembers {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalLoadBalancerMembers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdditionalLoadBalancerStatus) DeepCopyInto(out *AdditionalLoadBalancerStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdditionalLoadBalancerStatus.
+func (in *AdditionalLoadBalancerStatus) DeepCopy() *AdditionalLoadBalancerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AdditionalLoadBalancerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddressPair) DeepCopyInto(out *AddressPair) {
 	*out = *in
@@ -218,6 +530,11 @@ func (in *Bastion) DeepCopyInto(out *Bastion) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.FloatingIPDNS != nil {
+		in, out := &in.FloatingIPDNS, &out.FloatingIPDNS
+		*out = new(FloatingIPDNS)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bastion.
@@ -456,6 +773,21 @@ func (in *FlavorParam) DeepCopy() *FlavorParam {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FloatingIPDNS) DeepCopyInto(out *FloatingIPDNS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FloatingIPDNS.
+func (in *FloatingIPDNS) DeepCopy() *FloatingIPDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(FloatingIPDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImageFilter) DeepCopyInto(out *ImageFilter) {
 	*out = *in
@@ -529,6 +861,23 @@ func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
 		*out = new(NetworkStatusWithSubnets)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]LoadBalancerZone, len(*in))
+		copy(*out, *in)
+	}
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make([]LoadBalancerListenerStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancer.
@@ -541,6 +890,119 @@ func (in *LoadBalancer) DeepCopy() *LoadBalancer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerFilter) DeepCopyInto(out *LoadBalancerFilter) {
+	*out = *in
+	in.FilterByNeutronTags.DeepCopyInto(&out.FilterByNeutronTags)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerFilter.
+func (in *LoadBalancerFilter) DeepCopy() *LoadBalancerFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerListenerStatus) DeepCopyInto(out *LoadBalancerListenerStatus) {
+	*out = *in
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]LoadBalancerPoolStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerListenerStatus.
+func (in *LoadBalancerListenerStatus) DeepCopy() *LoadBalancerListenerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerListenerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerMemberStatus) DeepCopyInto(out *LoadBalancerMemberStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerMemberStatus.
+func (in *LoadBalancerMemberStatus) DeepCopy() *LoadBalancerMemberStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerMemberStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerParam) DeepCopyInto(out *LoadBalancerParam) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(LoadBalancerFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerParam.
+func (in *LoadBalancerParam) DeepCopy() *LoadBalancerParam {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerParam)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerPoolStatus) DeepCopyInto(out *LoadBalancerPoolStatus) {
+	*out = *in
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = make([]LoadBalancerMemberStatus, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerPoolStatus.
+func (in *LoadBalancerPoolStatus) DeepCopy() *LoadBalancerPoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerPoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerZone) DeepCopyInto(out *LoadBalancerZone) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerZone.
+func (in *LoadBalancerZone) DeepCopy() *LoadBalancerZone {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineInitialization) DeepCopyInto(out *MachineInitialization) {
 	*out = *in
@@ -589,6 +1051,11 @@ func (in *ManagedNetwork) DeepCopyInto(out *ManagedNetwork) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.QoSPolicy != nil {
+		in, out := &in.QoSPolicy, &out.QoSPolicy
+		*out = new(QoSPolicyParam)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedNetwork.
@@ -874,6 +1341,13 @@ func (in *OpenStackClusterSpec) DeepCopyInto(out *OpenStackClusterSpec) {
 		*out = new(APIServer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AdditionalLoadBalancers != nil {
+		in, out := &in.AdditionalLoadBalancers, &out.AdditionalLoadBalancers
+		*out = make([]AdditionalLoadBalancer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ManagedSecurityGroups != nil {
 		in, out := &in.ManagedSecurityGroups, &out.ManagedSecurityGroups
 		*out = new(ManagedSecurityGroups)
@@ -952,6 +1426,21 @@ func (in *OpenStackClusterStatus) DeepCopyInto(out *OpenStackClusterStatus) {
 		*out = new(LoadBalancer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.APIServerLoadBalancerReplacement != nil {
+		in, out := &in.APIServerLoadBalancerReplacement, &out.APIServerLoadBalancerReplacement
+		*out = new(APIServerLoadBalancerReplacement)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIServerVirtualIP != nil {
+		in, out := &in.APIServerVirtualIP, &out.APIServerVirtualIP
+		*out = new(VirtualIPStatus)
+		**out = **in
+	}
+	if in.AdditionalLoadBalancers != nil {
+		in, out := &in.AdditionalLoadBalancers, &out.AdditionalLoadBalancers
+		*out = make([]AdditionalLoadBalancerStatus, len(*in))
+		copy(*out, *in)
+	}
 	if in.FailureDomains != nil {
 		in, out := &in.FailureDomains, &out.FailureDomains
 		*out = make([]corev1beta2.FailureDomain, len(*in))
@@ -1446,6 +1935,11 @@ func (in *PortOpts) DeepCopyInto(out *PortOpts) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.QoSPolicy != nil {
+		in, out := &in.QoSPolicy, &out.QoSPolicy
+		*out = new(QoSPolicyParam)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]string, len(*in))
@@ -1484,6 +1978,47 @@ func (in *PortStatus) DeepCopy() *PortStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QoSPolicyFilter) DeepCopyInto(out *QoSPolicyFilter) {
+	*out = *in
+	in.FilterByNeutronTags.DeepCopyInto(&out.FilterByNeutronTags)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QoSPolicyFilter.
+func (in *QoSPolicyFilter) DeepCopy() *QoSPolicyFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(QoSPolicyFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QoSPolicyParam) DeepCopyInto(out *QoSPolicyParam) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(QoSPolicyFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QoSPolicyParam.
+func (in *QoSPolicyParam) DeepCopy() *QoSPolicyParam {
+	if in == nil {
+		return nil
+	}
+	out := new(QoSPolicyParam)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResolvedFixedIP) DeepCopyInto(out *ResolvedFixedIP) {
 	*out = *in
@@ -1556,6 +2091,11 @@ func (in *ResolvedPortSpec) DeepCopyInto(out *ResolvedPortSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.QoSPolicyID != nil {
+		in, out := &in.QoSPolicyID, &out.QoSPolicyID
+		*out = new(string)
+		**out = **in
+	}
 	in.ResolvedPortSpecFields.DeepCopyInto(&out.ResolvedPortSpecFields)
 }
 
@@ -1619,6 +2159,16 @@ func (in *ResolvedPortSpecFields) DeepCopyInto(out *ResolvedPortSpecFields) {
 		*out = make([]ValueSpec, len(*in))
 		copy(*out, *in)
 	}
+	if in.DNSName != nil {
+		in, out := &in.DNSName, &out.DNSName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DNSDomain != nil {
+		in, out := &in.DNSDomain, &out.DNSDomain
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedPortSpecFields.
@@ -2006,9 +2556,60 @@ func (in *SubnetParam) DeepCopy() *SubnetParam {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetPoolFilter) DeepCopyInto(out *SubnetPoolFilter) {
+	*out = *in
+	in.FilterByNeutronTags.DeepCopyInto(&out.FilterByNeutronTags)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetPoolFilter.
+func (in *SubnetPoolFilter) DeepCopy() *SubnetPoolFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetPoolFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetPoolParam) DeepCopyInto(out *SubnetPoolParam) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(SubnetPoolFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetPoolParam.
+func (in *SubnetPoolParam) DeepCopy() *SubnetPoolParam {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetPoolParam)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 	*out = *in
+	if in.SubnetPool != nil {
+		in, out := &in.SubnetPool, &out.SubnetPool
+		*out = new(SubnetPoolParam)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrefixLength != nil {
+		in, out := &in.PrefixLength, &out.PrefixLength
+		*out = new(int32)
+		**out = **in
+	}
 	if in.DNSNameservers != nil {
 		in, out := &in.DNSNameservers, &out.DNSNameservers
 		*out = make([]string, len(*in))
@@ -2046,6 +2647,21 @@ func (in *ValueSpec) DeepCopy() *ValueSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualIPStatus) DeepCopyInto(out *VirtualIPStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualIPStatus.
+func (in *VirtualIPStatus) DeepCopy() *VirtualIPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualIPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeAvailabilityZone) DeepCopyInto(out *VolumeAvailabilityZone) {
 	*out = *in
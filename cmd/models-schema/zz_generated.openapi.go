@@ -330,9 +330,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		runtime.TypeMeta{}.OpenAPIModelName():                                                               schema_k8sio_apimachinery_pkg_runtime_TypeMeta(ref),
 		runtime.Unknown{}.OpenAPIModelName():                                                                schema_k8sio_apimachinery_pkg_runtime_Unknown(ref),
 		version.Info{}.OpenAPIModelName():                                                                   schema_k8sio_apimachinery_pkg_version_Info(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ApplicationCredentialAccessRule":           schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ApplicationCredentialAccessRule(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackAPIRateLimit":                     schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackAPIRateLimit(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackClusterIdentity":                  schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackClusterIdentity(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackClusterIdentityCloudStatus":       schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackClusterIdentityCloudStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackClusterIdentityList":              schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackClusterIdentityList(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackClusterIdentitySpec":              schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackClusterIdentitySpec(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackClusterIdentityStatus":            schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackClusterIdentityStatus(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackCredentialRotation":               schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackCredentialRotation(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackCredentialRotationList":           schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackCredentialRotationList(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackCredentialRotationSpec":           schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackCredentialRotationSpec(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackCredentialRotationStatus":         schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackCredentialRotationStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackCredentialSecretReference":        schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackCredentialSecretReference(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackFloatingIPPool":                   schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloatingIPPool(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackFloatingIPPoolList":               schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloatingIPPoolList(ref),
@@ -342,6 +350,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerList":                       schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerList(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerSpec":                       schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerSpec(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerStatus":                     schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerStatus(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServiceEndpoint":                  schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServiceEndpoint(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.PendingApplicationCredentialRevocation":    schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_PendingApplicationCredentialRevocation(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ResolvedServerSpec":                        schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ResolvedServerSpec(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ServerResources":                           schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ServerResources(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.APIServerLoadBalancer":                      schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_APIServerLoadBalancer(ref),
@@ -415,8 +425,18 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta1.VolumeAvailabilityZone":                     schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_VolumeAvailabilityZone(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServer":                                  schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServer(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancer":                      schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServerLoadBalancer(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerListener":              schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServerLoadBalancerListener(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerMemberDrain":           schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServerLoadBalancerMemberDrain(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerMonitor":               schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServerLoadBalancerMonitor(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerReplacement":           schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServerLoadBalancerReplacement(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerTLS":                   schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServerLoadBalancerTLS(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerZone":                  schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServerLoadBalancerZone(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerVirtualIP":                         schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServerVirtualIP(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AdditionalBlockDevice":                      schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_AdditionalBlockDevice(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AdditionalLoadBalancer":                     schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_AdditionalLoadBalancer(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AdditionalLoadBalancerListener":             schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_AdditionalLoadBalancerListener(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AdditionalLoadBalancerMembers":              schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_AdditionalLoadBalancerMembers(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AdditionalLoadBalancerStatus":               schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_AdditionalLoadBalancerStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AddressPair":                                schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_AddressPair(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AllocationPool":                             schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_AllocationPool(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.Bastion":                                    schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_Bastion(ref),
@@ -430,9 +450,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.FixedIP":                                    schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_FixedIP(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.FlavorFilter":                               schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_FlavorFilter(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.FlavorParam":                                schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_FlavorParam(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.FloatingIPDNS":                              schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_FloatingIPDNS(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ImageFilter":                                schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ImageFilter(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ImageParam":                                 schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ImageParam(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancer":                               schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_LoadBalancer(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancerFilter":                         schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_LoadBalancerFilter(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancerListenerStatus":                 schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_LoadBalancerListenerStatus(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancerMemberStatus":                   schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_LoadBalancerMemberStatus(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancerParam":                          schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_LoadBalancerParam(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancerPoolStatus":                     schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_LoadBalancerPoolStatus(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancerZone":                           schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_LoadBalancerZone(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.MachineInitialization":                      schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_MachineInitialization(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.MachineResources":                           schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_MachineResources(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ManagedNetwork":                             schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ManagedNetwork(ref),
@@ -463,6 +490,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.OpenStackMachineTemplateStatus":             schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_OpenStackMachineTemplateStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.PortOpts":                                   schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_PortOpts(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.PortStatus":                                 schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_PortStatus(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.QoSPolicyFilter":                            schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_QoSPolicyFilter(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.QoSPolicyParam":                             schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_QoSPolicyParam(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ResolvedFixedIP":                            schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ResolvedFixedIP(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ResolvedMachineSpec":                        schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ResolvedMachineSpec(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ResolvedPortSpec":                           schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ResolvedPortSpec(ref),
@@ -484,8 +513,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.Subnet":                                     schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_Subnet(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetFilter":                               schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_SubnetFilter(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetParam":                                schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_SubnetParam(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetPoolFilter":                           schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_SubnetPoolFilter(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetPoolParam":                            schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_SubnetPoolParam(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetSpec":                                 schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_SubnetSpec(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ValueSpec":                                  schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ValueSpec(ref),
+		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.VirtualIPStatus":                            schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_VirtualIPStatus(ref),
 		"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.VolumeAvailabilityZone":                     schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_VolumeAvailabilityZone(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta1.APIEndpoint":                                              schema_cluster_api_api_core_v1beta1_APIEndpoint(ref),
 		"sigs.k8s.io/cluster-api/api/core/v1beta1.Bootstrap":                                                schema_cluster_api_api_core_v1beta1_Bootstrap(ref),
@@ -17510,6 +17542,78 @@ func schema_k8sio_apimachinery_pkg_version_Info(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ApplicationCredentialAccessRule(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ApplicationCredentialAccessRule permits an API call with an application credential.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"service": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Service is the type of the service the API call is made to, for example `compute`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"method": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Method is the HTTP method of the API call.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path of the API call, which may contain `*` to match a single path segment and `**` to match any number of path segments, for example `/v2.1/servers/**`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"service", "method", "path"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackAPIRateLimit(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackAPIRateLimit limits the OpenStack API calls made with a set of credentials.\n\nLimits are enforced by the manager for each combination of identity endpoint and project, so all identities which authenticate to the same project share a single budget. If they specify different limits, the limits of the identity which was used most recently apply.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"qps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QPS is the sustained number of API calls per second.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Burst is the maximum number of API calls which may be made at once after a period without calls. If QPS is set and Burst is not, Burst is equal to QPS.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxInFlight": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxInFlight is the maximum number of concurrent API calls. Further calls wait until an earlier call has completed.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackClusterIdentity(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -17543,11 +17647,107 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackClust
 							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackClusterIdentitySpec"),
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackClusterIdentityStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			metav1.ObjectMeta{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackClusterIdentitySpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackClusterIdentityStatus"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackClusterIdentityCloudStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackClusterIdentityCloudStatus is the result of authenticating with a cloud in a `clouds.yaml` file.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the cloud in the `clouds.yaml` file.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"error": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Error is the reason authentication with the cloud failed. It is not set if authentication succeeded.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"projectID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProjectID is the ID of the project the credentials are scoped to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UserID is the ID of the user the credentials belong to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"roles": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Roles are the names of the roles of the credentials in the project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tokenExpiresAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TokenExpiresAt is when the token obtained with the credentials expires.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"endpoints": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoints are the endpoints in the service catalog for the region and interface of the cloud.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServiceEndpoint"),
+									},
+								},
+							},
+						},
+					},
 				},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			metav1.ObjectMeta{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackClusterIdentitySpec"},
+			metav1.Time{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServiceEndpoint"},
 	}
 }
 
@@ -17620,50 +17820,91 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackClust
 							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
 						},
 					},
+					"rateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RateLimit limits the rate and concurrency of OpenStack API calls made with this identity. Limits which are not set default to the limits passed to the manager on the command line.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackAPIRateLimit"),
+						},
+					},
 				},
 				Required: []string{"secretRef"},
 			},
 		},
 		Dependencies: []string{
-			metav1.LabelSelector{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackCredentialSecretReference"},
+			metav1.LabelSelector{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackAPIRateLimit", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackCredentialSecretReference"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackCredentialSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackClusterIdentityStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackCredentialSecretReference references a Secret containing OpenStack credentials.",
+				Description: "OpenStackClusterIdentityStatus defines the observed state of an OpenStackClusterIdentity.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"clouds": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the Secret which contains a `clouds.yaml` key (and optionally `cacert`).",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Clouds is the result of authenticating with each cloud in the `clouds.yaml` file of the referenced Secret.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackClusterIdentityCloudStatus"),
+									},
+								},
+							},
 						},
 					},
-					"namespace": {
+					"lastCheckTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace where the Secret resides.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastCheckTime is when the credentials were last checked.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions defines current service state of the OpenStackClusterIdentity.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(metav1.Condition{}.OpenAPIModelName()),
+									},
+								},
+							},
 						},
 					},
 				},
-				Required: []string{"name", "namespace"},
 			},
 		},
+		Dependencies: []string{
+			metav1.Condition{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackClusterIdentityCloudStatus"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloatingIPPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackCredentialRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackFloatingIPPool is the Schema for the openstackfloatingippools API.",
+				Description: "OpenStackCredentialRotation periodically replaces the credentials in a Secret with a new Keystone application credential, and deletes the replaced application credentials after a grace period. When it is deleted, the application credentials it created are deleted except for the one still in the Secret, which remains valid until it expires.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -17689,28 +17930,28 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloat
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackFloatingIPPoolSpec"),
+							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackCredentialRotationSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackFloatingIPPoolStatus"),
+							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackCredentialRotationStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			metav1.ObjectMeta{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackFloatingIPPoolSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackFloatingIPPoolStatus"},
+			metav1.ObjectMeta{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackCredentialRotationSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackCredentialRotationStatus"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloatingIPPoolList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackCredentialRotationList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackFloatingIPPoolList contains a list of OpenStackFloatingIPPool.",
+				Description: "OpenStackCredentialRotationList contains a list of OpenStackCredentialRotation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -17740,7 +17981,7 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloat
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackFloatingIPPool"),
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackCredentialRotation"),
 									},
 								},
 							},
@@ -17751,79 +17992,60 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloat
 			},
 		},
 		Dependencies: []string{
-			metav1.ListMeta{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackFloatingIPPool"},
+			metav1.ListMeta{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackCredentialRotation"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloatingIPPoolSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackCredentialRotationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackFloatingIPPoolSpec defines the desired state of OpenStackFloatingIPPool.",
+				Description: "OpenStackCredentialRotationSpec defines the desired state of OpenStackCredentialRotation.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"preAllocatedFloatingIPs": {
+					"secretName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PreAllocatedFloatingIPs is a list of floating IPs precreated in OpenStack that should be used by this pool. These are used before allocating new ones and are not deleted from OpenStack when the pool is deleted.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "SecretName is the name of the Secret containing the credentials to update, in the namespace of the OpenStackCredentialRotation. This is the Secret referenced by the identityRef of clusters using the credentials, or by the secretRef of an OpenStackClusterIdentity.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"maxIPs": {
+					"cloudName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MaxIPs is the maximum number of floating ips that can be allocated from this pool, if nil there is no limit. If set, the pool will stop allocating floating ips when it reaches this number of ClaimedIPs.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "CloudName is the name of the entry in the `clouds.yaml` file to update. The entry must exist and contain an `auth_url`. Its credentials are replaced by an application credential, and its other settings are kept. If not set, the Secret is one referenced by an identityRef of type ApplicationCredential, and its `applicationCredentialID` and `applicationCredentialSecret` are replaced.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"identityRef": {
+					"bootstrapIdentityRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IdentityRef is a reference to a identity to be used when reconciling this pool.",
+							Description: "BootstrapIdentityRef is a reference to the credentials used to create the application credentials. The application credentials belong to the user of these credentials. If they are themselves an application credential it must be unrestricted. They may refer to a different entry of the Secret being updated.",
 							Default:     map[string]interface{}{},
 							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.OpenStackIdentityReference"),
 						},
 					},
-					"floatingIPNetwork": {
+					"rotationInterval": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FloatingIPNetwork is the external network to use for floating ips, if there's only one external network it will be used by default",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkParam"),
+							Description: "RotationInterval is how often a new application credential is created. Defaults to 720h (30 days).",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
 						},
 					},
-					"reclaimPolicy": {
+					"gracePeriod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The stratergy to use for reclaiming floating ips when they are released from a machine",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "GracePeriod is how long a replaced application credential remains valid before it is deleted, to allow all users of the Secret to observe the new credential. Defaults to 24h. Application credentials expire after RotationInterval plus GracePeriod, so they become invalid even if they are not rotated.",
+							Ref:         ref(metav1.Duration{}.OpenAPIModelName()),
 						},
 					},
-				},
-				Required: []string{"identityRef", "reclaimPolicy"},
-			},
-		},
-		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.OpenStackIdentityReference"},
-	}
-}
-
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloatingIPPoolStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackFloatingIPPoolStatus defines the observed state of OpenStackFloatingIPPool.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"claimedIPs": {
+					"roles": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Roles are the names of the roles of the application credentials. If not set, the application credentials have all the roles of the bootstrap credentials.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -17835,44 +18057,101 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloat
 							},
 						},
 					},
-					"availableIPs": {
+					"accessRules": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "AccessRules restrict the API calls which may be made with the application credentials. If not set, the application credentials may make any API call permitted by their roles.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ApplicationCredentialAccessRule"),
 									},
 								},
 							},
 						},
 					},
-					"failedIPs": {
+					"unrestricted": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FailedIPs contains a list of floating ips that failed to be allocated",
+							Description: "Unrestricted allows the application credentials to create and delete other application credentials and trusts. This is required if the Secret being updated is also the bootstrap credentials.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"secretName", "bootstrapIdentityRef"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Duration{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.ApplicationCredentialAccessRule", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.OpenStackIdentityReference"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackCredentialRotationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackCredentialRotationStatus defines the observed state of OpenStackCredentialRotation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"applicationCredentialID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ApplicationCredentialID is the ID of the application credential currently in the Secret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastRotationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRotationTime is when the application credential in the Secret was last replaced.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"nextRotationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextRotationTime is when the application credential in the Secret will next be replaced.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+					"pendingRevocations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"id",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingRevocations are the application credentials which have been replaced and will be deleted at the end of their grace period.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.PendingApplicationCredentialRevocation"),
 									},
 								},
 							},
 						},
 					},
-					"floatingIPNetwork": {
-						SchemaProps: spec.SchemaProps{
-							Description: "floatingIPNetwork contains information about the network used for floating ips",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkStatus"),
-						},
-					},
 					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Conditions defines current service state of the OpenStackCredentialRotation.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -17887,15 +18166,45 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloat
 			},
 		},
 		Dependencies: []string{
-			metav1.Condition{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkStatus"},
+			metav1.Condition{}.OpenAPIModelName(), metav1.Time{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.PendingApplicationCredentialRevocation"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackCredentialSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackServer is the Schema for the openstackservers API.",
+				Description: "OpenStackCredentialSecretReference references a Secret containing OpenStack credentials.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Secret which contains a `clouds.yaml` key (and optionally `cacert`).",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace where the Secret resides.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "namespace"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloatingIPPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackFloatingIPPool is the Schema for the openstackfloatingippools API.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -17921,28 +18230,28 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServe
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerSpec"),
+							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackFloatingIPPoolSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerStatus"),
+							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackFloatingIPPoolStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			metav1.ObjectMeta{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerStatus"},
+			metav1.ObjectMeta{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackFloatingIPPoolSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackFloatingIPPoolStatus"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloatingIPPoolList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackServerList contains a list of OpenStackServer.",
+				Description: "OpenStackFloatingIPPoolList contains a list of OpenStackFloatingIPPool.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -17972,7 +18281,7 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServe
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServer"),
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackFloatingIPPool"),
 									},
 								},
 							},
@@ -17983,119 +18292,358 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServe
 			},
 		},
 		Dependencies: []string{
-			metav1.ListMeta{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServer"},
+			metav1.ListMeta{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackFloatingIPPool"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloatingIPPoolSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OpenStackServerSpec defines the desired state of OpenStackServer.",
+				Description: "OpenStackFloatingIPPoolSpec defines the desired state of OpenStackFloatingIPPool.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"additionalBlockDevices": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"name",
-								},
-								"x-kubernetes-list-type": "map",
-							},
-						},
+					"preAllocatedFloatingIPs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AdditionalBlockDevices is a list of specifications for additional block devices to attach to the server instance.",
+							Description: "PreAllocatedFloatingIPs is a list of floating IPs precreated in OpenStack that should be used by this pool. These are used before allocating new ones and are not deleted from OpenStack when the pool is deleted.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AdditionalBlockDevice"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"availabilityZone": {
+					"maxIPs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AvailabilityZone is the availability zone in which to create the server instance.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MaxIPs is the maximum number of floating ips that can be allocated from this pool, if nil there is no limit. If set, the pool will stop allocating floating ips when it reaches this number of ClaimedIPs.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"configDrive": {
+					"identityRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConfigDrive is a flag to enable config drive for the server instance.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "IdentityRef is a reference to a identity to be used when reconciling this pool.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.OpenStackIdentityReference"),
 						},
 					},
-					"flavor": {
+					"floatingIPNetwork": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The flavor reference for the flavor for the server instance.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "FloatingIPNetwork is the external network to use for floating ips, if there's only one external network it will be used by default",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkParam"),
 						},
 					},
-					"flavorID": {
+					"dnsDomain": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlavorID allows flavors to be specified by ID.  This field takes precedence over Flavor.",
+							Description: "DNSDomain is the DNS domain set on the floating ips allocated by this pool. When set and the Neutron dns-integration extension is available, each floating ip is given a unique DNS name derived from the pool name, so that an external DNS service such as Designate publishes a record for it. The domain must be a fully qualified domain name ending with a dot.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"floatingIPPoolRef": {
+					"reclaimPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FloatingIPPoolRef is a reference to a FloatingIPPool to allocate a floating IP from.",
-							Ref:         ref(v1.TypedLocalObjectReference{}.OpenAPIModelName()),
+							Description: "The stratergy to use for reclaiming floating ips when they are released from a machine",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"identityRef": {
+				},
+				Required: []string{"identityRef", "reclaimPolicy"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.OpenStackIdentityReference"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackFloatingIPPoolStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackFloatingIPPoolStatus defines the observed state of OpenStackFloatingIPPool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"claimedIPs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IdentityRef is a reference to a secret holding OpenStack credentials.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.OpenStackIdentityReference"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"image": {
+					"availableIPs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The image to use for the server instance.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ImageParam"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"ports": {
+					"failedIPs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ports to be attached to the server instance.",
+							Description: "FailedIPs contains a list of floating ips that failed to be allocated",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.PortOpts"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"rootVolume": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RootVolume is the specification for the root volume of the server instance.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.RootVolume"),
-						},
-					},
-					"sshKeyName": {
+					"floatingIPNetwork": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SSHKeyName is the name of the SSH key to inject in the instance.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "floatingIPNetwork contains information about the network used for floating ips",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkStatus"),
 						},
 					},
-					"securityGroups": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SecurityGroups is a list of security groups names to assign to the instance.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref(metav1.Condition{}.OpenAPIModelName()),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			metav1.Condition{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkStatus"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackServer is the Schema for the openstackservers API.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(metav1.ObjectMeta{}.OpenAPIModelName()),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			metav1.ObjectMeta{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerSpec", "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServerStatus"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackServerList contains a list of OpenStackServer.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref(metav1.ListMeta{}.OpenAPIModelName()),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServer"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			metav1.ListMeta{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1.OpenStackServer"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackServerSpec defines the desired state of OpenStackServer.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"additionalBlockDevices": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "AdditionalBlockDevices is a list of specifications for additional block devices to attach to the server instance.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AdditionalBlockDevice"),
+									},
+								},
+							},
+						},
+					},
+					"availabilityZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AvailabilityZone is the availability zone in which to create the server instance.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configDrive": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigDrive is a flag to enable config drive for the server instance.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"flavor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The flavor reference for the flavor for the server instance.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"flavorID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlavorID allows flavors to be specified by ID.  This field takes precedence over Flavor.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"floatingIPPoolRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FloatingIPPoolRef is a reference to a FloatingIPPool to allocate a floating IP from.",
+							Ref:         ref(v1.TypedLocalObjectReference{}.OpenAPIModelName()),
+						},
+					},
+					"identityRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdentityRef is a reference to a secret holding OpenStack credentials.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.OpenStackIdentityReference"),
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The image to use for the server instance.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ImageParam"),
+						},
+					},
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports to be attached to the server instance.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.PortOpts"),
+									},
+								},
+							},
+						},
+					},
+					"rootVolume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RootVolume is the specification for the root volume of the server instance.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.RootVolume"),
+						},
+					},
+					"sshKeyName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHKeyName is the name of the SSH key to inject in the instance.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"securityGroups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecurityGroups is a list of security groups names to assign to the instance.",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -18276,53 +18824,134 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServe
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ResolvedServerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_OpenStackServiceEndpoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ResolvedServerSpec contains resolved references to resources required by the server.",
+				Description: "OpenStackServiceEndpoint is an endpoint in the Keystone service catalog.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"serverGroupID": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ServerGroupID is the ID of the server group the server should be added to and is calculated based on ServerGroupFilter.",
+							Description: "Type is the type of the service, for example `compute`.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"imageID": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImageID is the ID of the image to use for the server and is calculated based on ImageFilter.",
+							Description: "Name is the name of the service, for example `nova`.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"flavorID": {
+					"region": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FlavorID is the ID of the flavor to use.",
+							Description: "Region is the region of the endpoint.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ports": {
+					"interface": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ports is the fully resolved list of ports to create for the server.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ResolvedPortSpec"),
-									},
-								},
-							},
+							Description: "Interface is the interface of the endpoint: `public`, `internal` or `admin`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"url": {
+						SchemaProps: spec.SchemaProps{
+							Description: "URL is the URL of the endpoint.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"type", "url"},
 			},
 		},
-		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ResolvedPortSpec"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_PendingApplicationCredentialRevocation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "PendingApplicationCredentialRevocation is an application credential which will be deleted.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the ID of the application credential.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"revokeAfter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RevokeAfter is when the application credential will be deleted.",
+							Ref:         ref(metav1.Time{}.OpenAPIModelName()),
+						},
+					},
+				},
+				Required: []string{"id", "revokeAfter"},
+			},
+		},
+		Dependencies: []string{
+			metav1.Time{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1alpha1_ResolvedServerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ResolvedServerSpec contains resolved references to resources required by the server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"serverGroupID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServerGroupID is the ID of the server group the server should be added to and is calculated based on ServerGroupFilter.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imageID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImageID is the ID of the image to use for the server and is calculated based on ImageFilter.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"flavorID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FlavorID is the ID of the flavor to use.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports is the fully resolved list of ports to create for the server.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ResolvedPortSpec"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ResolvedPortSpec"},
 	}
 }
 
@@ -20120,7 +20749,7 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_OpenStackIdenti
 					},
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of a Secret (type=Secret) in the same namespace as the resource being provisioned, or the name of an OpenStackClusterIdentity (type=ClusterIdentity). The Secret must contain a key named `clouds.yaml` which contains an OpenStack clouds.yaml file. The Secret may optionally contain a key named `cacert` containing a PEM-encoded CA certificate.",
+							Description: "Name is the name of a Secret (type=Secret, ApplicationCredential, Token or Federated) in the same namespace as the resource being provisioned, or the name of an OpenStackClusterIdentity (type=ClusterIdentity). For type=Secret the Secret must contain a key named `clouds.yaml` which contains an OpenStack clouds.yaml file. For type=ApplicationCredential the Secret must contain the keys `authURL` and `applicationCredentialSecret`, and either `applicationCredentialID`, or `applicationCredentialName` together with `userID` or with `userName` and `userDomainName`. For type=Token the Secret must contain the keys `authURL` and `token`. For type=Federated the Secret must contain the keys `authURL`, `identityProvider` and `protocol`, and either `trustID`, `projectID`, or `projectName` together with `projectDomainName` or `projectDomainID`. The Secret may optionally contain a key named `cacert` containing a PEM-encoded CA certificate.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -20128,8 +20757,7 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_OpenStackIdenti
 					},
 					"cloudName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CloudName specifies the name of the entry in the clouds.yaml file to use.",
-							Default:     "",
+							Description: "CloudName specifies the name of the entry in the clouds.yaml file to use. It is required for type=Secret and type=ClusterIdentity, and ignored otherwise.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -20142,7 +20770,7 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta1_OpenStackIdenti
 						},
 					},
 				},
-				Required: []string{"name", "cloudName"},
+				Required: []string{"name"},
 			},
 		},
 	}
@@ -22339,17 +22967,29 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServer(ref c
 							Format:      "",
 						},
 					},
+					"floatingIPDNS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "floatingIPDNS sets the DNS name and domain of the floating IP of the API server load balancer or, without a load balancer, of the floating IP attached to a control plane machine. It is not applied to the floating IPs of zonal or additional load balancers or of the API server virtual IP, which would otherwise claim the same DNS name. This field is not used if EnableFloatingIP is set to false.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.FloatingIPDNS"),
+						},
+					},
 					"managedLoadBalancer": {
 						SchemaProps: spec.SchemaProps{
 							Description: "managedLoadBalancer configures the optional LoadBalancer for the API server. If not specified, no load balancer will be created.",
 							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancer"),
 						},
 					},
+					"virtualIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "virtualIP configures a virtual IP for the API server which is held by one of the control plane machines at a time. It is an alternative to a managed load balancer on clouds without Octavia, and cannot be used together with an enabled managed load balancer.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerVirtualIP"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancer"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancer", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerVirtualIP", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.FloatingIPDNS"},
 	}
 }
 
@@ -22458,406 +23098,1441 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServerLoadBa
 							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerMonitor"),
 						},
 					},
+					"tls": {
+						SchemaProps: spec.SchemaProps{
+							Description: "tls configures TLS on the listener for the API server port. If it is not specified the listener forwards TCP connections to the API server. Additional ports are not affected.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerTLS"),
+						},
+					},
+					"zones": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "zones creates a zonal load balancer for the API server in each of the given availability zones, in addition to the API server load balancer. The members of a zonal load balancer are the control plane machines in its zone. Each zonal load balancer has its own VIP, and its own floating IP if the API server uses a floating IP, so the API server remains reachable through the other zones if a zone fails. The addresses of all load balancers are listed in the addresses of the API server load balancer status, for example to publish them as DNS records of the control plane endpoint.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerZone"),
+									},
+								},
+							},
+						},
+					},
+					"memberDrain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "memberDrain drains the load balancer members of a control plane machine before the machine is deleted. The members first stop receiving new connections, and are deleted once the drain period has elapsed. If it is not specified the members are deleted immediately.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerMemberDrain"),
+						},
+					},
+					"loadBalancer": {
+						SchemaProps: spec.SchemaProps{
+							Description: "loadBalancer is an existing load balancer to use as the API server load balancer instead of creating one, for example a load balancer shared with other clusters. The listeners, pools and health monitors of the API server are created on it unless poolID is specified. The load balancer is never deleted, only the objects created on it.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancerParam"),
+						},
+					},
+					"poolID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "poolID is the ID of an existing pool of loadBalancer. The control plane machines are added to it as members, and no listener, pool or health monitor is created. The listener of the pool is expected to listen on the API server port.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"listener": {
+						SchemaProps: spec.SchemaProps{
+							Description: "listener configures the timeouts, connection limit and inserted headers of the listeners of the API server load balancer. Changes are applied to the existing listeners. Options which are not specified keep the value of the listener, which is the Octavia default for a new listener.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerListener"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerMonitor", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetParam"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerListener", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerMemberDrain", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerMonitor", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerTLS", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerZone", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancerParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetParam"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServerLoadBalancerMonitor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServerLoadBalancerListener(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "APIServerLoadBalancerMonitor contains configuration for the load balancer health monitor.",
+				Description: "APIServerLoadBalancerListener configures the listeners of the API server load balancer.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"delay": {
+					"timeoutClientData": {
 						SchemaProps: spec.SchemaProps{
-							Description: "delay is the time in seconds between sending probes to members.",
+							Description: "timeoutClientData is the client inactivity timeout in milliseconds. Long-running requests such as watches and kubectl exec sessions are closed when they are idle for longer. The Octavia default is 50000.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"timeout": {
+					"timeoutMemberConnect": {
 						SchemaProps: spec.SchemaProps{
-							Description: "timeout is the maximum time in seconds for a monitor to wait for a connection to be established before it times out.",
+							Description: "timeoutMemberConnect is the timeout in milliseconds for connecting to a member. The Octavia default is 5000.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"maxRetries": {
+					"timeoutMemberData": {
 						SchemaProps: spec.SchemaProps{
-							Description: "maxRetries is the number of successful checks before changing the operating status of the member to ONLINE.",
+							Description: "timeoutMemberData is the member inactivity timeout in milliseconds. The Octavia default is 50000.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"maxRetriesDown": {
+					"timeoutTCPInspect": {
 						SchemaProps: spec.SchemaProps{
-							Description: "maxRetriesDown is the number of allowed check failures before changing the operating status of the member to ERROR.",
+							Description: "timeoutTCPInspect is the time in milliseconds to wait for additional TCP packets for content inspection. The Octavia default is 0.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"connectionLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "connectionLimit is the maximum number of connections of each listener. -1 means unlimited, which is the Octavia default.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
+					"insertHeaders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "insertHeaders are the headers inserted into the requests forwarded to the API servers, for example X-Forwarded-For, with the value \"true\" or \"false\". They can only be inserted by the listener of the API server port, and only if it terminates TLS.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_AdditionalBlockDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServerLoadBalancerMemberDrain(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AdditionalBlockDevice is a block device to attach to the server.",
+				Description: "APIServerLoadBalancerMemberDrain configures the draining of the load balancer members of a control plane machine which is being deleted.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
-						SchemaProps: spec.SchemaProps{
-							Description: "name of the block device in the context of a machine. If the block device is a volume, the Cinder volume will be named as a combination of the machine name and this name. Also, this name will be used for tagging the block device. Information about the block device tag can be obtained from the OpenStack metadata API or the config drive. Name cannot be 'root', which is reserved for the root volume.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"sizeGiB": {
+					"periodSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "sizeGiB is the size of the block device in gibibytes (GiB).",
+							Description: "periodSeconds is the time in seconds during which the members of a deleted machine receive no new connections before they are deleted. The period is always waited in full, as Octavia does not report the active connections of a single member.",
+							Default:     0,
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"storage": {
-						SchemaProps: spec.SchemaProps{
-							Description: "storage specifies the storage type of the block device and additional storage options.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.BlockDeviceStorage"),
-						},
-					},
 				},
-				Required: []string{"name", "sizeGiB", "storage"},
+				Required: []string{"periodSeconds"},
 			},
 		},
-		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.BlockDeviceStorage"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_AddressPair(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServerLoadBalancerMonitor(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "APIServerLoadBalancerMonitor contains configuration for the load balancer health monitor.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ipAddress": {
+					"delay": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ipAddress is the IP address of the allowed address pair. Depending on the configuration of Neutron, it may be supported to specify a CIDR instead of a specific IP address.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "delay is the time in seconds between sending probes to members.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"macAddress": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "macAddress is the MAC address of the allowed address pair. If not specified, the MAC address will be the MAC address of the port.",
+							Description: "timeout is the maximum time in seconds for a monitor to wait for a connection to be established before it times out.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxRetries": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxRetries is the number of successful checks before changing the operating status of the member to ONLINE.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxRetriesDown": {
+						SchemaProps: spec.SchemaProps{
+							Description: "maxRetriesDown is the number of allowed check failures before changing the operating status of the member to ERROR.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "type is the type of the health monitor of the API server port. TCP only checks that the API server accepts connections. HTTPS requests urlPath from the API server and checks the response code. Health monitors of additional ports always use TCP.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"ipAddress"},
-			},
-		},
-	}
-}
-
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_AllocationPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"start": {
+					"urlPath": {
 						SchemaProps: spec.SchemaProps{
-							Description: "start represents the start of the AllocationPool, that is the lowest IP of the pool.",
+							Description: "urlPath is the path requested by an HTTPS health monitor. It defaults to /readyz.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"end": {
+					"expectedCodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "end represents the end of the AlloctionPool, that is the highest IP of the pool.",
+							Description: "expectedCodes are the HTTP status codes an HTTPS health monitor expects from a healthy API server. It is a single code, a comma separated list of codes or a range, for example 200, 200,202 or 200-204. It defaults to 200.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"start", "end"},
 			},
 		},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_Bastion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServerLoadBalancerReplacement(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Bastion represents basic information about the bastion node. If you enable bastion, the spec has to be specified.",
+				Description: "APIServerLoadBalancerReplacement describes the replacement of the API server load balancer after a property which cannot be changed on an existing load balancer, such as its provider, was changed.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "enabled means that bastion is enabled. The bastion is enabled by default if this field is not specified. Set this field to false to disable the bastion.\n\nIt is not currently possible to remove the bastion from the cluster spec without first disabling it by setting this field to false and waiting until the bastion has been deleted.",
-							Type:        []string{"boolean"},
+							Description: "phase is the current phase of the replacement.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"spec": {
+					"changes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "spec for the bastion itself",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.OpenStackMachineSpec"),
+							Description: "changes lists the properties of the load balancer which changed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"availabilityZone": {
+					"previousID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "availabilityZone is the failure domain that will be used to create the Bastion Spec.",
+							Description: "previousID is the ID of the load balancer being replaced.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"floatingIP": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "floatingIP which will be associated to the bastion machine. It's the IP address, not UUID. The floating IP should already exist and should not be associated with a port. If FIP of this address does not exist, CAPO will try to create it, but by default only OpenStack administrators have privileges to do so.",
+							Description: "name is the name of the replacement load balancer. It is renamed to the name of the previous load balancer once that has been deleted.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "id is the ID of the replacement load balancer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"internalIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "internalIP is the VIP address of the replacement load balancer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"phase", "previousID", "name"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServerLoadBalancerTLS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIServerLoadBalancerTLS configures TLS on the API server listener.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "mode defines how the load balancer handles TLS. Passthrough forwards TLS connections to the API server. Terminated terminates TLS with the certificate in certificateSecretName and re-encrypts the connection to the API server. As the load balancer terminates TLS, clients cannot authenticate to the API server with client certificates in Terminated mode.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"certificateSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "certificateSecretName is the name of a Secret of type kubernetes.io/tls in the namespace of the OpenStackCluster containing the certificate and private key served by the load balancer. It is stored in Barbican. It is required when mode is Terminated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"mode"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServerLoadBalancerZone(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIServerLoadBalancerZone is an availability zone with a zonal load balancer for the API server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the compute availability zone. The control plane machines in this zone are the members of the zonal load balancer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"availabilityZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "availabilityZone is the Octavia availability zone in which the zonal load balancer is created. It defaults to name. It is ignored if the Octavia provider does not support availability zones.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_APIServerVirtualIP(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIServerVirtualIP configures a virtual IP for the API server. A Neutron port is created to reserve the virtual IP, and the virtual IP is added to the allowed address pairs of the ports of the control plane machines. The configuration of the provider which moves the virtual IP between the control plane machines is written to a Secret for the bootstrap provider.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "provider is the software which holds the virtual IP on the control plane machines.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"interface": {
+						SchemaProps: spec.SchemaProps{
+							Description: "interface is the name of the network interface of the control plane machines on which the virtual IP is held. It defaults to eth0.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"virtualRouterID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "virtualRouterID is the VRRP virtual router ID used by keepalived. It must be unique among the VRRP routers on the network of the cluster. It defaults to 51.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "image is the kube-vip container image. It defaults to a kube-vip release known to work with this version of CAPO.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_AdditionalBlockDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdditionalBlockDevice is a block device to attach to the server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name of the block device in the context of a machine. If the block device is a volume, the Cinder volume will be named as a combination of the machine name and this name. Also, this name will be used for tagging the block device. Information about the block device tag can be obtained from the OpenStack metadata API or the config drive. Name cannot be 'root', which is reserved for the root volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sizeGiB": {
+						SchemaProps: spec.SchemaProps{
+							Description: "sizeGiB is the size of the block device in gibibytes (GiB).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"storage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "storage specifies the storage type of the block device and additional storage options.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.BlockDeviceStorage"),
+						},
+					},
+				},
+				Required: []string{"name", "sizeGiB", "storage"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.BlockDeviceStorage"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_AdditionalLoadBalancer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdditionalLoadBalancer is an Octavia load balancer managed by the OpenStackCluster in addition to the API server load balancer, for example for ingress.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name identifies the load balancer in the cluster. It is part of the names of the OpenStack resources of the load balancer.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"network": {
+						SchemaProps: spec.SchemaProps{
+							Description: "network is the network the VIP of the load balancer is allocated on. If neither network nor subnet are specified, the VIP is allocated on the primary subnet of the cluster.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkParam"),
+						},
+					},
+					"subnet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "subnet is the subnet the VIP of the load balancer is allocated on. If network is also specified, the subnet must be in it.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetParam"),
+						},
+					},
+					"provider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "provider specifies name of a specific Octavia provider to use for the load balancer. The Octavia default will be used if it is not specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"flavor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "flavor is the flavor name that will be used to create the load balancer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"availabilityZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "availabilityZone is the failure domain that will be used to create the load balancer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"enableFloatingIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "enableFloatingIP determines whether to associate a floating IP from the external network of the cluster with the VIP of the load balancer.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"listeners": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"port",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "listeners are the listeners of the load balancer.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AdditionalLoadBalancerListener"),
+									},
+								},
+							},
+						},
+					},
+					"members": {
+						SchemaProps: spec.SchemaProps{
+							Description: "members selects the machines of the cluster which are members of the pools of the listeners.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AdditionalLoadBalancerMembers"),
+						},
+					},
+				},
+				Required: []string{"name", "listeners", "members"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AdditionalLoadBalancerListener", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AdditionalLoadBalancerMembers", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetParam"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_AdditionalLoadBalancerListener(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdditionalLoadBalancerListener is a listener of an additional load balancer, and the pool of members it forwards connections to.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "port is the port the listener listens on.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "protocol is the protocol of the listener and its pool. It defaults to TCP.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"memberPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "memberPort is the port members receive connections on. It defaults to port.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"allowedCIDRs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "allowedCIDRs restrict access to the listener to the given address CIDRs.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"monitor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "monitor contains configuration for the health monitor of the pool. The health monitor of a UDP listener always uses UDP-CONNECT.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerMonitor"),
+						},
+					},
+				},
+				Required: []string{"port"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerMonitor"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_AdditionalLoadBalancerMembers(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdditionalLoadBalancerMembers selects the machines which are members of an additional load balancer. A machine is a member if it matches machineSelector or belongs to one of machineDeployments.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"machineSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "machineSelector selects Machines of the cluster by their labels. The control plane machines are selected by the label cluster.x-k8s.io/control-plane.",
+							Ref:         ref(metav1.LabelSelector{}.OpenAPIModelName()),
+						},
+					},
+					"machineDeployments": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "machineDeployments are the names of MachineDeployments whose Machines are members.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			metav1.LabelSelector{}.OpenAPIModelName()},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_AdditionalLoadBalancerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdditionalLoadBalancerStatus describes an additional load balancer.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the load balancer in spec.additionalLoadBalancers.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "id is the unique identifier of the load balancer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"internalIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "internalIP is the VIP address of the load balancer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ip is the floating IP address associated with the VIP of the load balancer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_AddressPair(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ipAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ipAddress is the IP address of the allowed address pair. Depending on the configuration of Neutron, it may be supported to specify a CIDR instead of a specific IP address.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"macAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "macAddress is the MAC address of the allowed address pair. If not specified, the MAC address will be the MAC address of the port.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"ipAddress"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_AllocationPool(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"start": {
+						SchemaProps: spec.SchemaProps{
+							Description: "start represents the start of the AllocationPool, that is the lowest IP of the pool.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "end represents the end of the AlloctionPool, that is the highest IP of the pool.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"start", "end"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_Bastion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Bastion represents basic information about the bastion node. If you enable bastion, the spec has to be specified.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "enabled means that bastion is enabled. The bastion is enabled by default if this field is not specified. Set this field to false to disable the bastion.\n\nIt is not currently possible to remove the bastion from the cluster spec without first disabling it by setting this field to false and waiting until the bastion has been deleted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "spec for the bastion itself",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.OpenStackMachineSpec"),
+						},
+					},
+					"availabilityZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "availabilityZone is the failure domain that will be used to create the Bastion Spec.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"floatingIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "floatingIP which will be associated to the bastion machine. It's the IP address, not UUID. The floating IP should already exist and should not be associated with a port. If FIP of this address does not exist, CAPO will try to create it, but by default only OpenStack administrators have privileges to do so.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"floatingIPDNS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "floatingIPDNS sets the DNS name and domain of the floating IP created for the bastion.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.FloatingIPDNS"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.FloatingIPDNS", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.OpenStackMachineSpec"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_BastionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "id is the unique identifier of the bastion.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the bastion.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sshKeyName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "sshKeyName is the name of the SSH key used for the bastion.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "state is the current state of the bastion.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ip is the IP address of the bastion.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"floatingIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "floatingIP is the floating IP address of the bastion.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resolved": {
+						SchemaProps: spec.SchemaProps{
+							Description: "resolved contains parts of the bastion's machine spec with all external references fully resolved.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ResolvedMachineSpec"),
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "resources contains references to OpenStack resources created for the bastion.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.MachineResources"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.MachineResources", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ResolvedMachineSpec"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_BindingProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ovsHWOffload": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ovsHWOffload enables or disables the OVS hardware offload feature. This flag is not required on OpenStack clouds since Yoga as Nova will set it automatically when the port is attached. See: https://bugs.launchpad.net/nova/+bug/2020813",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"trustedVF": {
+						SchemaProps: spec.SchemaProps{
+							Description: "trustedVF enables or disables the “trusted mode” for the VF.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_BlockDeviceStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlockDeviceStorage is the storage type of a block device to create and contains additional storage options.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "type is the type of block device to create. This can be either \"Volume\" or \"Local\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "volume contains additional storage options for a volume block device.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.BlockDeviceVolume"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+			VendorExtensible: spec.VendorExtensible{
+				Extensions: spec.Extensions{
+					"x-kubernetes-unions": []interface{}{
+						map[string]interface{}{
+							"discriminator": "type",
+							"fields-to-discriminateBy": map[string]interface{}{
+								"volume": "Volume",
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.BlockDeviceVolume"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_BlockDeviceVolume(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BlockDeviceVolume contains additional storage options for a volume block device.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "type is the Cinder volume type of the volume. If omitted, the default Cinder volume type that is configured in the OpenStack cloud will be used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"availabilityZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "availabilityZone is the volume availability zone to create the volume in. If not specified, the volume will be created without an explicit availability zone.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.VolumeAvailabilityZone"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.VolumeAvailabilityZone"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ClusterInitialization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterInitialization represents the initialization status of the cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"provisioned": {
+						SchemaProps: spec.SchemaProps{
+							Description: "provisioned is set to true when the initial provisioning of the cluster infrastructure is completed. The value of this field is never updated after provisioning is completed.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ExternalRouterIPParam(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fixedIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "fixedIP is the FixedIP in the corresponding subnet.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"subnet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "subnet is the subnet in which the FixedIP is used for the Gateway of this router.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetParam"),
+						},
+					},
+				},
+				Required: []string{"subnet"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetParam"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_FilterByNeutronTags(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"tags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "tags is a list of tags to filter by. If specified, the resource must have all of the tags specified to be included in the result.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tagsAny": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "tagsAny is a list of tags to filter by. If specified, the resource must have at least one of the tags specified to be included in the result.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"notTags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "notTags is a list of tags to filter by. If specified, resources which contain all of the given tags will be excluded from the result.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"notTagsAny": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "notTagsAny is a list of tags to filter by. If specified, resources which contain any of the given tags will be excluded from the result.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
-		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.OpenStackMachineSpec"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_BastionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_FixedIP(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"subnet": {
 						SchemaProps: spec.SchemaProps{
-							Description: "id is the unique identifier of the bastion.",
+							Description: "subnet is an openstack subnet query that will return the id of a subnet to create the fixed IP of a port in. This query must not return more than one subnet.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetParam"),
+						},
+					},
+					"ipAddress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ipAddress is a specific IP address to assign to the port. If Subnet is also specified, IPAddress must be a valid IP address in the subnet. If Subnet is not specified, IPAddress must be a valid IP address in any subnet of the port's network.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetParam"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_FlavorFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FlavorFilter describes a query for a flavor. If defined, the combination of attributes should return exactly one flavor, if not an error will be raised.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name is the name of the bastion.",
+							Description: "name is the name of the desired flavor.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"sshKeyName": {
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_FlavorParam(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FlavorParam describes a nova flavor. It can be specified by ID or filter",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "sshKeyName is the name of the SSH key used for the bastion.",
+							Description: "id is the uuid of the flavor. ID will not be validated before use.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"state": {
+					"filter": {
 						SchemaProps: spec.SchemaProps{
-							Description: "state is the current state of the bastion.",
+							Description: "filter describes a query for a flavor.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.FlavorFilter"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.FlavorFilter"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_FloatingIPDNS(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FloatingIPDNS is the DNS configuration of a floating IP. It is only applied when the Neutron dns-integration extension is available, in which case an external DNS service such as Designate will publish a record for the floating IP. Neutron requires both dnsName and dnsDomain to be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dnsName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "dnsName is the DNS name of the floating IP.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ip": {
+					"dnsDomain": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ip is the IP address of the bastion.",
+							Description: "dnsDomain is the DNS domain in which the record for the floating IP will be published. It must be a fully qualified domain name ending with a dot.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"floatingIP": {
+				},
+				Required: []string{"dnsName", "dnsDomain"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ImageFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ImageFilter describes a query for an image.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "floatingIP is the floating IP address of the bastion.",
+							Description: "name is the name of the desired image. If specified, the combination of name and tags must return a single matching image or an error will be raised.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"resolved": {
+					"tags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "resolved contains parts of the bastion's machine spec with all external references fully resolved.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ResolvedMachineSpec"),
+							Description: "tags are the tags associated with the desired image. If specified, the combination of name and tags must return a single matching image or an error will be raised.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"resources": {
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ImageParam(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ImageParam describes a glance image. It can be specified by ID, filter, or a reference to an ORC Image.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "resources contains references to OpenStack resources created for the bastion.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.MachineResources"),
+							Description: "id is the uuid of the image. ID will not be validated before use.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "filter describes a query for an image. If specified, the combination of name and tags must return a single matching image or an error will be raised.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ImageFilter"),
+						},
+					},
+					"imageRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "imageRef is a reference to an ORC Image in the same namespace as the referring object.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ResourceReference"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.MachineResources", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ResolvedMachineSpec"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ImageFilter", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ResourceReference"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_BindingProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_LoadBalancer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "LoadBalancer represents basic information about the associated OpenStack LoadBalancer.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ovsHWOffload": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ovsHWOffload enables or disables the OVS hardware offload feature. This flag is not required on OpenStack clouds since Yoga as Nova will set it automatically when the port is attached. See: https://bugs.launchpad.net/nova/+bug/2020813",
-							Type:        []string{"boolean"},
+							Description: "name is the name of the load balancer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "id is the unique identifier of the load balancer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ip is the IP address of the load balancer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"internalIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "internalIP is the internal IP address of the load balancer.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"trustedVF": {
+					"allowedCIDRs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "allowedCIDRs is a list of CIDRs that are allowed to access the load balancer.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "tags is a list of tags on the load balancer.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"loadBalancerNetwork": {
+						SchemaProps: spec.SchemaProps{
+							Description: "loadBalancerNetwork contains information about network and/or subnets which the loadbalancer is allocated on. If subnets are specified within the LoadBalancerNetwork currently only the first subnet in the list is taken into account.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkStatusWithSubnets"),
+						},
+					},
+					"zones": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "zones describes the zonal load balancers of the API server.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancerZone"),
+									},
+								},
+							},
+						},
+					},
+					"addresses": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "trustedVF enables or disables the “trusted mode” for the VF.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "addresses are the addresses at which the API server can be reached through the load balancer and its zonal load balancers. These are their floating IPs if the API server uses a floating IP, and their VIPs otherwise.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_BlockDeviceStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "BlockDeviceStorage is the storage type of a block device to create and contains additional storage options.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"type": {
+					"provisioningStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "type is the type of block device to create. This can be either \"Volume\" or \"Local\".",
+							Description: "provisioningStatus is the provisioning status of the load balancer reported by Octavia.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"volume": {
-						SchemaProps: spec.SchemaProps{
-							Description: "volume contains additional storage options for a volume block device.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.BlockDeviceVolume"),
-						},
-					},
-				},
-				Required: []string{"type"},
-			},
-			VendorExtensible: spec.VendorExtensible{
-				Extensions: spec.Extensions{
-					"x-kubernetes-unions": []interface{}{
-						map[string]interface{}{
-							"discriminator": "type",
-							"fields-to-discriminateBy": map[string]interface{}{
-								"volume": "Volume",
-							},
-						},
-					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.BlockDeviceVolume"},
-	}
-}
-
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_BlockDeviceVolume(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "BlockDeviceVolume contains additional storage options for a volume block device.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"type": {
+					"operatingStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "type is the Cinder volume type of the volume. If omitted, the default Cinder volume type that is configured in the OpenStack cloud will be used.",
+							Description: "operatingStatus is the operating status of the load balancer reported by Octavia.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"availabilityZone": {
+					"listeners": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"id",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "availabilityZone is the volume availability zone to create the volume in. If not specified, the volume will be created without an explicit availability zone.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.VolumeAvailabilityZone"),
+							Description: "listeners describes the listeners of the load balancer used by the API server, with their pools and members, as reported by the status tree of the load balancer.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancerListenerStatus"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"name", "id", "ip", "internalIP"},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.VolumeAvailabilityZone"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancerListenerStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancerZone", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkStatusWithSubnets"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ClusterInitialization(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_LoadBalancerFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterInitialization represents the initialization status of the cluster.",
+				Description: "LoadBalancerFilter specifies a query to select an Octavia load balancer. At least one property must be set.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"provisioned": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "provisioned is set to true when the initial provisioning of the cluster infrastructure is completed. The value of this field is never updated after provisioning is completed.",
-							Type:        []string{"boolean"},
+							Description: "name filters load balancers by name.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ExternalRouterIPParam(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"fixedIP": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "fixedIP is the FixedIP in the corresponding subnet.",
+							Description: "description filters load balancers by description.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"subnet": {
+					"projectID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "subnet is the subnet in which the FixedIP is used for the Gateway of this router.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetParam"),
+							Description: "projectID filters load balancers by project ID.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"subnet"},
-			},
-		},
-		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetParam"},
-	}
-}
-
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_FilterByNeutronTags(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
 					"tags": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -22944,242 +24619,254 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_FilterByNeutron
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_FixedIP(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_LoadBalancerListenerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "LoadBalancerListenerStatus describes the status of a listener of the API server load balancer.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"subnet": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "subnet is an openstack subnet query that will return the id of a subnet to create the fixed IP of a port in. This query must not return more than one subnet.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetParam"),
+							Description: "id is the ID of the listener.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"ipAddress": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ipAddress is a specific IP address to assign to the port. If Subnet is also specified, IPAddress must be a valid IP address in the subnet. If Subnet is not specified, IPAddress must be a valid IP address in any subnet of the port's network.",
+							Description: "name is the name of the listener.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetParam"},
-	}
-}
-
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_FlavorFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "FlavorFilter describes a query for a flavor. If defined, the combination of attributes should return exactly one flavor, if not an error will be raised.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"provisioningStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name is the name of the desired flavor.",
+							Description: "provisioningStatus is the provisioning status of the listener.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_FlavorParam(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "FlavorParam describes a nova flavor. It can be specified by ID or filter",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"id": {
+					"operatingStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "id is the uuid of the flavor. ID will not be validated before use.",
+							Description: "operatingStatus is the operating status of the listener.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"filter": {
+					"pools": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"id",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "filter describes a query for a flavor.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.FlavorFilter"),
+							Description: "pools describes the pools of the listener.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancerPoolStatus"),
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"id"},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.FlavorFilter"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancerPoolStatus"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ImageFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_LoadBalancerMemberStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ImageFilter describes a query for an image.",
+				Description: "LoadBalancerMemberStatus describes the status of a member of a pool of the API server load balancer.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "id is the ID of the member.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name is the name of the desired image. If specified, the combination of name and tags must return a single matching image or an error will be raised.",
+							Description: "name is the name of the member.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tags": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
-							},
+					"address": {
+						SchemaProps: spec.SchemaProps{
+							Description: "address is the IP address of the member.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
+					},
+					"provisioningStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "tags are the tags associated with the desired image. If specified, the combination of name and tags must return a single matching image or an error will be raised.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "provisioningStatus is the provisioning status of the member.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"operatingStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "operatingStatus is the operating status of the member: ONLINE, OFFLINE, DRAINING, ERROR or NO_MONITOR if the pool has no health monitor.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"id"},
 			},
 		},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ImageParam(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_LoadBalancerParam(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ImageParam describes a glance image. It can be specified by ID, filter, or a reference to an ORC Image.",
+				Description: "LoadBalancerParam specifies an Octavia load balancer. It may be specified by ID or filter, but not both.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "id is the uuid of the image. ID will not be validated before use.",
+							Description: "id is the ID of the load balancer to use. If ID is provided, the other filters cannot be provided. Must be in UUID format.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"filter": {
 						SchemaProps: spec.SchemaProps{
-							Description: "filter describes a query for an image. If specified, the combination of name and tags must return a single matching image or an error will be raised.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ImageFilter"),
-						},
-					},
-					"imageRef": {
-						SchemaProps: spec.SchemaProps{
-							Description: "imageRef is a reference to an ORC Image in the same namespace as the referring object.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ResourceReference"),
+							Description: "filter specifies a filter to select an Octavia load balancer. If provided, cannot be empty.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancerFilter"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ImageFilter", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ResourceReference"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancerFilter"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_LoadBalancer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_LoadBalancerPoolStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LoadBalancer represents basic information about the associated OpenStack LoadBalancer.",
+				Description: "LoadBalancerPoolStatus describes the status of a pool of the API server load balancer.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name is the name of the load balancer.",
+							Description: "id is the ID of the pool.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"id": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "id is the unique identifier of the load balancer.",
+							Description: "name is the name of the pool.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"ip": {
+					"provisioningStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ip is the IP address of the load balancer.",
+							Description: "provisioningStatus is the provisioning status of the pool.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"internalIP": {
+					"operatingStatus": {
 						SchemaProps: spec.SchemaProps{
-							Description: "internalIP is the internal IP address of the load balancer.",
+							Description: "operatingStatus is the operating status of the pool.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"allowedCIDRs": {
+					"members": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
+								"x-kubernetes-list-map-keys": []interface{}{
+									"id",
+								},
+								"x-kubernetes-list-type": "map",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "allowedCIDRs is a list of CIDRs that are allowed to access the load balancer.",
+							Description: "members describes the members of the pool.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancerMemberStatus"),
 									},
 								},
 							},
 						},
 					},
-					"tags": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "set",
-							},
+				},
+				Required: []string{"id"},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancerMemberStatus"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_LoadBalancerZone(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LoadBalancerZone describes a zonal load balancer of the API server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the availability zone.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
+					},
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "tags is a list of tags on the load balancer.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "id is the ID of the zonal load balancer.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"loadBalancerNetwork": {
+					"internalIP": {
 						SchemaProps: spec.SchemaProps{
-							Description: "loadBalancerNetwork contains information about network and/or subnets which the loadbalancer is allocated on. If subnets are specified within the LoadBalancerNetwork currently only the first subnet in the list is taken into account.",
-							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkStatusWithSubnets"),
+							Description: "internalIP is the VIP address of the zonal load balancer.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ip is the floating IP address associated with the VIP of the zonal load balancer.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "id", "ip", "internalIP"},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkStatusWithSubnets"},
 	}
 }
 
@@ -23257,9 +24944,17 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ManagedNetwork(
 							Format:      "",
 						},
 					},
+					"qosPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "qosPolicy is the Neutron QoS policy to apply to the network created for the Kubernetes cluster. It applies to every port of the network which does not set its own QoS policy. This value will be used only if the Cluster actuator creates the network.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.QoSPolicyParam"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.QoSPolicyParam"},
 	}
 }
 
@@ -23783,7 +25478,7 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_OpenStackCluste
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "managedSubnets describe OpenStack Subnets to be created. Cluster actuator will create a network, subnets with the defined CIDR, and a router connected to these subnets. Currently only one IPv4 subnet is supported. If you leave this empty, no network will be created.",
+							Description: "managedSubnets describe OpenStack Subnets to be created. Cluster actuator will create a network, subnets with the defined CIDR, and a router connected to these subnets. At most one IPv4 and one IPv6 subnet are supported, which creates a dual-stack network. If you leave this empty, no network will be created.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -23863,6 +25558,28 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_OpenStackCluste
 							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServer"),
 						},
 					},
+					"additionalLoadBalancers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "additionalLoadBalancers are Octavia load balancers managed by the cluster in addition to the API server load balancer, for example for ingress. Their members are selected from all machines of the cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AdditionalLoadBalancer"),
+									},
+								},
+							},
+						},
+					},
 					"managedSecurityGroups": {
 						SchemaProps: spec.SchemaProps{
 							Description: "managedSecurityGroups determines whether OpenStack security groups for the cluster will be managed by the OpenStack provider or whether pre-existing security groups will be specified as part of the configuration. By default, the managed security groups have rules that allow the Kubelet, etcd, and the Kubernetes API server to function correctly. It's possible to add additional rules to the managed security groups. When defined to an empty struct, the managed security groups will be created with the default rules.",
@@ -23940,7 +25657,7 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_OpenStackCluste
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServer", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.Bastion", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ManagedNetwork", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ManagedRouter", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ManagedSecurityGroups", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.OpenStackIdentityReference", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.RouterParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.APIEndpoint"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServer", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AdditionalLoadBalancer", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.Bastion", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ManagedNetwork", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ManagedRouter", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ManagedSecurityGroups", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.OpenStackIdentityReference", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.RouterParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetSpec", "sigs.k8s.io/cluster-api/api/core/v1beta2.APIEndpoint"},
 	}
 }
 
@@ -24003,6 +25720,40 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_OpenStackCluste
 							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancer"),
 						},
 					},
+					"apiServerLoadBalancerReplacement": {
+						SchemaProps: spec.SchemaProps{
+							Description: "apiServerLoadBalancerReplacement describes the replacement of the api server load balancer while one is in progress.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerReplacement"),
+						},
+					},
+					"apiServerVirtualIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "apiServerVirtualIP describes the virtual IP of the api server if one exists",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.VirtualIPStatus"),
+						},
+					},
+					"additionalLoadBalancers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "additionalLoadBalancers describes the load balancers in spec.additionalLoadBalancers.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AdditionalLoadBalancerStatus"),
+									},
+								},
+							},
+						},
+					},
 					"failureDomains": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -24053,7 +25804,7 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_OpenStackCluste
 			},
 		},
 		Dependencies: []string{
-			metav1.Condition{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.BastionStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ClusterInitialization", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancer", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkStatusWithSubnets", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.Router", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SecurityGroupStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.FailureDomain"},
+			metav1.Condition{}.OpenAPIModelName(), "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.APIServerLoadBalancerReplacement", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AdditionalLoadBalancerStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.BastionStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ClusterInitialization", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.LoadBalancer", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkStatusWithSubnets", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.Router", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SecurityGroupStatus", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.VirtualIPStatus", "sigs.k8s.io/cluster-api/api/core/v1beta2.FailureDomain"},
 	}
 }
 
@@ -24218,14 +25969,14 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_OpenStackIdenti
 					},
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name is the name of a Secret (type=Secret) in the same namespace as the resource being provisioned, or the name of an OpenStackClusterIdentity (type=ClusterIdentity). The Secret must contain a key named `clouds.yaml` which contains an OpenStack clouds.yaml file. The Secret may optionally contain a key named `cacert` containing a PEM-encoded CA certificate.",
+							Description: "name is the name of a Secret (type=Secret, ApplicationCredential, Token or Federated) in the same namespace as the resource being provisioned, or the name of an OpenStackClusterIdentity (type=ClusterIdentity).\n\nFor type=Secret the Secret must contain a key named `clouds.yaml` which contains an OpenStack clouds.yaml file.\n\nFor type=ApplicationCredential the Secret must contain the keys `authURL` and `applicationCredentialSecret`, and either `applicationCredentialID`, or `applicationCredentialName` together with `userID` or with `userName` and `userDomainName`. The application credential may be restricted by access rules.\n\nFor type=Token the Secret must contain the keys `authURL` and `token`, a project-scoped token which is used until it expires. The token is not renewed by the controllers, so the Secret must be updated with a new token before the current one expires.\n\nFor type=Federated the Secret must contain the keys `authURL`, `identityProvider` and `protocol`, and either `trustID`, `projectID`, or `projectName` together with `projectDomainName` or `projectDomainID`. The manager's projected service account token is exchanged for an unscoped token with the federated identity provider and protocol, which is then scoped to the trust or project. No long-lived credentials are stored.\n\nFor type=ApplicationCredential, type=Token and type=Federated the Secret may optionally contain the keys `regionName` and `interface`. For all types the Secret may optionally contain a key named `cacert` containing a PEM-encoded CA certificate.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"cloudName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudName specifies the name of the entry in the clouds.yaml file to use.",
+							Description: "cloudName specifies the name of the entry in the clouds.yaml file to use. It is required for type=Secret and type=ClusterIdentity, and ignored otherwise.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -24238,7 +25989,7 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_OpenStackIdenti
 						},
 					},
 				},
-				Required: []string{"name", "cloudName"},
+				Required: []string{"name"},
 			},
 		},
 	}
@@ -24904,6 +26655,12 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_PortOpts(ref co
 							},
 						},
 					},
+					"qosPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "qosPolicy is the Neutron QoS policy to apply to the port. If not specified, the port uses the QoS policy of its network, if any.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.QoSPolicyParam"),
+						},
+					},
 					"tags": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -24998,23 +26755,173 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_PortOpts(ref co
 							Format:      "",
 						},
 					},
-					"valueSpecs": {
+					"valueSpecs": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"name",
+								},
+								"x-kubernetes-list-type": "map",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "valueSpecs are extra parameters to include in the API request with OpenStack. This is an extension point for the API, so what they do and if they are supported, depends on the specific OpenStack implementation.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ValueSpec"),
+									},
+								},
+							},
+						},
+					},
+					"dnsName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "dnsName is the DNS name of the port. It is only applied when the Neutron dns-integration extension is available. If not specified and dnsDomain is set, it defaults to the hostname Nova derives from the name of the server the port is attached to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsDomain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "dnsDomain is the DNS domain in which records for the port will be published by the external DNS service. It is only applied when the Neutron dns-integration extension is available.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AddressPair", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.BindingProfile", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.FixedIP", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.QoSPolicyParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SecurityGroupParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ValueSpec"},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_PortStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "id is the unique identifier of the port.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"id"},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_QoSPolicyFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QoSPolicyFilter specifies a query to select an OpenStack QoS policy. At least one property must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name filters QoS policies by name.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "description filters QoS policies by description.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"projectID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "projectID filters QoS policies by project ID.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "tags is a list of tags to filter by. If specified, the resource must have all of the tags specified to be included in the result.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tagsAny": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "tagsAny is a list of tags to filter by. If specified, the resource must have at least one of the tags specified to be included in the result.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"notTags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "notTags is a list of tags to filter by. If specified, resources which contain all of the given tags will be excluded from the result.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"notTagsAny": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
-								"x-kubernetes-list-map-keys": []interface{}{
-									"name",
-								},
-								"x-kubernetes-list-type": "map",
+								"x-kubernetes-list-type": "set",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "valueSpecs are extra parameters to include in the API request with OpenStack. This is an extension point for the API, so what they do and if they are supported, depends on the specific OpenStack implementation.",
+							Description: "notTagsAny is a list of tags to filter by. If specified, resources which contain any of the given tags will be excluded from the result.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ValueSpec"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
@@ -25023,28 +26930,34 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_PortOpts(ref co
 				},
 			},
 		},
-		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AddressPair", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.BindingProfile", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.FixedIP", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.NetworkParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SecurityGroupParam", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.ValueSpec"},
 	}
 }
 
-func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_PortStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_QoSPolicyParam(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "QoSPolicyParam specifies an OpenStack QoS policy. It may be specified by either ID or filter, but not both.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "id is the unique identifier of the port.",
+							Description: "id is the ID of the QoS policy to use. If ID is provided, the other filters cannot be provided. Must be in UUID format.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "filter specifies a filter to select an OpenStack QoS policy. If provided, cannot be empty.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.QoSPolicyFilter"),
+						},
+					},
 				},
-				Required: []string{"id"},
 			},
 		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.QoSPolicyFilter"},
 	}
 }
 
@@ -25225,6 +27138,13 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ResolvedPortSpe
 							},
 						},
 					},
+					"qosPolicyID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "qosPolicyID is the ID of the QoS policy to apply to the port.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"adminStateUp": {
 						SchemaProps: spec.SchemaProps{
 							Description: "adminStateUp specifies whether the port should be created in the up (true) or down (false) state. The default is up.",
@@ -25314,6 +27234,20 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ResolvedPortSpe
 							},
 						},
 					},
+					"dnsName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "dnsName is the DNS name of the port. It is only applied when the Neutron dns-integration extension is available. If not specified and dnsDomain is set, it defaults to the hostname Nova derives from the name of the server the port is attached to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsDomain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "dnsDomain is the DNS domain in which records for the port will be published by the external DNS service. It is only applied when the Neutron dns-integration extension is available.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name", "networkID"},
 			},
@@ -25419,6 +27353,20 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ResolvedPortSpe
 							},
 						},
 					},
+					"dnsName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "dnsName is the DNS name of the port. It is only applied when the Neutron dns-integration extension is available. If not specified and dnsDomain is set, it defaults to the hostname Nova derives from the name of the server the port is attached to.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dnsDomain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "dnsDomain is the DNS domain in which records for the port will be published by the external DNS service. It is only applied when the Neutron dns-integration extension is available.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -26338,6 +28286,162 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_SubnetParam(ref
 	}
 }
 
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_SubnetPoolFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubnetPoolFilter specifies a query to select an OpenStack subnet pool. At least one property must be set.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name filters subnet pools by name.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "description filters subnet pools by description.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"projectID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "projectID filters subnet pools by project ID.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ipVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ipVersion filters subnet pools by IP version.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"addressScopeID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "addressScopeID filters subnet pools by the ID of their address scope.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "tags is a list of tags to filter by. If specified, the resource must have all of the tags specified to be included in the result.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tagsAny": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "tagsAny is a list of tags to filter by. If specified, the resource must have at least one of the tags specified to be included in the result.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"notTags": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "notTags is a list of tags to filter by. If specified, resources which contain all of the given tags will be excluded from the result.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"notTagsAny": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "notTagsAny is a list of tags to filter by. If specified, resources which contain any of the given tags will be excluded from the result.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_SubnetPoolParam(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SubnetPoolParam specifies an OpenStack subnet pool. It may be specified by either ID or filter, but not both.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "id is the ID of the subnet pool to use. If ID is provided, the other filters cannot be provided. Must be in UUID format.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "filter specifies a filter to select an OpenStack subnet pool. If provided, cannot be empty.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetPoolFilter"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetPoolFilter"},
+	}
+}
+
 func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_SubnetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -26346,11 +28450,24 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_SubnetSpec(ref
 				Properties: map[string]spec.Schema{
 					"cidr": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cidr is representing the IP address range used to create the subnet, e.g. 10.0.0.0/24. This field is required when defining a subnet.",
+							Description: "cidr is representing the IP address range used to create the subnet, e.g. 10.0.0.0/24. Either cidr or subnetPool must be set.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"subnetPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "subnetPool is the Neutron subnet pool from which the CIDR of the subnet is allocated, instead of specifying cidr. The allocated CIDR is reported in the status of the cluster network. The subnet is in the address scope of the subnet pool, if it has one.",
+							Ref:         ref("sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetPoolParam"),
+						},
+					},
+					"prefixLength": {
+						SchemaProps: spec.SchemaProps{
+							Description: "prefixLength is the prefix length of the CIDR allocated from subnetPool. If not set, the default prefix length of the subnet pool is used.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 					"dnsNameservers": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
@@ -26390,12 +28507,25 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_SubnetSpec(ref
 							},
 						},
 					},
+					"ipv6AddressMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ipv6AddressMode specifies how IPv6 addresses are assigned to ports on the subnet. It can only be set on an IPv6 subnet, or on a subnet allocated from an IPv6 subnet pool. If not set, ports get addresses from the OpenStack IPAM.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ipv6RAMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ipv6RAMode specifies how router advertisements are sent on the subnet by the router it is attached to. It can only be set on an IPv6 subnet, or on a subnet allocated from an IPv6 subnet pool. If not set, OpenStack does not send router advertisements.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"cidr"},
 			},
 		},
 		Dependencies: []string{
-			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AllocationPool"},
+			"sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.AllocationPool", "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2.SubnetPoolParam"},
 	}
 }
 
@@ -26434,6 +28564,48 @@ func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_ValueSpec(ref c
 	}
 }
 
+func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_VirtualIPStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualIPStatus describes the virtual IP of the API server.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"portID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "portID is the ID of the Neutron port which reserves the virtual IP.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ip": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ip is the virtual IP address.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"floatingIP": {
+						SchemaProps: spec.SchemaProps{
+							Description: "floatingIP is the floating IP address associated with the virtual IP.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "configSecretName is the name of the Secret in the namespace of the OpenStackCluster containing the configuration of the provider for the control plane machines.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"portID"},
+			},
+		},
+	}
+}
+
 func schema_sigsk8sio_cluster_api_provider_openstack_api_v1beta2_VolumeAvailabilityZone(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -0,0 +1,245 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// openstack-simulator serves an in-memory OpenStack cloud over the Keystone,
+// Nova, Neutron, Cinder, Glance and Octavia APIs, so that the manager can be
+// run against it with an ordinary clouds.yaml.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
+	"github.com/spf13/pflag"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake/simulator"
+)
+
+var (
+	bindAddress     string
+	tlsCertFile     string
+	tlsKeyFile      string
+	region          string
+	username        string
+	password        string
+	projectName     string
+	projectID       string
+	tokenTTL        time.Duration
+	transitionDelay int
+	flavors         []string
+	images          []string
+	externalNetwork string
+	zones           []string
+	lbFlavors       []string
+	cloudsYAML      string
+	cloudName       string
+)
+
+func initFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&bindAddress, "bind-address", "127.0.0.1:8080",
+		"The address the OpenStack APIs are served on.")
+	fs.StringVar(&tlsCertFile, "tls-cert-file", "",
+		"The certificate to serve the APIs over HTTPS with. If unset the APIs are served over plain HTTP.")
+	fs.StringVar(&tlsKeyFile, "tls-key-file", "",
+		"The private key for --tls-cert-file.")
+	fs.StringVar(&region, "region", simulator.DefaultRegion,
+		"The region of the endpoints in the service catalog.")
+	fs.StringVar(&username, "username", simulator.DefaultUsername,
+		"The username accepted by Keystone.")
+	fs.StringVar(&password, "password", simulator.DefaultPassword,
+		"The password accepted by Keystone.")
+	fs.StringVar(&projectName, "project-name", simulator.DefaultProjectName,
+		"The name of the project tokens are scoped to.")
+	fs.StringVar(&projectID, "project-id", fake.DefaultProjectID,
+		"The ID of the project which owns all resources.")
+	fs.DurationVar(&tokenTTL, "token-ttl", simulator.DefaultTokenTTL,
+		"The lifetime of issued tokens.")
+	fs.IntVar(&transitionDelay, "transition-delay", 3,
+		"The number of times a resource in a transitional state, for example a server in BUILD, is read before it reaches its final state.")
+	fs.StringArrayVar(&flavors, "flavor", []string{"m1.small:1:2048:20", "m1.medium:2:4096:40", "m1.large:4:8192:80"},
+		"A compute flavor, as name:vcpus:ramMiB:diskGiB. May be repeated.")
+	fs.StringArrayVar(&images, "image", nil,
+		"The name of an active image. May be repeated.")
+	fs.StringVar(&externalNetwork, "external-network", "public:172.24.4.0/24",
+		"The external network routers and floating IPs are allocated from, as name:cidr. Empty disables it.")
+	fs.StringArrayVar(&zones, "availability-zone", nil,
+		"An additional compute availability zone. May be repeated.")
+	fs.StringArrayVar(&lbFlavors, "load-balancer-flavor", nil,
+		"The name of an Octavia flavor. May be repeated.")
+	fs.StringVar(&cloudsYAML, "clouds-yaml", "",
+		"If set, write a clouds.yaml for the simulator to this path.")
+	fs.StringVar(&cloudName, "cloud-name", "openstack",
+		"The name of the cloud in the clouds.yaml written by --clouds-yaml.")
+}
+
+func main() {
+	klog.InitFlags(nil)
+	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
+	initFlags(pflag.CommandLine)
+	pflag.Parse()
+
+	if err := run(); err != nil {
+		klog.ErrorS(err, "Simulator failed")
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	logger := klog.Background()
+
+	cloud, err := newCloud()
+	if err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("tcp", bindAddress)
+	if err != nil {
+		return err
+	}
+	scheme := "http"
+	if tlsCertFile != "" {
+		scheme = "https"
+	}
+	authURL := fmt.Sprintf("%s://%s/identity/v3", scheme, listener.Addr())
+
+	if cloudsYAML != "" {
+		if err := writeCloudsYAML(authURL); err != nil {
+			return err
+		}
+		logger.Info("Wrote clouds.yaml", "path", cloudsYAML, "cloud", cloudName)
+	}
+
+	server := &http.Server{
+		Handler: simulator.New(cloud,
+			simulator.WithRegion(region),
+			simulator.WithCredentials(username, password),
+			simulator.WithProjectName(projectName),
+			simulator.WithTokenTTL(tokenTTL),
+			simulator.WithLogger(logger),
+		),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = server.Shutdown(shutdownCtx)
+	}()
+
+	logger.Info("Serving OpenStack APIs", "authURL", authURL)
+	if tlsCertFile != "" {
+		err = server.ServeTLS(listener, tlsCertFile, tlsKeyFile)
+	} else {
+		err = server.Serve(listener)
+	}
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// newCloud returns a Cloud seeded from the command line flags.
+func newCloud() (*fake.Cloud, error) {
+	cloud := fake.NewCloud(
+		fake.WithProjectID(projectID),
+		fake.WithTransitionDelay(transitionDelay),
+	)
+
+	for _, flavor := range flavors {
+		parts := strings.Split(flavor, ":")
+		if len(parts) != 4 {
+			return nil, fmt.Errorf("invalid flavor %q: expected name:vcpus:ramMiB:diskGiB", flavor)
+		}
+		var sizes [3]int
+		for i := range sizes {
+			size, err := strconv.Atoi(parts[i+1])
+			if err != nil {
+				return nil, fmt.Errorf("invalid flavor %q: %w", flavor, err)
+			}
+			sizes[i] = size
+		}
+		cloud.AddFlavor(parts[0], sizes[0], sizes[1], sizes[2])
+	}
+	for _, image := range images {
+		cloud.AddImage(image)
+	}
+	for _, zone := range zones {
+		cloud.AddAvailabilityZone(zone)
+	}
+	for _, flavor := range lbFlavors {
+		cloud.AddLoadBalancerFlavor(flavor)
+	}
+	if externalNetwork != "" {
+		name, cidr, ok := strings.Cut(externalNetwork, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid external network %q: expected name:cidr", externalNetwork)
+		}
+		if _, err := cloud.AddExternalNetwork(name, cidr); err != nil {
+			return nil, fmt.Errorf("creating external network: %w", err)
+		}
+	}
+	return cloud, nil
+}
+
+// writeCloudsYAML writes a clouds.yaml which authenticates to the simulator.
+func writeCloudsYAML(authURL string) error {
+	clouds := clientconfig.Clouds{
+		Clouds: map[string]clientconfig.Cloud{
+			cloudName: {
+				AuthInfo: &clientconfig.AuthInfo{
+					AuthURL:           authURL,
+					Username:          username,
+					Password:          password,
+					ProjectName:       projectName,
+					UserDomainName:    simulator.DefaultDomainName,
+					ProjectDomainName: simulator.DefaultDomainName,
+				},
+				RegionName:         region,
+				Interface:          "public",
+				IdentityAPIVersion: "3",
+			},
+		},
+	}
+	if tlsCertFile != "" {
+		// The simulator is expected to be run with a self-signed certificate
+		cloud := clouds.Clouds[cloudName]
+		cloud.Verify = ptr.To(false)
+		clouds.Clouds[cloudName] = cloud
+	}
+	out, err := yaml.Marshal(clouds)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(cloudsYAML, out, 0o600)
+}
@@ -0,0 +1,318 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	"sigs.k8s.io/cluster-api/test/framework"
+	conditions "sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+// gvkClient sets the GroupVersionKind of the objects it gets, as the cache
+// of the manager does. The reconcilers rely on it to set owner references.
+type gvkClient struct {
+	client.Client
+}
+
+func (c gvkClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if err := c.Client.Get(ctx, key, obj, opts...); err != nil {
+		return err
+	}
+	gvk, err := apiutil.GVKForObject(obj, c.Scheme())
+	if err != nil {
+		return err
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	return nil
+}
+
+var _ = Describe("Reconcilers with a fake OpenStack cloud", func() {
+	var (
+		cloud             *fake.Cloud
+		testNamespace     string
+		capiCluster       *clusterv1.Cluster
+		openStackCluster  *infrav1.OpenStackCluster
+		clusterReconciler *OpenStackClusterReconciler
+		machineReconciler *OpenStackMachineReconciler
+		serverReconciler  *OpenStackServerReconciler
+		testNum           int
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		testNum++
+		testNamespace = fmt.Sprintf("fake-cloud-test-%d", testNum)
+
+		framework.CreateNamespace(ctx, framework.CreateNamespaceInput{
+			Creator: k8sClient,
+			Name:    testNamespace,
+		})
+
+		cloud = fake.NewCloud()
+		_, err := cloud.AddExternalNetwork("public", "203.0.113.0/24")
+		Expect(err).NotTo(HaveOccurred())
+		cloud.AddFlavor("m1.small", 2, 4096, 20)
+		cloud.AddImage("ubuntu")
+
+		capiCluster = &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      capiClusterName,
+				Namespace: testNamespace,
+			},
+			Spec: clusterv1.ClusterSpec{
+				InfrastructureRef: clusterv1.ContractVersionedObjectReference{
+					APIGroup: infrav1.GroupName,
+					Kind:     "OpenStackCluster",
+					Name:     "test-cluster",
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, capiCluster)).To(Succeed())
+
+		openStackCluster = &infrav1.OpenStackCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-cluster",
+				Namespace: testNamespace,
+				Labels: map[string]string{
+					clusterv1.ClusterNameLabel: capiClusterName,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Cluster",
+						Name:       capiCluster.Name,
+						UID:        capiCluster.UID,
+					},
+				},
+			},
+			Spec: infrav1.OpenStackClusterSpec{
+				IdentityRef: infrav1.OpenStackIdentityReference{
+					Name:      "test-creds",
+					CloudName: "openstack",
+				},
+				ManagedSubnets: []infrav1.SubnetSpec{
+					{CIDR: "10.6.0.0/24"},
+				},
+				ExternalNetwork: &infrav1.NetworkParam{
+					Filter: &infrav1.NetworkFilter{Name: "public"},
+				},
+				ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{},
+				APIServer: &infrav1.APIServer{
+					ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+						Enabled: ptr.To(true),
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, openStackCluster)).To(Succeed())
+
+		Expect(k8sClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "bootstrap-data",
+				Namespace: testNamespace,
+			},
+			Data: map[string][]byte{
+				"value": []byte("#cloud-config"),
+			},
+		})).To(Succeed())
+
+		scopeFactory := scope.NewFakeScopeFactory(cloud)
+		reconcilerClient := gvkClient{k8sClient}
+		clusterReconciler = &OpenStackClusterReconciler{
+			Client:       reconcilerClient,
+			ScopeFactory: scopeFactory,
+		}
+		machineReconciler = &OpenStackMachineReconciler{
+			Client:       reconcilerClient,
+			ScopeFactory: scopeFactory,
+		}
+		serverReconciler = &OpenStackServerReconciler{
+			Client:       reconcilerClient,
+			ScopeFactory: scopeFactory,
+		}
+	})
+
+	AfterEach(func() {
+		// Finalizers are left in place: the namespace stays in Terminating
+		// as the controller-manager is not part of envtest
+		framework.DeleteNamespace(ctx, framework.DeleteNamespaceInput{
+			Deleter: k8sClient,
+			Name:    testNamespace,
+		})
+	})
+
+	// reconcileUntil calls reconcileOnce until obj satisfies ready, as the
+	// manager would reconcile it when it or the resources it watches change,
+	// or a requeue is due.
+	reconcileUntil := func(reconcileOnce func() error, obj client.Object, ready func(g Gomega)) {
+		Eventually(func(g Gomega) {
+			g.Expect(reconcileOnce()).To(Succeed())
+			g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+			ready(g)
+		}).WithTimeout(30 * time.Second).WithPolling(10 * time.Millisecond).Should(Succeed())
+	}
+
+	reconcileCluster := func() {
+		reconcileUntil(func() error {
+			_, err := clusterReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(openStackCluster)})
+			return err
+		}, openStackCluster, func(g Gomega) {
+			g.Expect(conditions.IsTrue(openStackCluster, clusterv1.ReadyCondition)).To(BeTrue())
+		})
+	}
+
+	It("should reconcile an OpenStackCluster to Ready", func() {
+		reconcileCluster()
+
+		Expect(openStackCluster.Finalizers).To(ContainElement(infrav1.ClusterFinalizer))
+		Expect(openStackCluster.Status.Initialization).NotTo(BeNil())
+		Expect(openStackCluster.Status.Initialization.Provisioned).To(BeTrue())
+		Expect(openStackCluster.Status.Network).NotTo(BeNil())
+		Expect(openStackCluster.Status.Network.Subnets).To(HaveLen(1))
+		Expect(openStackCluster.Status.Router).NotTo(BeNil())
+		Expect(openStackCluster.Status.ControlPlaneSecurityGroup).NotTo(BeNil())
+		Expect(openStackCluster.Status.WorkerSecurityGroup).NotTo(BeNil())
+		Expect(openStackCluster.Status.FailureDomains).To(ConsistOf(HaveField("Name", fake.DefaultAvailabilityZone)))
+
+		lbStatus := openStackCluster.Status.APIServerManagedLoadBalancer
+		Expect(lbStatus).NotTo(BeNil())
+		Expect(lbStatus.IP).NotTo(BeEmpty())
+		Expect(openStackCluster.Spec.ControlPlaneEndpoint).NotTo(BeNil())
+		Expect(openStackCluster.Spec.ControlPlaneEndpoint.Host).To(Equal(lbStatus.IP))
+		Expect(cloud.Count("loadbalancer")).To(Equal(1))
+		Expect(cloud.Count("floatingip")).To(Equal(1))
+
+		for _, conditionType := range []string{
+			infrav1.NetworkReadyCondition,
+			infrav1.RouterReadyCondition,
+			infrav1.SecurityGroupsReadyCondition,
+			infrav1.APIEndpointReadyCondition,
+		} {
+			Expect(conditions.IsTrue(openStackCluster, conditionType)).To(BeTrue(), "condition %s", conditionType)
+		}
+
+		// Until the spec changes, only the load balancer is polled
+		Expect(clusterReconciler.needsFullReconcile(openStackCluster)).To(BeFalse())
+		result, err := clusterReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(openStackCluster)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(DefaultLoadBalancerHealthPollInterval))
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(openStackCluster), openStackCluster)).To(Succeed())
+		Expect(conditions.IsFalse(openStackCluster, infrav1.APIServerLoadBalancerDegradedCondition)).To(BeTrue())
+	})
+
+	It("should reconcile a control plane OpenStackMachine and its OpenStackServer to Ready", func() {
+		reconcileCluster()
+
+		capiMachine := &clusterv1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "capi-machine",
+				Namespace: testNamespace,
+				Labels: map[string]string{
+					clusterv1.ClusterNameLabel:         capiClusterName,
+					clusterv1.MachineControlPlaneLabel: "",
+				},
+			},
+			Spec: clusterv1.MachineSpec{
+				ClusterName: capiClusterName,
+				Bootstrap: clusterv1.Bootstrap{
+					DataSecretName: ptr.To("bootstrap-data"),
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, capiMachine)).To(Succeed())
+
+		openStackMachine := &infrav1.OpenStackMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-machine",
+				Namespace: testNamespace,
+				Labels: map[string]string{
+					clusterv1.ClusterNameLabel: capiClusterName,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion: clusterv1.GroupVersion.String(),
+						Kind:       "Machine",
+						Name:       capiMachine.Name,
+						UID:        capiMachine.UID,
+					},
+				},
+			},
+			Spec: infrav1.OpenStackMachineSpec{
+				Flavor: infrav1.FlavorParam{
+					Filter: &infrav1.FlavorFilter{Name: ptr.To("m1.small")},
+				},
+				Image: infrav1.ImageParam{
+					Filter: &infrav1.ImageFilter{Name: ptr.To("ubuntu")},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, openStackMachine)).To(Succeed())
+
+		// The OpenStackServer is created by the first reconciles of the
+		// OpenStackMachine, and reconciled alongside it
+		openStackServer := &infrav1alpha1.OpenStackServer{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      openStackMachine.Name,
+				Namespace: testNamespace,
+			},
+		}
+		reconcileUntil(func() error {
+			if _, err := machineReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(openStackMachine)}); err != nil {
+				return err
+			}
+			_, err := serverReconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(openStackServer)})
+			return err
+		}, openStackMachine, func(g Gomega) {
+			g.Expect(conditions.IsTrue(openStackMachine, clusterv1.ReadyCondition)).To(BeTrue())
+			g.Expect(conditions.IsTrue(openStackMachine, infrav1.APIServerIngressReadyCondition)).To(BeTrue())
+		})
+
+		Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(openStackServer), openStackServer)).To(Succeed())
+		Expect(openStackServer.Finalizers).To(ContainElement(infrav1alpha1.OpenStackServerFinalizer))
+		Expect(openStackServer.Status.Ready).To(BeTrue())
+		Expect(openStackServer.Status.InstanceState).To(HaveValue(Equal(infrav1.InstanceStateActive)))
+		Expect(openStackServer.Status.Resources.Ports).To(HaveLen(1))
+		Expect(conditions.IsTrue(openStackServer, infrav1.InstanceReadyCondition)).To(BeTrue())
+
+		Expect(openStackMachine.Finalizers).To(ContainElement(infrav1.MachineFinalizer))
+		Expect(openStackMachine.Status.InstanceID).To(Equal(openStackServer.Status.InstanceID))
+		Expect(openStackMachine.Spec.ProviderID).To(HaveValue(Equal("openstack:///" + *openStackServer.Status.InstanceID)))
+		Expect(openStackMachine.Status.Initialization).NotTo(BeNil())
+		Expect(openStackMachine.Status.Initialization.Provisioned).To(BeTrue())
+		Expect(openStackMachine.Status.Addresses).To(ContainElement(HaveField("Type", corev1.NodeInternalIP)))
+
+		Expect(cloud.Count("server")).To(Equal(1))
+		Expect(cloud.Count("member")).To(Equal(1))
+	})
+})
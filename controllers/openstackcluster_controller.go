@@ -21,6 +21,8 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
@@ -30,6 +32,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/tools/events"
 	"k8s.io/klog/v2"
@@ -52,16 +55,32 @@ import (
 	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/compute"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/identity"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/loadbalancer"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/networking"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
 	utils "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/controllers"
 	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
 )
 
+const (
+	// DefaultLoadBalancerHealthPollInterval is how often the health of the
+	// API server load balancer is refreshed once the cluster is ready.
+	DefaultLoadBalancerHealthPollInterval = time.Minute
+
+	// DefaultFullReconcileInterval is how often the whole infrastructure of a
+	// ready cluster is reconciled while its spec does not change.
+	DefaultFullReconcileInterval = 10 * time.Minute
+)
+
 const (
 	waitForBastionToReconcile = 15 * time.Second
+
+	// applicationCredentialCheckInterval is how often Keystone is asked when the application credential referenced
+	// by an identityRef expires, unless its Secret changes.
+	applicationCredentialCheckInterval = 10 * time.Minute
 )
 
 // OpenStackClusterReconciler reconciles a OpenStackCluster object.
@@ -71,6 +90,41 @@ type OpenStackClusterReconciler struct {
 	WatchFilterValue string
 	ScopeFactory     scope.Factory
 	CaCertificates   []byte // PEM encoded ca certificates.
+
+	// CredentialExpiryWindow is how long before it expires an application credential or token referenced by an
+	// identityRef is reported as expiring.
+	CredentialExpiryWindow time.Duration
+
+	// LoadBalancerHealthPollInterval is how often the health of the API server load balancer is refreshed once the
+	// cluster is ready, as Octavia does not emit events. Defaults to DefaultLoadBalancerHealthPollInterval.
+	LoadBalancerHealthPollInterval time.Duration
+
+	// FullReconcileInterval is how often the whole infrastructure of a ready cluster is reconciled while its spec does
+	// not change. Polls of the API server load balancer in between only refresh the load balancer. Defaults to
+	// DefaultFullReconcileInterval.
+	FullReconcileInterval time.Duration
+
+	// fullReconciles holds the fullReconcile of each ready cluster, by its types.NamespacedName.
+	fullReconciles sync.Map
+
+	// applicationCredentialExpiries holds the last observed applicationCredentialExpiry of each Secret, by its
+	// types.NamespacedName.
+	applicationCredentialExpiries sync.Map
+}
+
+// fullReconcile is when the whole infrastructure of a ready cluster was last reconciled, and the generation of the
+// cluster it reconciled.
+type fullReconcile struct {
+	generation   int64
+	reconciledAt time.Time
+}
+
+// applicationCredentialExpiry is when the application credential in a Secret expires.
+type applicationCredentialExpiry struct {
+	resourceVersion string
+	id              string
+	expiresAt       time.Time
+	checkedAt       time.Time
 }
 
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackclusters,verbs=get;list;watch;create;update;patch;delete
@@ -78,9 +132,14 @@ type OpenStackClusterReconciler struct {
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackclusteridentities,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackcredentialrotations,verbs=get;list;watch
 
 func (r *OpenStackClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, reterr error) {
 	log := ctrl.LoggerFrom(ctx)
+	defer func() {
+		result, reterr = utils.HandleRequeueAfterError(log, result, reterr)
+	}()
 
 	// Fetch the OpenStackCluster instance
 	openStackCluster := &infrav1.OpenStackCluster{}
@@ -120,10 +179,15 @@ func (r *OpenStackClusterReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		if err := patchHelper.Patch(ctx, openStackCluster, patch.WithOwnedConditions{Conditions: []string{
 			clusterv1.ReadyCondition,
 			infrav1.OpenStackAuthenticationSucceeded,
+			infrav1.CredentialsValidCondition,
 			infrav1.SecurityGroupsReadyCondition,
 			infrav1.APIEndpointReadyCondition,
 			infrav1.NetworkReadyCondition,
 			infrav1.RouterReadyCondition,
+			infrav1.AdditionalLoadBalancersReadyCondition,
+			infrav1.LoadBalancerProviderCompatibleCondition,
+			infrav1.APIServerLoadBalancerDegradedCondition,
+			infrav1.APIServerLoadBalancerUpToDateCondition,
 		}}); err != nil {
 			result = ctrl.Result{}
 			reterr = kerrors.NewAggregate([]error{reterr, fmt.Errorf("error patching OpenStackCluster %s/%s: %w", openStackCluster.Namespace, openStackCluster.Name, err)})
@@ -153,9 +217,194 @@ func (r *OpenStackClusterReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	}
 
 	// Handle non-deleted clusters
+	r.reconcileCredentials(ctx, scope, openStackCluster)
+	if !r.needsFullReconcile(openStackCluster) {
+		return r.reconcileLoadBalancerOnly(ctx, scope, cluster, openStackCluster)
+	}
 	return r.reconcileNormal(ctx, scope, cluster, openStackCluster)
 }
 
+// needsFullReconcile returns whether the whole infrastructure of the cluster
+// must be reconciled. Otherwise the cluster is ready, its spec did not change
+// since its infrastructure was last reconciled less than
+// FullReconcileInterval ago, and only its API server load balancer must be
+// polled.
+func (r *OpenStackClusterReconciler) needsFullReconcile(openStackCluster *infrav1.OpenStackCluster) bool {
+	if !openStackCluster.Spec.APIServer.GetManagedLoadBalancer().IsEnabled() || !conditions.IsTrue(openStackCluster, clusterv1.ReadyCondition) {
+		return true
+	}
+
+	last, ok := r.fullReconciles.Load(client.ObjectKeyFromObject(openStackCluster))
+	if !ok {
+		return true
+	}
+
+	fullReconcileInterval := r.FullReconcileInterval
+	if fullReconcileInterval == 0 {
+		fullReconcileInterval = DefaultFullReconcileInterval
+	}
+	return last.(fullReconcile).generation != openStackCluster.Generation || time.Since(last.(fullReconcile).reconciledAt) >= fullReconcileInterval
+}
+
+// reconcileLoadBalancerOnly polls the API server load balancer of a ready
+// cluster between full reconciles: it progresses the replacement of the load
+// balancer if one is in progress, and otherwise only refreshes its health.
+func (r *OpenStackClusterReconciler) reconcileLoadBalancerOnly(ctx context.Context, scope *scope.WithLogger, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster) (ctrl.Result, error) {
+	scope.Logger().V(4).Info("Reconciling API server load balancer")
+
+	clusterResourceName := names.ClusterResourceName(cluster)
+
+	if openStackCluster.Status.APIServerLoadBalancerReplacement != nil {
+		networkingService, err := networking.NewService(scope)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		apiServerCertificate, err := r.getAPIServerLoadBalancerCertificate(ctx, openStackCluster)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if err := reconcileControlPlaneEndpoint(scope, networkingService, openStackCluster, clusterResourceName, apiServerCertificate); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{RequeueAfter: r.loadBalancerPollInterval(openStackCluster)}, nil
+	}
+
+	loadBalancerService, err := loadbalancer.NewService(scope)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if err := loadBalancerService.ReconcileLoadBalancerHealth(openStackCluster, clusterResourceName); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to get load balancer health: %w", err)
+	}
+	setAPIServerLoadBalancerDegradedCondition(openStackCluster)
+
+	return reconcile.Result{RequeueAfter: r.loadBalancerPollInterval(openStackCluster)}, nil
+}
+
+// reconcileCredentials records the expiry of the application credential or token referenced by the cluster's
+// identityRef in the CredentialsValid condition. Failures are reported in the condition rather than returned, as the
+// current credentials can still be used. Application credentials are only rotated by an OpenStackCredentialRotation of
+// their Secret, so the condition reports expiring application credentials which no OpenStackCredentialRotation rotates.
+func (r *OpenStackClusterReconciler) reconcileCredentials(ctx context.Context, clientScope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster) {
+	identityRef := openStackCluster.Spec.IdentityRef
+	switch identityRef.Type {
+	case infrav1.IdentityTypeToken:
+		token, err := clientScope.ExtractToken()
+		if err != nil {
+			setCredentialsExpiryUnknown(openStackCluster, fmt.Errorf("failed to get token: %w", err))
+			return
+		}
+		r.setCredentialsExpiry(openStackCluster, "Token", token.ExpiresAt)
+		return
+	case infrav1.IdentityTypeApplicationCredential:
+	default:
+		conditions.Delete(openStackCluster, infrav1.CredentialsValidCondition)
+		return
+	}
+
+	secretKey := types.NamespacedName{Namespace: openStackCluster.Namespace, Name: identityRef.Name}
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, secretKey, secret); err != nil {
+		setCredentialsExpiryUnknown(openStackCluster, fmt.Errorf("failed to get secret %s: %w", identityRef.Name, err))
+		return
+	}
+
+	var expiry applicationCredentialExpiry
+	if cached, ok := r.applicationCredentialExpiries.Load(secretKey); ok {
+		expiry = cached.(applicationCredentialExpiry)
+	}
+	if expiry.resourceVersion != secret.ResourceVersion || time.Since(expiry.checkedAt) >= applicationCredentialCheckInterval {
+		identityService, err := identity.NewService(clientScope)
+		if err != nil {
+			setCredentialsExpiryUnknown(openStackCluster, err)
+			return
+		}
+		credential, err := identityService.GetApplicationCredential(string(secret.Data[scope.ApplicationCredentialIDSecretKey]), string(secret.Data[scope.ApplicationCredentialNameSecretKey]))
+		if err != nil {
+			setCredentialsExpiryUnknown(openStackCluster, fmt.Errorf("failed to get application credential: %w", err))
+			return
+		}
+		expiry = applicationCredentialExpiry{
+			resourceVersion: secret.ResourceVersion,
+			id:              credential.ID,
+			expiresAt:       credential.ExpiresAt,
+			checkedAt:       time.Now(),
+		}
+		r.applicationCredentialExpiries.Store(secretKey, expiry)
+	}
+	r.setCredentialsExpiry(openStackCluster, fmt.Sprintf("Application credential %s", expiry.id), expiry.expiresAt)
+
+	condition := conditions.Get(openStackCluster, infrav1.CredentialsValidCondition)
+	if condition.Reason != infrav1.CredentialsExpiringReason {
+		return
+	}
+	rotated, err := r.isCredentialRotated(ctx, secretKey)
+	if err != nil {
+		clientScope.Logger().Error(err, "Failed to list OpenStackCredentialRotations")
+		return
+	}
+	if !rotated {
+		condition.Message += fmt.Sprintf(" and is not rotated: create an OpenStackCredentialRotation for Secret %s", identityRef.Name)
+		conditions.Set(openStackCluster, *condition)
+	}
+}
+
+// isCredentialRotated returns whether an OpenStackCredentialRotation rotates the application credential in the
+// given Secret.
+func (r *OpenStackClusterReconciler) isCredentialRotated(ctx context.Context, secretKey types.NamespacedName) (bool, error) {
+	rotations := &infrav1alpha1.OpenStackCredentialRotationList{}
+	if err := r.Client.List(ctx, rotations, client.InNamespace(secretKey.Namespace)); err != nil {
+		return false, err
+	}
+	for i := range rotations.Items {
+		rotation := &rotations.Items[i]
+		if rotation.Spec.SecretName == secretKey.Name && rotation.Spec.CloudName == "" && rotation.DeletionTimestamp.IsZero() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// setCredentialsExpiry sets the CredentialsValid condition for credentials which expire at expiresAt, or never if
+// expiresAt is zero.
+func (r *OpenStackClusterReconciler) setCredentialsExpiry(openStackCluster *infrav1.OpenStackCluster, description string, expiresAt time.Time) {
+	if expiresAt.IsZero() {
+		conditions.Set(openStackCluster, metav1.Condition{
+			Type:    infrav1.CredentialsValidCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  infrav1.ReadyConditionReason,
+			Message: description + " does not expire",
+		})
+		return
+	}
+
+	message := fmt.Sprintf("%s expires at %s", description, expiresAt.UTC().Format(time.RFC3339))
+	if time.Until(expiresAt) > r.CredentialExpiryWindow {
+		conditions.Set(openStackCluster, metav1.Condition{
+			Type:    infrav1.CredentialsValidCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  infrav1.ReadyConditionReason,
+			Message: message,
+		})
+		return
+	}
+	conditions.Set(openStackCluster, metav1.Condition{
+		Type:    infrav1.CredentialsValidCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  infrav1.CredentialsExpiringReason,
+		Message: message,
+	})
+}
+
+func setCredentialsExpiryUnknown(openStackCluster *infrav1.OpenStackCluster, err error) {
+	conditions.Set(openStackCluster, metav1.Condition{
+		Type:    infrav1.CredentialsValidCondition,
+		Status:  metav1.ConditionUnknown,
+		Reason:  infrav1.CredentialsExpiryUnknownReason,
+		Message: err.Error(),
+	})
+}
+
 func (r *OpenStackClusterReconciler) reconcileDelete(ctx context.Context, scope *scope.WithLogger, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster) (ctrl.Result, error) {
 	scope.Logger().Info("Reconciling Cluster delete")
 
@@ -199,6 +448,22 @@ func (r *OpenStackClusterReconciler) reconcileDelete(ctx context.Context, scope
 		return reconcile.Result{}, err
 	}
 
+	if len(openStackCluster.Spec.AdditionalLoadBalancers) > 0 || len(openStackCluster.Status.AdditionalLoadBalancers) > 0 {
+		loadBalancerService, err := loadbalancer.NewService(scope)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		result, err := loadBalancerService.DeleteAdditionalLoadBalancers(openStackCluster, clusterResourceName)
+		if err != nil {
+			handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to delete additional load balancers: %w", err))
+			return reconcile.Result{}, fmt.Errorf("failed to delete additional load balancers: %w", err)
+		}
+		if result != nil {
+			return *result, nil
+		}
+	}
+
 	if openStackCluster.Spec.APIServer.GetManagedLoadBalancer().IsEnabled() {
 		loadBalancerService, err := loadbalancer.NewService(scope)
 		if err != nil {
@@ -215,6 +480,13 @@ func (r *OpenStackClusterReconciler) reconcileDelete(ctx context.Context, scope
 		}
 	}
 
+	if openStackCluster.Status.APIServerVirtualIP != nil {
+		if err = networkingService.DeleteAPIServerVirtualIP(openStackCluster); err != nil {
+			handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to delete API server virtual IP: %w", err))
+			return reconcile.Result{}, fmt.Errorf("failed to delete API server virtual IP: %w", err)
+		}
+	}
+
 	// if ManagedSubnets was not set, no network was created.
 	if len(openStackCluster.Spec.ManagedSubnets) > 0 {
 		if err = networkingService.DeleteRouter(openStackCluster, clusterResourceName); err != nil {
@@ -240,6 +512,7 @@ func (r *OpenStackClusterReconciler) reconcileDelete(ctx context.Context, scope
 
 	// Cluster is deleted so remove the finalizer.
 	controllerutil.RemoveFinalizer(openStackCluster, infrav1.ClusterFinalizer)
+	r.fullReconciles.Delete(client.ObjectKeyFromObject(openStackCluster))
 	scope.Logger().Info("Reconciled Cluster deleted successfully")
 	return ctrl.Result{}, nil
 }
@@ -346,11 +619,33 @@ func (r *OpenStackClusterReconciler) reconcileNormal(ctx context.Context, scope
 		return reconcile.Result{}, err
 	}
 
-	err = reconcileNetworkComponents(scope, cluster, openStackCluster)
+	apiServerCertificate, err := r.getAPIServerLoadBalancerCertificate(ctx, openStackCluster)
+	if err != nil {
+		conditions.Set(openStackCluster, metav1.Condition{
+			Type:    infrav1.APIEndpointReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.APIEndpointConfigFailedReason,
+			Message: err.Error(),
+		})
+		return reconcile.Result{}, err
+	}
+
+	err = reconcileNetworkComponents(scope, cluster, openStackCluster, apiServerCertificate)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
+	err = r.reconcileAPIServerVirtualIPConfig(ctx, cluster, openStackCluster)
+	if err != nil {
+		conditions.Set(openStackCluster, metav1.Condition{
+			Type:    infrav1.APIEndpointReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.APIEndpointConfigFailedReason,
+			Message: fmt.Sprintf("Failed to write API server virtual IP configuration: %v", err),
+		})
+		return reconcile.Result{}, fmt.Errorf("failed to write API server virtual IP configuration: %w", err)
+	}
+
 	availabilityZones, err := computeService.GetAvailabilityZones()
 	if err != nil {
 		return ctrl.Result{}, err
@@ -410,7 +705,32 @@ func (r *OpenStackClusterReconciler) reconcileNormal(ctx context.Context, scope
 	}
 	scope.Logger().Info("Reconciled Bastion created successfully")
 
-	return reconcile.Result{}, nil
+	r.fullReconciles.Store(client.ObjectKeyFromObject(openStackCluster), fullReconcile{
+		generation:   openStackCluster.Generation,
+		reconciledAt: time.Now(),
+	})
+
+	return reconcile.Result{RequeueAfter: r.loadBalancerPollInterval(openStackCluster)}, nil
+}
+
+// loadBalancerPollInterval returns how soon the API server load balancer of a
+// ready cluster must be polled again, as Octavia does not emit events, or 0
+// if it has none. Polls only reconcile the load balancer, see
+// needsFullReconcile.
+func (r *OpenStackClusterReconciler) loadBalancerPollInterval(openStackCluster *infrav1.OpenStackCluster) time.Duration {
+	if !openStackCluster.Spec.APIServer.GetManagedLoadBalancer().IsEnabled() {
+		return 0
+	}
+
+	// Poll until the replacement of the API server load balancer is complete
+	if openStackCluster.Status.APIServerLoadBalancerReplacement != nil {
+		return 15 * time.Second
+	}
+
+	if r.LoadBalancerHealthPollInterval == 0 {
+		return DefaultLoadBalancerHealthPollInterval
+	}
+	return r.LoadBalancerHealthPollInterval
 }
 
 func (r *OpenStackClusterReconciler) reconcileBastion(ctx context.Context, scope *scope.WithLogger, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster) (*ctrl.Result, error) {
@@ -486,7 +806,7 @@ func bastionAddFloatingIP(openStackCluster *infrav1.OpenStackCluster, clusterRes
 		floatingIP = openStackCluster.Spec.Bastion.FloatingIP
 	}
 	// Check if there is an existing floating IP attached to bastion, in case where FloatingIP would not yet have been stored in cluster status
-	fp, err = networkingService.GetOrCreateFloatingIP(openStackCluster, openStackCluster, clusterResourceName, floatingIP)
+	fp, err = networkingService.GetOrCreateFloatingIP(openStackCluster, openStackCluster, clusterResourceName, floatingIP, openStackCluster.Spec.Bastion.FloatingIPDNS)
 	if err != nil {
 		handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to get or create floating IP for bastion: %w", err))
 		return nil, fmt.Errorf("failed to get or create floating IP for bastion: %w", err)
@@ -672,6 +992,12 @@ func resolveLoadBalancerNetwork(openStackCluster *infrav1.OpenStackCluster, netw
 		return nil
 	}
 
+	// The network of an existing load balancer is set when the load
+	// balancer is reconciled
+	if lbSpec.LoadBalancer != nil {
+		return nil
+	}
+
 	lbStatus := openStackCluster.Status.APIServerManagedLoadBalancer
 	if lbStatus == nil {
 		lbStatus = &infrav1.LoadBalancer{}
@@ -729,7 +1055,68 @@ func resolveLoadBalancerNetwork(openStackCluster *infrav1.OpenStackCluster, netw
 	return nil
 }
 
-func reconcileNetworkComponents(scope *scope.WithLogger, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster) error {
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=create;patch
+
+// reconcileAPIServerVirtualIPConfig writes the configuration of the provider
+// of the API server virtual IP for the control plane machines to a Secret,
+// from which the bootstrap provider can write it to the machines.
+func (r *OpenStackClusterReconciler) reconcileAPIServerVirtualIPConfig(ctx context.Context, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster) error {
+	if openStackCluster.Spec.APIServer.GetVirtualIP() == nil {
+		return nil
+	}
+
+	config, err := networking.GetAPIServerVirtualIPConfig(openStackCluster)
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: openStackCluster.Namespace,
+			Name:      virtualIPConfigSecretName(openStackCluster),
+		},
+	}
+	_, err = controllerutil.CreateOrUpdate(ctx, r.Client, secret, func() error {
+		if secret.Labels == nil {
+			secret.Labels = map[string]string{}
+		}
+		secret.Labels[clusterv1.ClusterNameLabel] = cluster.Name
+		secret.Data = config
+		return controllerutil.SetOwnerReference(openStackCluster, secret, r.Client.Scheme())
+	})
+	if err != nil {
+		return err
+	}
+
+	openStackCluster.Status.APIServerVirtualIP.ConfigSecretName = secret.Name
+	return nil
+}
+
+func virtualIPConfigSecretName(openStackCluster *infrav1.OpenStackCluster) string {
+	return fmt.Sprintf("%s-apiserver-vip", openStackCluster.Name)
+}
+
+// getAPIServerLoadBalancerCertificate returns the certificate served by the
+// API server load balancer if it terminates TLS, or nil otherwise.
+func (r *OpenStackClusterReconciler) getAPIServerLoadBalancerCertificate(ctx context.Context, openStackCluster *infrav1.OpenStackCluster) (*loadbalancer.Certificate, error) {
+	lbSpec := openStackCluster.Spec.APIServer.GetManagedLoadBalancer()
+	if !lbSpec.IsEnabled() || lbSpec.TLS == nil || lbSpec.TLS.Mode != infrav1.APIServerLoadBalancerTLSTerminated || lbSpec.TLS.CertificateSecretName == nil {
+		return nil, nil
+	}
+
+	secretName := *lbSpec.TLS.CertificateSecretName
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: openStackCluster.Namespace, Name: secretName}, secret); err != nil {
+		return nil, fmt.Errorf("failed to get API server load balancer certificate secret %s: %w", secretName, err)
+	}
+	certificate, err := loadbalancer.NewCertificate(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, fmt.Errorf("invalid API server load balancer certificate secret %s: %w", secretName, err)
+	}
+	return certificate, nil
+}
+
+func reconcileNetworkComponents(scope *scope.WithLogger, cluster *clusterv1.Cluster, openStackCluster *infrav1.OpenStackCluster, apiServerCertificate *loadbalancer.Certificate) error {
 	clusterResourceName := names.ClusterResourceName(cluster)
 
 	networkingService, err := networking.NewService(scope)
@@ -755,20 +1142,21 @@ func reconcileNetworkComponents(scope *scope.WithLogger, cluster *clusterv1.Clus
 		if err := reconcilePreExistingNetworkComponents(scope, networkingService, openStackCluster); err != nil {
 			return err
 		}
-	} else if len(openStackCluster.Spec.ManagedSubnets) == 1 {
+	} else {
+		if err := networking.ValidateManagedSubnets(openStackCluster.Spec.ManagedSubnets); err != nil {
+			err = fmt.Errorf("failed to reconcile network: %w", err)
+			conditions.Set(openStackCluster, metav1.Condition{
+				Type:    infrav1.NetworkReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.NetworkReconcileFailedReason,
+				Message: err.Error(),
+			})
+			handleUpdateOSCError(openStackCluster, err)
+			return err
+		}
 		if err := reconcileProvisionedNetworkComponents(networkingService, openStackCluster, clusterResourceName); err != nil {
 			return err
 		}
-	} else {
-		err := fmt.Errorf("failed to reconcile network: ManagedSubnets only supports one element, %d provided", len(openStackCluster.Spec.ManagedSubnets))
-		conditions.Set(openStackCluster, metav1.Condition{
-			Type:    infrav1.NetworkReadyCondition,
-			Status:  metav1.ConditionFalse,
-			Reason:  infrav1.NetworkReconcileFailedReason,
-			Message: err.Error(),
-		})
-		handleUpdateOSCError(openStackCluster, err)
-		return err
 	}
 
 	err = resolveLoadBalancerNetwork(openStackCluster, networkingService)
@@ -800,7 +1188,7 @@ func reconcileNetworkComponents(scope *scope.WithLogger, cluster *clusterv1.Clus
 		Reason: infrav1.ReadyConditionReason,
 	})
 
-	err = reconcileControlPlaneEndpoint(scope, networkingService, openStackCluster, clusterResourceName)
+	err = reconcileControlPlaneEndpoint(scope, networkingService, openStackCluster, clusterResourceName, apiServerCertificate)
 	if err != nil {
 		conditions.Set(openStackCluster, metav1.Condition{
 			Type:    infrav1.APIEndpointReadyCondition,
@@ -816,9 +1204,118 @@ func reconcileNetworkComponents(scope *scope.WithLogger, cluster *clusterv1.Clus
 		Reason: infrav1.ReadyConditionReason,
 	})
 
+	return reconcileAdditionalLoadBalancers(scope, openStackCluster, clusterResourceName)
+}
+
+func reconcileAdditionalLoadBalancers(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, clusterResourceName string) error {
+	if len(openStackCluster.Spec.AdditionalLoadBalancers) == 0 && len(openStackCluster.Status.AdditionalLoadBalancers) == 0 {
+		conditions.Delete(openStackCluster, infrav1.AdditionalLoadBalancersReadyCondition)
+		return nil
+	}
+
+	loadBalancerService, err := loadbalancer.NewService(scope)
+	if err != nil {
+		return err
+	}
+
+	if err := loadBalancerService.ReconcileAdditionalLoadBalancers(openStackCluster, clusterResourceName); err != nil {
+		conditions.Set(openStackCluster, metav1.Condition{
+			Type:    infrav1.AdditionalLoadBalancersReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.AdditionalLoadBalancersReconcileFailedReason,
+			Message: fmt.Sprintf("Failed to reconcile additional load balancers: %v", err),
+		})
+		return fmt.Errorf("failed to reconcile additional load balancers: %w", err)
+	}
+	conditions.Set(openStackCluster, metav1.Condition{
+		Type:   infrav1.AdditionalLoadBalancersReadyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: infrav1.ReadyConditionReason,
+	})
 	return nil
 }
 
+// setLoadBalancerProviderCompatibleCondition reports the features requested
+// for the API server load balancer which its Octavia provider does not
+// support.
+func setLoadBalancerProviderCompatibleCondition(openStackCluster *infrav1.OpenStackCluster, unsupported []string) {
+	if len(unsupported) == 0 {
+		conditions.Set(openStackCluster, metav1.Condition{
+			Type:   infrav1.LoadBalancerProviderCompatibleCondition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.ReadyConditionReason,
+		})
+		return
+	}
+
+	provider := "The Octavia provider"
+	if lbSpec := openStackCluster.Spec.APIServer.GetManagedLoadBalancer(); lbSpec.Provider != nil {
+		provider = fmt.Sprintf("Octavia provider %s", *lbSpec.Provider)
+	}
+	conditions.Set(openStackCluster, metav1.Condition{
+		Type:    infrav1.LoadBalancerProviderCompatibleCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  infrav1.LoadBalancerFeaturesUnsupportedReason,
+		Message: fmt.Sprintf("%s of the API server load balancer does not support %s: the load balancer is configured without them", provider, strings.Join(unsupported, ", ")),
+	})
+}
+
+// setAPIServerLoadBalancerUpToDateCondition reports the properties of the API
+// server load balancer which must be changed by replacing it, but cannot be
+// because the API server does not use a floating IP. A warning event is only
+// emitted when the condition changes, rather than on every reconcile.
+func setAPIServerLoadBalancerUpToDateCondition(openStackCluster *infrav1.OpenStackCluster, blocked []string) {
+	if len(blocked) == 0 {
+		conditions.Set(openStackCluster, metav1.Condition{
+			Type:   infrav1.APIServerLoadBalancerUpToDateCondition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.ReadyConditionReason,
+		})
+		return
+	}
+
+	message := fmt.Sprintf("The API server load balancer must be replaced to change its %s, which is only possible if the API server uses a floating IP", strings.Join(blocked, ", "))
+	if !conditions.IsFalse(openStackCluster, infrav1.APIServerLoadBalancerUpToDateCondition) ||
+		conditions.GetMessage(openStackCluster, infrav1.APIServerLoadBalancerUpToDateCondition) != message {
+		record.Warnf(openStackCluster, "LoadBalancerReplacementNotPossible", "%s", message)
+	}
+	conditions.Set(openStackCluster, metav1.Condition{
+		Type:    infrav1.APIServerLoadBalancerUpToDateCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  infrav1.LoadBalancerReplacementNotPossibleReason,
+		Message: message,
+	})
+}
+
+// setAPIServerLoadBalancerDegradedCondition reports the listeners and pools
+// of the API server load balancer which are in ERROR, and its members which
+// are OFFLINE or ERROR.
+func setAPIServerLoadBalancerDegradedCondition(openStackCluster *infrav1.OpenStackCluster) {
+	provisioningErrors, unhealthyMembers := loadbalancer.GetLoadBalancerHealthIssues(openStackCluster.Status.APIServerManagedLoadBalancer)
+	switch {
+	case len(provisioningErrors) > 0:
+		conditions.Set(openStackCluster, metav1.Condition{
+			Type:    infrav1.APIServerLoadBalancerDegradedCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  infrav1.LoadBalancerProvisioningErrorReason,
+			Message: fmt.Sprintf("The API server load balancer has objects in ERROR: %s", strings.Join(provisioningErrors, ", ")),
+		})
+	case len(unhealthyMembers) > 0:
+		conditions.Set(openStackCluster, metav1.Condition{
+			Type:    infrav1.APIServerLoadBalancerDegradedCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  infrav1.LoadBalancerMembersUnhealthyReason,
+			Message: fmt.Sprintf("The API server load balancer has unhealthy members: %s", strings.Join(unhealthyMembers, ", ")),
+		})
+	default:
+		conditions.Set(openStackCluster, metav1.Condition{
+			Type:   infrav1.APIServerLoadBalancerDegradedCondition,
+			Status: metav1.ConditionFalse,
+			Reason: infrav1.LoadBalancerNotDegradedReason,
+		})
+	}
+}
+
 // reconcilePreExistingNetworkComponents reconciles the cluster network status when the cluster is
 // using pre-existing networks, subnets and router which are not provisioned by the
 // cluster controller.
@@ -990,13 +1487,19 @@ func reconcileProvisionedNetworkComponents(networkingService *networking.Service
 // reconcileControlPlaneEndpoint configures the control plane endpoint for the
 // cluster, creating it if necessary, and updates ControlPlaneEndpoint in the
 // cluster spec.
-func reconcileControlPlaneEndpoint(scope *scope.WithLogger, networkingService *networking.Service, openStackCluster *infrav1.OpenStackCluster, clusterResourceName string) error {
+func reconcileControlPlaneEndpoint(scope *scope.WithLogger, networkingService *networking.Service, openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, apiServerCertificate *loadbalancer.Certificate) error {
 	// Calculate the port that we will use for the API server
 	apiServerPort := getAPIServerPort(openStackCluster)
 
 	// host must be set by a matching control plane endpoint provider below
 	var host string
 
+	if !openStackCluster.Spec.APIServer.GetManagedLoadBalancer().IsEnabled() {
+		conditions.Delete(openStackCluster, infrav1.LoadBalancerProviderCompatibleCondition)
+		conditions.Delete(openStackCluster, infrav1.APIServerLoadBalancerDegradedCondition)
+		conditions.Delete(openStackCluster, infrav1.APIServerLoadBalancerUpToDateCondition)
+	}
+
 	switch {
 	// API server load balancer is enabled. Create an Octavia load balancer.
 	// Note that we reconcile the load balancer even if the control plane
@@ -1008,12 +1511,24 @@ func reconcileControlPlaneEndpoint(scope *scope.WithLogger, networkingService *n
 			return err
 		}
 
-		_, err = loadBalancerService.ReconcileLoadBalancer(openStackCluster, clusterResourceName, int(apiServerPort))
+		_, err = loadBalancerService.ReconcileLoadBalancer(openStackCluster, clusterResourceName, int(apiServerPort), apiServerCertificate)
 		if err != nil {
 			handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to reconcile load balancer: %w", err))
 			return fmt.Errorf("failed to reconcile load balancer: %w", err)
 		}
 
+		unsupported, err := loadBalancerService.GetUnsupportedFeatures(openStackCluster)
+		if err != nil {
+			return fmt.Errorf("failed to get load balancer provider capabilities: %w", err)
+		}
+		setLoadBalancerProviderCompatibleCondition(openStackCluster, unsupported)
+		setAPIServerLoadBalancerUpToDateCondition(openStackCluster, loadBalancerService.GetBlockedChanges())
+
+		if err := loadBalancerService.ReconcileLoadBalancerHealth(openStackCluster, clusterResourceName); err != nil {
+			return fmt.Errorf("failed to get load balancer health: %w", err)
+		}
+		setAPIServerLoadBalancerDegradedCondition(openStackCluster)
+
 		// Control plane endpoint is the floating IP if one was defined, otherwise the VIP address
 		if openStackCluster.Status.APIServerManagedLoadBalancer.IP != "" {
 			host = openStackCluster.Status.APIServerManagedLoadBalancer.IP
@@ -1021,6 +1536,23 @@ func reconcileControlPlaneEndpoint(scope *scope.WithLogger, networkingService *n
 			host = openStackCluster.Status.APIServerManagedLoadBalancer.InternalIP
 		}
 
+	// API server virtual IP is enabled. Reserve the virtual IP with a Neutron
+	// port. Note that we reconcile the virtual IP even if the control plane
+	// endpoint is already set.
+	case openStackCluster.Spec.APIServer.GetVirtualIP() != nil:
+		err := networkingService.ReconcileAPIServerVirtualIP(openStackCluster, clusterResourceName)
+		if err != nil {
+			handleUpdateOSCError(openStackCluster, fmt.Errorf("failed to reconcile API server virtual IP: %w", err))
+			return fmt.Errorf("failed to reconcile API server virtual IP: %w", err)
+		}
+
+		// Control plane endpoint is the floating IP if one was defined, otherwise the virtual IP
+		if openStackCluster.Status.APIServerVirtualIP.FloatingIP != "" {
+			host = openStackCluster.Status.APIServerVirtualIP.FloatingIP
+		} else {
+			host = openStackCluster.Status.APIServerVirtualIP.IP
+		}
+
 	// Control plane endpoint is already set
 	// Note that checking this here means that we don't re-execute any of
 	// the branches below if the control plane endpoint is already set.
@@ -1030,7 +1562,7 @@ func reconcileControlPlaneEndpoint(scope *scope.WithLogger, networkingService *n
 	// API server load balancer is disabled, but external network and floating IP are not. Create
 	// a floating IP to be attached directly to a control plane host.
 	case ptr.Deref(openStackCluster.Spec.APIServer.GetEnableFloatingIP(), true) && ptr.Deref(openStackCluster.Spec.EnableExternalNetwork, true):
-		fp, err := networkingService.GetOrCreateFloatingIP(openStackCluster, openStackCluster, clusterResourceName, openStackCluster.Spec.APIServer.GetFloatingIP())
+		fp, err := networkingService.GetOrCreateFloatingIP(openStackCluster, openStackCluster, clusterResourceName, openStackCluster.Spec.APIServer.GetFloatingIP(), openStackCluster.Spec.APIServer.GetFloatingIPDNS())
 		if err != nil {
 			handleUpdateOSCError(openStackCluster, fmt.Errorf("floating IP cannot be got or created: %w", err))
 			return fmt.Errorf("floating IP cannot be got or created: %w", err)
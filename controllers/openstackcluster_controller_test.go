@@ -18,10 +18,21 @@ package controllers
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/applicationcredentials"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
@@ -30,8 +41,12 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 	"sigs.k8s.io/cluster-api/test/framework"
@@ -39,7 +54,10 @@ import (
 	conditions "sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
 
 	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
@@ -497,7 +515,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 			},
 		}, nil)
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).To(BeNil())
 
 		// Verify conditions are set correctly
@@ -585,7 +603,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 			CIDR:      "2001:db8:2222:5555::/64",
 		}, nil)
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).To(BeNil())
 		Expect(len(testCluster.Status.Network.Subnets)).To(Equal(2))
 
@@ -638,7 +656,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 			ID: clusterNetworkID,
 		}, nil)
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).To(BeNil())
 		Expect(testCluster.Status.Network.ID).To(Equal(clusterNetworkID))
 
@@ -861,7 +879,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 			ID:         "floating-ip-id",
 		}, nil)
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).To(BeNil())
 
 		// Verify API endpoint was set
@@ -924,7 +942,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 			},
 		}, nil)
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).To(BeNil())
 
 		// Verify API endpoint was set with fixed IP
@@ -938,8 +956,53 @@ var _ = Describe("OpenStackCluster controller", func() {
 		Expect(conditions.IsTrue(testCluster, infrav1.APIEndpointReadyCondition)).To(BeTrue())
 	})
 
-	It("should set NetworkReadyCondition to False when ManagedSubnets has more than one element", func() {
-		testCluster.SetName("managed-subnets-too-many")
+	It("should create the API server virtual IP configuration Secret with the manager RBAC", func() {
+		testCluster.SetName("api-server-virtual-ip-config")
+		testCluster.Spec.APIServer = &infrav1.APIServer{
+			VirtualIP: &infrav1.APIServerVirtualIP{Provider: infrav1.APIServerVirtualIPProviderKeepalived},
+		}
+		testCluster.Spec.ControlPlaneEndpoint = &clusterv1.APIEndpoint{Host: "10.0.0.100", Port: 6443}
+		Expect(k8sClient.Create(ctx, testCluster)).To(Succeed())
+		Expect(k8sClient.Create(ctx, capiCluster)).To(Succeed())
+		testCluster.Status.APIServerVirtualIP = &infrav1.VirtualIPStatus{PortID: "vip-port", IP: "10.0.0.100"}
+
+		// Reconcile as a user which only has the permissions of the manager
+		roleYAML, err := os.ReadFile(filepath.Join("..", "config", "rbac", "role.yaml"))
+		Expect(err).NotTo(HaveOccurred())
+		role := &rbacv1.ClusterRole{}
+		Expect(yaml.Unmarshal(roleYAML, role)).To(Succeed())
+		role.ObjectMeta = metav1.ObjectMeta{Name: testNamespace + "-manager-role"}
+		Expect(k8sClient.Create(ctx, role)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, ctx, role)
+
+		managerUser := testNamespace + "-manager"
+		binding := &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: role.Name},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: role.Name},
+			Subjects:   []rbacv1.Subject{{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: managerUser}},
+		}
+		Expect(k8sClient.Create(ctx, binding)).To(Succeed())
+		DeferCleanup(k8sClient.Delete, ctx, binding)
+
+		user, err := testEnv.AddUser(envtest.User{Name: managerUser}, cfg)
+		Expect(err).NotTo(HaveOccurred())
+		managerClient, err := client.New(user.Config(), client.Options{Scheme: scheme.Scheme})
+		Expect(err).NotTo(HaveOccurred())
+		reconciler.Client = managerClient
+
+		// Creating the Secret and then updating it requires the create and
+		// patch verbs on Secrets
+		for range 2 {
+			Expect(reconciler.reconcileAPIServerVirtualIPConfig(ctx, capiCluster, testCluster)).To(Succeed())
+		}
+
+		secret := &corev1.Secret{}
+		Expect(k8sClient.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: testCluster.Status.APIServerVirtualIP.ConfigSecretName}, secret)).To(Succeed())
+		Expect(secret.Data).To(HaveKey(networking.VirtualIPKeepalivedConfigKey))
+	})
+
+	It("should set NetworkReadyCondition to False when ManagedSubnets has two subnets of the same IP version", func() {
+		testCluster.SetName("managed-subnets-same-ip-version")
 		testCluster.Spec = infrav1.OpenStackClusterSpec{
 			IdentityRef: infrav1.OpenStackIdentityReference{
 				Name:      "test-creds",
@@ -959,8 +1022,8 @@ var _ = Describe("OpenStackCluster controller", func() {
 		err = k8sClient.Create(ctx, capiCluster)
 		Expect(err).To(BeNil())
 
-		// Add a second managed subnet in memory to bypass CRD validation
-		// (maxItems: 1) and test the controller-level check.
+		// Add a second IPv4 managed subnet in memory to bypass CRD
+		// validation and test the controller-level check.
 		testCluster.Spec.ManagedSubnets = append(testCluster.Spec.ManagedSubnets,
 			infrav1.SubnetSpec{CIDR: "192.168.1.0/24", DNSNameservers: []string{"8.8.8.8"}},
 		)
@@ -970,9 +1033,9 @@ var _ = Describe("OpenStackCluster controller", func() {
 		Expect(err).To(BeNil())
 		scope := scope.NewWithLogger(clientScope, log)
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).ToNot(BeNil())
-		Expect(err.Error()).To(ContainSubstring("ManagedSubnets only supports one element"))
+		Expect(err.Error()).To(ContainSubstring("ManagedSubnets can contain at most one IPv4 subnet"))
 
 		// Verify NetworkReadyCondition is set to False
 		Expect(conditions.IsFalse(testCluster, infrav1.NetworkReadyCondition)).To(BeTrue())
@@ -1016,7 +1079,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 		// External network lookup fails
 		networkClientRecorder.GetNetwork(externalNetworkID).Return(nil, fmt.Errorf("external network not found"))
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).ToNot(BeNil())
 		Expect(err.Error()).To(ContainSubstring("failed to reconcile external network"))
 
@@ -1064,7 +1127,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 		// Simulate network lookup failure
 		networkClientRecorder.GetNetwork(clusterNetworkID).Return(nil, fmt.Errorf("unable to get network"))
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).ToNot(BeNil())
 		Expect(err.Error()).To(ContainSubstring("error fetching cluster network"))
 
@@ -1117,7 +1180,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 			NetworkID: clusterNetworkID,
 		}).Return(nil, fmt.Errorf("failed to list subnets"))
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).ToNot(BeNil())
 
 		// Verify NetworkReadyCondition is set to False
@@ -1182,7 +1245,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 		// Router lookup fails
 		networkClientRecorder.GetRouter(clusterRouterID).Return(nil, fmt.Errorf("unable to get router"))
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).ToNot(BeNil())
 		Expect(err.Error()).To(ContainSubstring("error fetching cluster router"))
 
@@ -1261,7 +1324,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 		networkClientRecorder.ListSecGroup(gomock.Any()).Return([]groups.SecGroup{}, nil).AnyTimes()
 		networkClientRecorder.CreateSecGroup(gomock.Any()).Return(nil, fmt.Errorf("quota exceeded")).AnyTimes()
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).ToNot(BeNil())
 		Expect(err.Error()).To(ContainSubstring("failed to reconcile security groups"))
 
@@ -1363,7 +1426,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 		networkClientRecorder.ListSecGroup(gomock.Any()).Return([]groups.SecGroup{}, nil).AnyTimes()
 		networkClientRecorder.CreateSecGroup(gomock.Any()).Return(nil, fmt.Errorf("SecurityGroupRuleExists")).AnyTimes()
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).ToNot(BeNil())
 		Expect(err.Error()).To(ContainSubstring("failed to reconcile security groups"))
 
@@ -1441,7 +1504,7 @@ var _ = Describe("OpenStackCluster controller", func() {
 		// Mock floating IP creation failure
 		networkClientRecorder.CreateFloatingIP(gomock.Any()).Return(nil, fmt.Errorf("quota exceeded"))
 
-		err = reconcileNetworkComponents(scope, capiCluster, testCluster)
+		err = reconcileNetworkComponents(scope, capiCluster, testCluster, nil)
 		Expect(err).ToNot(BeNil())
 
 		// Verify APIEndpointReadyCondition is set to False
@@ -1531,3 +1594,450 @@ func Test_getAPIServerPort(t *testing.T) {
 		})
 	}
 }
+
+func TestOpenStackClusterReconciler_reconcileCredentials(t *testing.T) {
+	const (
+		userID       = "7a9bd1b4-5b12-4bd1-8b53-6b0b0e1a0a6d"
+		credentialID = "d2a0bfac-5b4b-4c7b-9d2f-7d2c8e0d9f61"
+		secretName   = "cloud-credentials"
+	)
+
+	scheme := runtime.NewScheme()
+	NewWithT(t).Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	NewWithT(t).Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	tests := []struct {
+		name         string
+		identityType string
+		expiresAt    time.Time
+		rotated      bool
+		wantStatus   metav1.ConditionStatus
+		wantReason   string
+		wantMessage  string
+	}{
+		{
+			name:         "secret identity has no condition",
+			identityType: infrav1.IdentityTypeSecret,
+		},
+		{
+			name:         "application credential does not expire",
+			identityType: infrav1.IdentityTypeApplicationCredential,
+			wantStatus:   metav1.ConditionTrue,
+			wantReason:   infrav1.ReadyConditionReason,
+		},
+		{
+			name:         "application credential expires outside the window",
+			identityType: infrav1.IdentityTypeApplicationCredential,
+			expiresAt:    time.Now().Add(30 * 24 * time.Hour),
+			wantStatus:   metav1.ConditionTrue,
+			wantReason:   infrav1.ReadyConditionReason,
+		},
+		{
+			name:         "application credential is expiring",
+			identityType: infrav1.IdentityTypeApplicationCredential,
+			expiresAt:    time.Now().Add(24 * time.Hour),
+			wantStatus:   metav1.ConditionFalse,
+			wantReason:   infrav1.CredentialsExpiringReason,
+			wantMessage:  "create an OpenStackCredentialRotation for Secret " + secretName,
+		},
+		{
+			name:         "application credential is expiring and rotated",
+			identityType: infrav1.IdentityTypeApplicationCredential,
+			expiresAt:    time.Now().Add(24 * time.Hour),
+			rotated:      true,
+			wantStatus:   metav1.ConditionFalse,
+			wantReason:   infrav1.CredentialsExpiringReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: secretName},
+				Data: map[string][]byte{
+					scope.AuthURLSecretKey:                     []byte("https://keystone.example.com/"),
+					scope.ApplicationCredentialIDSecretKey:     []byte(credentialID),
+					scope.ApplicationCredentialSecretSecretKey: []byte("secret"),
+				},
+			}
+			openStackCluster := &infrav1.OpenStackCluster{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-cluster"},
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{Type: tt.identityType, Name: secretName},
+				},
+			}
+			objects := []client.Object{secret}
+			if tt.rotated {
+				objects = append(objects, &infrav1alpha1.OpenStackCredentialRotation{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "rotation"},
+					Spec:       infrav1alpha1.OpenStackCredentialRotationSpec{SecretName: secretName},
+				})
+			}
+			k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+
+			mockScopeFactory := scope.NewMockScopeFactory(gomock.NewController(t), "")
+			mockScopeFactory.SetUserID(userID)
+			if tt.identityType == infrav1.IdentityTypeApplicationCredential {
+				mockScopeFactory.IdentityClient.EXPECT().GetApplicationCredential(userID, credentialID).Return(&applicationcredentials.ApplicationCredential{
+					ID:        credentialID,
+					Name:      "capo",
+					ExpiresAt: tt.expiresAt,
+				}, nil)
+			}
+
+			r := &OpenStackClusterReconciler{
+				Client:                 k8sClient,
+				CredentialExpiryWindow: 7 * 24 * time.Hour,
+			}
+			r.reconcileCredentials(ctx, scope.NewWithLogger(mockScopeFactory, testr.New(t)), openStackCluster)
+
+			condition := conditions.Get(openStackCluster, infrav1.CredentialsValidCondition)
+			if tt.wantStatus == "" {
+				g.Expect(condition).To(BeNil())
+				return
+			}
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantStatus))
+			g.Expect(condition.Reason).To(Equal(tt.wantReason))
+			if tt.wantMessage != "" {
+				g.Expect(condition.Message).To(ContainSubstring(tt.wantMessage))
+			} else {
+				g.Expect(condition.Message).NotTo(ContainSubstring("OpenStackCredentialRotation"))
+			}
+		})
+	}
+}
+
+func TestOpenStackClusterReconciler_reconcileCredentialsInterval(t *testing.T) {
+	const (
+		userID       = "7a9bd1b4-5b12-4bd1-8b53-6b0b0e1a0a6d"
+		credentialID = "d2a0bfac-5b4b-4c7b-9d2f-7d2c8e0d9f61"
+		rotatedID    = "9c1e5f3a-2b7d-4e8f-a6c4-0d3b5e7f9a1c"
+		secretName   = "cloud-credentials"
+	)
+
+	g := NewWithT(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: secretName},
+		Data: map[string][]byte{
+			scope.AuthURLSecretKey:                     []byte("https://keystone.example.com/"),
+			scope.ApplicationCredentialIDSecretKey:     []byte(credentialID),
+			scope.ApplicationCredentialSecretSecretKey: []byte("secret"),
+		},
+	}
+	openStackCluster := &infrav1.OpenStackCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-cluster"},
+		Spec: infrav1.OpenStackClusterSpec{
+			IdentityRef: infrav1.OpenStackIdentityReference{Type: infrav1.IdentityTypeApplicationCredential, Name: secretName},
+		},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+
+	mockScopeFactory := scope.NewMockScopeFactory(gomock.NewController(t), "")
+	mockScopeFactory.SetUserID(userID)
+	clientScope := scope.NewWithLogger(mockScopeFactory, testr.New(t))
+	r := &OpenStackClusterReconciler{
+		Client:                 k8sClient,
+		CredentialExpiryWindow: 7 * 24 * time.Hour,
+	}
+
+	// The expiry is only looked up again once the check interval has passed
+	mockScopeFactory.IdentityClient.EXPECT().GetApplicationCredential(userID, credentialID).Return(&applicationcredentials.ApplicationCredential{
+		ID:        credentialID,
+		ExpiresAt: time.Now().Add(30 * 24 * time.Hour),
+	}, nil).Times(1)
+	r.reconcileCredentials(ctx, clientScope, openStackCluster)
+	r.reconcileCredentials(ctx, clientScope, openStackCluster)
+	g.Expect(conditions.IsTrue(openStackCluster, infrav1.CredentialsValidCondition)).To(BeTrue())
+
+	// A credential which starts expiring before the next lookup is still reported
+	r.CredentialExpiryWindow = 60 * 24 * time.Hour
+	r.reconcileCredentials(ctx, clientScope, openStackCluster)
+	g.Expect(conditions.GetReason(openStackCluster, infrav1.CredentialsValidCondition)).To(Equal(infrav1.CredentialsExpiringReason))
+
+	// The expiry is looked up again when the Secret changes
+	g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+	secret.Data[scope.ApplicationCredentialIDSecretKey] = []byte(rotatedID)
+	g.Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+	mockScopeFactory.IdentityClient.EXPECT().GetApplicationCredential(userID, rotatedID).Return(&applicationcredentials.ApplicationCredential{
+		ID:        rotatedID,
+		ExpiresAt: time.Now().Add(90 * 24 * time.Hour),
+	}, nil).Times(1)
+	r.reconcileCredentials(ctx, clientScope, openStackCluster)
+	g.Expect(conditions.IsTrue(openStackCluster, infrav1.CredentialsValidCondition)).To(BeTrue())
+	g.Expect(conditions.Get(openStackCluster, infrav1.CredentialsValidCondition).Message).To(ContainSubstring(rotatedID))
+}
+
+func TestOpenStackClusterReconciler_getAPIServerLoadBalancerCertificate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	NewWithT(t).Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	NewWithT(t).Expect(err).NotTo(HaveOccurred())
+	template := &x509.Certificate{SerialNumber: big.NewInt(1), NotBefore: time.Now(), NotAfter: time.Now().Add(time.Hour)}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	NewWithT(t).Expect(err).NotTo(HaveOccurred())
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	NewWithT(t).Expect(err).NotTo(HaveOccurred())
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	tests := []struct {
+		name       string
+		tls        *infrav1.APIServerLoadBalancerTLS
+		secretData map[string][]byte
+		wantCert   bool
+		wantErr    bool
+	}{
+		{
+			name: "TLS is not configured",
+		},
+		{
+			name: "TLS passthrough",
+			tls:  &infrav1.APIServerLoadBalancerTLS{Mode: infrav1.APIServerLoadBalancerTLSPassthrough},
+		},
+		{
+			name:       "TLS termination",
+			tls:        &infrav1.APIServerLoadBalancerTLS{Mode: infrav1.APIServerLoadBalancerTLSTerminated, CertificateSecretName: ptr.To("api-tls")},
+			secretData: map[string][]byte{corev1.TLSCertKey: certPEM, corev1.TLSPrivateKeyKey: keyPEM},
+			wantCert:   true,
+		},
+		{
+			name:       "secret does not contain a valid certificate",
+			tls:        &infrav1.APIServerLoadBalancerTLS{Mode: infrav1.APIServerLoadBalancerTLSTerminated, CertificateSecretName: ptr.To("api-tls")},
+			secretData: map[string][]byte{corev1.TLSCertKey: certPEM},
+			wantErr:    true,
+		},
+		{
+			name:    "secret does not exist",
+			tls:     &infrav1.APIServerLoadBalancerTLS{Mode: infrav1.APIServerLoadBalancerTLSTerminated, CertificateSecretName: ptr.To("api-tls")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			builder := fake.NewClientBuilder().WithScheme(scheme)
+			if tt.secretData != nil {
+				builder = builder.WithObjects(&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "api-tls"},
+					Type:       corev1.SecretTypeTLS,
+					Data:       tt.secretData,
+				})
+			}
+			openStackCluster := &infrav1.OpenStackCluster{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-cluster"},
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServer: &infrav1.APIServer{
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{Enabled: ptr.To(true), TLS: tt.tls},
+					},
+				},
+			}
+
+			r := &OpenStackClusterReconciler{Client: builder.Build()}
+			certificate, err := r.getAPIServerLoadBalancerCertificate(context.Background(), openStackCluster)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			if tt.wantCert {
+				g.Expect(certificate).NotTo(BeNil())
+				g.Expect(certificate.Certificate).To(Equal(certPEM))
+			} else {
+				g.Expect(certificate).To(BeNil())
+			}
+		})
+	}
+}
+
+func TestOpenStackClusterReconciler_reconcileAPIServerVirtualIPConfig(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(infrav1.AddToScheme(scheme)).To(Succeed())
+
+	cluster := &clusterv1.Cluster{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-cluster"}}
+	openStackCluster := &infrav1.OpenStackCluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test-cluster", UID: "test-uid"},
+		Spec: infrav1.OpenStackClusterSpec{
+			APIServer: &infrav1.APIServer{
+				VirtualIP: &infrav1.APIServerVirtualIP{Provider: infrav1.APIServerVirtualIPProviderKeepalived},
+			},
+			ControlPlaneEndpoint: &clusterv1.APIEndpoint{Host: "10.0.0.100", Port: 6443},
+		},
+		Status: infrav1.OpenStackClusterStatus{
+			APIServerVirtualIP: &infrav1.VirtualIPStatus{PortID: "vip-port", IP: "10.0.0.100"},
+		},
+	}
+
+	r := &OpenStackClusterReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).Build()}
+	for range 2 {
+		g.Expect(r.reconcileAPIServerVirtualIPConfig(context.Background(), cluster, openStackCluster)).To(Succeed())
+	}
+	g.Expect(openStackCluster.Status.APIServerVirtualIP.ConfigSecretName).To(Equal("test-cluster-apiserver-vip"))
+
+	secret := &corev1.Secret{}
+	g.Expect(r.Client.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "test-cluster-apiserver-vip"}, secret)).To(Succeed())
+	g.Expect(secret.Labels).To(HaveKeyWithValue(clusterv1.ClusterNameLabel, "test-cluster"))
+	g.Expect(secret.OwnerReferences).To(ConsistOf(HaveField("UID", types.UID("test-uid"))))
+	g.Expect(secret.Data).To(HaveKey(networking.VirtualIPKeepalivedConfigKey))
+	g.Expect(secret.Data).To(HaveKey(networking.VirtualIPKeepalivedCheckScriptKey))
+}
+
+func Test_setLoadBalancerProviderCompatibleCondition(t *testing.T) {
+	g := NewWithT(t)
+
+	openStackCluster := &infrav1.OpenStackCluster{
+		Spec: infrav1.OpenStackClusterSpec{
+			APIServer: &infrav1.APIServer{
+				ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+					Enabled:  ptr.To(true),
+					Provider: ptr.To("ovn"),
+				},
+			},
+		},
+	}
+
+	setLoadBalancerProviderCompatibleCondition(openStackCluster, []string{"allowed CIDRs", "flavors"})
+	condition := conditions.Get(openStackCluster, infrav1.LoadBalancerProviderCompatibleCondition)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(condition.Reason).To(Equal(infrav1.LoadBalancerFeaturesUnsupportedReason))
+	g.Expect(condition.Message).To(Equal("Octavia provider ovn of the API server load balancer does not support allowed CIDRs, flavors: the load balancer is configured without them"))
+
+	setLoadBalancerProviderCompatibleCondition(openStackCluster, nil)
+	condition = conditions.Get(openStackCluster, infrav1.LoadBalancerProviderCompatibleCondition)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+}
+
+func Test_setAPIServerLoadBalancerUpToDateCondition(t *testing.T) {
+	g := NewWithT(t)
+
+	openStackCluster := &infrav1.OpenStackCluster{}
+
+	setAPIServerLoadBalancerUpToDateCondition(openStackCluster, []string{"provider", "flavor"})
+	condition := conditions.Get(openStackCluster, infrav1.APIServerLoadBalancerUpToDateCondition)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(condition.Reason).To(Equal(infrav1.LoadBalancerReplacementNotPossibleReason))
+	g.Expect(condition.Message).To(Equal("The API server load balancer must be replaced to change its provider, flavor, which is only possible if the API server uses a floating IP"))
+
+	setAPIServerLoadBalancerUpToDateCondition(openStackCluster, nil)
+	condition = conditions.Get(openStackCluster, infrav1.APIServerLoadBalancerUpToDateCondition)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+}
+
+func TestOpenStackClusterReconciler_loadBalancerPollInterval(t *testing.T) {
+	g := NewWithT(t)
+
+	openStackCluster := &infrav1.OpenStackCluster{}
+	r := &OpenStackClusterReconciler{}
+	g.Expect(r.loadBalancerPollInterval(openStackCluster)).To(BeZero())
+
+	openStackCluster.Spec.APIServer = &infrav1.APIServer{
+		ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{Enabled: ptr.To(true)},
+	}
+	g.Expect(r.loadBalancerPollInterval(openStackCluster)).To(Equal(DefaultLoadBalancerHealthPollInterval))
+
+	r.LoadBalancerHealthPollInterval = 5 * time.Minute
+	g.Expect(r.loadBalancerPollInterval(openStackCluster)).To(Equal(5 * time.Minute))
+
+	openStackCluster.Status.APIServerLoadBalancerReplacement = &infrav1.APIServerLoadBalancerReplacement{}
+	g.Expect(r.loadBalancerPollInterval(openStackCluster)).To(Equal(15 * time.Second))
+}
+
+func TestOpenStackClusterReconciler_needsFullReconcile(t *testing.T) {
+	g := NewWithT(t)
+
+	openStackCluster := &infrav1.OpenStackCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-cluster", Namespace: "test", Generation: 1},
+		Spec: infrav1.OpenStackClusterSpec{
+			APIServer: &infrav1.APIServer{
+				ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{Enabled: ptr.To(true)},
+			},
+		},
+	}
+	r := &OpenStackClusterReconciler{}
+
+	// Clusters which are not ready are always fully reconciled
+	g.Expect(r.needsFullReconcile(openStackCluster)).To(BeTrue())
+	conditions.Set(openStackCluster, metav1.Condition{
+		Type:   clusterv1.ReadyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: infrav1.ReadyConditionReason,
+	})
+	g.Expect(r.needsFullReconcile(openStackCluster)).To(BeTrue())
+
+	key := client.ObjectKeyFromObject(openStackCluster)
+	r.fullReconciles.Store(key, fullReconcile{generation: 1, reconciledAt: time.Now()})
+	g.Expect(r.needsFullReconcile(openStackCluster)).To(BeFalse())
+
+	// Spec changes are reconciled immediately
+	openStackCluster.Generation = 2
+	g.Expect(r.needsFullReconcile(openStackCluster)).To(BeTrue())
+	openStackCluster.Generation = 1
+
+	r.fullReconciles.Store(key, fullReconcile{generation: 1, reconciledAt: time.Now().Add(-DefaultFullReconcileInterval)})
+	g.Expect(r.needsFullReconcile(openStackCluster)).To(BeTrue())
+	r.FullReconcileInterval = time.Hour
+	g.Expect(r.needsFullReconcile(openStackCluster)).To(BeFalse())
+
+	// Clusters without an API server load balancer have nothing to poll
+	openStackCluster.Spec.APIServer.ManagedLoadBalancer.Enabled = ptr.To(false)
+	g.Expect(r.needsFullReconcile(openStackCluster)).To(BeTrue())
+}
+
+func Test_setAPIServerLoadBalancerDegradedCondition(t *testing.T) {
+	g := NewWithT(t)
+
+	lbStatus := &infrav1.LoadBalancer{
+		Listeners: []infrav1.LoadBalancerListenerStatus{{
+			ID:                 "listener",
+			ProvisioningStatus: "ACTIVE",
+			Pools: []infrav1.LoadBalancerPoolStatus{{
+				ID:                 "pool",
+				Name:               "k8s-clusterapi-cluster-AAAAA-kubeapi-6443",
+				ProvisioningStatus: "ACTIVE",
+				Members: []infrav1.LoadBalancerMemberStatus{
+					{ID: "member-a", Name: "control-plane-a", OperatingStatus: "ONLINE"},
+					{ID: "member-b", Name: "control-plane-b", OperatingStatus: "OFFLINE"},
+				},
+			}},
+		}},
+	}
+	openStackCluster := &infrav1.OpenStackCluster{
+		Status: infrav1.OpenStackClusterStatus{APIServerManagedLoadBalancer: lbStatus},
+	}
+
+	setAPIServerLoadBalancerDegradedCondition(openStackCluster)
+	condition := conditions.Get(openStackCluster, infrav1.APIServerLoadBalancerDegradedCondition)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+	g.Expect(condition.Reason).To(Equal(infrav1.LoadBalancerMembersUnhealthyReason))
+	g.Expect(condition.Message).To(Equal("The API server load balancer has unhealthy members: control-plane-b (OFFLINE)"))
+
+	lbStatus.Listeners[0].Pools[0].ProvisioningStatus = "ERROR"
+	setAPIServerLoadBalancerDegradedCondition(openStackCluster)
+	condition = conditions.Get(openStackCluster, infrav1.APIServerLoadBalancerDegradedCondition)
+	g.Expect(condition.Reason).To(Equal(infrav1.LoadBalancerProvisioningErrorReason))
+	g.Expect(condition.Message).To(Equal("The API server load balancer has objects in ERROR: pool k8s-clusterapi-cluster-AAAAA-kubeapi-6443"))
+
+	lbStatus.Listeners[0].Pools[0].ProvisioningStatus = "ACTIVE"
+	lbStatus.Listeners[0].Pools[0].Members[1].OperatingStatus = "ONLINE"
+	setAPIServerLoadBalancerDegradedCondition(openStackCluster)
+	condition = conditions.Get(openStackCluster, infrav1.APIServerLoadBalancerDegradedCondition)
+	g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(condition.Reason).To(Equal(infrav1.LoadBalancerNotDegradedReason))
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/tokens"
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	conditions "sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/yaml"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/controllers"
+)
+
+const DefaultClusterIdentityCheckInterval = 10 * time.Minute
+
+// OpenStackClusterIdentityReconciler reconciles a OpenStackClusterIdentity object.
+type OpenStackClusterIdentityReconciler struct {
+	Client           client.Client
+	Recorder         events.EventRecorder
+	WatchFilterValue string
+	ScopeFactory     scope.Factory
+	CaCertificates   []byte // PEM encoded ca certificates.
+	// CheckInterval is how often the credentials are checked. Defaults to DefaultClusterIdentityCheckInterval.
+	CheckInterval time.Duration
+
+	// secretVersions holds the last observed resourceVersion of each Secret, by its types.NamespacedName.
+	secretVersions sync.Map
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackclusteridentities,verbs=get;list;watch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackclusteridentities/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get
+
+func (r *OpenStackClusterIdentityReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+	defer func() {
+		result, reterr = controllers.HandleRequeueAfterError(log, result, reterr)
+	}()
+
+	identity := &infrav1alpha1.OpenStackClusterIdentity{}
+	if err := r.Client.Get(ctx, req.NamespacedName, identity); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !identity.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	patchHelper, err := patch.NewHelper(identity, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, identity); err != nil {
+			if reterr == nil {
+				reterr = fmt.Errorf("error patching OpenStackClusterIdentity %s: %w", identity.Name, err)
+			}
+		}
+	}()
+
+	return r.reconcileNormal(ctx, log, identity)
+}
+
+func (r *OpenStackClusterIdentityReconciler) reconcileNormal(ctx context.Context, log logr.Logger, identity *infrav1alpha1.OpenStackClusterIdentity) (ctrl.Result, error) {
+	checkInterval := r.CheckInterval
+	if checkInterval == 0 {
+		checkInterval = DefaultClusterIdentityCheckInterval
+	}
+	identity.Status.LastCheckTime = &metav1.Time{Time: time.Now()}
+
+	secretRef := identity.Spec.SecretRef
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: secretRef.Namespace, Name: secretRef.Name}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			setClusterIdentityInvalid(identity, fmt.Sprintf("Secret %s/%s does not exist", secretRef.Namespace, secretRef.Name))
+			return ctrl.Result{RequeueAfter: checkInterval}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	clouds, err := getCloudsFromSecret(secret)
+	if err != nil {
+		setClusterIdentityInvalid(identity, err.Error())
+		return ctrl.Result{RequeueAfter: checkInterval}, nil
+	}
+
+	// The checks authenticate without the scope cache, so cached scopes of the users of the identity are only
+	// discarded when the Secret has changed and they may be using replaced credentials
+	if r.secretChanged(secret) {
+		r.ScopeFactory.InvalidateSecret(secret)
+	}
+
+	names := make([]string, 0, len(clouds.Clouds))
+	for name := range clouds.Clouds {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	statuses := make([]infrav1alpha1.OpenStackClusterIdentityCloudStatus, 0, len(names))
+	var failed []string
+	for _, name := range names {
+		status := r.checkCloud(ctx, log, identity, name, clouds.Clouds[name])
+		if status.Error != "" {
+			record.Warnf(identity, "FailedAuthenticate", "Failed to authenticate with cloud %s: %s", name, status.Error)
+			failed = append(failed, name)
+		}
+		statuses = append(statuses, status)
+	}
+	identity.Status.Clouds = statuses
+
+	if len(failed) > 0 {
+		conditions.Set(identity, metav1.Condition{
+			Type:    infrav1alpha1.OpenStackClusterIdentityReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.OpenStackAuthenticationFailedReason,
+			Message: fmt.Sprintf("Failed to authenticate with clouds: %s", strings.Join(failed, ", ")),
+		})
+	} else {
+		conditions.Set(identity, metav1.Condition{
+			Type:   infrav1alpha1.OpenStackClusterIdentityReadyCondition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.ReadyConditionReason,
+		})
+	}
+	return ctrl.Result{RequeueAfter: checkInterval}, nil
+}
+
+// checkCloud authenticates with a cloud in the Secret of the identity and returns what Keystone reported about the
+// credentials.
+func (r *OpenStackClusterIdentityReconciler) checkCloud(ctx context.Context, log logr.Logger, identity *infrav1alpha1.OpenStackClusterIdentity, name string, cloud clientconfig.Cloud) infrav1alpha1.OpenStackClusterIdentityCloudStatus {
+	status := infrav1alpha1.OpenStackClusterIdentityCloudStatus{Name: name}
+
+	clientScope, err := r.ScopeFactory.NewClientScopeFromClusterIdentity(ctx, r.Client, r.CaCertificates, log, identity, name)
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+	status.ProjectID = clientScope.ProjectID()
+	status.UserID = clientScope.UserID()
+
+	token, err := clientScope.ExtractToken()
+	if err != nil {
+		status.Error = fmt.Sprintf("failed to get token: %v", err)
+		return status
+	}
+	status.TokenExpiresAt = &metav1.Time{Time: token.ExpiresAt}
+
+	roles, err := clientScope.ExtractRoles()
+	if err != nil {
+		status.Error = fmt.Sprintf("failed to get roles: %v", err)
+		return status
+	}
+	for _, role := range roles {
+		if !slices.Contains(status.Roles, role.Name) {
+			status.Roles = append(status.Roles, role.Name)
+		}
+	}
+
+	catalog, err := clientScope.ExtractServiceCatalog()
+	if err != nil {
+		status.Error = fmt.Sprintf("failed to get service catalog: %v", err)
+		return status
+	}
+	status.Endpoints = getCloudEndpoints(catalog, cloud)
+	return status
+}
+
+// getCloudEndpoints returns the endpoints in catalog which are used by cloud, which are those in its region with its
+// interface.
+func getCloudEndpoints(catalog *tokens.ServiceCatalog, cloud clientconfig.Cloud) []infrav1alpha1.OpenStackServiceEndpoint {
+	endpointType := cloud.EndpointType
+	if endpointType == "" {
+		endpointType = cloud.Interface
+	}
+	availability := clientconfig.GetEndpointType(endpointType)
+	if availability == "" {
+		availability = gophercloud.AvailabilityPublic
+	}
+
+	var endpoints []infrav1alpha1.OpenStackServiceEndpoint
+	for _, entry := range catalog.Entries {
+		for _, endpoint := range entry.Endpoints {
+			if endpoint.Interface != string(availability) {
+				continue
+			}
+			if cloud.RegionName != "" && endpoint.Region != cloud.RegionName && endpoint.RegionID != cloud.RegionName {
+				continue
+			}
+			endpoints = append(endpoints, infrav1alpha1.OpenStackServiceEndpoint{
+				Type:      entry.Type,
+				Name:      entry.Name,
+				Region:    endpoint.Region,
+				Interface: endpoint.Interface,
+				URL:       endpoint.URL,
+			})
+		}
+	}
+	return endpoints
+}
+
+// getCloudsFromSecret returns the clouds in the clouds.yaml of secret.
+func getCloudsFromSecret(secret *corev1.Secret) (*clientconfig.Clouds, error) {
+	content, ok := secret.Data[scope.CloudsSecretKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s does not contain key %s", secret.Namespace, secret.Name, scope.CloudsSecretKey)
+	}
+	var clouds clientconfig.Clouds
+	if err := yaml.Unmarshal(content, &clouds); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal clouds.yaml in secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	}
+	if len(clouds.Clouds) == 0 {
+		return nil, fmt.Errorf("clouds.yaml in secret %s/%s does not contain any clouds", secret.Namespace, secret.Name)
+	}
+	return &clouds, nil
+}
+
+func setClusterIdentityInvalid(identity *infrav1alpha1.OpenStackClusterIdentity, message string) {
+	identity.Status.Clouds = nil
+	record.Warnf(identity, "FailedAuthenticate", "Failed to authenticate: %s", message)
+	conditions.Set(identity, metav1.Condition{
+		Type:    infrav1alpha1.OpenStackClusterIdentityReadyCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  infrav1alpha1.InvalidCredentialsSecretReason,
+		Message: message,
+	})
+}
+
+// secretChanged records the resourceVersion of secret and returns whether it differs from the one previously
+// observed. A Secret which has not been observed since the manager started has not changed, because the scope cache
+// is empty on start.
+func (r *OpenStackClusterIdentityReconciler) secretChanged(secret *corev1.Secret) bool {
+	previous, loaded := r.secretVersions.Swap(client.ObjectKeyFromObject(secret), secret.ResourceVersion)
+	return loaded && previous != secret.ResourceVersion
+}
+
+func (r *OpenStackClusterIdentityReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	// The Secret is read again at every check rather than being watched, so that the controller does not cache every
+	// Secret in the cluster
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		// Updates to the status do not change the generation, so recording the result of a check does not trigger another
+		For(&infrav1alpha1.OpenStackClusterIdentity{},
+			builder.WithPredicates(
+				predicate.GenerationChangedPredicate{},
+				predicates.ResourceNotPausedAndHasFilterLabel(mgr.GetScheme(), log, r.WatchFilterValue),
+			),
+		).
+		Complete(r)
+}
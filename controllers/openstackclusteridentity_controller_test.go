@@ -0,0 +1,242 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/tokens"
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	conditions "sigs.k8s.io/cluster-api/util/conditions"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func TestOpenStackClusterIdentityReconciler_Reconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	NewWithT(t).Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	NewWithT(t).Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	catalog := tokens.ServiceCatalog{Entries: []tokens.CatalogEntry{
+		{
+			Type: "compute",
+			Name: "nova",
+			Endpoints: []tokens.Endpoint{
+				{Region: "RegionOne", Interface: "public", URL: "https://nova.example.com/v2.1"},
+				{Region: "RegionOne", Interface: "internal", URL: "http://nova.internal:8774/v2.1"},
+				{Region: "RegionTwo", Interface: "public", URL: "https://nova.region-two.example.com/v2.1"},
+			},
+		},
+		{
+			Type: "network",
+			Name: "neutron",
+			Endpoints: []tokens.Endpoint{
+				{Region: "RegionOne", Interface: "public", URL: "https://neutron.example.com"},
+			},
+		},
+	}}
+
+	tests := []struct {
+		name          string
+		secretData    map[string][]byte
+		createError   error
+		wantReady     metav1.ConditionStatus
+		wantReason    string
+		wantClouds    []infrav1alpha1.OpenStackClusterIdentityCloudStatus
+		wantEndpoints []infrav1alpha1.OpenStackServiceEndpoint
+	}{
+		{
+			name:       "authentication succeeds",
+			secretData: map[string][]byte{scope.CloudsSecretKey: []byte(passwordCloudsYAML)},
+			wantReady:  metav1.ConditionTrue,
+			wantReason: infrav1.ReadyConditionReason,
+			wantClouds: []infrav1alpha1.OpenStackClusterIdentityCloudStatus{
+				{Name: "openstack", ProjectID: "project-id", UserID: rotationUserID, Roles: []string{"member", "load-balancer_member"}},
+			},
+			wantEndpoints: []infrav1alpha1.OpenStackServiceEndpoint{
+				{Type: "compute", Name: "nova", Region: "RegionOne", Interface: "public", URL: "https://nova.example.com/v2.1"},
+				{Type: "network", Name: "neutron", Region: "RegionOne", Interface: "public", URL: "https://neutron.example.com"},
+			},
+		},
+		{
+			name:        "authentication fails",
+			secretData:  map[string][]byte{scope.CloudsSecretKey: []byte(passwordCloudsYAML)},
+			createError: errors.New("Authentication failed"),
+			wantReady:   metav1.ConditionFalse,
+			wantReason:  infrav1.OpenStackAuthenticationFailedReason,
+			wantClouds: []infrav1alpha1.OpenStackClusterIdentityCloudStatus{
+				{Name: "openstack", Error: "Authentication failed"},
+			},
+		},
+		{
+			name:       "secret does not contain clouds.yaml",
+			secretData: map[string][]byte{},
+			wantReady:  metav1.ConditionFalse,
+			wantReason: infrav1alpha1.InvalidCredentialsSecretReason,
+		},
+		{
+			name:       "secret does not exist",
+			wantReady:  metav1.ConditionFalse,
+			wantReason: infrav1alpha1.InvalidCredentialsSecretReason,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+
+			identity := &infrav1alpha1.OpenStackClusterIdentity{
+				ObjectMeta: metav1.ObjectMeta{Name: "production"},
+				Spec: infrav1alpha1.OpenStackClusterIdentitySpec{
+					SecretRef: infrav1alpha1.OpenStackCredentialSecretReference{Namespace: "capo-system", Name: rotationSecretName},
+				},
+			}
+			objects := []client.Object{identity}
+			if tt.secretData != nil {
+				objects = append(objects, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "capo-system", Name: rotationSecretName},
+					Data:       tt.secretData,
+				})
+			}
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(objects...).
+				WithStatusSubresource(&infrav1alpha1.OpenStackClusterIdentity{}).
+				Build()
+
+			mockScopeFactory := scope.NewMockScopeFactory(gomock.NewController(t), "project-id")
+			mockScopeFactory.SetUserID(rotationUserID)
+			mockScopeFactory.SetAuthResult([]tokens.Role{{Name: "member"}, {Name: "load-balancer_member"}, {Name: "member"}}, catalog)
+			if tt.createError != nil {
+				mockScopeFactory.SetClientScopeCreateError(tt.createError)
+			}
+
+			r := &OpenStackClusterIdentityReconciler{
+				Client:       k8sClient,
+				ScopeFactory: mockScopeFactory,
+			}
+			result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(identity)})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(result.RequeueAfter).To(Equal(DefaultClusterIdentityCheckInterval))
+			g.Expect(mockScopeFactory.InvalidatedSecrets).To(BeEmpty())
+
+			g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(identity), identity)).To(Succeed())
+			condition := conditions.Get(identity, infrav1alpha1.OpenStackClusterIdentityReadyCondition)
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantReady))
+			g.Expect(condition.Reason).To(Equal(tt.wantReason))
+			g.Expect(identity.Status.LastCheckTime).NotTo(BeNil())
+
+			g.Expect(identity.Status.Clouds).To(HaveLen(len(tt.wantClouds)))
+			for i, want := range tt.wantClouds {
+				got := identity.Status.Clouds[i]
+				g.Expect(got.Name).To(Equal(want.Name))
+				g.Expect(got.Error).To(Equal(want.Error))
+				g.Expect(got.ProjectID).To(Equal(want.ProjectID))
+				g.Expect(got.UserID).To(Equal(want.UserID))
+				g.Expect(got.Roles).To(Equal(want.Roles))
+				g.Expect(got.Endpoints).To(Equal(tt.wantEndpoints))
+				g.Expect(got.TokenExpiresAt != nil).To(Equal(want.Error == ""))
+			}
+		})
+	}
+}
+
+func TestOpenStackClusterIdentityReconciler_InvalidateSecret(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	identity := &infrav1alpha1.OpenStackClusterIdentity{
+		ObjectMeta: metav1.ObjectMeta{Name: "production"},
+		Spec: infrav1alpha1.OpenStackClusterIdentitySpec{
+			SecretRef: infrav1alpha1.OpenStackCredentialSecretReference{Namespace: "capo-system", Name: rotationSecretName},
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "capo-system", Name: rotationSecretName},
+		Data:       map[string][]byte{scope.CloudsSecretKey: []byte(passwordCloudsYAML)},
+	}
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(identity, secret).
+		WithStatusSubresource(&infrav1alpha1.OpenStackClusterIdentity{}).
+		Build()
+
+	mockScopeFactory := scope.NewMockScopeFactory(gomock.NewController(t), "project-id")
+	mockScopeFactory.SetAuthResult(nil, tokens.ServiceCatalog{})
+	r := &OpenStackClusterIdentityReconciler{
+		Client:       k8sClient,
+		ScopeFactory: mockScopeFactory,
+	}
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(identity)}
+
+	// Checking unchanged credentials does not discard the scopes of their users
+	_, err := r.Reconcile(ctx, req)
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = r.Reconcile(ctx, req)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(mockScopeFactory.InvalidatedSecrets).To(BeEmpty())
+
+	g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+	secret.Data[scope.CloudsSecretKey] = []byte(applicationCredentialCloudsYAML)
+	g.Expect(k8sClient.Update(ctx, secret)).To(Succeed())
+
+	_, err = r.Reconcile(ctx, req)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(mockScopeFactory.InvalidatedSecrets).To(ConsistOf(types.NamespacedName{Namespace: "capo-system", Name: rotationSecretName}))
+}
+
+func Test_getCloudEndpoints(t *testing.T) {
+	g := NewWithT(t)
+
+	catalog := &tokens.ServiceCatalog{Entries: []tokens.CatalogEntry{
+		{
+			Type: "identity",
+			Name: "keystone",
+			Endpoints: []tokens.Endpoint{
+				{RegionID: "RegionOne", Interface: "internal", URL: "http://keystone.internal:5000"},
+				{RegionID: "RegionTwo", Interface: "internal", URL: "http://keystone.region-two.internal:5000"},
+				{RegionID: "RegionOne", Interface: "public", URL: "https://keystone.example.com"},
+			},
+		},
+	}}
+
+	cloud := clientconfig.Cloud{RegionName: "RegionOne", EndpointType: "internalURL"}
+	g.Expect(getCloudEndpoints(catalog, cloud)).To(Equal([]infrav1alpha1.OpenStackServiceEndpoint{
+		{Type: "identity", Name: "keystone", Interface: "internal", URL: "http://keystone.internal:5000"},
+	}))
+
+	// Without a region, the endpoints in every region are used
+	cloud.RegionName = ""
+	g.Expect(getCloudEndpoints(catalog, cloud)).To(HaveLen(2))
+}
@@ -0,0 +1,470 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/applicationcredentials"
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	conditions "sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/cluster-api/util/predicates"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/identity"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/controllers"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+const (
+	defaultCredentialRotationInterval    = 30 * 24 * time.Hour
+	defaultCredentialRotationGracePeriod = 24 * time.Hour
+
+	// credentialRotationSecretCheckInterval is how often a missing or invalid Secret is read again. Secrets are not
+	// watched, so that the controller does not cache every Secret in the cluster.
+	credentialRotationSecretCheckInterval = 10 * time.Minute
+)
+
+// OpenStackCredentialRotationReconciler reconciles a OpenStackCredentialRotation object.
+type OpenStackCredentialRotationReconciler struct {
+	Client           client.Client
+	Recorder         events.EventRecorder
+	WatchFilterValue string
+	ScopeFactory     scope.Factory
+	CaCertificates   []byte // PEM encoded ca certificates.
+}
+
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackcredentialrotations,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=openstackcredentialrotations/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;update
+
+func (r *OpenStackCredentialRotationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, reterr error) {
+	log := ctrl.LoggerFrom(ctx)
+	defer func() {
+		result, reterr = controllers.HandleRequeueAfterError(log, result, reterr)
+	}()
+
+	rotation := &infrav1alpha1.OpenStackCredentialRotation{}
+	if err := r.Client.Get(ctx, req.NamespacedName, rotation); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	patchHelper, err := patch.NewHelper(rotation, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		if err := patchHelper.Patch(ctx, rotation); err != nil {
+			if reterr == nil {
+				reterr = fmt.Errorf("error patching OpenStackCredentialRotation %s/%s: %w", rotation.Namespace, rotation.Name, err)
+			}
+		}
+	}()
+
+	if !rotation.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.reconcileDelete(ctx, log, rotation)
+	}
+
+	// Register the finalizer before creating any application credentials, so that they are not left behind
+	if controllerutil.AddFinalizer(rotation, infrav1alpha1.OpenStackCredentialRotationFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: rotation.Namespace, Name: rotation.Spec.SecretName}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			setCredentialRotationInvalid(rotation, fmt.Sprintf("Secret %s does not exist", rotation.Spec.SecretName))
+			return ctrl.Result{RequeueAfter: credentialRotationSecretCheckInterval}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	currentID, err := getApplicationCredentialIDFromSecret(secret, rotation.Spec.CloudName)
+	if err != nil {
+		setCredentialRotationInvalid(rotation, err.Error())
+		return ctrl.Result{RequeueAfter: credentialRotationSecretCheckInterval}, nil
+	}
+
+	scope, err := r.newScope(ctx, log, rotation)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	return r.reconcileNormal(ctx, scope, rotation, secret, currentID)
+}
+
+// newScope creates a scope with the bootstrap credentials of rotation.
+func (r *OpenStackCredentialRotationReconciler) newScope(ctx context.Context, log logr.Logger, rotation *infrav1alpha1.OpenStackCredentialRotation) (*scope.WithLogger, error) {
+	clientScope, err := r.ScopeFactory.NewClientScopeFromObject(ctx, r.Client, r.CaCertificates, log, rotation)
+	if err != nil {
+		conditions.Set(rotation, metav1.Condition{
+			Type:    infrav1.OpenStackAuthenticationSucceeded,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.OpenStackAuthenticationFailedReason,
+			Message: fmt.Sprintf("Failed to create OpenStack client scope: %v", err),
+		})
+		return nil, err
+	}
+	conditions.Set(rotation, metav1.Condition{
+		Type:   infrav1.OpenStackAuthenticationSucceeded,
+		Status: metav1.ConditionTrue,
+		Reason: infrav1.ReadyConditionReason,
+	})
+	return scope.NewWithLogger(clientScope, log), nil
+}
+
+// reconcileDelete deletes the application credentials created for the rotation, except for the one still in the
+// Secret. Deleting it would break every user of the Secret, so it is left to expire.
+func (r *OpenStackCredentialRotationReconciler) reconcileDelete(ctx context.Context, log logr.Logger, rotation *infrav1alpha1.OpenStackCredentialRotation) error {
+	if !controllerutil.ContainsFinalizer(rotation, infrav1alpha1.OpenStackCredentialRotationFinalizer) {
+		return nil
+	}
+
+	var currentID string
+	secret := &corev1.Secret{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Namespace: rotation.Namespace, Name: rotation.Spec.SecretName}, secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+	} else if id, err := getApplicationCredentialIDFromSecret(secret, rotation.Spec.CloudName); err == nil {
+		currentID = id
+	}
+
+	scope, err := r.newScope(ctx, log, rotation)
+	if err != nil {
+		return err
+	}
+	identityService, err := identity.NewService(scope)
+	if err != nil {
+		return err
+	}
+	credentials, err := identityService.ListApplicationCredentials()
+	if err != nil {
+		return err
+	}
+	description := getCredentialRotationDescription(rotation)
+	for _, credential := range credentials {
+		if credential.Description != description || credential.ID == currentID {
+			continue
+		}
+		if err := identityService.DeleteApplicationCredential(credential.ID); err != nil && !capoerrors.IsNotFound(err) {
+			return fmt.Errorf("delete application credential %s: %w", credential.ID, err)
+		}
+		record.Eventf(rotation, "SuccessfulRevokeApplicationCredential", "Deleted application credential %s", credential.ID)
+	}
+
+	controllerutil.RemoveFinalizer(rotation, infrav1alpha1.OpenStackCredentialRotationFinalizer)
+	return nil
+}
+
+func (r *OpenStackCredentialRotationReconciler) reconcileNormal(ctx context.Context, scope *scope.WithLogger, rotation *infrav1alpha1.OpenStackCredentialRotation, secret *corev1.Secret, currentID string) (ctrl.Result, error) {
+	identityService, err := identity.NewService(scope)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Application credentials created for the rotation are recognised by their description, so that credentials
+	// which were created but could not be written to the Secret are also revoked
+	description := getCredentialRotationDescription(rotation)
+	credentials, err := identityService.ListApplicationCredentials()
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	var owned []applicationcredentials.ApplicationCredential
+	var current *applicationcredentials.ApplicationCredential
+	for i := range credentials {
+		if credentials[i].Description != description {
+			continue
+		}
+		owned = append(owned, credentials[i])
+		if credentials[i].ID == currentID {
+			current = &credentials[i]
+		}
+	}
+
+	now := time.Now()
+	interval, gracePeriod := getCredentialRotationPeriods(rotation)
+	if err := r.reconcileRevocations(identityService, rotation, owned, currentID, now, gracePeriod); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// An application credential in the Secret which was not created for the rotation is replaced immediately
+	var nextRotation time.Time
+	if current != nil {
+		nextRotation = current.ExpiresAt.Add(-gracePeriod)
+		if last := rotation.Status.LastRotationTime; last != nil && rotation.Status.ApplicationCredentialID == currentID {
+			if t := last.Add(interval); t.Before(nextRotation) {
+				nextRotation = t
+			}
+		}
+	}
+	if current == nil || !now.Before(nextRotation) {
+		rotated, err := r.rotate(ctx, identityService, rotation, secret, now, interval, gracePeriod)
+		if err != nil {
+			record.Warnf(rotation, "FailedRotateApplicationCredential", "Failed to rotate application credential in secret %s: %v", secret.Name, err)
+			conditions.Set(rotation, metav1.Condition{
+				Type:    infrav1alpha1.OpenStackCredentialRotationReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.CredentialsRotationFailedReason,
+				Message: fmt.Sprintf("Failed to rotate application credential: %v", err),
+			})
+			return ctrl.Result{}, err
+		}
+		if current != nil {
+			addPendingRevocation(rotation, current.ID, now.Add(gracePeriod))
+		}
+		record.Eventf(rotation, "SuccessfulRotateApplicationCredential", "Replaced application credential %s in secret %s with %s", currentID, secret.Name, rotated.ID)
+
+		currentID = rotated.ID
+		rotation.Status.LastRotationTime = &metav1.Time{Time: now}
+		nextRotation = now.Add(interval)
+	}
+
+	rotation.Status.ApplicationCredentialID = currentID
+	rotation.Status.NextRotationTime = &metav1.Time{Time: nextRotation}
+	conditions.Set(rotation, metav1.Condition{
+		Type:    infrav1alpha1.OpenStackCredentialRotationReadyCondition,
+		Status:  metav1.ConditionTrue,
+		Reason:  infrav1.ReadyConditionReason,
+		Message: fmt.Sprintf("Application credential %s will be replaced at %s", currentID, nextRotation.UTC().Format(time.RFC3339)),
+	})
+
+	requeueAt := nextRotation
+	for _, pending := range rotation.Status.PendingRevocations {
+		if pending.RevokeAfter.Before(&metav1.Time{Time: requeueAt}) {
+			requeueAt = pending.RevokeAfter.Time
+		}
+	}
+	return ctrl.Result{RequeueAfter: time.Until(requeueAt)}, nil
+}
+
+// rotate creates a new application credential and writes it to the Secret. If the Secret can not be updated the
+// new application credential is deleted.
+func (r *OpenStackCredentialRotationReconciler) rotate(ctx context.Context, identityService *identity.Service, rotation *infrav1alpha1.OpenStackCredentialRotation, secret *corev1.Secret, now time.Time, interval, gracePeriod time.Duration) (*applicationcredentials.ApplicationCredential, error) {
+	roles := make([]applicationcredentials.Role, 0, len(rotation.Spec.Roles))
+	for _, role := range rotation.Spec.Roles {
+		roles = append(roles, applicationcredentials.Role{Name: role})
+	}
+	accessRules := make([]applicationcredentials.AccessRule, 0, len(rotation.Spec.AccessRules))
+	for _, rule := range rotation.Spec.AccessRules {
+		accessRules = append(accessRules, applicationcredentials.AccessRule{
+			Service: rule.Service,
+			Method:  rule.Method,
+			Path:    rule.Path,
+		})
+	}
+	// The application credential expires even if it is never rotated or revoked
+	expiresAt := now.Add(interval + gracePeriod)
+	rotated, err := identityService.CreateApplicationCredential(applicationcredentials.CreateOpts{
+		Name:         fmt.Sprintf("%s-%s-%s", rotation.Namespace, rotation.Name, now.UTC().Format("20060102150405")),
+		Description:  getCredentialRotationDescription(rotation),
+		Unrestricted: rotation.Spec.Unrestricted,
+		Roles:        roles,
+		AccessRules:  accessRules,
+		ExpiresAt:    &expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The update fails if the Secret was modified since it was read, so other changes to it are not lost
+	updated := secret.DeepCopy()
+	if rotation.Spec.CloudName == "" {
+		setSecretApplicationCredential(updated, rotated)
+	} else {
+		updated.Data[scope.CloudsSecretKey], err = setCloudApplicationCredential(secret.Data[scope.CloudsSecretKey], rotation.Spec.CloudName, rotated.ID, rotated.Secret)
+	}
+	if err == nil {
+		err = r.Client.Update(ctx, updated)
+	}
+	if err != nil {
+		if deleteErr := identityService.DeleteApplicationCredential(rotated.ID); deleteErr != nil {
+			ctrl.LoggerFrom(ctx).Error(deleteErr, "Failed to delete unused application credential", "id", rotated.ID)
+		}
+		return nil, fmt.Errorf("update secret %s: %w", secret.Name, err)
+	}
+
+	// Scopes using the previous credential must not be used once it has been revoked
+	r.ScopeFactory.InvalidateSecret(secret)
+	return rotated, nil
+}
+
+// reconcileRevocations deletes the application credentials created for the rotation which are no longer in the
+// Secret once their grace period has passed.
+func (r *OpenStackCredentialRotationReconciler) reconcileRevocations(identityService *identity.Service, rotation *infrav1alpha1.OpenStackCredentialRotation, owned []applicationcredentials.ApplicationCredential, currentID string, now time.Time, gracePeriod time.Duration) error {
+	exists := make(map[string]bool, len(owned))
+	for _, credential := range owned {
+		exists[credential.ID] = true
+	}
+
+	pending := make([]infrav1alpha1.PendingApplicationCredentialRevocation, 0, len(rotation.Status.PendingRevocations))
+	for _, revocation := range rotation.Status.PendingRevocations {
+		// The credential was deleted by someone else, or has been put back in the Secret
+		if !exists[revocation.ID] || revocation.ID == currentID {
+			continue
+		}
+		if now.Before(revocation.RevokeAfter.Time) {
+			pending = append(pending, revocation)
+			continue
+		}
+		if err := identityService.DeleteApplicationCredential(revocation.ID); err != nil && !capoerrors.IsNotFound(err) {
+			rotation.Status.PendingRevocations = pending
+			return fmt.Errorf("delete application credential %s: %w", revocation.ID, err)
+		}
+		record.Eventf(rotation, "SuccessfulRevokeApplicationCredential", "Deleted replaced application credential %s", revocation.ID)
+		delete(exists, revocation.ID)
+	}
+	rotation.Status.PendingRevocations = pending
+
+	for _, credential := range owned {
+		if exists[credential.ID] && credential.ID != currentID {
+			addPendingRevocation(rotation, credential.ID, now.Add(gracePeriod))
+		}
+	}
+	return nil
+}
+
+// addPendingRevocation schedules the deletion of an application credential, unless it is already scheduled.
+func addPendingRevocation(rotation *infrav1alpha1.OpenStackCredentialRotation, id string, revokeAfter time.Time) {
+	for _, revocation := range rotation.Status.PendingRevocations {
+		if revocation.ID == id {
+			return
+		}
+	}
+	rotation.Status.PendingRevocations = append(rotation.Status.PendingRevocations, infrav1alpha1.PendingApplicationCredentialRevocation{
+		ID:          id,
+		RevokeAfter: metav1.Time{Time: revokeAfter},
+	})
+}
+
+func setCredentialRotationInvalid(rotation *infrav1alpha1.OpenStackCredentialRotation, message string) {
+	conditions.Set(rotation, metav1.Condition{
+		Type:    infrav1alpha1.OpenStackCredentialRotationReadyCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  infrav1alpha1.InvalidCredentialsSecretReason,
+		Message: message,
+	})
+}
+
+func getCredentialRotationDescription(rotation *infrav1alpha1.OpenStackCredentialRotation) string {
+	return fmt.Sprintf("Created by cluster-api-provider-openstack for OpenStackCredentialRotation %s/%s", rotation.Namespace, rotation.Name)
+}
+
+func getCredentialRotationPeriods(rotation *infrav1alpha1.OpenStackCredentialRotation) (time.Duration, time.Duration) {
+	interval := defaultCredentialRotationInterval
+	if rotation.Spec.RotationInterval != nil {
+		interval = rotation.Spec.RotationInterval.Duration
+	}
+	gracePeriod := defaultCredentialRotationGracePeriod
+	if rotation.Spec.GracePeriod != nil {
+		gracePeriod = rotation.Spec.GracePeriod.Duration
+	}
+	return interval, gracePeriod
+}
+
+// getApplicationCredentialIDFromSecret returns the ID of the application credential used by cloudName in the
+// clouds.yaml of secret, which is empty if it does not use an application credential. If cloudName is empty, secret
+// is in the format of an identityRef of type ApplicationCredential.
+func getApplicationCredentialIDFromSecret(secret *corev1.Secret, cloudName string) (string, error) {
+	if cloudName == "" {
+		if len(secret.Data[scope.AuthURLSecretKey]) == 0 {
+			return "", fmt.Errorf("secret %s does not contain key %s", secret.Name, scope.AuthURLSecretKey)
+		}
+		return string(secret.Data[scope.ApplicationCredentialIDSecretKey]), nil
+	}
+
+	content, ok := secret.Data[scope.CloudsSecretKey]
+	if !ok {
+		return "", fmt.Errorf("secret %s does not contain key %s", secret.Name, scope.CloudsSecretKey)
+	}
+	var clouds clientconfig.Clouds
+	if err := yaml.Unmarshal(content, &clouds); err != nil {
+		return "", fmt.Errorf("failed to unmarshal clouds.yaml in secret %s: %w", secret.Name, err)
+	}
+	cloud, ok := clouds.Clouds[cloudName]
+	if !ok {
+		return "", fmt.Errorf("clouds.yaml in secret %s does not contain cloud %s", secret.Name, cloudName)
+	}
+	if cloud.AuthInfo == nil || cloud.AuthInfo.AuthURL == "" {
+		return "", fmt.Errorf("cloud %s in secret %s does not have an auth_url", cloudName, secret.Name)
+	}
+	if cloud.AuthType != clientconfig.AuthV3ApplicationCredential {
+		return "", nil
+	}
+	return cloud.AuthInfo.ApplicationCredentialID, nil
+}
+
+// setSecretApplicationCredential replaces the application credential in secret, which is in the format of an
+// identityRef of type ApplicationCredential. A name is only kept up to date if the Secret already has one.
+func setSecretApplicationCredential(secret *corev1.Secret, credential *applicationcredentials.ApplicationCredential) {
+	secret.Data[scope.ApplicationCredentialIDSecretKey] = []byte(credential.ID)
+	if _, ok := secret.Data[scope.ApplicationCredentialNameSecretKey]; ok {
+		secret.Data[scope.ApplicationCredentialNameSecretKey] = []byte(credential.Name)
+	}
+	secret.Data[scope.ApplicationCredentialSecretSecretKey] = []byte(credential.Secret)
+}
+
+// setCloudApplicationCredential replaces the credentials of cloudName in clouds.yaml content with an application
+// credential. Its other settings, and the other clouds, are kept.
+func setCloudApplicationCredential(content []byte, cloudName, id, secret string) ([]byte, error) {
+	var clouds map[string]any
+	if err := yaml.Unmarshal(content, &clouds); err != nil {
+		return nil, err
+	}
+	cloudsMap, _ := clouds["clouds"].(map[string]any)
+	cloud, ok := cloudsMap[cloudName].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("clouds.yaml does not contain cloud %s", cloudName)
+	}
+	auth, _ := cloud["auth"].(map[string]any)
+	cloud["auth"] = map[string]any{
+		"auth_url":                      auth["auth_url"],
+		"application_credential_id":     id,
+		"application_credential_secret": secret,
+	}
+	cloud["auth_type"] = string(clientconfig.AuthV3ApplicationCredential)
+	return yaml.Marshal(clouds)
+}
+
+func (r *OpenStackCredentialRotationReconciler) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	log := ctrl.LoggerFrom(ctx)
+
+	// The Secret is read again when the rotation is next due, or at credentialRotationSecretCheckInterval if it is
+	// missing or invalid, rather than being watched
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&infrav1alpha1.OpenStackCredentialRotation{},
+			builder.WithPredicates(predicates.ResourceNotPausedAndHasFilterLabel(mgr.GetScheme(), log, r.WatchFilterValue)),
+		).
+		Complete(r)
+}
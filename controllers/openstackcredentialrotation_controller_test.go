@@ -0,0 +1,543 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/applicationcredentials"
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	conditions "sigs.k8s.io/cluster-api/util/conditions"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+const (
+	rotationUserID     = "7a9bd1b4-5b12-4bd1-8b53-6b0b0e1a0a6d"
+	rotationSecretName = "cloud-config"
+	currentCredential  = "d2a0bfac-5b4b-4c7b-9d2f-7d2c8e0d9f61"
+	rotatedCredential  = "9c1e5f3a-2b7d-4e8f-a6c4-0d3b5e7f9a1c"
+	replacedCredential = "3f6e2a1b-8c4d-4e5f-9a0b-1c2d3e4f5a6b"
+)
+
+const passwordCloudsYAML = `clouds:
+  openstack:
+    auth:
+      auth_url: https://keystone.example.com/
+      username: admin
+      password: secret
+      project_name: admin
+      user_domain_name: Default
+      project_domain_name: Default
+    region_name: RegionOne
+    interface: public
+`
+
+const applicationCredentialCloudsYAML = `clouds:
+  openstack:
+    auth:
+      auth_url: https://keystone.example.com/
+      application_credential_id: ` + currentCredential + `
+      application_credential_secret: secret
+    auth_type: v3applicationcredential
+    region_name: RegionOne
+`
+
+func TestOpenStackCredentialRotationReconciler_Reconcile(t *testing.T) {
+	now := time.Now()
+	scheme := runtime.NewScheme()
+	NewWithT(t).Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	NewWithT(t).Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	newRotation := func() *infrav1alpha1.OpenStackCredentialRotation {
+		return &infrav1alpha1.OpenStackCredentialRotation{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:  "capo-system",
+				Name:       "production",
+				Finalizers: []string{infrav1alpha1.OpenStackCredentialRotationFinalizer},
+			},
+			Spec: infrav1alpha1.OpenStackCredentialRotationSpec{
+				SecretName: rotationSecretName,
+				CloudName:  "openstack",
+				BootstrapIdentityRef: infrav1.OpenStackIdentityReference{
+					Type:      infrav1.IdentityTypeSecret,
+					Name:      "bootstrap",
+					CloudName: "openstack",
+				},
+				Roles: []string{"member"},
+			},
+		}
+	}
+	owned := func(rotation *infrav1alpha1.OpenStackCredentialRotation, id string, expiresAt time.Time) applicationcredentials.ApplicationCredential {
+		return applicationcredentials.ApplicationCredential{
+			ID:          id,
+			Description: getCredentialRotationDescription(rotation),
+			ExpiresAt:   expiresAt,
+		}
+	}
+	expectRotate := func(m *scope.MockScopeFactory) {
+		m.IdentityClient.EXPECT().CreateApplicationCredential(rotationUserID, gomock.Any()).DoAndReturn(
+			func(_ string, opts applicationcredentials.CreateOptsBuilder) (*applicationcredentials.ApplicationCredential, error) {
+				createOpts := opts.(applicationcredentials.CreateOpts)
+				if createOpts.Description != "Created by cluster-api-provider-openstack for OpenStackCredentialRotation capo-system/production" {
+					return nil, fmt.Errorf("unexpected description %q", createOpts.Description)
+				}
+				if len(createOpts.Roles) != 1 || createOpts.Roles[0].Name != "member" {
+					return nil, fmt.Errorf("unexpected roles %v", createOpts.Roles)
+				}
+				return &applicationcredentials.ApplicationCredential{ID: rotatedCredential, Secret: "rotated-secret", ExpiresAt: *createOpts.ExpiresAt}, nil
+			})
+	}
+
+	tests := []struct {
+		name               string
+		cloudsYAML         string
+		modify             func(*infrav1alpha1.OpenStackCredentialRotation)
+		expect             func(r *infrav1alpha1.OpenStackCredentialRotation, m *scope.MockScopeFactory)
+		wantReady          metav1.ConditionStatus
+		wantReason         string
+		wantCredential     string
+		wantPending        []string
+		wantInvalidated    bool
+		wantRequeueAtLeast time.Duration
+	}{
+		{
+			name:       "secret does not use an application credential",
+			cloudsYAML: passwordCloudsYAML,
+			expect: func(_ *infrav1alpha1.OpenStackCredentialRotation, m *scope.MockScopeFactory) {
+				m.IdentityClient.EXPECT().ListApplicationCredentials(rotationUserID, gomock.Any()).Return(nil, nil)
+				expectRotate(m)
+			},
+			wantReady:          metav1.ConditionTrue,
+			wantReason:         infrav1.ReadyConditionReason,
+			wantCredential:     rotatedCredential,
+			wantInvalidated:    true,
+			wantRequeueAtLeast: 29 * 24 * time.Hour,
+		},
+		{
+			name:       "application credential is not due",
+			cloudsYAML: applicationCredentialCloudsYAML,
+			expect: func(r *infrav1alpha1.OpenStackCredentialRotation, m *scope.MockScopeFactory) {
+				m.IdentityClient.EXPECT().ListApplicationCredentials(rotationUserID, gomock.Any()).Return(
+					[]applicationcredentials.ApplicationCredential{owned(r, currentCredential, now.Add(10*24*time.Hour))}, nil)
+			},
+			wantReady:          metav1.ConditionTrue,
+			wantReason:         infrav1.ReadyConditionReason,
+			wantCredential:     currentCredential,
+			wantRequeueAtLeast: 8 * 24 * time.Hour,
+		},
+		{
+			name:       "application credential is due",
+			cloudsYAML: applicationCredentialCloudsYAML,
+			expect: func(r *infrav1alpha1.OpenStackCredentialRotation, m *scope.MockScopeFactory) {
+				m.IdentityClient.EXPECT().ListApplicationCredentials(rotationUserID, gomock.Any()).Return(
+					[]applicationcredentials.ApplicationCredential{owned(r, currentCredential, now.Add(time.Hour))}, nil)
+				expectRotate(m)
+			},
+			wantReady:          metav1.ConditionTrue,
+			wantReason:         infrav1.ReadyConditionReason,
+			wantCredential:     rotatedCredential,
+			wantPending:        []string{currentCredential},
+			wantInvalidated:    true,
+			wantRequeueAtLeast: 23 * time.Hour,
+		},
+		{
+			name:       "rotation interval was shortened",
+			cloudsYAML: applicationCredentialCloudsYAML,
+			modify: func(r *infrav1alpha1.OpenStackCredentialRotation) {
+				r.Spec.RotationInterval = &metav1.Duration{Duration: 7 * 24 * time.Hour}
+				r.Status.ApplicationCredentialID = currentCredential
+				r.Status.LastRotationTime = &metav1.Time{Time: now.Add(-8 * 24 * time.Hour)}
+			},
+			expect: func(r *infrav1alpha1.OpenStackCredentialRotation, m *scope.MockScopeFactory) {
+				m.IdentityClient.EXPECT().ListApplicationCredentials(rotationUserID, gomock.Any()).Return(
+					[]applicationcredentials.ApplicationCredential{owned(r, currentCredential, now.Add(20*24*time.Hour))}, nil)
+				expectRotate(m)
+			},
+			wantReady:       metav1.ConditionTrue,
+			wantReason:      infrav1.ReadyConditionReason,
+			wantCredential:  rotatedCredential,
+			wantPending:     []string{currentCredential},
+			wantInvalidated: true,
+		},
+		{
+			name:       "replaced application credential is revoked after its grace period",
+			cloudsYAML: applicationCredentialCloudsYAML,
+			modify: func(r *infrav1alpha1.OpenStackCredentialRotation) {
+				r.Status.PendingRevocations = []infrav1alpha1.PendingApplicationCredentialRevocation{
+					{ID: replacedCredential, RevokeAfter: metav1.Time{Time: now.Add(-time.Minute)}},
+				}
+			},
+			expect: func(r *infrav1alpha1.OpenStackCredentialRotation, m *scope.MockScopeFactory) {
+				m.IdentityClient.EXPECT().ListApplicationCredentials(rotationUserID, gomock.Any()).Return(
+					[]applicationcredentials.ApplicationCredential{
+						owned(r, currentCredential, now.Add(10*24*time.Hour)),
+						owned(r, replacedCredential, now.Add(time.Hour)),
+					}, nil)
+				m.IdentityClient.EXPECT().DeleteApplicationCredential(rotationUserID, replacedCredential).Return(nil)
+			},
+			wantReady:      metav1.ConditionTrue,
+			wantReason:     infrav1.ReadyConditionReason,
+			wantCredential: currentCredential,
+		},
+		{
+			name:       "unused application credential is scheduled for revocation",
+			cloudsYAML: applicationCredentialCloudsYAML,
+			expect: func(r *infrav1alpha1.OpenStackCredentialRotation, m *scope.MockScopeFactory) {
+				m.IdentityClient.EXPECT().ListApplicationCredentials(rotationUserID, gomock.Any()).Return(
+					[]applicationcredentials.ApplicationCredential{
+						owned(r, currentCredential, now.Add(10*24*time.Hour)),
+						owned(r, replacedCredential, now.Add(time.Hour)),
+						{ID: "not-owned", Description: "created by someone else"},
+					}, nil)
+			},
+			wantReady:          metav1.ConditionTrue,
+			wantReason:         infrav1.ReadyConditionReason,
+			wantCredential:     currentCredential,
+			wantPending:        []string{replacedCredential},
+			wantRequeueAtLeast: 23 * time.Hour,
+		},
+		{
+			name:       "application credential can not be created",
+			cloudsYAML: passwordCloudsYAML,
+			expect: func(_ *infrav1alpha1.OpenStackCredentialRotation, m *scope.MockScopeFactory) {
+				m.IdentityClient.EXPECT().ListApplicationCredentials(rotationUserID, gomock.Any()).Return(nil, nil)
+				m.IdentityClient.EXPECT().CreateApplicationCredential(rotationUserID, gomock.Any()).Return(nil, gophercloud.ErrUnexpectedResponseCode{Actual: 403})
+			},
+			wantReady:  metav1.ConditionFalse,
+			wantReason: infrav1.CredentialsRotationFailedReason,
+		},
+		{
+			name:               "secret does not contain the cloud",
+			cloudsYAML:         "clouds: {}\n",
+			expect:             func(*infrav1alpha1.OpenStackCredentialRotation, *scope.MockScopeFactory) {},
+			wantReady:          metav1.ConditionFalse,
+			wantReason:         infrav1alpha1.InvalidCredentialsSecretReason,
+			wantRequeueAtLeast: credentialRotationSecretCheckInterval,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+
+			rotation := newRotation()
+			if tt.modify != nil {
+				tt.modify(rotation)
+			}
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Namespace: rotation.Namespace, Name: rotationSecretName},
+				Data: map[string][]byte{
+					scope.CloudsSecretKey: []byte(tt.cloudsYAML),
+					scope.CASecretKey:     []byte("ca"),
+				},
+			}
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(rotation, secret).
+				WithStatusSubresource(&infrav1alpha1.OpenStackCredentialRotation{}).
+				Build()
+
+			mockScopeFactory := scope.NewMockScopeFactory(gomock.NewController(t), "")
+			mockScopeFactory.SetUserID(rotationUserID)
+			tt.expect(rotation, mockScopeFactory)
+
+			r := &OpenStackCredentialRotationReconciler{
+				Client:       k8sClient,
+				ScopeFactory: mockScopeFactory,
+			}
+			result, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(rotation)})
+			if tt.wantReady == metav1.ConditionFalse && tt.wantReason == infrav1.CredentialsRotationFailedReason {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(result.RequeueAfter).To(BeNumerically(">=", tt.wantRequeueAtLeast))
+
+			g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(rotation), rotation)).To(Succeed())
+			condition := conditions.Get(rotation, infrav1alpha1.OpenStackCredentialRotationReadyCondition)
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.wantReady))
+			g.Expect(condition.Reason).To(Equal(tt.wantReason))
+
+			if tt.wantInvalidated {
+				g.Expect(mockScopeFactory.InvalidatedSecrets).To(ConsistOf(types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name}))
+			} else {
+				g.Expect(mockScopeFactory.InvalidatedSecrets).To(BeEmpty())
+			}
+			pending := []string{}
+			for _, revocation := range rotation.Status.PendingRevocations {
+				pending = append(pending, revocation.ID)
+			}
+			g.Expect(pending).To(ConsistOf(tt.wantPending))
+			if tt.wantCredential == "" {
+				return
+			}
+			g.Expect(rotation.Status.ApplicationCredentialID).To(Equal(tt.wantCredential))
+
+			g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+			g.Expect(secret.Data[scope.CASecretKey]).To(Equal([]byte("ca")))
+			var clouds clientconfig.Clouds
+			g.Expect(yaml.Unmarshal(secret.Data[scope.CloudsSecretKey], &clouds)).To(Succeed())
+			cloud := clouds.Clouds["openstack"]
+			g.Expect(cloud.RegionName).To(Equal("RegionOne"))
+			g.Expect(cloud.AuthInfo.AuthURL).To(Equal("https://keystone.example.com/"))
+			g.Expect(cloud.AuthInfo.ApplicationCredentialID).To(Equal(tt.wantCredential))
+			if tt.wantCredential == rotatedCredential {
+				g.Expect(cloud.AuthType).To(Equal(clientconfig.AuthV3ApplicationCredential))
+				g.Expect(cloud.AuthInfo.ApplicationCredentialSecret).To(Equal("rotated-secret"))
+				g.Expect(cloud.AuthInfo.Username).To(BeEmpty())
+				g.Expect(cloud.AuthInfo.Password).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestOpenStackCredentialRotationReconciler_ReconcileApplicationCredentialSecret(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	now := time.Now()
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	rotation := &infrav1alpha1.OpenStackCredentialRotation{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  "capo-system",
+			Name:       "production",
+			Finalizers: []string{infrav1alpha1.OpenStackCredentialRotationFinalizer},
+		},
+		Spec: infrav1alpha1.OpenStackCredentialRotationSpec{
+			SecretName: rotationSecretName,
+			BootstrapIdentityRef: infrav1.OpenStackIdentityReference{
+				Type: infrav1.IdentityTypeApplicationCredential,
+				Name: rotationSecretName,
+			},
+			Unrestricted: true,
+		},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: rotation.Namespace, Name: rotationSecretName},
+		Data: map[string][]byte{
+			scope.AuthURLSecretKey:                     []byte("https://keystone.example.com/"),
+			scope.ApplicationCredentialIDSecretKey:     []byte(currentCredential),
+			scope.ApplicationCredentialNameSecretKey:   []byte("capo"),
+			scope.ApplicationCredentialSecretSecretKey: []byte("secret"),
+		},
+	}
+	k8sClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(rotation, secret).
+		WithStatusSubresource(&infrav1alpha1.OpenStackCredentialRotation{}).
+		Build()
+
+	mockScopeFactory := scope.NewMockScopeFactory(gomock.NewController(t), "")
+	mockScopeFactory.SetUserID(rotationUserID)
+	mockScopeFactory.IdentityClient.EXPECT().ListApplicationCredentials(rotationUserID, gomock.Any()).Return(
+		[]applicationcredentials.ApplicationCredential{{
+			ID:          currentCredential,
+			Description: getCredentialRotationDescription(rotation),
+			ExpiresAt:   now.Add(time.Hour),
+		}}, nil)
+	mockScopeFactory.IdentityClient.EXPECT().CreateApplicationCredential(rotationUserID, gomock.Any()).DoAndReturn(
+		func(_ string, opts applicationcredentials.CreateOptsBuilder) (*applicationcredentials.ApplicationCredential, error) {
+			createOpts := opts.(applicationcredentials.CreateOpts)
+			return &applicationcredentials.ApplicationCredential{ID: rotatedCredential, Name: createOpts.Name, Secret: "rotated-secret", ExpiresAt: *createOpts.ExpiresAt}, nil
+		})
+
+	r := &OpenStackCredentialRotationReconciler{
+		Client:       k8sClient,
+		ScopeFactory: mockScopeFactory,
+	}
+	_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(rotation)})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(rotation), rotation)).To(Succeed())
+	g.Expect(rotation.Status.ApplicationCredentialID).To(Equal(rotatedCredential))
+	g.Expect(rotation.Status.PendingRevocations).To(HaveLen(1))
+	g.Expect(rotation.Status.PendingRevocations[0].ID).To(Equal(currentCredential))
+
+	g.Expect(k8sClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+	g.Expect(string(secret.Data[scope.AuthURLSecretKey])).To(Equal("https://keystone.example.com/"))
+	g.Expect(string(secret.Data[scope.ApplicationCredentialIDSecretKey])).To(Equal(rotatedCredential))
+	g.Expect(string(secret.Data[scope.ApplicationCredentialNameSecretKey])).To(HavePrefix("capo-system-production-"))
+	g.Expect(string(secret.Data[scope.ApplicationCredentialSecretSecretKey])).To(Equal("rotated-secret"))
+}
+
+func TestOpenStackCredentialRotationReconciler_ReconcileFinalizer(t *testing.T) {
+	now := time.Now()
+	scheme := runtime.NewScheme()
+	NewWithT(t).Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	NewWithT(t).Expect(infrav1alpha1.AddToScheme(scheme)).To(Succeed())
+
+	newRotation := func() *infrav1alpha1.OpenStackCredentialRotation {
+		return &infrav1alpha1.OpenStackCredentialRotation{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "capo-system", Name: "production"},
+			Spec: infrav1alpha1.OpenStackCredentialRotationSpec{
+				SecretName: rotationSecretName,
+				CloudName:  "openstack",
+				BootstrapIdentityRef: infrav1.OpenStackIdentityReference{
+					Type:      infrav1.IdentityTypeSecret,
+					Name:      "bootstrap",
+					CloudName: "openstack",
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		deleted       bool
+		withSecret    bool
+		expect        func(r *infrav1alpha1.OpenStackCredentialRotation, m *scope.MockScopeFactory)
+		wantFinalizer bool
+	}{
+		{
+			name:          "finalizer is added before rotating",
+			expect:        func(*infrav1alpha1.OpenStackCredentialRotation, *scope.MockScopeFactory) {},
+			wantFinalizer: true,
+		},
+		{
+			name:       "application credentials are deleted except the one in the secret",
+			deleted:    true,
+			withSecret: true,
+			expect: func(r *infrav1alpha1.OpenStackCredentialRotation, m *scope.MockScopeFactory) {
+				description := getCredentialRotationDescription(r)
+				m.IdentityClient.EXPECT().ListApplicationCredentials(rotationUserID, gomock.Any()).Return(
+					[]applicationcredentials.ApplicationCredential{
+						{ID: currentCredential, Description: description, ExpiresAt: now.Add(10 * 24 * time.Hour)},
+						{ID: replacedCredential, Description: description, ExpiresAt: now.Add(time.Hour)},
+						{ID: "not-owned", Description: "created by someone else"},
+					}, nil)
+				m.IdentityClient.EXPECT().DeleteApplicationCredential(rotationUserID, replacedCredential).Return(nil)
+			},
+		},
+		{
+			name:    "all application credentials are deleted when the secret does not exist",
+			deleted: true,
+			expect: func(r *infrav1alpha1.OpenStackCredentialRotation, m *scope.MockScopeFactory) {
+				description := getCredentialRotationDescription(r)
+				m.IdentityClient.EXPECT().ListApplicationCredentials(rotationUserID, gomock.Any()).Return(
+					[]applicationcredentials.ApplicationCredential{
+						{ID: currentCredential, Description: description, ExpiresAt: now.Add(10 * 24 * time.Hour)},
+						{ID: replacedCredential, Description: description, ExpiresAt: now.Add(time.Hour)},
+					}, nil)
+				m.IdentityClient.EXPECT().DeleteApplicationCredential(rotationUserID, currentCredential).Return(nil)
+				m.IdentityClient.EXPECT().DeleteApplicationCredential(rotationUserID, replacedCredential).Return(gophercloud.ErrUnexpectedResponseCode{Actual: 404})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			ctx := context.Background()
+
+			rotation := newRotation()
+			if tt.deleted {
+				rotation.Finalizers = []string{infrav1alpha1.OpenStackCredentialRotationFinalizer}
+				rotation.DeletionTimestamp = &metav1.Time{Time: now}
+			}
+			objects := []client.Object{rotation}
+			if tt.withSecret {
+				objects = append(objects, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: rotation.Namespace, Name: rotationSecretName},
+					Data:       map[string][]byte{scope.CloudsSecretKey: []byte(applicationCredentialCloudsYAML)},
+				})
+			}
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(objects...).
+				WithStatusSubresource(&infrav1alpha1.OpenStackCredentialRotation{}).
+				Build()
+
+			mockScopeFactory := scope.NewMockScopeFactory(gomock.NewController(t), "")
+			mockScopeFactory.SetUserID(rotationUserID)
+			tt.expect(rotation, mockScopeFactory)
+
+			r := &OpenStackCredentialRotationReconciler{
+				Client:       k8sClient,
+				ScopeFactory: mockScopeFactory,
+			}
+			_, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(rotation)})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			err = k8sClient.Get(ctx, client.ObjectKeyFromObject(rotation), rotation)
+			if tt.wantFinalizer {
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(rotation.Finalizers).To(ConsistOf(infrav1alpha1.OpenStackCredentialRotationFinalizer))
+			} else {
+				// The fake client deletes the object once its last finalizer is removed
+				g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+			}
+		})
+	}
+}
+
+func Test_setCloudApplicationCredential(t *testing.T) {
+	g := NewWithT(t)
+
+	content := []byte(`clouds:
+  bootstrap:
+    auth:
+      auth_url: https://keystone.example.com/
+      username: admin
+      password: secret
+  openstack:
+    auth:
+      auth_url: https://keystone.example.com/
+      username: admin
+      password: secret
+    region_name: RegionOne
+    verify: false
+`)
+	updated, err := setCloudApplicationCredential(content, "openstack", "id", "secret")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	var clouds clientconfig.Clouds
+	g.Expect(yaml.Unmarshal(updated, &clouds)).To(Succeed())
+	g.Expect(clouds.Clouds["bootstrap"].AuthInfo.Username).To(Equal("admin"))
+	cloud := clouds.Clouds["openstack"]
+	g.Expect(cloud.AuthType).To(Equal(clientconfig.AuthV3ApplicationCredential))
+	g.Expect(*cloud.AuthInfo).To(Equal(clientconfig.AuthInfo{
+		AuthURL:                     "https://keystone.example.com/",
+		ApplicationCredentialID:     "id",
+		ApplicationCredentialSecret: "secret",
+	}))
+	g.Expect(cloud.RegionName).To(Equal("RegionOne"))
+	g.Expect(cloud.Verify).To(Equal(ptr.To(false)))
+
+	_, err = setCloudApplicationCredential(content, "missing", "id", "secret")
+	g.Expect(err).To(HaveOccurred())
+}
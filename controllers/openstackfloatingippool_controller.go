@@ -41,6 +41,8 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/networking"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/controllers"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
 )
 
 const (
@@ -72,8 +74,11 @@ type OpenStackFloatingIPPoolReconciler struct {
 // +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddressclaims;ipaddressclaims/status,verbs=get;list;watch;update;create;delete
 // +kubebuilder:rbac:groups=ipam.cluster.x-k8s.io,resources=ipaddresses;ipaddresses/status,verbs=get;list;watch;create;update;delete
 
-func (r *OpenStackFloatingIPPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+func (r *OpenStackFloatingIPPoolReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, reterr error) {
 	log := ctrl.LoggerFrom(ctx)
+	defer func() {
+		result, reterr = controllers.HandleRequeueAfterError(log, result, reterr)
+	}()
 	pool := &infrav1alpha1.OpenStackFloatingIPPool{}
 	if err := r.Client.Get(ctx, req.NamespacedName, pool); err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
@@ -377,6 +382,16 @@ func (r *OpenStackFloatingIPPoolReconciler) getIP(ctx context.Context, scope *sc
 		return "", errMaxIPsReached
 	}
 
+	if err := networkingService.CheckQuota(0, 1); err != nil {
+		conditions.Set(pool, metav1.Condition{
+			Type:    infrav1alpha1.OpenstackFloatingIPPoolReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.QuotaExceededReason,
+			Message: fmt.Sprintf("Failed to create floating IP: %v", err),
+		})
+		return "", &capoerrors.RequeueAfterError{Err: err, RequeueAfter: capoerrors.QuotaExceededRequeueAfter}
+	}
+
 	fp, err := networkingService.CreateFloatingIPForPool(pool)
 	if err != nil {
 		scope.Logger().Error(err, "Failed to create floating IP", "pool", pool.Name)
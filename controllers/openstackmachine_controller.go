@@ -69,6 +69,7 @@ const (
 	waitForClusterInfrastructureReadyDuration = 15 * time.Second
 	waitForInstanceBecomeActiveToReconcile    = 60 * time.Second
 	waitForBuildingInstanceToReconcile        = 10 * time.Second
+	memberDrainCheckInterval                  = 10 * time.Second
 	waitingForInstanceCreatedMessage          = "Waiting for instance to be created"
 )
 
@@ -84,6 +85,9 @@ const (
 
 func (r *OpenStackMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, reterr error) {
 	log := ctrl.LoggerFrom(ctx)
+	defer func() {
+		result, reterr = controllers.HandleRequeueAfterError(log, result, reterr)
+	}()
 
 	// Fetch the OpenStackMachine instance.
 	openStackMachine := &infrav1.OpenStackMachine{}
@@ -186,6 +190,8 @@ func patchMachine(ctx context.Context, patchHelper *patch.Helper, openStackMachi
 			clusterv1.ReadyCondition,
 			infrav1.InstanceReadyCondition,
 			infrav1.APIServerIngressReadyCondition,
+			infrav1.AdditionalLoadBalancerMembersReadyCondition,
+			infrav1.APIServerLoadBalancerMemberDrainedCondition,
 		}},
 	)
 	return patchHelper.Patch(ctx, openStackMachine, options...)
@@ -225,7 +231,7 @@ func (r *OpenStackMachineReconciler) SetupWithManager(ctx context.Context, mgr c
 		Complete(r)
 }
 
-func (r *OpenStackMachineReconciler) reconcileDelete(ctx context.Context, scope *scope.WithLogger, clusterResourceName string, openStackCluster *infrav1.OpenStackCluster, machine *clusterv1.Machine, openStackMachine *infrav1.OpenStackMachine) (ctrl.Result, error) { //nolint:unparam
+func (r *OpenStackMachineReconciler) reconcileDelete(ctx context.Context, scope *scope.WithLogger, clusterResourceName string, openStackCluster *infrav1.OpenStackCluster, machine *clusterv1.Machine, openStackMachine *infrav1.OpenStackMachine) (ctrl.Result, error) {
 	scope.Logger().Info("Reconciling Machine delete")
 
 	computeService, err := compute.NewService(scope)
@@ -255,11 +261,20 @@ func (r *OpenStackMachineReconciler) reconcileDelete(ctx context.Context, scope
 	}
 
 	if util.IsControlPlaneMachine(machine) {
+		requeueAfter, err := drainAPIServerLoadBalancerMember(scope, openStackCluster, openStackMachine, clusterResourceName)
+		if err != nil || requeueAfter > 0 {
+			return ctrl.Result{RequeueAfter: requeueAfter}, err
+		}
+
 		if err := removeAPIServerEndpoint(scope, openStackCluster, openStackMachine, instanceStatus, clusterResourceName); err != nil {
 			return ctrl.Result{}, err
 		}
 	}
 
+	if err := removeAdditionalLoadBalancerMembers(scope, openStackCluster, openStackMachine, clusterResourceName); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	if machineServer != nil {
 		scope.Logger().Info("Deleting server", "name", machineServer.Name)
 		if err := r.Client.Delete(ctx, machineServer); err != nil {
@@ -283,6 +298,97 @@ func (r *OpenStackMachineReconciler) reconcileDelete(ctx context.Context, scope
 	return ctrl.Result{}, nil
 }
 
+func removeAdditionalLoadBalancerMembers(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, clusterResourceName string) error {
+	if len(openStackCluster.Spec.AdditionalLoadBalancers) == 0 {
+		return nil
+	}
+
+	loadBalancerService, err := loadbalancer.NewService(scope)
+	if err != nil {
+		return err
+	}
+
+	if err := loadBalancerService.DeleteAdditionalLoadBalancerMembers(openStackCluster, openStackMachine, clusterResourceName); err != nil {
+		conditions.Set(openStackMachine, metav1.Condition{
+			Type:    infrav1.AdditionalLoadBalancerMembersReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.LoadBalancerMemberErrorReason,
+			Message: fmt.Sprintf("Machine could not be removed from additional load balancers: %v", err),
+		})
+		return err
+	}
+	return nil
+}
+
+// drainAPIServerLoadBalancerMember drains the API server load balancer
+// members of a control plane machine if member draining is configured. It
+// returns the time after which the drain should be checked again, or zero
+// once the members can be deleted. The drain starts at the last transition
+// time of the APIServerLoadBalancerMemberDrained condition and lasts for the
+// drain period: Octavia does not report the connections of a single member.
+func drainAPIServerLoadBalancerMember(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, clusterResourceName string) (time.Duration, error) {
+	lbSpec := openStackCluster.Spec.APIServer.GetManagedLoadBalancer()
+	if !lbSpec.IsEnabled() || lbSpec.MemberDrain == nil {
+		return 0, nil
+	}
+	if conditions.IsTrue(openStackMachine, infrav1.APIServerLoadBalancerMemberDrainedCondition) {
+		return 0, nil
+	}
+
+	loadBalancerService, err := loadbalancer.NewService(scope)
+	if err != nil {
+		return 0, err
+	}
+
+	drainStatus, err := loadBalancerService.DrainLoadBalancerMember(openStackCluster, openStackMachine, clusterResourceName)
+	if err != nil {
+		conditions.Set(openStackMachine, metav1.Condition{
+			Type:    infrav1.APIServerLoadBalancerMemberDrainedCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.LoadBalancerMemberErrorReason,
+			Message: fmt.Sprintf("Draining load balancer members failed: %v", err),
+		})
+		return 0, fmt.Errorf("drain load balancer members: %w", err)
+	}
+
+	if drainStatus.Members == 0 {
+		conditions.Set(openStackMachine, metav1.Condition{
+			Type:   infrav1.APIServerLoadBalancerMemberDrainedCondition,
+			Status: metav1.ConditionTrue,
+			Reason: infrav1.LoadBalancerMemberDrainedReason,
+		})
+		return 0, nil
+	}
+
+	drainStarted := time.Now()
+	if condition := conditions.Get(openStackMachine, infrav1.APIServerLoadBalancerMemberDrainedCondition); condition != nil && condition.Reason == infrav1.LoadBalancerMemberDrainingReason {
+		drainStarted = condition.LastTransitionTime.Time
+	} else {
+		// The condition keeps its last transition time if its status
+		// does not change, so delete it to record the start of the drain
+		conditions.Delete(openStackMachine, infrav1.APIServerLoadBalancerMemberDrainedCondition)
+	}
+	remaining := time.Until(drainStarted.Add(time.Duration(lbSpec.MemberDrain.PeriodSeconds) * time.Second))
+
+	if remaining <= 0 {
+		conditions.Set(openStackMachine, metav1.Condition{
+			Type:    infrav1.APIServerLoadBalancerMemberDrainedCondition,
+			Status:  metav1.ConditionTrue,
+			Reason:  infrav1.LoadBalancerMemberDrainPeriodElapsedReason,
+			Message: "Drain period elapsed",
+		})
+		return 0, nil
+	}
+
+	conditions.Set(openStackMachine, metav1.Condition{
+		Type:    infrav1.APIServerLoadBalancerMemberDrainedCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  infrav1.LoadBalancerMemberDrainingReason,
+		Message: fmt.Sprintf("Waiting for the drain period, %s remaining", remaining.Round(time.Second)),
+	})
+	return min(remaining, memberDrainCheckInterval), nil
+}
+
 func removeAPIServerEndpoint(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, instanceStatus *compute.InstanceStatus, clusterResourceName string) error {
 	if openStackCluster.Spec.APIServer.GetManagedLoadBalancer().IsEnabled() {
 		loadBalancerService, err := loadbalancer.NewService(scope)
@@ -303,6 +409,12 @@ func removeAPIServerEndpoint(scope *scope.WithLogger, openStackCluster *infrav1.
 		return nil
 	}
 
+	// The virtual IP port is deleted with the cluster, and the allowed
+	// address pair is deleted with the management port.
+	if openStackCluster.Spec.APIServer.GetVirtualIP() != nil {
+		return nil
+	}
+
 	// XXX(mdbooth): This looks wrong to me. Surely we should only ever
 	// disassociate the floating IP here. I would expect the API server
 	// floating IP to be created and deleted with the cluster. And if the
@@ -455,6 +567,10 @@ func (r *OpenStackMachineReconciler) reconcileNormal(ctx context.Context, scope
 		})
 	}
 
+	if err := r.reconcileAdditionalLoadBalancerMembers(scope, openStackCluster, openStackMachine, machine, instanceNS, clusterResourceName); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	scope.Logger().Info("Reconciled OpenStackMachine create successfully")
 	return ctrl.Result{}, nil
 }
@@ -482,6 +598,10 @@ func (r *OpenStackMachineReconciler) reconcileMachineState(scope *scope.WithLogg
 				Reason:  condition.Reason,
 				Message: condition.Message,
 			})
+			// The OpenStackServer backs off until quota is released, so there is no point checking it more often
+			if condition.Reason == infrav1.QuotaExceededReason {
+				return &ctrl.Result{RequeueAfter: capoerrors.QuotaExceededRequeueAfter}
+			}
 			return &ctrl.Result{RequeueAfter: waitForBuildingInstanceToReconcile}
 		}
 
@@ -819,7 +939,7 @@ func (r *OpenStackMachineReconciler) reconcileAPIServerLoadBalancer(scope *scope
 	}
 
 	if openStackCluster.Spec.APIServer.GetManagedLoadBalancer().IsEnabled() {
-		err = r.reconcileLoadBalancerMember(scope, openStackCluster, openStackMachine, instanceNS, clusterResourceName)
+		err = r.reconcileLoadBalancerMember(scope, openStackCluster, openStackMachine, instanceStatus, instanceNS, clusterResourceName)
 		if err != nil {
 			conditions.Set(openStackMachine, metav1.Condition{
 				Type:    infrav1.APIServerIngressReadyCondition,
@@ -829,6 +949,17 @@ func (r *OpenStackMachineReconciler) reconcileAPIServerLoadBalancer(scope *scope
 			})
 			return fmt.Errorf("reconcile load balancer member: %w", err)
 		}
+	} else if openStackCluster.Spec.APIServer.GetVirtualIP() != nil {
+		err = reconcileAPIServerVirtualIPAddressPair(openStackCluster, instanceStatus, computeService, networkingService)
+		if err != nil {
+			conditions.Set(openStackMachine, metav1.Condition{
+				Type:    infrav1.APIServerIngressReadyCondition,
+				Status:  metav1.ConditionFalse,
+				Reason:  infrav1.VirtualIPErrorReason,
+				Message: fmt.Sprintf("Allowing the virtual IP on the management port failed: %v", err),
+			})
+			return fmt.Errorf("allow virtual IP on management port: %w", err)
+		}
 	} else if ptr.Deref(openStackCluster.Spec.APIServer.GetEnableFloatingIP(), true) && ptr.Deref(openStackCluster.Spec.EnableExternalNetwork, true) {
 		var floatingIPAddress *string
 		switch {
@@ -837,7 +968,7 @@ func (r *OpenStackMachineReconciler) reconcileAPIServerLoadBalancer(scope *scope
 		case openStackCluster.Spec.APIServer.GetFloatingIP() != nil:
 			floatingIPAddress = openStackCluster.Spec.APIServer.GetFloatingIP()
 		}
-		fp, err := networkingService.GetOrCreateFloatingIP(openStackMachine, openStackCluster, clusterResourceName, floatingIPAddress)
+		fp, err := networkingService.GetOrCreateFloatingIP(openStackMachine, openStackCluster, clusterResourceName, floatingIPAddress, openStackCluster.Spec.APIServer.GetFloatingIPDNS())
 		if err != nil {
 			conditions.Set(openStackMachine, metav1.Condition{
 				Type:    infrav1.APIServerIngressReadyCondition,
@@ -881,6 +1012,22 @@ func (r *OpenStackMachineReconciler) reconcileAPIServerLoadBalancer(scope *scope
 	return nil
 }
 
+// reconcileAPIServerVirtualIPAddressPair allows the management port of a
+// control plane machine to hold the virtual IP of the API server.
+func reconcileAPIServerVirtualIPAddressPair(openStackCluster *infrav1.OpenStackCluster, instanceStatus *compute.InstanceStatus, computeService *compute.Service, networkingService *networking.Service) error {
+	vipStatus := openStackCluster.Status.APIServerVirtualIP
+	if vipStatus == nil || vipStatus.IP == "" {
+		return errors.New("virtual IP is not reserved yet")
+	}
+
+	port, err := computeService.GetManagementPort(openStackCluster, instanceStatus)
+	if err != nil {
+		return fmt.Errorf("get management port for control plane machine: %w", err)
+	}
+
+	return networkingService.AddAllowedAddressPair(port.ID, vipStatus.IP)
+}
+
 // getManagedSecurityGroup returns the ID of the security group managed by the
 // OpenStackCluster whether it's a control plane or a worker machine.
 func getManagedSecurityGroup(openStackCluster *infrav1.OpenStackCluster, machine *clusterv1.Machine) *string {
@@ -905,14 +1052,46 @@ func getManagedSecurityGroup(openStackCluster *infrav1.OpenStackCluster, machine
 	return nil
 }
 
-func (r *OpenStackMachineReconciler) reconcileLoadBalancerMember(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, instanceNS *compute.InstanceNetworkStatus, clusterResourceName string) error {
+func (r *OpenStackMachineReconciler) reconcileLoadBalancerMember(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, instanceStatus *compute.InstanceStatus, instanceNS *compute.InstanceNetworkStatus, clusterResourceName string) error {
 	ip := instanceNS.IP(openStackCluster.Status.Network.Name)
 	loadbalancerService, err := loadbalancer.NewService(scope)
 	if err != nil {
 		return err
 	}
 
-	return loadbalancerService.ReconcileLoadBalancerMember(openStackCluster, openStackMachine, clusterResourceName, ip)
+	return loadbalancerService.ReconcileLoadBalancerMember(openStackCluster, openStackMachine, clusterResourceName, instanceStatus.AvailabilityZone(), ip)
+}
+
+// reconcileAdditionalLoadBalancerMembers adds the machine to the additional
+// load balancers of the cluster which select it.
+func (r *OpenStackMachineReconciler) reconcileAdditionalLoadBalancerMembers(scope *scope.WithLogger, openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, machine *clusterv1.Machine, instanceNS *compute.InstanceNetworkStatus, clusterResourceName string) error {
+	if len(openStackCluster.Spec.AdditionalLoadBalancers) == 0 {
+		conditions.Delete(openStackMachine, infrav1.AdditionalLoadBalancerMembersReadyCondition)
+		return nil
+	}
+
+	loadbalancerService, err := loadbalancer.NewService(scope)
+	if err != nil {
+		return err
+	}
+
+	ip := instanceNS.IP(openStackCluster.Status.Network.Name)
+	if err := loadbalancerService.ReconcileAdditionalLoadBalancerMembers(openStackCluster, openStackMachine, machine.Labels, clusterResourceName, ip); err != nil {
+		conditions.Set(openStackMachine, metav1.Condition{
+			Type:    infrav1.AdditionalLoadBalancerMembersReadyCondition,
+			Status:  metav1.ConditionFalse,
+			Reason:  infrav1.LoadBalancerMemberErrorReason,
+			Message: fmt.Sprintf("Reconciling additional load balancer members failed: %v", err),
+		})
+		return fmt.Errorf("reconcile additional load balancer members: %w", err)
+	}
+
+	conditions.Set(openStackMachine, metav1.Condition{
+		Type:   infrav1.AdditionalLoadBalancerMembersReadyCondition,
+		Status: metav1.ConditionTrue,
+		Reason: infrav1.ReadyConditionReason,
+	})
+	return nil
 }
 
 // OpenStackClusterToOpenStackMachines is a handler.ToRequestsFunc to be used to enqeue requests for reconciliation
@@ -21,13 +21,17 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
@@ -35,12 +39,16 @@ import (
 	conditions "sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/loadbalancer"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
 )
 
 const (
@@ -597,6 +605,7 @@ func TestReconcileMachineState(t *testing.T) { //nolint:gocyclo,cyclop // this i
 		serverConditions                []metav1.Condition
 		expectRequeue                   bool
 		expectRequeueAfter              bool
+		expectRequeueAfterDuration      time.Duration
 		expectedInstanceReadyCondition  *metav1.Condition
 		expectedReadyCondition          *metav1.Condition
 		expectInitializationProvisioned bool
@@ -651,6 +660,30 @@ func TestReconcileMachineState(t *testing.T) { //nolint:gocyclo,cyclop // this i
 				Message: "Waiting for dependencies",
 			},
 		},
+		{
+			name:          "Nil InstanceState with QuotaExceeded condition requeues after the quota interval",
+			instanceState: nil,
+			serverConditions: []metav1.Condition{
+				{
+					Type:    infrav1.InstanceReadyCondition,
+					Status:  metav1.ConditionFalse,
+					Reason:  infrav1.QuotaExceededReason,
+					Message: "create OpenStack instance: quota exceeded for cores: requested 4, but 18 of 20 are in use",
+				},
+			},
+			expectRequeue:              true,
+			expectRequeueAfterDuration: capoerrors.QuotaExceededRequeueAfter,
+			expectedInstanceReadyCondition: &metav1.Condition{
+				Type:   infrav1.InstanceReadyCondition,
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.QuotaExceededReason,
+			},
+			expectedReadyCondition: &metav1.Condition{
+				Type:   clusterv1.ReadyCondition,
+				Status: metav1.ConditionFalse,
+				Reason: infrav1.QuotaExceededReason,
+			},
+		},
 		{
 			name:          "Nil InstanceState with no server condition sets default waiting conditions",
 			instanceState: nil,
@@ -822,6 +855,11 @@ func TestReconcileMachineState(t *testing.T) { //nolint:gocyclo,cyclop // this i
 					t.Errorf("expected RequeueAfter %v, got %v", waitForInstanceBecomeActiveToReconcile, result)
 				}
 			}
+			if tt.expectRequeueAfterDuration != 0 {
+				if result == nil || result.RequeueAfter != tt.expectRequeueAfterDuration {
+					t.Errorf("expected RequeueAfter %v, got %v", tt.expectRequeueAfterDuration, result)
+				}
+			}
 
 			// Check InstanceReadyCondition
 			if tt.expectedInstanceReadyCondition != nil {
@@ -873,6 +911,105 @@ func TestReconcileMachineState(t *testing.T) { //nolint:gocyclo,cyclop // this i
 	}
 }
 
+// TestDrainAPIServerLoadBalancerMember tests that the deletion of a control plane machine waits for the drain period of
+// its API server load balancer members, and then deletes them.
+func TestDrainAPIServerLoadBalancerMember(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	const clusterResourceName = "AAAAA"
+
+	cloud := fake.NewCloud(fake.WithTransitionDelay(0))
+	factory := scope.NewFakeScopeFactory(cloud)
+	scope := scope.NewWithLogger(factory, logr.Discard())
+
+	networkClient, err := factory.NewNetworkClient()
+	g.Expect(err).NotTo(HaveOccurred())
+	network, err := networkClient.CreateNetwork(networks.CreateOpts{Name: "cluster"})
+	g.Expect(err).NotTo(HaveOccurred())
+	subnet, err := networkClient.CreateSubnet(subnets.CreateOpts{NetworkID: network.ID, CIDR: "10.0.0.0/24", IPVersion: 4})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	networkStatus := &infrav1.NetworkStatusWithSubnets{
+		NetworkStatus: infrav1.NetworkStatus{ID: network.ID, Name: network.Name},
+		Subnets:       []infrav1.Subnet{{ID: subnet.ID, CIDR: subnet.CIDR}},
+	}
+	openStackCluster := &infrav1.OpenStackCluster{
+		Spec: infrav1.OpenStackClusterSpec{
+			APIServer: &infrav1.APIServer{
+				EnableFloatingIP: ptr.To(false),
+				ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+					Enabled:     ptr.To(true),
+					MemberDrain: &infrav1.APIServerLoadBalancerMemberDrain{PeriodSeconds: 60},
+				},
+			},
+			ControlPlaneEndpoint: &clusterv1.APIEndpoint{Host: "10.0.0.5", Port: 6443},
+		},
+		Status: infrav1.OpenStackClusterStatus{
+			Initialization: &infrav1.ClusterInitialization{Provisioned: true},
+			Network:        networkStatus,
+			APIServerManagedLoadBalancer: &infrav1.LoadBalancer{
+				LoadBalancerNetwork: networkStatus,
+			},
+		},
+	}
+	machine := &clusterv1.Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   openStackMachineName,
+			Labels: map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+		},
+	}
+	openStackMachine := &infrav1.OpenStackMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  namespace,
+			Name:       openStackMachineName,
+			Finalizers: []string{infrav1.MachineFinalizer},
+		},
+	}
+
+	k8sScheme := runtime.NewScheme()
+	g.Expect(infrav1alpha1.AddToScheme(k8sScheme)).To(Succeed())
+	r := &OpenStackMachineReconciler{Client: fakeclient.NewClientBuilder().WithScheme(k8sScheme).Build()}
+
+	loadBalancerService, err := loadbalancer.NewService(scope)
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = loadBalancerService.ReconcileLoadBalancer(openStackCluster, clusterResourceName, 6443, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(loadBalancerService.ReconcileLoadBalancerMember(openStackCluster, openStackMachine, clusterResourceName, "", "10.0.0.10")).To(Succeed())
+
+	// The deletion waits for the drain period, and the member is kept
+	result, err := r.reconcileDelete(context.Background(), scope, clusterResourceName, openStackCluster, machine, openStackMachine)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(Equal(memberDrainCheckInterval))
+	g.Expect(cloud.Count("member")).To(Equal(1))
+	g.Expect(openStackMachine.Finalizers).To(ContainElement(infrav1.MachineFinalizer))
+	condition := conditions.Get(openStackMachine, infrav1.APIServerLoadBalancerMemberDrainedCondition)
+	g.Expect(condition).NotTo(BeNil())
+	g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+	g.Expect(condition.Reason).To(Equal(infrav1.LoadBalancerMemberDrainingReason))
+	g.Expect(condition.Message).To(ContainSubstring("remaining"))
+
+	// The deletion still waits before the drain period has elapsed
+	condition.LastTransitionTime = metav1.NewTime(time.Now().Add(-55 * time.Second))
+	conditions.Set(openStackMachine, *condition)
+	result, err = r.reconcileDelete(context.Background(), scope, clusterResourceName, openStackCluster, machine, openStackMachine)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(BeNumerically("~", 5*time.Second, time.Second))
+	g.Expect(cloud.Count("member")).To(Equal(1))
+
+	// The deletion continues when the drain period has elapsed
+	condition = conditions.Get(openStackMachine, infrav1.APIServerLoadBalancerMemberDrainedCondition)
+	condition.LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Minute))
+	conditions.Set(openStackMachine, *condition)
+	result, err = r.reconcileDelete(context.Background(), scope, clusterResourceName, openStackCluster, machine, openStackMachine)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.RequeueAfter).To(BeZero())
+	condition = conditions.Get(openStackMachine, infrav1.APIServerLoadBalancerMemberDrainedCondition)
+	g.Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+	g.Expect(condition.Reason).To(Equal(infrav1.LoadBalancerMemberDrainPeriodElapsedReason))
+	g.Expect(cloud.Count("member")).To(Equal(0))
+	g.Expect(openStackMachine.Finalizers).NotTo(ContainElement(infrav1.MachineFinalizer))
+}
+
 func TestOpenStackServerStatusReportable_MachineRecoversFromError(t *testing.T) {
 	g := NewGomegaWithT(t)
 
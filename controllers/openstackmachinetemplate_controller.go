@@ -80,6 +80,9 @@ type OpenStackMachineTemplateReconciler struct {
 
 func (r *OpenStackMachineTemplateReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, reterr error) {
 	log := ctrl.LoggerFrom(ctx)
+	defer func() {
+		result, reterr = controllers.HandleRequeueAfterError(log, result, reterr)
+	}()
 
 	// Fetch the OpenStackMachine instance.
 	openStackMachineTemplate := &infrav1.OpenStackMachineTemplate{}
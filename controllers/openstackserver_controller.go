@@ -57,6 +57,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/compute"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/networking"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/controllers"
 	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
 )
@@ -87,6 +88,9 @@ type OpenStackServerReconciler struct {
 
 func (r *OpenStackServerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, reterr error) {
 	log := ctrl.LoggerFrom(ctx)
+	defer func() {
+		result, reterr = controllers.HandleRequeueAfterError(log, result, reterr)
+	}()
 
 	// Fetch the OpenStackServer instance.
 	openStackServer := &infrav1alpha1.OpenStackServer{}
@@ -486,7 +490,14 @@ func getOrCreateServerPorts(openStackServer *infrav1alpha1.OpenStackServer, netw
 	}
 	desiredPorts := resolved.Ports
 
+	if err := networkingService.CheckQuota(len(desiredPorts)-len(resources.Ports), 0); err != nil {
+		return quotaExceeded(openStackServer, fmt.Errorf("creating ports: %w", err))
+	}
+
 	if err := networkingService.EnsurePorts(openStackServer, desiredPorts, resources); err != nil {
+		if capoerrors.IsQuotaExceeded(err) {
+			return quotaExceeded(openStackServer, fmt.Errorf("creating ports: %w", err))
+		}
 		conditions.Set(openStackServer, metav1.Condition{
 			Type:    infrav1.InstanceReadyCondition,
 			Status:  metav1.ConditionFalse,
@@ -544,7 +555,16 @@ func (r *OpenStackServerReconciler) getOrCreateServer(ctx context.Context, logge
 			return nil, err
 		}
 		instanceSpec.Name = openStackServer.Name
+		if err := computeService.CheckInstanceQuota(instanceSpec); err != nil {
+			if capoerrors.IsQuotaExceeded(err) {
+				return nil, quotaExceeded(openStackServer, fmt.Errorf("create OpenStack instance: %w", err))
+			}
+			return nil, err
+		}
 		instanceStatus, err = computeService.CreateInstance(openStackServer, instanceSpec, portIDs)
+		if capoerrors.IsQuotaExceeded(err) {
+			return nil, quotaExceeded(openStackServer, fmt.Errorf("create OpenStack instance: %w", err))
+		}
 		if err != nil {
 			conditions.Set(openStackServer, metav1.Condition{
 				Type:    infrav1.InstanceReadyCondition,
@@ -562,6 +582,19 @@ func (r *OpenStackServerReconciler) getOrCreateServer(ctx context.Context, logge
 	return instanceStatus, nil
 }
 
+// quotaExceeded marks the server as waiting for quota to be released and
+// returns an error which requeues it after QuotaExceededRequeueAfter, instead
+// of retrying with the usual backoff.
+func quotaExceeded(openStackServer *infrav1alpha1.OpenStackServer, err error) error {
+	conditions.Set(openStackServer, metav1.Condition{
+		Type:    infrav1.InstanceReadyCondition,
+		Status:  metav1.ConditionFalse,
+		Reason:  infrav1.QuotaExceededReason,
+		Message: err.Error(),
+	})
+	return &capoerrors.RequeueAfterError{Err: err, RequeueAfter: capoerrors.QuotaExceededRequeueAfter}
+}
+
 func (r *OpenStackServerReconciler) getUserDataSecretValue(ctx context.Context, namespace, secretName string) (string, error) {
 	secret := &corev1.Secret{}
 	key := types.NamespacedName{Namespace: namespace, Name: secretName}
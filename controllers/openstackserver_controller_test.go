@@ -26,9 +26,12 @@ import (
 	"github.com/go-logr/logr/testr"
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
+	computequotasets "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/quotasets"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/portsbinding"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/quotas"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/trunks"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
 	. "github.com/onsi/ginkgo/v2"
@@ -51,6 +54,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/compute"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
 )
 
 const (
@@ -1078,6 +1082,114 @@ func TestOpenStackServerReconciler_getOrCreateServer(t *testing.T) {
 	}
 }
 
+func Test_OpenStackServerReconcileCreateQuotaExceeded(t *testing.T) {
+	const projectID = "project-id"
+
+	newServer := func(portsStatus []infrav1.PortStatus) infrav1alpha1.OpenStackServer {
+		return infrav1alpha1.OpenStackServer{
+			Spec: infrav1alpha1.OpenStackServerSpec{
+				Flavor: ptr.To(defaultFlavor),
+				Image:  defaultImage,
+				Ports:  defaultPortOpts,
+			},
+			Status: infrav1alpha1.OpenStackServerStatus{
+				Resolved: &infrav1alpha1.ResolvedServerSpec{
+					ImageID:  imageUUID,
+					FlavorID: flavorUUID,
+					Ports:    defaultResolvedPorts,
+				},
+				Resources: &infrav1alpha1.ServerResources{
+					Ports: portsStatus,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		osServer    infrav1alpha1.OpenStackServer
+		expect      func(r *recorders)
+		wantMessage string
+	}{
+		{
+			name:     "Port quota exceeded",
+			osServer: newServer(nil),
+			expect: func(r *recorders) {
+				listDefaultPortsNotFound(r)
+				r.network.GetQuotaDetail(projectID).Return(&quotas.QuotaDetailSet{
+					Port: quotas.QuotaDetail{Used: 10, Limit: 10},
+				}, nil)
+			},
+			wantMessage: "creating ports: quota exceeded for port: requested 1, but 10 of 10 are in use",
+		},
+		{
+			name:     "Cores quota exceeded",
+			osServer: newServer(defaultPortsStatus),
+			expect: func(r *recorders) {
+				listDefaultPortsWithID(r)
+				listDefaultServerNotFound(r)
+				r.compute.GetQuotaSetDetail(projectID).Return(&computequotasets.QuotaDetailSet{
+					Instances: computequotasets.QuotaDetail{InUse: 1, Limit: 10},
+					Cores:     computequotasets.QuotaDetail{InUse: 18, Limit: 20},
+					RAM:       computequotasets.QuotaDetail{Limit: -1},
+				}, nil)
+				r.compute.GetFlavor(flavorUUID).Return(&flavors.Flavor{ID: flavorUUID, VCPUs: 4, RAM: 8192}, nil)
+			},
+			wantMessage: "create OpenStack instance: quota exceeded for cores: requested 4, but 18 of 20 are in use",
+		},
+		{
+			name:     "Nova rejects the server because of quota",
+			osServer: newServer(defaultPortsStatus),
+			expect: func(r *recorders) {
+				listDefaultPortsWithID(r)
+				listDefaultServerNotFound(r)
+				r.compute.GetQuotaSetDetail(projectID).Return(nil, gophercloud.ErrUnexpectedResponseCode{Actual: 403})
+				r.compute.CreateServer(gomock.Any(), gomock.Any()).Return(nil, gophercloud.ErrUnexpectedResponseCode{
+					Actual: 403,
+					Body:   []byte(`{"forbidden": {"code": 403, "message": "Quota exceeded for instances: Requested 1, but already used 10 of 10 instances"}}`),
+				})
+			},
+		},
+	}
+	for i := range tests {
+		tt := &tests[i]
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			log := testr.New(t)
+
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, projectID)
+			tt.expect(&recorders{
+				compute: mockScopeFactory.ComputeClient.EXPECT(),
+				image:   mockScopeFactory.ImageClient.EXPECT(),
+				network: mockScopeFactory.NetworkClient.EXPECT(),
+				volume:  mockScopeFactory.VolumeClient.EXPECT(),
+			})
+
+			osServer := &tt.osServer
+			osServer.Name = openStackServerName
+			osServer.Finalizers = []string{infrav1alpha1.OpenStackServerFinalizer}
+
+			reconciler := OpenStackServerReconciler{}
+			_, err := reconciler.reconcileNormal(ctx, scope.NewWithLogger(mockScopeFactory, log), osServer)
+			g.Expect(capoerrors.IsQuotaExceeded(err)).To(BeTrue())
+			requeueAfter, ok := capoerrors.RequeueAfter(err)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(requeueAfter).To(Equal(capoerrors.QuotaExceededRequeueAfter))
+
+			// The server is not in an error state: it is created once quota is available
+			g.Expect(osServer.Status.InstanceState).To(BeNil())
+			condition := conditions.Get(osServer, infrav1.InstanceReadyCondition)
+			g.Expect(condition).ToNot(BeNil())
+			g.Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			g.Expect(condition.Reason).To(Equal(infrav1.QuotaExceededReason))
+			if tt.wantMessage != "" {
+				g.Expect(condition.Message).To(Equal(tt.wantMessage))
+			}
+		})
+	}
+}
+
 func TestOpenStackServerStatusReportable(t *testing.T) {
 	g := NewGomegaWithT(t)
 	pred := OpenStackServerStatusReportable(logr.Discard())
@@ -54,6 +54,7 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-openstack/controllers"
 	"sigs.k8s.io/cluster-api-provider-openstack/feature"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/faults"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/metrics"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
@@ -99,6 +100,14 @@ var (
 	caCertsPath                         string
 	showVersion                         bool
 	scopeCacheMaxSize                   int
+	openStackAPIQPS                     float32
+	openStackAPIBurst                   int
+	openStackAPIMaxInFlight             int
+	credentialExpiryWindow              time.Duration
+	loadBalancerHealthPollInterval      time.Duration
+	federatedTokenFile                  string
+	clusterIdentityCheckInterval        time.Duration
+	faultInjectionConfig                string
 	skipCRDMigrationPhases              []string
 	logOptions                          = logs.NewOptions()
 )
@@ -179,10 +188,35 @@ func InitFlags(fs *pflag.FlagSet) {
 
 	fs.IntVar(&scopeCacheMaxSize, "scope-cache-max-size", 10, "The maximum credentials count the operator should keep in cache. Setting this value to 0 means no cache.")
 
+	fs.Float32Var(&openStackAPIQPS, "openstack-api-qps", 0,
+		"Maximum sustained OpenStack API calls per second for each identity endpoint and project. Setting this value to 0 means no limit. May be overridden by an OpenStackClusterIdentity.")
+
+	fs.IntVar(&openStackAPIBurst, "openstack-api-burst", 0,
+		"Maximum burst of OpenStack API calls for each identity endpoint and project. Defaults to --openstack-api-qps rounded up. May be overridden by an OpenStackClusterIdentity.")
+
+	fs.IntVar(&openStackAPIMaxInFlight, "openstack-api-max-in-flight", 0,
+		"Maximum concurrent OpenStack API calls for each identity endpoint and project. Setting this value to 0 means no limit. May be overridden by an OpenStackClusterIdentity.")
+
+	fs.DurationVar(&credentialExpiryWindow, "credential-expiry-window", 7*24*time.Hour,
+		"How long before it expires an application credential or token referenced by an OpenStackCluster is reported as expiring.")
+
+	fs.DurationVar(&loadBalancerHealthPollInterval, "loadbalancer-health-poll-interval", controllers.DefaultLoadBalancerHealthPollInterval,
+		"The interval at which the health of the API server load balancer of a ready OpenStackCluster is refreshed.")
+
+	fs.StringVar(&federatedTokenFile, "federated-token-file", scope.DefaultFederatedTokenFile,
+		"The path to the projected service account token exchanged for a Keystone token by identityRefs of type Federated.")
+
+	fs.DurationVar(&clusterIdentityCheckInterval, "cluster-identity-check-interval", controllers.DefaultClusterIdentityCheckInterval,
+		"The interval at which the credentials of each OpenStackClusterIdentity are checked by authenticating with them.")
+
 	fs.StringArrayVar(&skipCRDMigrationPhases, "skip-crd-migration-phases", []string{},
 		"List of CRD migration phases to skip. Valid values are: StorageVersionMigration, CleanupManagedFields.")
 	fs.BoolVar(&showVersion, "version", false, "Show current version and exit.")
 
+	fs.StringVar(&faultInjectionConfig, "fault-injection-config", "",
+		"The path to a fault injection config. Faults are injected into all calls to OpenStack. For testing only.")
+	_ = fs.MarkHidden("fault-injection-config")
+
 	feature.MutableGates.AddFlag(fs)
 }
 
@@ -321,7 +355,20 @@ func setupChecks(mgr ctrl.Manager) {
 }
 
 func setupReconcilers(ctx context.Context, mgr ctrl.Manager, caCerts []byte) {
-	scopeFactory := scope.NewFactory(scopeCacheMaxSize)
+	scopeFactory := scope.NewFactory(scopeCacheMaxSize, scope.APIRateLimit{
+		QPS:         float64(openStackAPIQPS),
+		Burst:       openStackAPIBurst,
+		MaxInFlight: openStackAPIMaxInFlight,
+	}, federatedTokenFile)
+	if faultInjectionConfig != "" {
+		config, err := faults.LoadConfig(faultInjectionConfig)
+		if err != nil {
+			setupLog.Error(err, "unable to load fault injection config")
+			os.Exit(1)
+		}
+		setupLog.Info("WARNING: injecting faults into calls to OpenStack", "config", faultInjectionConfig, "rules", len(config.Rules))
+		scopeFactory = scope.NewFaultInjectingFactory(scopeFactory, faults.NewInjector(*config))
+	}
 
 	crdMigratorConfig := map[client.Object]crdmigrator.ByObjectConfig{
 		&infrav1beta1.OpenStackCluster{}: {
@@ -366,6 +413,10 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, caCerts []byte) {
 		WatchFilterValue: watchFilterValue,
 		ScopeFactory:     scopeFactory,
 		CaCertificates:   caCerts,
+
+		CredentialExpiryWindow:         credentialExpiryWindow,
+		LoadBalancerHealthPollInterval: loadBalancerHealthPollInterval,
+		FullReconcileInterval:          syncPeriod,
 	}).SetupWithManager(ctx, mgr, concurrency(openStackClusterConcurrency)); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "OpenStackCluster")
 		os.Exit(1)
@@ -401,6 +452,27 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager, caCerts []byte) {
 		setupLog.Error(err, "unable to create controller", "controller", "OpenStackServer")
 		os.Exit(1)
 	}
+	if err := (&controllers.OpenStackCredentialRotationReconciler{
+		Client:           mgr.GetClient(),
+		Recorder:         mgr.GetEventRecorder("openstackcredentialrotation-controller"),
+		WatchFilterValue: watchFilterValue,
+		ScopeFactory:     scopeFactory,
+		CaCertificates:   caCerts,
+	}).SetupWithManager(ctx, mgr, concurrency(1)); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "OpenStackCredentialRotation")
+		os.Exit(1)
+	}
+	if err := (&controllers.OpenStackClusterIdentityReconciler{
+		Client:           mgr.GetClient(),
+		Recorder:         mgr.GetEventRecorder("openstackclusteridentity-controller"),
+		WatchFilterValue: watchFilterValue,
+		ScopeFactory:     scopeFactory,
+		CaCertificates:   caCerts,
+		CheckInterval:    clusterIdentityCheckInterval,
+	}).SetupWithManager(ctx, mgr, concurrency(1)); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "OpenStackClusterIdentity")
+		os.Exit(1)
+	}
 
 	if feature.Gates.Enabled(feature.AutoScaleFromZero) {
 		if err := (&controllers.OpenStackMachineTemplateReconciler{
@@ -25,6 +25,7 @@ import (
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/attachinterfaces"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/availabilityzones"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/quotasets"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servergroups"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
 	"github.com/gophercloud/utils/v2/openstack/clientconfig"
@@ -71,6 +72,9 @@ type ComputeClient interface {
 
 	ListServerGroups() ([]servergroups.ServerGroup, error)
 	GetConsoleOutput(serverID string) (string, error)
+
+	GetQuotaSetDetail(projectID string) (*quotasets.QuotaDetailSet, error)
+
 	WithMicroversion(required string) (ComputeClient, error)
 }
 
@@ -203,6 +207,15 @@ func (c computeClient) GetConsoleOutput(serverID string) (string, error) {
 	return servers.ShowConsoleOutput(context.TODO(), c.client, serverID, opts).Extract()
 }
 
+func (c computeClient) GetQuotaSetDetail(projectID string) (*quotasets.QuotaDetailSet, error) {
+	mc := metrics.NewMetricPrometheusContext("quota_set", "get")
+	quotaSet, err := quotasets.GetDetail(context.TODO(), c.client, projectID).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return &quotaSet, nil
+}
+
 // WithMicroversion checks that the required Nova microversion is supported and sets it for
 // the ComputeClient.
 func (c computeClient) WithMicroversion(required string) (ComputeClient, error) {
@@ -269,6 +282,10 @@ func (e computeErrorClient) GetConsoleOutput(_ string) (string, error) {
 	return "", e.error
 }
 
+func (e computeErrorClient) GetQuotaSetDetail(_ string) (*quotasets.QuotaDetailSet, error) {
+	return nil, e.error
+}
+
 func (e computeErrorClient) WithMicroversion(_ string) (ComputeClient, error) {
 	return nil, e.error
 }
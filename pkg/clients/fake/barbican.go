@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"cmp"
+	"fmt"
+	"net/url"
+	"path"
+	"slices"
+)
+
+// KeyManagerEndpoint is the base URL of the references to secrets and
+// containers returned by the fake Barbican.
+const KeyManagerEndpoint = "https://key-manager.fake/v1"
+
+// containerSecretNames are the names of the secrets a Barbican container of
+// type certificate may refer to.
+var containerSecretNames = []string{"certificate", "private_key", "private_key_passphrase", "intermediates"}
+
+func keyManagerRef(collection, id string) string {
+	return KeyManagerEndpoint + "/" + collection + "/" + id
+}
+
+// refID returns the ID of the secret or container a Barbican reference
+// refers to.
+func refID(ref string) string {
+	return path.Base(ref)
+}
+
+// CreateSecret stores a secret from the body of a Barbican create secret
+// request. As in Barbican, only the reference to the secret is returned.
+func (c *Cloud) CreateSecret(req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if str(req, "payload") != "" && str(req, "payload_content_type") == "" {
+		return nil, badRequest("Provided object does not match schema 'Secret': If 'payload' is supplied, 'payload_content_type' must also be supplied.")
+	}
+	obj := c.add(kindSecret, Object{
+		"name":                 str(req, "name"),
+		"secret_type":          cmp.Or(str(req, "secret_type"), "opaque"),
+		"payload":              str(req, "payload"),
+		"payload_content_type": str(req, "payload_content_type"),
+		"status":               "ACTIVE",
+		"creator_id":           DefaultUserID,
+		"created":              nowNoZ(),
+		"updated":              nowNoZ(),
+	})
+	obj["secret_ref"] = keyManagerRef("secrets", str(obj, "id"))
+	return Object{"secret_ref": str(obj, "secret_ref")}, nil
+}
+
+// DeleteSecret deletes a secret.
+func (c *Cloud) DeleteSecret(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.lookup(kindSecret, id); !ok {
+		return notFound("Secret", id)
+	}
+	c.remove(kindSecret, id)
+	return nil
+}
+
+// CreateContainer creates a container from the body of a Barbican create
+// container request. As in Barbican, only the reference to the container is
+// returned.
+func (c *Cloud) CreateContainer(req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	containerType := str(req, "type")
+	if !slices.Contains([]string{"generic", "rsa", "certificate"}, containerType) {
+		return nil, badRequest(fmt.Sprintf("Provided object does not match schema 'Container': '%s' is not one of ['generic', 'rsa', 'certificate']", containerType))
+	}
+	refs := objects(req, "secret_refs")
+	names := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		if _, ok := c.lookup(kindSecret, refID(str(ref, "secret_ref"))); !ok {
+			return nil, notFound("Secret", str(ref, "secret_ref"))
+		}
+		names = append(names, str(ref, "name"))
+	}
+	if containerType == "certificate" {
+		if !slices.Contains(names, "certificate") {
+			return nil, badRequest("Provided object does not match schema 'Container': 'certificate' is a required property")
+		}
+		for _, name := range names {
+			if !slices.Contains(containerSecretNames, name) {
+				return nil, badRequest(fmt.Sprintf("Provided object does not match schema 'Container': '%s' is not a valid secret name for a certificate container", name))
+			}
+		}
+	}
+	obj := c.add(kindContainer, Object{
+		"name":        str(req, "name"),
+		"type":        containerType,
+		"secret_refs": refs,
+		"consumers":   []any{},
+		"status":      "ACTIVE",
+		"creator_id":  DefaultUserID,
+		"created":     nowNoZ(),
+		"updated":     nowNoZ(),
+	})
+	obj["container_ref"] = keyManagerRef("containers", str(obj, "id"))
+	return Object{"container_ref": str(obj, "container_ref")}, nil
+}
+
+// ListContainers returns the containers matching a Barbican list query.
+func (c *Cloud) ListContainers(query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	query.Del("offset")
+	return c.listResources(kindContainer, query, nil)
+}
+
+// DeleteContainer deletes a container. As in Barbican, the secrets it refers
+// to are not deleted.
+func (c *Cloud) DeleteContainer(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.lookup(kindContainer, id); !ok {
+		return notFound("Container", id)
+	}
+	c.remove(kindContainer, id)
+	return nil
+}
+
+// certificateContainer returns the certificate container a reference refers
+// to.
+func (c *Cloud) certificateContainer(ref string) (Object, bool) {
+	obj, ok := c.lookup(kindContainer, refID(ref))
+	if !ok || str(obj, "type") != "certificate" {
+		return nil, false
+	}
+	return obj, true
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"net/url"
+)
+
+const (
+	volumeStatusCreating  = "creating"
+	volumeStatusAvailable = "available"
+	volumeStatusInUse     = "in-use"
+	volumeStatusDeleting  = "deleting"
+)
+
+// CreateVolume creates a volume from the body of a Cinder create volume
+// request. The volume starts in creating and becomes available after the
+// transition delay.
+func (c *Cloud) CreateVolume(body map[string]any) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	req, err := unwrap(body, "volume")
+	if err != nil {
+		return nil, err
+	}
+	obj, err := c.createVolume(req)
+	if err != nil {
+		return nil, err
+	}
+	return copyObject(obj), nil
+}
+
+func (c *Cloud) createVolume(req Object) (Object, error) {
+	if num(req, "size") <= 0 {
+		return nil, badRequest("Invalid input received: Volume size must be greater than 0.")
+	}
+	if err := c.checkQuota(QuotaVolumes, 1); err != nil {
+		return nil, err
+	}
+	if err := c.checkQuota(QuotaGigabytes, num(req, "size")); err != nil {
+		return nil, err
+	}
+	bootable := "false"
+	if imageID := str(req, "imageRef"); imageID != "" {
+		image, ok := c.lookup(kindImage, imageID)
+		if !ok {
+			return nil, badRequest(fmt.Sprintf("Invalid image identifier or unable to access requested image %s.", imageID))
+		}
+		if minDisk := num(image, "min_disk"); minDisk > num(req, "size") {
+			return nil, badRequest(fmt.Sprintf("Invalid input received: Image minDisk size %d is larger than the volume size %d.", minDisk, num(req, "size")))
+		}
+		bootable = "true"
+	}
+
+	ts := nowNoZ()
+	obj := Object{
+		"name":                              str(req, "name"),
+		"description":                       str(req, "description"),
+		"size":                              num(req, "size"),
+		"availability_zone":                 str(req, "availability_zone"),
+		"volume_type":                       str(req, "volume_type"),
+		"status":                            volumeStatusCreating,
+		"attachments":                       []any{},
+		"bootable":                          bootable,
+		"encrypted":                         false,
+		"multiattach":                       boolOr(req, "multiattach", false),
+		"metadata":                          Object{},
+		"snapshot_id":                       str(req, "snapshot_id"),
+		"source_volid":                      str(req, "source_volid"),
+		"os-vol-tenant-attr:tenant_id":      c.projectID,
+		"user_id":                           DefaultUserID,
+		"created_at":                        ts,
+		"updated_at":                        ts,
+		"volume_image_metadata":             Object{},
+		"os-vol-host-attr:host":             "fake-volume-host",
+		"consistencygroup_id":               "",
+		"replication_status":                "disabled",
+		"os-volume-replication:driver_data": "",
+	}
+	if obj["availability_zone"] == "" {
+		obj["availability_zone"] = DefaultAvailabilityZone
+	}
+	if obj["volume_type"] == "" {
+		obj["volume_type"] = "__DEFAULT__"
+	}
+	if metadata, ok := req["metadata"].(Object); ok {
+		obj["metadata"] = metadata
+	}
+	if imageID := str(req, "imageRef"); imageID != "" {
+		obj["volume_image_metadata"] = Object{"image_id": imageID}
+	}
+	c.add(kindVolume, obj)
+	c.transition(kindVolume, str(obj, "id"), nil, Object{"status": volumeStatusAvailable})
+	return obj, nil
+}
+
+func (c *Cloud) attachVolume(volume Object, serverID string) {
+	delete(c.transitions, kindVolume+"/"+str(volume, "id"))
+	attachments := objects(volume, "attachments")
+	attachment := Object{
+		"id":            c.newID(),
+		"attachment_id": c.newID(),
+		"volume_id":     str(volume, "id"),
+		"server_id":     serverID,
+		"host_name":     fakeHypervisor,
+		"device":        fmt.Sprintf("/dev/vd%c", 'a'+len(attachments)),
+		"attached_at":   nowNoZ(),
+	}
+	volume["attachments"] = append(volume["attachments"].([]any), attachment)
+	volume["status"] = volumeStatusInUse
+}
+
+func (c *Cloud) detachVolume(volume Object, serverID string) {
+	remaining := []any{}
+	for _, attachment := range objects(volume, "attachments") {
+		if str(attachment, "server_id") != serverID {
+			remaining = append(remaining, attachment)
+		}
+	}
+	volume["attachments"] = remaining
+	if len(remaining) == 0 {
+		volume["status"] = volumeStatusAvailable
+	}
+}
+
+// GetVolume returns a volume.
+func (c *Cloud) GetVolume(id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindVolume, id)
+	if !ok {
+		return nil, notFound(kindVolume, id)
+	}
+	return copyObject(obj), nil
+}
+
+// ListVolumes returns the volumes matching a Cinder list query.
+func (c *Cloud) ListVolumes(query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	filter, err := queryFilter(query)
+	if err != nil {
+		return nil, err
+	}
+	return copyObjects(c.list(kindVolume, filter)), nil
+}
+
+// DeleteVolume deletes a volume. The volume remains visible in deleting for
+// the transition delay. It fails if the volume is attached to a server.
+func (c *Cloud) DeleteVolume(id string, _ url.Values) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindVolume, id)
+	if !ok {
+		return notFound(kindVolume, id)
+	}
+	switch str(obj, "status") {
+	case volumeStatusDeleting:
+		return nil
+	case volumeStatusAvailable, "error":
+	default:
+		return badRequest(fmt.Sprintf("Invalid volume: Volume status must be available or error or error_restoring or error_extending or error_managing and must not be migrating, attached, belong to a group, have snapshots, awaiting a transfer, or be disassociated from snapshots after volume transfer, but current status is: %s.", str(obj, "status")))
+	}
+	c.scheduleRemoval(kindVolume, id, Object{"status": volumeStatusDeleting}, nil)
+	return nil
+}
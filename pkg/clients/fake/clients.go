@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/url"
+)
+
+// requestBody returns the resource in a request body built by a gophercloud
+// options builder, for example the port in {"port": {...}}.
+func requestBody(body map[string]any, err error, key string) (Object, error) {
+	if err != nil {
+		return nil, err
+	}
+	return unwrap(body, key)
+}
+
+// listQuery parses a query string built by a gophercloud list options
+// builder.
+func listQuery(query string, err error) (url.Values, error) {
+	if err != nil {
+		return nil, err
+	}
+	return ParseQuery(query)
+}
+
+// decode converts the result of a Cloud operation to a gophercloud result
+// type.
+func decode[T any](obj Object, err error) (*T, error) {
+	if err != nil {
+		return nil, err
+	}
+	var out T
+	if err := Decode(obj, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// decodeList converts the result of a Cloud list operation to a slice of a
+// gophercloud result type.
+func decodeList[T any](objs []Object, err error) ([]T, error) {
+	if err != nil {
+		return nil, err
+	}
+	out := make([]T, len(objs))
+	for i := range objs {
+		if err := Decode(objs[i], &out[i]); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
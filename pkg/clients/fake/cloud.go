@@ -0,0 +1,599 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides a stateful, in-memory implementation of the OpenStack
+// service clients defined in pkg/clients.
+//
+// All clients created from the same Cloud share its state, so a port created
+// through the NetworkClient can be attached to a server created through the
+// ComputeClient, and a load balancer VIP allocates a port on the network it is
+// created on. Resources move through the same provisioning states as they do
+// in a real cloud: servers start in BUILD, load balancers in PENDING_CREATE,
+// volumes in creating, and so on.
+//
+// Cloud stores every resource as its JSON API representation. The typed
+// clients convert gophercloud request options to request bodies and decode
+// the stored objects into gophercloud result types, which keeps the fake
+// close to the wire format and allows the same state to be served over HTTP.
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// Object is the JSON representation of an OpenStack resource, as returned by
+// the OpenStack API.
+type Object = map[string]any
+
+const (
+	// DefaultProjectID is the project which owns resources created in a Cloud
+	// which was not given a project with WithProjectID.
+	DefaultProjectID = "fake-project"
+
+	// DefaultUserID is the user which creates resources in every Cloud.
+	DefaultUserID = "fake-user"
+
+	// DefaultAvailabilityZone is the compute and volume availability zone
+	// which exists in every Cloud.
+	DefaultAvailabilityZone = "nova"
+)
+
+const (
+	kindAvailabilityZone  = "availability_zone"
+	kindFlavor            = "flavor"
+	kindServer            = "server"
+	kindServerGroup       = "server_group"
+	kindNetwork           = "network"
+	kindSubnet            = "subnet"
+	kindSubnetPool        = "subnetpool"
+	kindQoSPolicy         = "qos_policy"
+	kindPort              = "port"
+	kindRouter            = "router"
+	kindSecurityGroup     = "security_group"
+	kindSecurityGroupRule = "security_group_rule"
+	kindFloatingIP        = "floatingip"
+	kindTrunk             = "trunk"
+	kindNetworkExtension  = "network_extension"
+	kindVolume            = "volume"
+	kindImage             = "image"
+	kindLoadBalancer      = "loadbalancer"
+	kindListener          = "listener"
+	kindPool              = "pool"
+	kindMember            = "member"
+	kindMonitor           = "healthmonitor"
+	kindLBProvider        = "provider"
+	kindLBFlavor          = "lb_flavor"
+
+	kindApplicationCredential = "application_credential"
+
+	kindSecret    = "secret"
+	kindContainer = "container"
+)
+
+// Option configures a Cloud.
+type Option func(*Cloud)
+
+// WithProjectID sets the project which owns all resources created in the Cloud.
+func WithProjectID(projectID string) Option {
+	return func(c *Cloud) {
+		c.projectID = projectID
+	}
+}
+
+// WithTransitionDelay sets the number of times a resource in a transitional
+// state, for example a server in BUILD, is observed before it reaches its
+// final state. A delay of 0 makes every transition complete immediately.
+func WithTransitionDelay(delay int) Option {
+	return func(c *Cloud) {
+		c.transitionDelay = delay
+	}
+}
+
+// WithNetworkExtensions sets the aliases of the Neutron extensions returned by
+// ListExtensions, replacing the default set.
+func WithNetworkExtensions(aliases ...string) Option {
+	return func(c *Cloud) {
+		c.resources[kindNetworkExtension] = map[string]*entry{}
+		for _, alias := range aliases {
+			c.add(kindNetworkExtension, Object{"alias": alias, "name": alias, "description": alias})
+		}
+	}
+}
+
+// Cloud is the shared in-memory state of a fake OpenStack cloud.
+type Cloud struct {
+	mu sync.Mutex
+
+	projectID       string
+	transitionDelay int
+
+	seq    int
+	macSeq int
+
+	resources   map[string]map[string]*entry
+	transitions map[string]*transition
+	allocations map[string]map[string]bool
+
+	// novaOwnedPorts are ports created by Nova for a server booted on a
+	// network rather than a port. They are deleted with the server.
+	novaOwnedPorts map[string]bool
+
+	consoleOutput map[string]string
+
+	// memberOperatingStatus overrides the operating status reported for a
+	// load balancer member, by member ID.
+	memberOperatingStatus map[string]string
+
+	// quotas are the limits of the quotas of the project, by quota name.
+	quotas map[string]int
+}
+
+type entry struct {
+	seq int
+	obj Object
+}
+
+// transition describes a pending change to a resource in a transitional
+// state. The change is applied once the resource has been observed delay
+// times, after which onComplete is called. If remove is set the resource is
+// deleted instead, after calling onRemove.
+type transition struct {
+	delay      int
+	updates    Object
+	onComplete func()
+	remove     bool
+	onRemove   func()
+}
+
+// NewCloud returns an empty Cloud with a single availability zone, the
+// default Octavia providers and the Neutron extensions CAPO relies on.
+func NewCloud(opts ...Option) *Cloud {
+	c := &Cloud{
+		projectID:       DefaultProjectID,
+		transitionDelay: 1,
+		resources:       map[string]map[string]*entry{},
+		transitions:     map[string]*transition{},
+		allocations:     map[string]map[string]bool{},
+		novaOwnedPorts:  map[string]bool{},
+		consoleOutput:   map[string]string{},
+		quotas:          map[string]int{},
+
+		memberOperatingStatus: map[string]string{},
+	}
+
+	c.add(kindAvailabilityZone, Object{
+		"id":       DefaultAvailabilityZone,
+		"zoneName": DefaultAvailabilityZone,
+		"zoneState": Object{
+			"available": true,
+		},
+		"hosts": nil,
+	})
+	c.add(kindLBProvider, Object{"id": "amphora", "name": "amphora", "description": "The Octavia Amphora driver."})
+	c.add(kindLBProvider, Object{"id": "ovn", "name": "ovn", "description": "Octavia OVN driver."})
+	for _, alias := range []string{"standard-attr-tag", "trunk", "port-security", "allowed-address-pairs", "binding", "security-group", "router", "external-net"} {
+		c.add(kindNetworkExtension, Object{"alias": alias, "name": alias, "description": alias})
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// ProjectID returns the project which owns resources created in the Cloud.
+func (c *Cloud) ProjectID() string {
+	return c.projectID
+}
+
+// UserID returns the user which creates resources in the Cloud.
+func (c *Cloud) UserID() string {
+	return DefaultUserID
+}
+
+// SetConsoleOutput sets the console log returned for the given server.
+func (c *Cloud) SetConsoleOutput(serverID, output string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.consoleOutput[serverID] = output
+}
+
+// Count returns the number of resources of the given kind which currently
+// exist in the Cloud. Kinds are named as in the OpenStack API, for example
+// "server", "port" or "loadbalancer".
+func (c *Cloud) Count(kind string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.resources[kind])
+}
+
+func (c *Cloud) newID() string {
+	return uuid.New().String()
+}
+
+func (c *Cloud) newMAC() string {
+	c.macSeq++
+	return fmt.Sprintf("fa:16:3e:%02x:%02x:%02x", (c.macSeq>>16)&0xff, (c.macSeq>>8)&0xff, c.macSeq&0xff)
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+// nowNoZ returns the current time in the format used by Cinder and Octavia,
+// which omits the time zone.
+func nowNoZ() string {
+	return time.Now().UTC().Format(gophercloud.RFC3339NoZ)
+}
+
+// add stores obj, assigning it an ID if it does not already have one.
+func (c *Cloud) add(kind string, obj Object) Object {
+	id, _ := obj["id"].(string)
+	if id == "" {
+		id = c.newID()
+		obj["id"] = id
+	}
+	if c.resources[kind] == nil {
+		c.resources[kind] = map[string]*entry{}
+	}
+	c.seq++
+	c.resources[kind][id] = &entry{seq: c.seq, obj: obj}
+	return obj
+}
+
+// lookup returns the stored resource without observing it.
+func (c *Cloud) lookup(kind, id string) (Object, bool) {
+	e, ok := c.resources[kind][id]
+	if !ok {
+		return nil, false
+	}
+	return e.obj, true
+}
+
+// observe returns the stored resource, advancing any pending transition. It
+// returns false if the resource does not exist, including when a pending
+// deletion completes on this observation.
+func (c *Cloud) observe(kind, id string) (Object, bool) {
+	e, ok := c.resources[kind][id]
+	if !ok {
+		return nil, false
+	}
+	key := kind + "/" + id
+	t, ok := c.transitions[key]
+	if !ok {
+		return e.obj, true
+	}
+	if t.delay > 0 {
+		t.delay--
+		return e.obj, true
+	}
+	if !c.complete(kind, id) {
+		return nil, false
+	}
+	return e.obj, true
+}
+
+// complete applies the pending transition of a resource immediately. It
+// returns false if the resource was removed.
+func (c *Cloud) complete(kind, id string) bool {
+	key := kind + "/" + id
+	t, ok := c.transitions[key]
+	if !ok {
+		return true
+	}
+	delete(c.transitions, key)
+	if t.remove {
+		if t.onRemove != nil {
+			t.onRemove()
+		}
+		delete(c.resources[kind], id)
+		return false
+	}
+	if e, ok := c.resources[kind][id]; ok {
+		for k, v := range t.updates {
+			e.obj[k] = v
+		}
+	}
+	if t.onComplete != nil {
+		t.onComplete()
+	}
+	return true
+}
+
+// all returns the IDs of all resources of kind in creation order.
+func (c *Cloud) all(kind string) []string {
+	entries := make([]*entry, 0, len(c.resources[kind]))
+	for _, e := range c.resources[kind] {
+		entries = append(entries, e)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].seq < entries[j].seq })
+	ids := make([]string, len(entries))
+	for i := range entries {
+		ids[i] = entries[i].obj["id"].(string)
+	}
+	return ids
+}
+
+// list observes all resources of kind which match filter.
+func (c *Cloud) list(kind string, filter func(Object) bool) []Object {
+	var objs []Object
+	for _, id := range c.all(kind) {
+		obj, ok := c.observe(kind, id)
+		if !ok {
+			continue
+		}
+		if filter == nil || filter(obj) {
+			objs = append(objs, obj)
+		}
+	}
+	return objs
+}
+
+// find returns the resources of kind for which filter is true, without
+// observing them.
+func (c *Cloud) find(kind string, filter func(Object) bool) []Object {
+	var objs []Object
+	for _, id := range c.all(kind) {
+		obj, _ := c.lookup(kind, id)
+		if filter(obj) {
+			objs = append(objs, obj)
+		}
+	}
+	return objs
+}
+
+func (c *Cloud) remove(kind, id string) {
+	delete(c.resources[kind], id)
+	delete(c.transitions, kind+"/"+id)
+}
+
+// transition schedules updates to be applied to a resource after the
+// configured transition delay. The resource is modified immediately with
+// pending.
+func (c *Cloud) transition(kind, id string, pending, updates Object) {
+	c.transitionThen(kind, id, pending, updates, nil)
+}
+
+// transitionThen is transition, calling onComplete once the updates have been
+// applied.
+func (c *Cloud) transitionThen(kind, id string, pending, updates Object, onComplete func()) {
+	obj, ok := c.lookup(kind, id)
+	if !ok {
+		return
+	}
+	if c.transitionDelay == 0 {
+		for k, v := range updates {
+			obj[k] = v
+		}
+		delete(c.transitions, kind+"/"+id)
+		if onComplete != nil {
+			onComplete()
+		}
+		return
+	}
+	for k, v := range pending {
+		obj[k] = v
+	}
+	c.transitions[kind+"/"+id] = &transition{delay: c.transitionDelay - 1, updates: updates, onComplete: onComplete}
+}
+
+// scheduleRemoval deletes a resource after the configured transition delay,
+// calling onRemove first. The resource is modified immediately with pending.
+func (c *Cloud) scheduleRemoval(kind, id string, pending Object, onRemove func()) {
+	obj, ok := c.lookup(kind, id)
+	if !ok {
+		return
+	}
+	if c.transitionDelay == 0 {
+		if onRemove != nil {
+			onRemove()
+		}
+		c.remove(kind, id)
+		return
+	}
+	for k, v := range pending {
+		obj[k] = v
+	}
+	c.transitions[kind+"/"+id] = &transition{delay: c.transitionDelay - 1, remove: true, onRemove: onRemove}
+}
+
+// pending returns true if the resource has a transition which has not yet
+// completed.
+func (c *Cloud) pending(kind, id string) bool {
+	_, ok := c.transitions[kind+"/"+id]
+	return ok
+}
+
+// Decode converts an Object to the given gophercloud result type.
+func Decode(obj Object, out any) error {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
+
+// copyObject returns a deep copy of obj so that callers can not modify the
+// stored state.
+func copyObject(obj Object) Object {
+	if obj == nil {
+		return nil
+	}
+	b, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+	var out Object
+	if err := json.Unmarshal(b, &out); err != nil {
+		panic(err)
+	}
+	return out
+}
+
+func copyObjects(objs []Object) []Object {
+	out := make([]Object, len(objs))
+	for i := range objs {
+		out[i] = copyObject(objs[i])
+	}
+	return out
+}
+
+// toObject converts a request body fragment to an Object.
+func toObject(v any) (Object, error) {
+	if v == nil {
+		return Object{}, nil
+	}
+	if obj, ok := v.(Object); ok {
+		return copyObject(obj), nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out Object
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// unwrap returns the object stored under key in a request body, as in
+// {"port": {...}}.
+func unwrap(body map[string]any, key string) (Object, error) {
+	v, ok := body[key]
+	if !ok {
+		return nil, badRequest(fmt.Sprintf("request body does not contain %q", key))
+	}
+	return toObject(v)
+}
+
+func str(obj Object, key string) string {
+	s, _ := obj[key].(string)
+	return s
+}
+
+func boolOr(obj Object, key string, def bool) bool {
+	if b, ok := obj[key].(bool); ok {
+		return b
+	}
+	return def
+}
+
+func num(obj Object, key string) int {
+	switch v := obj[key].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	}
+	return 0
+}
+
+func strs(obj Object, key string) []string {
+	var out []string
+	switch v := obj[key].(type) {
+	case []string:
+		out = append(out, v...)
+	case []any:
+		for _, s := range v {
+			if s, ok := s.(string); ok {
+				out = append(out, s)
+			}
+		}
+	}
+	return out
+}
+
+func objects(obj Object, key string) []Object {
+	var out []Object
+	if v, ok := obj[key].([]any); ok {
+		for _, o := range v {
+			if o, ok := o.(Object); ok {
+				out = append(out, o)
+			}
+		}
+	}
+	return out
+}
+
+// idRefs returns a list of {"id": id} references, as used by Octavia to refer
+// to related resources.
+func idRefs(ids ...string) []any {
+	refs := make([]any, 0, len(ids))
+	for _, id := range ids {
+		refs = append(refs, Object{"id": id})
+	}
+	return refs
+}
+
+func refIDs(obj Object, key string) []string {
+	var ids []string
+	for _, ref := range objects(obj, key) {
+		ids = append(ids, str(ref, "id"))
+	}
+	return ids
+}
+
+func addRef(obj Object, key, id string) {
+	ids := refIDs(obj, key)
+	if !slices.Contains(ids, id) {
+		ids = append(ids, id)
+	}
+	obj[key] = idRefs(ids...)
+}
+
+func removeRef(obj Object, key, id string) {
+	obj[key] = idRefs(slices.DeleteFunc(refIDs(obj, key), func(s string) bool { return s == id })...)
+}
+
+// APIError returns an error with the given HTTP status code, in the form
+// returned by gophercloud for an unexpected response.
+func APIError(code int, message string) error {
+	body, _ := json.Marshal(Object{
+		"NeutronError": Object{
+			"type":    http.StatusText(code),
+			"message": message,
+		},
+	})
+	return gophercloud.ErrUnexpectedResponseCode{
+		Method:   "fake",
+		URL:      "fake",
+		Expected: []int{http.StatusOK},
+		Actual:   code,
+		Body:     body,
+	}
+}
+
+func notFound(kind, id string) error {
+	return APIError(http.StatusNotFound, fmt.Sprintf("%s %s could not be found.", kind, id))
+}
+
+func conflict(message string) error {
+	return APIError(http.StatusConflict, message)
+}
+
+func badRequest(message string) error {
+	return APIError(http.StatusBadRequest, message)
+}
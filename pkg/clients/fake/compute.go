@@ -0,0 +1,131 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"maps"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/attachinterfaces"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/availabilityzones"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/quotasets"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servergroups"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	openstackutil "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/openstack"
+)
+
+const (
+	// MinNovaMicroversion is the minimum Nova microversion supported by the
+	// fake ComputeClient.
+	MinNovaMicroversion = "2.1"
+	// MaxNovaMicroversion is the maximum Nova microversion supported by the
+	// fake ComputeClient.
+	MaxNovaMicroversion = "2.96"
+)
+
+type computeClient struct {
+	cloud        *Cloud
+	microversion string
+}
+
+var _ clients.ComputeClient = computeClient{}
+
+// NewComputeClient returns a ComputeClient backed by cloud.
+func NewComputeClient(cloud *Cloud) clients.ComputeClient {
+	return computeClient{cloud: cloud, microversion: clients.MinimumNovaMicroversion}
+}
+
+func (c computeClient) ListAvailabilityZones() ([]availabilityzones.AvailabilityZone, error) {
+	return decodeList[availabilityzones.AvailabilityZone](c.cloud.ListAvailabilityZones(), nil)
+}
+
+func (c computeClient) ListFlavors() ([]flavors.Flavor, error) {
+	return decodeList[flavors.Flavor](c.cloud.ListFlavors(), nil)
+}
+
+func (c computeClient) GetFlavor(flavorID string) (*flavors.Flavor, error) {
+	return decode[flavors.Flavor](c.cloud.GetFlavor(flavorID))
+}
+
+func (c computeClient) CreateServer(createOpts servers.CreateOptsBuilder, schedulerHints servers.SchedulerHintOptsBuilder) (*servers.Server, error) {
+	body, err := createOpts.ToServerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	if schedulerHints != nil {
+		hints, err := schedulerHints.ToSchedulerHintsMap()
+		if err != nil {
+			return nil, err
+		}
+		maps.Copy(body, hints)
+	}
+	return decode[servers.Server](c.cloud.CreateServer(body))
+}
+
+func (c computeClient) DeleteServer(serverID string) error {
+	return c.cloud.DeleteServer(serverID)
+}
+
+func (c computeClient) GetServer(serverID string) (*servers.Server, error) {
+	return decode[servers.Server](c.cloud.GetServer(serverID))
+}
+
+func (c computeClient) ListServers(listOpts servers.ListOptsBuilder) ([]servers.Server, error) {
+	query, err := listQuery(listOpts.ToServerListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[servers.Server](c.cloud.ListServers(query))
+}
+
+func (c computeClient) ListAttachedInterfaces(serverID string) ([]attachinterfaces.Interface, error) {
+	return decodeList[attachinterfaces.Interface](c.cloud.ListAttachedInterfaces(serverID))
+}
+
+func (c computeClient) DeleteAttachedInterface(serverID, portID string) error {
+	return c.cloud.DeleteAttachedInterface(serverID, portID)
+}
+
+func (c computeClient) ListServerGroups() ([]servergroups.ServerGroup, error) {
+	return decodeList[servergroups.ServerGroup](c.cloud.ListServerGroups(), nil)
+}
+
+func (c computeClient) GetConsoleOutput(serverID string) (string, error) {
+	return c.cloud.GetConsoleOutput(serverID)
+}
+
+func (c computeClient) GetQuotaSetDetail(projectID string) (*quotasets.QuotaDetailSet, error) {
+	return decode[quotasets.QuotaDetailSet](c.cloud.GetComputeQuotaDetail(projectID))
+}
+
+// WithMicroversion checks that the required Nova microversion is supported by
+// the fake, as the real ComputeClient does against the server.
+func (c computeClient) WithMicroversion(required string) (clients.ComputeClient, error) {
+	supported, err := openstackutil.MicroversionSupported(required, MinNovaMicroversion, MaxNovaMicroversion)
+	if err != nil {
+		return nil, err
+	}
+	if !supported {
+		return nil, fmt.Errorf("microversion %s not supported. Min=%s, max=%s", required, MinNovaMicroversion, MaxNovaMicroversion)
+	}
+	versionedClient := c
+	versionedClient.microversion = required
+	return versionedClient, nil
+}
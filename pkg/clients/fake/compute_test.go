@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+func Test_ComputeClient_ServerLifecycle(t *testing.T) {
+	g := NewWithT(t)
+	cloud := NewCloud(WithTransitionDelay(2))
+	flavorID := cloud.AddFlavor("m1.small", 1, 2048, 20)
+	imageID := cloud.AddImage("ubuntu")
+	groupID := cloud.AddServerGroup("group", "soft-anti-affinity")
+
+	networkClient := NewNetworkClient(cloud)
+	computeClient := NewComputeClient(cloud)
+	volumeClient := NewVolumeClient(cloud)
+
+	network, _ := createNetwork(g, networkClient, "test", "10.0.0.0/24")
+	port, err := networkClient.CreatePort(ports.CreateOpts{NetworkID: network.ID})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	server, err := computeClient.CreateServer(servers.CreateOpts{
+		Name:      "server",
+		FlavorRef: flavorID,
+		ImageRef:  imageID,
+		Networks:  []servers.Network{{Port: port.ID}},
+		BlockDevice: []servers.BlockDevice{{
+			SourceType:          servers.SourceBlank,
+			DestinationType:     servers.DestinationVolume,
+			VolumeSize:          10,
+			BootIndex:           -1,
+			DeleteOnTermination: true,
+		}},
+	}, servers.SchedulerHintOpts{Group: groupID})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(server.Status).To(Equal("BUILD"))
+
+	server, err = computeClient.GetServer(server.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(server.Status).To(Equal("BUILD"))
+	port, err = networkClient.GetPort(port.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(port.DeviceID).To(Equal(server.ID))
+	g.Expect(port.Status).To(Equal("DOWN"))
+
+	server, err = computeClient.GetServer(server.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(server.Status).To(Equal("ACTIVE"))
+	g.Expect(server.Addresses).To(HaveKey("test"))
+	port, err = networkClient.GetPort(port.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(port.Status).To(Equal("ACTIVE"))
+
+	serverGroups, err := computeClient.ListServerGroups()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(serverGroups[0].Members).To(ConsistOf(server.ID))
+
+	attached, err := volumeClient.ListVolumes(volumes.ListOpts{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(attached).To(HaveLen(1))
+	g.Expect(attached[0].Status).To(Equal("in-use"))
+
+	listed, err := computeClient.ListServers(servers.ListOpts{Name: "^serv"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(listed).To(HaveLen(1))
+
+	g.Expect(computeClient.DeleteServer(server.ID)).To(Succeed())
+	server, err = computeClient.GetServer(server.ID)
+	g.Expect(err).NotTo(HaveOccurred(), "server should still exist while it is being deleted")
+	g.Expect(server.TaskState).To(Equal("deleting"))
+	_, err = computeClient.GetServer(server.ID)
+	g.Expect(capoerrors.IsNotFound(err)).To(BeTrue())
+
+	// The port passed at boot survives the server, the volume does not
+	port, err = networkClient.GetPort(port.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(port.DeviceID).To(BeEmpty())
+	g.Expect(cloud.Count("volume")).To(Equal(0))
+}
+
+func Test_ComputeClient_CreateServerErrors(t *testing.T) {
+	cloud := NewCloud()
+	flavorID := cloud.AddFlavor("m1.small", 1, 2048, 20)
+	imageID := cloud.AddImage("ubuntu")
+
+	tests := []struct {
+		name string
+		opts servers.CreateOpts
+	}{
+		{
+			name: "unknown flavor",
+			opts: servers.CreateOpts{Name: "server", FlavorRef: "missing", ImageRef: imageID},
+		},
+		{
+			name: "unknown image",
+			opts: servers.CreateOpts{Name: "server", FlavorRef: flavorID, ImageRef: "missing"},
+		},
+		{
+			name: "unknown availability zone",
+			opts: servers.CreateOpts{Name: "server", FlavorRef: flavorID, ImageRef: imageID, AvailabilityZone: "missing"},
+		},
+		{
+			name: "unknown port",
+			opts: servers.CreateOpts{Name: "server", FlavorRef: flavorID, ImageRef: imageID, Networks: []servers.Network{{Port: "missing"}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			_, err := NewComputeClient(cloud).CreateServer(tt.opts, nil)
+			g.Expect(capoerrors.IsInvalidError(err)).To(BeTrue(), "expected bad request, got %v", err)
+			g.Expect(cloud.Count("server")).To(Equal(0))
+		})
+	}
+}
+
+func Test_ComputeClient_WithMicroversion(t *testing.T) {
+	g := NewWithT(t)
+	c := NewComputeClient(NewCloud())
+
+	_, err := c.WithMicroversion(clients.NovaMultiAttachVolume)
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = c.WithMicroversion("2.200")
+	g.Expect(err).To(HaveOccurred())
+}
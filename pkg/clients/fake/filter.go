@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ignoredQueryParameters are list parameters which control pagination,
+// sorting or scope rather than filtering.
+var ignoredQueryParameters = map[string]bool{
+	"limit":         true,
+	"marker":        true,
+	"page_reverse":  true,
+	"sort":          true,
+	"sort_key":      true,
+	"sort_dir":      true,
+	"fields":        true,
+	"all_tenants":   true,
+	"changes-since": true,
+}
+
+// ParseQuery parses a list query as generated by a gophercloud ListOpts, with
+// or without the leading '?'.
+func ParseQuery(query string) (url.Values, error) {
+	return url.ParseQuery(strings.TrimPrefix(query, "?"))
+}
+
+// queryFilter returns a filter which matches objects against the parameters of
+// a list query in the way the OpenStack APIs do: all parameters must match,
+// and repeated parameters match any of their values. Parameters listed in
+// regexParams are matched as regular expressions, as Nova does for server
+// names.
+func queryFilter(query url.Values, regexParams ...string) (func(Object) bool, error) {
+	regexes := map[string]*regexp.Regexp{}
+	for _, param := range regexParams {
+		if v := query.Get(param); v != "" {
+			re, err := regexp.Compile(v)
+			if err != nil {
+				return nil, badRequest(fmt.Sprintf("invalid regular expression for %s: %v", param, err))
+			}
+			regexes[param] = re
+		}
+	}
+
+	return func(obj Object) bool {
+		for param, values := range query {
+			if ignoredQueryParameters[param] {
+				continue
+			}
+			if re, ok := regexes[param]; ok {
+				if !re.MatchString(str(obj, param)) {
+					return false
+				}
+				continue
+			}
+			if !matchParam(obj, param, values) {
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+func matchParam(obj Object, param string, values []string) bool {
+	switch param {
+	case "tags", "tag":
+		// Neutron and Nova take a comma separated list, Glance takes
+		// repeated parameters. All given tags must be present.
+		return containsAll(strs(obj, "tags"), splitTags(values))
+	case "tags-any":
+		return containsAny(strs(obj, "tags"), splitTags(values))
+	case "not-tags":
+		return !containsAll(strs(obj, "tags"), splitTags(values))
+	case "not-tags-any":
+		return !containsAny(strs(obj, "tags"), splitTags(values))
+	case "fixed_ips":
+		return matchFixedIPs(obj, values)
+	case "project_id", "tenant_id":
+		// Not all resources report their owner, and everything in a fake
+		// cloud is owned by the same project.
+		if _, ok := obj[param]; !ok {
+			return true
+		}
+	}
+
+	v, ok := obj[param]
+	if !ok {
+		return false
+	}
+	actual := fmt.Sprint(v)
+	if v == nil {
+		actual = ""
+	}
+	return slices.Contains(values, actual)
+}
+
+func splitTags(values []string) []string {
+	var tags []string
+	for _, v := range values {
+		for _, t := range strings.Split(v, ",") {
+			if t != "" {
+				tags = append(tags, t)
+			}
+		}
+	}
+	return tags
+}
+
+func containsAll(have, want []string) bool {
+	for _, w := range want {
+		if !slices.Contains(have, w) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAny(have, want []string) bool {
+	for _, w := range want {
+		if slices.Contains(have, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchFixedIPs matches Neutron's fixed_ips port filter, whose values take the
+// form ip_address=x, ip_address_substr=x or subnet_id=x.
+func matchFixedIPs(obj Object, values []string) bool {
+	for _, v := range values {
+		key, want, _ := strings.Cut(v, "=")
+		found := false
+		for _, ip := range objects(obj, "fixed_ips") {
+			switch key {
+			case "ip_address_substr":
+				found = strings.Contains(str(ip, "ip_address"), want)
+			default:
+				found = str(ip, key) == want
+			}
+			if found {
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
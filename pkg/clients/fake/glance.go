@@ -0,0 +1,194 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"crypto/md5" //nolint:gosec // Glance reports the MD5 checksum of image data
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+)
+
+const (
+	imageStatusQueued    = "queued"
+	imageStatusSaving    = "saving"
+	imageStatusImporting = "importing"
+	imageStatusActive    = "active"
+)
+
+// imageImportMethods are the import methods advertised by GetImportInfo.
+var imageImportMethods = []any{"glance-direct", "web-download"}
+
+func (c *Cloud) newImage(req Object) Object {
+	ts := now()
+	obj := Object{
+		"name":             "",
+		"status":           imageStatusQueued,
+		"visibility":       "shared",
+		"protected":        false,
+		"os_hidden":        false,
+		"tags":             []any{},
+		"container_format": "bare",
+		"disk_format":      "qcow2",
+		"min_disk":         0,
+		"min_ram":          0,
+		"owner":            c.projectID,
+		"created_at":       ts,
+		"updated_at":       ts,
+		"file":             "",
+		"schema":           "/v2/schemas/image",
+	}
+	for k, v := range req {
+		obj[k] = v
+	}
+	return obj
+}
+
+// AddImage adds an active image to the Cloud and returns its ID.
+func (c *Cloud) AddImage(name string, tags ...string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	imageTags := make([]any, 0, len(tags))
+	for _, t := range tags {
+		imageTags = append(imageTags, t)
+	}
+	obj := c.add(kindImage, c.newImage(Object{"name": name, "tags": imageTags}))
+	obj["status"] = imageStatusActive
+	obj["size"] = 0
+	return str(obj, "id")
+}
+
+// CreateImage creates an image record from the body of a Glance create image
+// request. The image is queued until data is uploaded or imported.
+func (c *Cloud) CreateImage(req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if id := str(req, "id"); id != "" {
+		if _, ok := c.lookup(kindImage, id); ok {
+			return nil, conflict(fmt.Sprintf("Image with identifier %s already exists!", id))
+		}
+	}
+	obj := c.add(kindImage, c.newImage(req))
+	obj["file"] = fmt.Sprintf("/v2/images/%s/file", str(obj, "id"))
+	return copyObject(obj), nil
+}
+
+// GetImage returns an image.
+func (c *Cloud) GetImage(id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindImage, id)
+	if !ok {
+		return nil, notFound(kindImage, id)
+	}
+	return copyObject(obj), nil
+}
+
+// ListImages returns the images matching a Glance list query.
+func (c *Cloud) ListImages(query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	filter, err := queryFilter(query)
+	if err != nil {
+		return nil, err
+	}
+	return copyObjects(c.list(kindImage, filter)), nil
+}
+
+// DeleteImage deletes an image. It fails if the image is protected.
+func (c *Cloud) DeleteImage(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindImage, id)
+	if !ok {
+		return notFound(kindImage, id)
+	}
+	if boolOr(obj, "protected", false) {
+		return APIError(403, fmt.Sprintf("Image %s is protected and cannot be deleted.", id))
+	}
+	c.remove(kindImage, id)
+	return nil
+}
+
+// UploadImageData stores the data of a queued image. The image is saving for
+// the transition delay and then becomes active.
+func (c *Cloud) UploadImageData(id string, data io.Reader) error {
+	// Read the data before taking the lock: it may be slow.
+	b, err := io.ReadAll(data)
+	if err != nil {
+		return badRequest(fmt.Sprintf("failed to read image data: %v", err))
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindImage, id)
+	if !ok {
+		return notFound(kindImage, id)
+	}
+	if str(obj, "status") != imageStatusQueued {
+		return conflict(fmt.Sprintf("Image status transition from %s to saving is not allowed", str(obj, "status")))
+	}
+	md5sum := md5.Sum(b) //nolint:gosec
+	sha512sum := sha512.Sum512(b)
+	obj["size"] = len(b)
+	obj["checksum"] = hex.EncodeToString(md5sum[:])
+	obj["os_hash_algo"] = "sha512"
+	obj["os_hash_value"] = hex.EncodeToString(sha512sum[:])
+	c.transition(kindImage, id, Object{"status": imageStatusSaving}, Object{"status": imageStatusActive})
+	return nil
+}
+
+// GetImportInfo returns the image import methods supported by the Cloud.
+func (c *Cloud) GetImportInfo() Object {
+	return Object{
+		"import-methods": Object{
+			"description": "Import methods available.",
+			"type":        "array",
+			"value":       append([]any{}, imageImportMethods...),
+		},
+	}
+}
+
+// ImportImage starts an import of image data from the body of a Glance image
+// import request. The image is importing for the transition delay and then
+// becomes active.
+func (c *Cloud) ImportImage(id string, req Object) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindImage, id)
+	if !ok {
+		return notFound(kindImage, id)
+	}
+	method, _ := req["method"].(Object)
+	name := str(method, "name")
+	supported := false
+	for _, m := range imageImportMethods {
+		if m == name {
+			supported = true
+		}
+	}
+	if !supported {
+		return badRequest(fmt.Sprintf("Import method %s is not supported.", name))
+	}
+	if str(obj, "status") != imageStatusQueued {
+		return conflict(fmt.Sprintf("Image status transition from %s to importing is not allowed", str(obj, "status")))
+	}
+	c.transition(kindImage, id, Object{"status": imageStatusImporting}, Object{"status": imageStatusActive})
+	return nil
+}
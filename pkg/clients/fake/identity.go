@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/applicationcredentials"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+)
+
+type identityClient struct {
+	cloud *Cloud
+}
+
+var _ clients.IdentityClient = identityClient{}
+
+// NewIdentityClient returns an IdentityClient backed by cloud.
+func NewIdentityClient(cloud *Cloud) clients.IdentityClient {
+	return identityClient{cloud: cloud}
+}
+
+func (c identityClient) ListApplicationCredentials(userID string, opts applicationcredentials.ListOptsBuilder) ([]applicationcredentials.ApplicationCredential, error) {
+	query, err := listQuery(opts.ToApplicationCredentialListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[applicationcredentials.ApplicationCredential](c.cloud.ListApplicationCredentials(userID, query))
+}
+
+func (c identityClient) GetApplicationCredential(userID, id string) (*applicationcredentials.ApplicationCredential, error) {
+	return decode[applicationcredentials.ApplicationCredential](c.cloud.GetApplicationCredential(userID, id))
+}
+
+func (c identityClient) CreateApplicationCredential(userID string, opts applicationcredentials.CreateOptsBuilder) (*applicationcredentials.ApplicationCredential, error) {
+	body, err := opts.ToApplicationCredentialCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	return decode[applicationcredentials.ApplicationCredential](c.cloud.CreateApplicationCredential(userID, body))
+}
+
+func (c identityClient) DeleteApplicationCredential(userID, id string) error {
+	return c.cloud.DeleteApplicationCredential(userID, id)
+}
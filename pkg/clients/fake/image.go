@@ -0,0 +1,78 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"io"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/imageimport"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+)
+
+type imageClient struct {
+	cloud *Cloud
+}
+
+var _ clients.ImageClient = imageClient{}
+
+// NewImageClient returns an ImageClient backed by cloud.
+func NewImageClient(cloud *Cloud) clients.ImageClient {
+	return imageClient{cloud: cloud}
+}
+
+func (c imageClient) ListImages(listOpts images.ListOptsBuilder) ([]images.Image, error) {
+	query, err := listQuery(listOpts.ToImageListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[images.Image](c.cloud.ListImages(query))
+}
+
+func (c imageClient) GetImage(id string) (*images.Image, error) {
+	return decode[images.Image](c.cloud.GetImage(id))
+}
+
+func (c imageClient) CreateImage(_ context.Context, createOpts images.CreateOptsBuilder) (*images.Image, error) {
+	body, err := createOpts.ToImageCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	return decode[images.Image](c.cloud.CreateImage(body))
+}
+
+func (c imageClient) DeleteImage(_ context.Context, id string) error {
+	return c.cloud.DeleteImage(id)
+}
+
+func (c imageClient) UploadData(_ context.Context, id string, data io.Reader) error {
+	return c.cloud.UploadImageData(id, data)
+}
+
+func (c imageClient) GetImportInfo(_ context.Context) (*imageimport.ImportInfo, error) {
+	return decode[imageimport.ImportInfo](c.cloud.GetImportInfo(), nil)
+}
+
+func (c imageClient) CreateImport(_ context.Context, id string, createOpts imageimport.CreateOptsBuilder) error {
+	body, err := createOpts.ToImportCreateMap()
+	if err != nil {
+		return err
+	}
+	return c.cloud.ImportImage(id, body)
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"net/netip"
+)
+
+// defaultGateway returns the first host address of a prefix, which Neutron
+// uses as the gateway of a subnet when none is given.
+func defaultGateway(prefix netip.Prefix) string {
+	return prefix.Masked().Addr().Next().String()
+}
+
+// defaultAllocationPool returns the range of a prefix from which Neutron
+// allocates addresses when no allocation pools are given: every host address
+// except the gateway and, for IPv4, the broadcast address.
+func defaultAllocationPool(prefix netip.Prefix, gateway string) Object {
+	start := prefix.Masked().Addr().Next()
+	if start.String() == gateway {
+		start = start.Next()
+	}
+	end := lastAddr(prefix)
+	if end.Is4() {
+		end = end.Prev()
+	}
+	return Object{"start": start.String(), "end": end.String()}
+}
+
+func lastAddr(prefix netip.Prefix) netip.Addr {
+	addr := prefix.Masked().Addr()
+	b := addr.AsSlice()
+	bits := prefix.Bits()
+	for i := range b {
+		hostBits := len(b)*8 - bits - (len(b)-1-i)*8
+		switch {
+		case hostBits >= 8:
+			b[i] = 0xff
+		case hostBits > 0:
+			b[i] |= byte(0xff >> (8 - hostBits))
+		}
+	}
+	last, _ := netip.AddrFromSlice(b)
+	return last
+}
+
+// allocateIP returns a free address from the allocation pools of subnet, or
+// validates and reserves the requested address if one is given.
+func (c *Cloud) allocateIP(subnet Object, requested string) (string, error) {
+	subnetID := str(subnet, "id")
+	prefix, err := netip.ParsePrefix(str(subnet, "cidr"))
+	if err != nil {
+		return "", badRequest(fmt.Sprintf("subnet %s has invalid cidr: %v", subnetID, err))
+	}
+	allocated := c.allocations[subnetID]
+	if allocated == nil {
+		allocated = map[string]bool{}
+		c.allocations[subnetID] = allocated
+	}
+
+	if requested != "" {
+		addr, err := netip.ParseAddr(requested)
+		if err != nil || !prefix.Contains(addr) {
+			return "", badRequest(fmt.Sprintf("IP address %s is not a valid IP for the specified subnet.", requested))
+		}
+		if allocated[addr.String()] {
+			return "", conflict(fmt.Sprintf("IP address %s already allocated in subnet %s", requested, subnetID))
+		}
+		allocated[addr.String()] = true
+		return addr.String(), nil
+	}
+
+	for _, pool := range objects(subnet, "allocation_pools") {
+		start, err := netip.ParseAddr(str(pool, "start"))
+		if err != nil {
+			continue
+		}
+		end, err := netip.ParseAddr(str(pool, "end"))
+		if err != nil {
+			continue
+		}
+		for addr := start; addr.IsValid() && addr.Compare(end) <= 0; addr = addr.Next() {
+			if !allocated[addr.String()] && addr.String() != str(subnet, "gateway_ip") {
+				allocated[addr.String()] = true
+				return addr.String(), nil
+			}
+		}
+	}
+	return "", conflict(fmt.Sprintf("No more IP addresses available on network %s.", str(subnet, "network_id")))
+}
+
+func (c *Cloud) releaseIP(subnetID, ip string) {
+	if allocated := c.allocations[subnetID]; allocated != nil {
+		delete(allocated, ip)
+	}
+}
+
+// subnetContaining returns the subnet of network which contains ip.
+func (c *Cloud) subnetContaining(networkID, ip string) Object {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return nil
+	}
+	for _, subnet := range c.find(kindSubnet, func(o Object) bool { return str(o, "network_id") == networkID }) {
+		prefix, err := netip.ParsePrefix(str(subnet, "cidr"))
+		if err == nil && prefix.Contains(addr) {
+			return subnet
+		}
+	}
+	return nil
+}
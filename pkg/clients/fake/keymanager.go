@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/secrets"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+)
+
+type keyManagerClient struct {
+	cloud *Cloud
+}
+
+var _ clients.KeyManagerClient = keyManagerClient{}
+
+// NewKeyManagerClient returns a KeyManagerClient backed by cloud.
+func NewKeyManagerClient(cloud *Cloud) clients.KeyManagerClient {
+	return keyManagerClient{cloud: cloud}
+}
+
+func (c keyManagerClient) CreateSecret(opts secrets.CreateOptsBuilder) (*secrets.Secret, error) {
+	body, err := opts.ToSecretCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	return decode[secrets.Secret](c.cloud.CreateSecret(body))
+}
+
+func (c keyManagerClient) DeleteSecret(id string) error {
+	return c.cloud.DeleteSecret(id)
+}
+
+func (c keyManagerClient) CreateContainer(opts containers.CreateOptsBuilder) (*containers.Container, error) {
+	body, err := opts.ToContainerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	return decode[containers.Container](c.cloud.CreateContainer(body))
+}
+
+func (c keyManagerClient) ListContainers(opts containers.ListOptsBuilder) ([]containers.Container, error) {
+	query, err := listQuery(opts.ToContainerListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[containers.Container](c.cloud.ListContainers(query))
+}
+
+func (c keyManagerClient) DeleteContainer(id string) error {
+	return c.cloud.DeleteContainer(id)
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// defaultRoles are the roles of the user on the project. Application
+// credentials which do not name their roles are given all of them.
+var defaultRoles = []Object{
+	{"id": "member", "name": "member", "domain_id": nil},
+	{"id": "reader", "name": "reader", "domain_id": nil},
+}
+
+// CreateApplicationCredential creates an application credential from the
+// body of a Keystone create application credential request. As in Keystone,
+// the secret is generated if the request does not contain one, and is only
+// returned by this call.
+func (c *Cloud) CreateApplicationCredential(userID string, body map[string]any) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if userID != DefaultUserID {
+		return nil, notFound("user", userID)
+	}
+	req, err := unwrap(body, "application_credential")
+	if err != nil {
+		return nil, err
+	}
+	name := str(req, "name")
+	if name == "" {
+		return nil, badRequest("Invalid input for field 'name': None is not of type 'string'")
+	}
+	if len(c.find(kindApplicationCredential, func(o Object) bool { return str(o, "name") == name })) > 0 {
+		return nil, conflict(fmt.Sprintf("Conflict occurred attempting to store application_credential - Duplicate entry found with name %s.", name))
+	}
+	if expiresAt := str(req, "expires_at"); expiresAt != "" {
+		if _, err := time.Parse(gophercloud.RFC3339MilliNoZ, expiresAt); err != nil {
+			return nil, badRequest(fmt.Sprintf("Invalid input for field 'expires_at': %v", err))
+		}
+	}
+
+	roles := defaultRoles
+	if requested := objects(req, "roles"); len(requested) > 0 {
+		roles = nil
+		for _, role := range requested {
+			found := false
+			for _, r := range defaultRoles {
+				if str(role, "id") == str(r, "id") || str(role, "name") == str(r, "name") {
+					roles = append(roles, r)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, notFound("role", str(role, "id")+str(role, "name"))
+			}
+		}
+	}
+	accessRules := []any{}
+	for _, rule := range objects(req, "access_rules") {
+		accessRules = append(accessRules, Object{
+			"id":      c.newID(),
+			"service": str(rule, "service"),
+			"method":  str(rule, "method"),
+			"path":    str(rule, "path"),
+		})
+	}
+
+	secret := str(req, "secret")
+	if secret == "" {
+		secret = strings.ReplaceAll(c.newID(), "-", "")
+	}
+	obj := c.add(kindApplicationCredential, Object{
+		"name":         name,
+		"description":  str(req, "description"),
+		"unrestricted": boolOr(req, "unrestricted", false),
+		"secret":       secret,
+		"project_id":   c.projectID,
+		"user_id":      userID,
+		"roles":        roles,
+		"expires_at":   req["expires_at"],
+		"access_rules": accessRules,
+	})
+	return copyObject(obj), nil
+}
+
+// GetApplicationCredential returns an application credential without its
+// secret.
+func (c *Cloud) GetApplicationCredential(userID, id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.lookup(kindApplicationCredential, id)
+	if !ok || str(obj, "user_id") != userID {
+		return nil, notFound(kindApplicationCredential, id)
+	}
+	return withoutSecret(obj), nil
+}
+
+// ListApplicationCredentials returns the application credentials of a user
+// matching a Keystone list query, without their secrets.
+func (c *Cloud) ListApplicationCredentials(userID string, query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if userID != DefaultUserID {
+		return nil, notFound("user", userID)
+	}
+	filter, err := queryFilter(query)
+	if err != nil {
+		return nil, err
+	}
+	var out []Object
+	for _, obj := range c.list(kindApplicationCredential, filter) {
+		out = append(out, withoutSecret(obj))
+	}
+	return out, nil
+}
+
+// DeleteApplicationCredential deletes an application credential.
+func (c *Cloud) DeleteApplicationCredential(userID, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.lookup(kindApplicationCredential, id)
+	if !ok || str(obj, "user_id") != userID {
+		return notFound(kindApplicationCredential, id)
+	}
+	c.remove(kindApplicationCredential, id)
+	return nil
+}
+
+// AuthenticateApplicationCredential returns the application credential
+// identified by id, or by name if id is empty, if secret is its secret and it
+// has not expired.
+func (c *Cloud) AuthenticateApplicationCredential(id, name, secret string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var obj Object
+	if id != "" {
+		obj, _ = c.lookup(kindApplicationCredential, id)
+	} else if found := c.find(kindApplicationCredential, func(o Object) bool { return str(o, "name") == name }); len(found) == 1 {
+		obj = found[0]
+	}
+	if obj == nil || str(obj, "secret") != secret {
+		return nil, APIError(http.StatusUnauthorized, "The request you have made requires authentication.")
+	}
+	if expiresAt := str(obj, "expires_at"); expiresAt != "" {
+		t, err := time.Parse(gophercloud.RFC3339MilliNoZ, expiresAt)
+		if err != nil || time.Now().After(t) {
+			return nil, APIError(http.StatusUnauthorized, "The request you have made requires authentication.")
+		}
+	}
+	return withoutSecret(obj), nil
+}
+
+func withoutSecret(obj Object) Object {
+	out := copyObject(obj)
+	delete(out, "secret")
+	return out
+}
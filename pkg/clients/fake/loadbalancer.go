@@ -0,0 +1,261 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/apiversions"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/flavors"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/providers"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+type lbClient struct {
+	cloud *Cloud
+}
+
+var _ clients.LbClient = lbClient{}
+
+// NewLbClient returns a LbClient backed by cloud.
+//
+// Errors are wrapped in the same way as by the real LbClient, so callers see
+// the same error types from both.
+func NewLbClient(cloud *Cloud) clients.LbClient {
+	return lbClient{cloud: cloud}
+}
+
+func (l lbClient) CreateLoadBalancer(opts loadbalancers.CreateOptsBuilder) (*loadbalancers.LoadBalancer, error) {
+	body, err := opts.ToLoadBalancerCreateMap()
+	req, err := requestBody(body, err, "loadbalancer")
+	if err != nil {
+		return nil, err
+	}
+	return decode[loadbalancers.LoadBalancer](l.cloud.CreateLoadBalancer(req))
+}
+
+func (l lbClient) ListLoadBalancers(opts loadbalancers.ListOptsBuilder) ([]loadbalancers.LoadBalancer, error) {
+	query, err := listQuery(opts.ToLoadBalancerListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[loadbalancers.LoadBalancer](l.cloud.ListLoadBalancers(query))
+}
+
+func (l lbClient) GetLoadBalancer(id string) (*loadbalancers.LoadBalancer, error) {
+	return decode[loadbalancers.LoadBalancer](l.cloud.GetLoadBalancer(id))
+}
+
+func (l lbClient) UpdateLoadBalancer(id string, opts loadbalancers.UpdateOptsBuilder) (*loadbalancers.LoadBalancer, error) {
+	body, err := opts.ToLoadBalancerUpdateMap()
+	req, err := requestBody(body, err, "loadbalancer")
+	if err != nil {
+		return nil, err
+	}
+	return decode[loadbalancers.LoadBalancer](l.cloud.UpdateLoadBalancer(id, req))
+}
+
+func (l lbClient) DeleteLoadBalancer(id string, opts loadbalancers.DeleteOptsBuilder) error {
+	query := url.Values{}
+	if opts != nil {
+		var err error
+		query, err = listQuery(opts.ToLoadBalancerDeleteQuery())
+		if err != nil {
+			return err
+		}
+	}
+	return l.cloud.DeleteLoadBalancer(id, query)
+}
+
+func (l lbClient) GetLoadBalancerStatuses(id string) (*loadbalancers.StatusTree, error) {
+	return decode[loadbalancers.StatusTree](l.cloud.GetLoadBalancerStatuses(id))
+}
+
+func (l lbClient) CreateListener(opts listeners.CreateOptsBuilder) (*listeners.Listener, error) {
+	body, err := opts.ToListenerCreateMap()
+	req, err := requestBody(body, err, "listener")
+	if err != nil {
+		return nil, err
+	}
+	return decode[listeners.Listener](l.cloud.CreateListener(req))
+}
+
+func (l lbClient) UpdateListener(id string, opts listeners.UpdateOpts) (*listeners.Listener, error) {
+	body, err := opts.ToListenerUpdateMap()
+	req, err := requestBody(body, err, "listener")
+	if err != nil {
+		return nil, err
+	}
+	return decode[listeners.Listener](l.cloud.UpdateListener(id, req))
+}
+
+func (l lbClient) ListListeners(opts listeners.ListOptsBuilder) ([]listeners.Listener, error) {
+	query, err := listQuery(opts.ToListenerListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[listeners.Listener](l.cloud.ListListeners(query))
+}
+
+func (l lbClient) GetListener(id string) (*listeners.Listener, error) {
+	return decode[listeners.Listener](l.cloud.GetListener(id))
+}
+
+func (l lbClient) DeleteListener(id string) error {
+	if err := l.cloud.DeleteListener(id); err != nil && !capoerrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting lbaas listener %s: %v", id, err)
+	}
+	return nil
+}
+
+func (l lbClient) CreatePool(opts pools.CreateOptsBuilder) (*pools.Pool, error) {
+	body, err := opts.ToPoolCreateMap()
+	req, err := requestBody(body, err, "pool")
+	if err != nil {
+		return nil, err
+	}
+	return decode[pools.Pool](l.cloud.CreatePool(req))
+}
+
+func (l lbClient) ListPools(opts pools.ListOptsBuilder) ([]pools.Pool, error) {
+	query, err := listQuery(opts.ToPoolListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[pools.Pool](l.cloud.ListPools(query))
+}
+
+func (l lbClient) GetPool(id string) (*pools.Pool, error) {
+	return decode[pools.Pool](l.cloud.GetPool(id))
+}
+
+func (l lbClient) UpdatePool(id string, opts pools.UpdateOptsBuilder) (*pools.Pool, error) {
+	body, err := opts.ToPoolUpdateMap()
+	req, err := requestBody(body, err, "pool")
+	if err != nil {
+		return nil, err
+	}
+	return decode[pools.Pool](l.cloud.UpdatePool(id, req))
+}
+
+func (l lbClient) DeletePool(id string) error {
+	if err := l.cloud.DeletePool(id); err != nil && !capoerrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting lbaas pool %s: %v", id, err)
+	}
+	return nil
+}
+
+func (l lbClient) CreatePoolMember(poolID string, opts pools.CreateMemberOptsBuilder) (*pools.Member, error) {
+	body, err := opts.ToMemberCreateMap()
+	req, err := requestBody(body, err, "member")
+	if err != nil {
+		return nil, err
+	}
+	member, err := decode[pools.Member](l.cloud.CreatePoolMember(poolID, req))
+	if err != nil {
+		return nil, fmt.Errorf("error create lbmember: %s", err)
+	}
+	return member, nil
+}
+
+func (l lbClient) ListPoolMember(poolID string, opts pools.ListMembersOptsBuilder) ([]pools.Member, error) {
+	query, err := listQuery(opts.ToMembersListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[pools.Member](l.cloud.ListPoolMembers(poolID, query))
+}
+
+func (l lbClient) GetPoolMember(poolID string, lbMemberID string) (*pools.Member, error) {
+	member, err := decode[pools.Member](l.cloud.GetPoolMember(poolID, lbMemberID))
+	if err != nil {
+		return nil, fmt.Errorf("error getting lbmember: %s", err)
+	}
+	return member, nil
+}
+
+func (l lbClient) UpdatePoolMember(poolID string, lbMemberID string, opts pools.UpdateMemberOptsBuilder) (*pools.Member, error) {
+	body, err := opts.ToMemberUpdateMap()
+	req, err := requestBody(body, err, "member")
+	if err != nil {
+		return nil, err
+	}
+	member, err := decode[pools.Member](l.cloud.UpdatePoolMember(poolID, lbMemberID, req))
+	if err != nil {
+		return nil, fmt.Errorf("error updating lbmember: %s", err)
+	}
+	return member, nil
+}
+
+func (l lbClient) DeletePoolMember(poolID string, lbMemberID string) error {
+	if err := l.cloud.DeletePoolMember(poolID, lbMemberID); err != nil {
+		return fmt.Errorf("error deleting lbmember: %s", err)
+	}
+	return nil
+}
+
+func (l lbClient) CreateMonitor(opts monitors.CreateOptsBuilder) (*monitors.Monitor, error) {
+	body, err := opts.ToMonitorCreateMap()
+	req, err := requestBody(body, err, "healthmonitor")
+	if err != nil {
+		return nil, err
+	}
+	return decode[monitors.Monitor](l.cloud.CreateMonitor(req))
+}
+
+func (l lbClient) ListMonitors(opts monitors.ListOptsBuilder) ([]monitors.Monitor, error) {
+	query, err := listQuery(opts.ToMonitorListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[monitors.Monitor](l.cloud.ListMonitors(query))
+}
+
+func (l lbClient) UpdateMonitor(id string, opts monitors.UpdateOptsBuilder) (*monitors.Monitor, error) {
+	body, err := opts.ToMonitorUpdateMap()
+	req, err := requestBody(body, err, "healthmonitor")
+	if err != nil {
+		return nil, err
+	}
+	return decode[monitors.Monitor](l.cloud.UpdateMonitor(id, req))
+}
+
+func (l lbClient) DeleteMonitor(id string) error {
+	if err := l.cloud.DeleteMonitor(id); err != nil && !capoerrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting lbaas monitor %s: %v", id, err)
+	}
+	return nil
+}
+
+func (l lbClient) ListLoadBalancerProviders() ([]providers.Provider, error) {
+	return decodeList[providers.Provider](l.cloud.ListLoadBalancerProviders(), nil)
+}
+
+func (l lbClient) ListOctaviaVersions() ([]apiversions.APIVersion, error) {
+	return decodeList[apiversions.APIVersion](l.cloud.ListOctaviaVersions(), nil)
+}
+
+func (l lbClient) ListLoadBalancerFlavors() ([]flavors.Flavor, error) {
+	return decodeList[flavors.Flavor](l.cloud.ListLoadBalancerFlavors(), nil)
+}
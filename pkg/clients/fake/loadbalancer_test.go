@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+// waitForActive observes a load balancer until it leaves its PENDING state.
+func waitForActive(g *WithT, c clients.LbClient, id string) *loadbalancers.LoadBalancer {
+	for range 10 {
+		lb, err := c.GetLoadBalancer(id)
+		g.Expect(err).NotTo(HaveOccurred())
+		if lb.ProvisioningStatus == "ACTIVE" {
+			return lb
+		}
+	}
+	g.Expect("load balancer").To(Equal("ACTIVE"))
+	return nil
+}
+
+func Test_LbClient_Lifecycle(t *testing.T) {
+	g := NewWithT(t)
+	cloud := NewCloud(WithTransitionDelay(2))
+	networkClient := NewNetworkClient(cloud)
+	c := NewLbClient(cloud)
+	_, subnet := createNetwork(g, networkClient, "test", "10.0.0.0/24")
+
+	lb, err := c.CreateLoadBalancer(loadbalancers.CreateOpts{Name: "lb", VipSubnetID: subnet.ID})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(lb.ProvisioningStatus).To(Equal("PENDING_CREATE"))
+	g.Expect(lb.VipAddress).To(Equal("10.0.0.2"))
+	vipPort, err := networkClient.GetPort(lb.VipPortID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(vipPort.DeviceOwner).To(Equal("Octavia"))
+
+	_, err = c.CreateListener(listeners.CreateOpts{LoadbalancerID: lb.ID, Protocol: listeners.ProtocolTCP, ProtocolPort: 6443})
+	g.Expect(capoerrors.IsConflict(err)).To(BeTrue(), "expected conflict while the load balancer is pending, got %v", err)
+
+	lb = waitForActive(g, c, lb.ID)
+	g.Expect(lb.OperatingStatus).To(Equal("ONLINE"))
+
+	listener, err := c.CreateListener(listeners.CreateOpts{LoadbalancerID: lb.ID, Protocol: listeners.ProtocolTCP, ProtocolPort: 6443})
+	g.Expect(err).NotTo(HaveOccurred())
+	waitForActive(g, c, lb.ID)
+
+	_, err = c.CreateListener(listeners.CreateOpts{LoadbalancerID: lb.ID, Protocol: listeners.ProtocolTCP, ProtocolPort: 6443})
+	g.Expect(capoerrors.IsConflict(err)).To(BeTrue(), "expected conflict for a duplicate port, got %v", err)
+
+	allowedCIDRs := []string{"192.168.0.0/16"}
+	listener, err = c.UpdateListener(listener.ID, listeners.UpdateOpts{AllowedCIDRs: &allowedCIDRs})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(listener.AllowedCIDRs).To(Equal(allowedCIDRs))
+	waitForActive(g, c, lb.ID)
+
+	pool, err := c.CreatePool(pools.CreateOpts{ListenerID: listener.ID, Protocol: pools.ProtocolTCP, LBMethod: pools.LBMethodRoundRobin})
+	g.Expect(err).NotTo(HaveOccurred())
+	waitForActive(g, c, lb.ID)
+	listener, err = c.GetListener(listener.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(listener.DefaultPoolID).To(Equal(pool.ID))
+
+	member, err := c.CreatePoolMember(pool.ID, pools.CreateMemberOpts{Address: "10.0.0.10", ProtocolPort: 6443, SubnetID: subnet.ID})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(member.Weight).To(Equal(1))
+	waitForActive(g, c, lb.ID)
+
+	_, err = c.CreateMonitor(monitors.CreateOpts{PoolID: pool.ID, Type: monitors.TypeTCP, Delay: 10, Timeout: 5, MaxRetries: 3})
+	g.Expect(err).NotTo(HaveOccurred())
+	waitForActive(g, c, lb.ID)
+	_, err = c.CreateMonitor(monitors.CreateOpts{PoolID: pool.ID, Type: monitors.TypeTCP, Delay: 10, Timeout: 5, MaxRetries: 3})
+	g.Expect(capoerrors.IsConflict(err)).To(BeTrue(), "expected conflict for a second monitor, got %v", err)
+
+	members, err := c.ListPoolMember(pool.ID, pools.ListMembersOpts{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(members).To(HaveLen(1))
+	g.Expect(members[0].OperatingStatus).To(Equal("ONLINE"))
+
+	err = c.DeleteLoadBalancer(lb.ID, loadbalancers.DeleteOpts{})
+	g.Expect(capoerrors.IsInvalidError(err)).To(BeTrue(), "expected bad request deleting a load balancer with children, got %v", err)
+
+	g.Expect(c.DeleteLoadBalancer(lb.ID, loadbalancers.DeleteOpts{Cascade: true})).To(Succeed())
+	lb, err = c.GetLoadBalancer(lb.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(lb.ProvisioningStatus).To(Equal("PENDING_DELETE"))
+	_, err = c.GetLoadBalancer(lb.ID)
+	g.Expect(capoerrors.IsNotFound(err)).To(BeTrue())
+
+	_, err = networkClient.GetPort(vipPort.ID)
+	g.Expect(capoerrors.IsNotFound(err)).To(BeTrue(), "VIP port should be deleted with the load balancer")
+	g.Expect(cloud.Count("listener")).To(Equal(0))
+	g.Expect(cloud.Count("pool")).To(Equal(0))
+	g.Expect(cloud.Count("member")).To(Equal(0))
+	g.Expect(cloud.Count("healthmonitor")).To(Equal(0))
+	remaining, err := networkClient.ListPort(ports.ListOpts{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(remaining).To(BeEmpty())
+}
+
+func Test_LbClient_OVNProvider(t *testing.T) {
+	g := NewWithT(t)
+	cloud := NewCloud(WithTransitionDelay(0))
+	c := NewLbClient(cloud)
+	_, subnet := createNetwork(g, NewNetworkClient(cloud), "test", "10.0.0.0/24")
+
+	lb, err := c.CreateLoadBalancer(loadbalancers.CreateOpts{Name: "lb", VipSubnetID: subnet.ID, Provider: "ovn"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(lb.ProvisioningStatus).To(Equal("ACTIVE"))
+
+	_, err = c.CreateListener(listeners.CreateOpts{
+		LoadbalancerID: lb.ID,
+		Protocol:       listeners.ProtocolTCP,
+		ProtocolPort:   6443,
+		AllowedCIDRs:   []string{"192.168.0.0/16"},
+	})
+	g.Expect(capoerrors.IsNotImplementedError(err)).To(BeTrue(), "expected allowed_cidrs to be rejected, got %v", err)
+
+	listener, err := c.CreateListener(listeners.CreateOpts{LoadbalancerID: lb.ID, Protocol: listeners.ProtocolTCP, ProtocolPort: 6443})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = c.CreatePool(pools.CreateOpts{ListenerID: listener.ID, Protocol: pools.ProtocolTCP, LBMethod: pools.LBMethodRoundRobin})
+	g.Expect(capoerrors.IsNotImplementedError(err)).To(BeTrue(), "expected ROUND_ROBIN to be rejected, got %v", err)
+
+	_, err = c.CreatePool(pools.CreateOpts{ListenerID: listener.ID, Protocol: pools.ProtocolTCP, LBMethod: pools.LBMethodSourceIpPort})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	versions, err := c.ListOctaviaVersions()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(versions[len(versions)-1].Status).To(Equal("CURRENT"))
+}
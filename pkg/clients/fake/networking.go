@@ -0,0 +1,376 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/quotas"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/subnetpools"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/trunks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+)
+
+type networkClient struct {
+	cloud *Cloud
+}
+
+var _ clients.NetworkClient = networkClient{}
+
+// NewNetworkClient returns a NetworkClient backed by cloud.
+func NewNetworkClient(cloud *Cloud) clients.NetworkClient {
+	return networkClient{cloud: cloud}
+}
+
+// buildQuery returns the query string for list options which have no query
+// builder method of their own.
+func buildQuery(opts any) (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	if err != nil {
+		return "", err
+	}
+	return q.String(), nil
+}
+
+func (c networkClient) ListFloatingIP(opts floatingips.ListOptsBuilder) ([]floatingips.FloatingIP, error) {
+	query, err := listQuery(opts.ToFloatingIPListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[floatingips.FloatingIP](c.cloud.ListFloatingIPs(query))
+}
+
+func (c networkClient) CreateFloatingIP(opts floatingips.CreateOptsBuilder) (*floatingips.FloatingIP, error) {
+	body, err := opts.ToFloatingIPCreateMap()
+	req, err := requestBody(body, err, "floatingip")
+	if err != nil {
+		return nil, err
+	}
+	return decode[floatingips.FloatingIP](c.cloud.CreateFloatingIP(req))
+}
+
+func (c networkClient) DeleteFloatingIP(id string) error {
+	return c.cloud.DeleteFloatingIP(id)
+}
+
+func (c networkClient) GetFloatingIP(id string) (*floatingips.FloatingIP, error) {
+	return decode[floatingips.FloatingIP](c.cloud.GetFloatingIP(id))
+}
+
+func (c networkClient) UpdateFloatingIP(id string, opts floatingips.UpdateOptsBuilder) (*floatingips.FloatingIP, error) {
+	body, err := opts.ToFloatingIPUpdateMap()
+	req, err := requestBody(body, err, "floatingip")
+	if err != nil {
+		return nil, err
+	}
+	return decode[floatingips.FloatingIP](c.cloud.UpdateFloatingIP(id, req))
+}
+
+func (c networkClient) ListPort(opts ports.ListOptsBuilder) ([]ports.Port, error) {
+	query, err := listQuery(opts.ToPortListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[ports.Port](c.cloud.ListPorts(query))
+}
+
+func (c networkClient) CreatePort(opts ports.CreateOptsBuilder) (*ports.Port, error) {
+	body, err := opts.ToPortCreateMap()
+	req, err := requestBody(body, err, "port")
+	if err != nil {
+		return nil, err
+	}
+	return decode[ports.Port](c.cloud.CreatePort(req))
+}
+
+func (c networkClient) DeletePort(id string) error {
+	return c.cloud.DeletePort(id)
+}
+
+func (c networkClient) GetPort(id string) (*ports.Port, error) {
+	return decode[ports.Port](c.cloud.GetPort(id))
+}
+
+func (c networkClient) UpdatePort(id string, opts ports.UpdateOptsBuilder) (*ports.Port, error) {
+	body, err := opts.ToPortUpdateMap()
+	req, err := requestBody(body, err, "port")
+	if err != nil {
+		return nil, err
+	}
+	return decode[ports.Port](c.cloud.UpdatePort(id, req))
+}
+
+func (c networkClient) ListTrunk(opts trunks.ListOptsBuilder) ([]trunks.Trunk, error) {
+	query, err := listQuery(opts.ToTrunkListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[trunks.Trunk](c.cloud.ListTrunks(query))
+}
+
+func (c networkClient) CreateTrunk(opts trunks.CreateOptsBuilder) (*trunks.Trunk, error) {
+	body, err := opts.ToTrunkCreateMap()
+	req, err := requestBody(body, err, "trunk")
+	if err != nil {
+		return nil, err
+	}
+	return decode[trunks.Trunk](c.cloud.CreateTrunk(req))
+}
+
+func (c networkClient) DeleteTrunk(id string) error {
+	return c.cloud.DeleteTrunk(id)
+}
+
+func (c networkClient) ListTrunkSubports(trunkID string) ([]trunks.Subport, error) {
+	return decodeList[trunks.Subport](c.cloud.ListTrunkSubports(trunkID))
+}
+
+func (c networkClient) RemoveSubports(id string, opts trunks.RemoveSubportsOpts) error {
+	body, err := opts.ToTrunkRemoveSubportsMap()
+	if err != nil {
+		return err
+	}
+	_, err = c.cloud.RemoveSubports(id, body)
+	return err
+}
+
+func (c networkClient) ListRouter(opts routers.ListOpts) ([]routers.Router, error) {
+	query, err := listQuery(opts.ToRouterListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[routers.Router](c.cloud.ListRouters(query))
+}
+
+func (c networkClient) CreateRouter(opts routers.CreateOptsBuilder) (*routers.Router, error) {
+	body, err := opts.ToRouterCreateMap()
+	req, err := requestBody(body, err, "router")
+	if err != nil {
+		return nil, err
+	}
+	return decode[routers.Router](c.cloud.CreateRouter(req))
+}
+
+func (c networkClient) DeleteRouter(id string) error {
+	return c.cloud.DeleteRouter(id)
+}
+
+func (c networkClient) GetRouter(id string) (*routers.Router, error) {
+	return decode[routers.Router](c.cloud.GetRouter(id))
+}
+
+func (c networkClient) UpdateRouter(id string, opts routers.UpdateOptsBuilder) (*routers.Router, error) {
+	body, err := opts.ToRouterUpdateMap()
+	req, err := requestBody(body, err, "router")
+	if err != nil {
+		return nil, err
+	}
+	return decode[routers.Router](c.cloud.UpdateRouter(id, req))
+}
+
+func (c networkClient) AddRouterInterface(id string, opts routers.AddInterfaceOptsBuilder) (*routers.InterfaceInfo, error) {
+	body, err := opts.ToRouterAddInterfaceMap()
+	if err != nil {
+		return nil, err
+	}
+	return decode[routers.InterfaceInfo](c.cloud.AddRouterInterface(id, body))
+}
+
+func (c networkClient) RemoveRouterInterface(id string, opts routers.RemoveInterfaceOptsBuilder) (*routers.InterfaceInfo, error) {
+	body, err := opts.ToRouterRemoveInterfaceMap()
+	if err != nil {
+		return nil, err
+	}
+	return decode[routers.InterfaceInfo](c.cloud.RemoveRouterInterface(id, body))
+}
+
+func (c networkClient) ListSecGroup(opts groups.ListOpts) ([]groups.SecGroup, error) {
+	query, err := listQuery(buildQuery(opts))
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[groups.SecGroup](c.cloud.ListSecurityGroups(query))
+}
+
+func (c networkClient) CreateSecGroup(opts groups.CreateOptsBuilder) (*groups.SecGroup, error) {
+	body, err := opts.ToSecGroupCreateMap()
+	req, err := requestBody(body, err, "security_group")
+	if err != nil {
+		return nil, err
+	}
+	return decode[groups.SecGroup](c.cloud.CreateSecurityGroup(req))
+}
+
+func (c networkClient) DeleteSecGroup(id string) error {
+	return c.cloud.DeleteSecurityGroup(id)
+}
+
+func (c networkClient) GetSecGroup(id string) (*groups.SecGroup, error) {
+	return decode[groups.SecGroup](c.cloud.GetSecurityGroup(id))
+}
+
+func (c networkClient) UpdateSecGroup(id string, opts groups.UpdateOptsBuilder) (*groups.SecGroup, error) {
+	body, err := opts.ToSecGroupUpdateMap()
+	req, err := requestBody(body, err, "security_group")
+	if err != nil {
+		return nil, err
+	}
+	return decode[groups.SecGroup](c.cloud.UpdateSecurityGroup(id, req))
+}
+
+func (c networkClient) ListSecGroupRule(opts rules.ListOpts) ([]rules.SecGroupRule, error) {
+	query, err := listQuery(opts.ToSecGroupListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[rules.SecGroupRule](c.cloud.ListSecurityGroupRules(query))
+}
+
+func (c networkClient) CreateSecGroupRule(opts rules.CreateOptsBuilder) (*rules.SecGroupRule, error) {
+	body, err := opts.ToSecGroupRuleCreateMap()
+	req, err := requestBody(body, err, "security_group_rule")
+	if err != nil {
+		return nil, err
+	}
+	return decode[rules.SecGroupRule](c.cloud.CreateSecurityGroupRule(req))
+}
+
+func (c networkClient) DeleteSecGroupRule(id string) error {
+	return c.cloud.DeleteSecurityGroupRule(id)
+}
+
+func (c networkClient) GetSecGroupRule(id string) (*rules.SecGroupRule, error) {
+	return decode[rules.SecGroupRule](c.cloud.GetSecurityGroupRule(id))
+}
+
+func (c networkClient) ListNetwork(opts networks.ListOptsBuilder) ([]networks.Network, error) {
+	query, err := listQuery(opts.ToNetworkListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[networks.Network](c.cloud.ListNetworks(query))
+}
+
+func (c networkClient) CreateNetwork(opts networks.CreateOptsBuilder) (*networks.Network, error) {
+	body, err := opts.ToNetworkCreateMap()
+	req, err := requestBody(body, err, "network")
+	if err != nil {
+		return nil, err
+	}
+	return decode[networks.Network](c.cloud.CreateNetwork(req))
+}
+
+func (c networkClient) DeleteNetwork(id string) error {
+	return c.cloud.DeleteNetwork(id)
+}
+
+func (c networkClient) GetNetwork(id string) (*networks.Network, error) {
+	return decode[networks.Network](c.cloud.GetNetwork(id))
+}
+
+func (c networkClient) UpdateNetwork(id string, opts networks.UpdateOptsBuilder) (*networks.Network, error) {
+	body, err := opts.ToNetworkUpdateMap()
+	req, err := requestBody(body, err, "network")
+	if err != nil {
+		return nil, err
+	}
+	return decode[networks.Network](c.cloud.UpdateNetwork(id, req))
+}
+
+func (c networkClient) ListSubnet(opts subnets.ListOptsBuilder) ([]subnets.Subnet, error) {
+	query, err := listQuery(opts.ToSubnetListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[subnets.Subnet](c.cloud.ListSubnets(query))
+}
+
+func (c networkClient) CreateSubnet(opts subnets.CreateOptsBuilder) (*subnets.Subnet, error) {
+	body, err := opts.ToSubnetCreateMap()
+	req, err := requestBody(body, err, "subnet")
+	if err != nil {
+		return nil, err
+	}
+	return decode[subnets.Subnet](c.cloud.CreateSubnet(req))
+}
+
+func (c networkClient) DeleteSubnet(id string) error {
+	return c.cloud.DeleteSubnet(id)
+}
+
+func (c networkClient) GetSubnet(id string) (*subnets.Subnet, error) {
+	return decode[subnets.Subnet](c.cloud.GetSubnet(id))
+}
+
+func (c networkClient) UpdateSubnet(id string, opts subnets.UpdateOptsBuilder) (*subnets.Subnet, error) {
+	body, err := opts.ToSubnetUpdateMap()
+	req, err := requestBody(body, err, "subnet")
+	if err != nil {
+		return nil, err
+	}
+	return decode[subnets.Subnet](c.cloud.UpdateSubnet(id, req))
+}
+
+func (c networkClient) ListSubnetPool(opts subnetpools.ListOptsBuilder) ([]subnetpools.SubnetPool, error) {
+	query, err := listQuery(opts.ToSubnetPoolListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[subnetpools.SubnetPool](c.cloud.ListSubnetPools(query))
+}
+
+func (c networkClient) GetSubnetPool(id string) (*subnetpools.SubnetPool, error) {
+	return decode[subnetpools.SubnetPool](c.cloud.GetSubnetPool(id))
+}
+
+func (c networkClient) ListQoSPolicy(opts policies.PolicyListOptsBuilder) ([]policies.Policy, error) {
+	query, err := listQuery(opts.ToPolicyListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[policies.Policy](c.cloud.ListQoSPolicies(query))
+}
+
+func (c networkClient) GetQoSPolicy(id string) (*policies.Policy, error) {
+	return decode[policies.Policy](c.cloud.GetQoSPolicy(id))
+}
+
+func (c networkClient) ListExtensions() ([]extensions.Extension, error) {
+	return decodeList[extensions.Extension](c.cloud.ListNetworkExtensions(), nil)
+}
+
+func (c networkClient) GetQuotaDetail(projectID string) (*quotas.QuotaDetailSet, error) {
+	return decode[quotas.QuotaDetailSet](c.cloud.GetNetworkQuotaDetail(projectID))
+}
+
+func (c networkClient) ReplaceAllAttributesTags(resourceType string, resourceID string, opts attributestags.ReplaceAllOptsBuilder) ([]string, error) {
+	body, err := opts.ToAttributeTagsReplaceAllMap()
+	if err != nil {
+		return nil, err
+	}
+	return c.cloud.ReplaceAllTags(resourceType, resourceID, strs(body, "tags"))
+}
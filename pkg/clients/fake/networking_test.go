@@ -0,0 +1,290 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/dns"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/subnetpools"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+// createNetwork creates a network with a single IPv4 subnet.
+func createNetwork(g *WithT, c clients.NetworkClient, name, cidr string) (*networks.Network, *subnets.Subnet) {
+	network, err := c.CreateNetwork(networks.CreateOpts{Name: name})
+	g.Expect(err).NotTo(HaveOccurred())
+	subnet, err := c.CreateSubnet(subnets.CreateOpts{NetworkID: network.ID, Name: name, CIDR: cidr, IPVersion: 4})
+	g.Expect(err).NotTo(HaveOccurred())
+	return network, subnet
+}
+
+func Test_NetworkClient_Ports(t *testing.T) {
+	g := NewWithT(t)
+	c := NewNetworkClient(NewCloud())
+	network, subnet := createNetwork(g, c, "test", "10.0.0.0/24")
+
+	port, err := c.CreatePort(ports.CreateOpts{Name: "port-a", NetworkID: network.ID})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(port.FixedIPs).To(ConsistOf(ports.IP{SubnetID: subnet.ID, IPAddress: "10.0.0.2"}))
+	g.Expect(port.Status).To(Equal("DOWN"))
+	g.Expect(port.SecurityGroups).To(HaveLen(1), "port should be in the default security group")
+
+	_, err = c.CreatePort(ports.CreateOpts{
+		Name:      "port-b",
+		NetworkID: network.ID,
+		FixedIPs:  []ports.IP{{SubnetID: subnet.ID, IPAddress: "10.0.0.2"}},
+	})
+	g.Expect(capoerrors.IsConflict(err)).To(BeTrue(), "expected conflict for an address in use, got %v", err)
+
+	listed, err := c.ListPort(ports.ListOpts{Name: "port-a"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(listed).To(HaveLen(1))
+	g.Expect(listed[0].ID).To(Equal(port.ID))
+
+	listed, err = c.ListPort(ports.ListOpts{FixedIPs: []ports.FixedIPOpts{{IPAddress: "10.0.0.2"}}})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(listed).To(HaveLen(1))
+
+	err = c.DeleteNetwork(network.ID)
+	g.Expect(capoerrors.IsConflict(err)).To(BeTrue(), "expected conflict deleting a network with ports, got %v", err)
+
+	g.Expect(c.DeletePort(port.ID)).To(Succeed())
+	_, err = c.GetPort(port.ID)
+	g.Expect(capoerrors.IsNotFound(err)).To(BeTrue())
+
+	// The released address can be allocated again
+	port, err = c.CreatePort(ports.CreateOpts{NetworkID: network.ID})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(port.FixedIPs[0].IPAddress).To(Equal("10.0.0.2"))
+}
+
+func Test_NetworkClient_Tags(t *testing.T) {
+	g := NewWithT(t)
+	c := NewNetworkClient(NewCloud())
+	network, _ := createNetwork(g, c, "test", "10.0.0.0/24")
+
+	tags, err := c.ReplaceAllAttributesTags("networks", network.ID, attributestags.ReplaceAllOpts{Tags: []string{"a", "b"}})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tags).To(ConsistOf("a", "b"))
+
+	listed, err := c.ListNetwork(networks.ListOpts{Tags: "a,b"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(listed).To(HaveLen(1))
+
+	listed, err = c.ListNetwork(networks.ListOpts{Tags: "a,c"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(listed).To(BeEmpty())
+}
+
+func Test_NetworkClient_SecurityGroups(t *testing.T) {
+	g := NewWithT(t)
+	c := NewNetworkClient(NewCloud())
+
+	group, err := c.CreateSecGroup(groups.CreateOpts{Name: "test"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(group.Rules).To(HaveLen(2), "new security group should have default egress rules")
+
+	ruleOpts := rules.CreateOpts{
+		SecGroupID:   group.ID,
+		Direction:    rules.DirIngress,
+		EtherType:    rules.EtherType4,
+		Protocol:     rules.ProtocolTCP,
+		PortRangeMin: 22,
+		PortRangeMax: 22,
+	}
+	_, err = c.CreateSecGroupRule(ruleOpts)
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = c.CreateSecGroupRule(ruleOpts)
+	g.Expect(capoerrors.IsConflict(err)).To(BeTrue(), "expected conflict for a duplicate rule, got %v", err)
+
+	group, err = c.GetSecGroup(group.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(group.Rules).To(HaveLen(3))
+
+	g.Expect(c.DeleteSecGroup(group.ID)).To(Succeed())
+	listed, err := c.ListSecGroupRule(rules.ListOpts{SecGroupID: group.ID})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(listed).To(BeEmpty())
+}
+
+func Test_NetworkClient_FloatingIP(t *testing.T) {
+	g := NewWithT(t)
+	cloud := NewCloud(WithTransitionDelay(0))
+	c := NewNetworkClient(cloud)
+	externalNetworkID, err := cloud.AddExternalNetwork("external", "172.24.4.0/24")
+	g.Expect(err).NotTo(HaveOccurred())
+	network, subnet := createNetwork(g, c, "test", "10.0.0.0/24")
+	port, err := c.CreatePort(ports.CreateOpts{NetworkID: network.ID})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	fip, err := c.CreateFloatingIP(floatingips.CreateOpts{FloatingNetworkID: externalNetworkID})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(fip.FloatingIP).To(HavePrefix("172.24.4."))
+
+	// The port's subnet is not connected to the external network
+	_, err = c.UpdateFloatingIP(fip.ID, floatingips.UpdateOpts{PortID: &port.ID})
+	g.Expect(capoerrors.IsNotFound(err)).To(BeTrue(), "expected not found for an unreachable network, got %v", err)
+
+	router, err := c.CreateRouter(routers.CreateOpts{
+		Name:        "router",
+		GatewayInfo: &routers.GatewayInfo{NetworkID: externalNetworkID},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(router.GatewayInfo.ExternalFixedIPs).To(HaveLen(1))
+	_, err = c.AddRouterInterface(router.ID, routers.AddInterfaceOpts{SubnetID: subnet.ID})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	fip, err = c.UpdateFloatingIP(fip.ID, floatingips.UpdateOpts{PortID: &port.ID})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(fip.PortID).To(Equal(port.ID))
+	g.Expect(fip.FixedIP).To(Equal(port.FixedIPs[0].IPAddress))
+	g.Expect(fip.Status).To(Equal("ACTIVE"))
+
+	err = c.DeleteRouter(router.ID)
+	g.Expect(capoerrors.IsConflict(err)).To(BeTrue(), "expected conflict deleting a router with interfaces, got %v", err)
+
+	g.Expect(c.DeletePort(port.ID)).To(Succeed())
+	fip, err = c.GetFloatingIP(fip.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(fip.PortID).To(BeEmpty(), "deleting the port should disassociate the floating IP")
+}
+
+func Test_NetworkClient_SubnetPools(t *testing.T) {
+	g := NewWithT(t)
+	cloud := NewCloud()
+	c := NewNetworkClient(cloud)
+	network, err := c.CreateNetwork(networks.CreateOpts{Name: "test"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	subnetPoolID, err := cloud.AddSubnetPool("pool", []string{"fd00:10::/56"}, 64)
+	g.Expect(err).NotTo(HaveOccurred())
+	subnetPool, err := c.GetSubnetPool(subnetPoolID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(subnetPool.IPversion).To(Equal(6))
+	g.Expect(subnetPool.DefaultPrefixLen).To(Equal(64))
+
+	listed, err := c.ListSubnetPool(subnetpools.ListOpts{Name: "pool"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(listed).To(HaveLen(1))
+
+	// Subnets are allocated the first free prefix of the pool
+	subnet, err := c.CreateSubnet(subnets.CreateOpts{NetworkID: network.ID, SubnetPoolID: subnetPoolID, IPVersion: 6})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(subnet.CIDR).To(Equal("fd00:10::/64"))
+	g.Expect(subnet.SubnetPoolID).To(Equal(subnetPoolID))
+
+	other, err := c.CreateNetwork(networks.CreateOpts{Name: "other"})
+	g.Expect(err).NotTo(HaveOccurred())
+	subnet, err = c.CreateSubnet(subnets.CreateOpts{NetworkID: other.ID, SubnetPoolID: subnetPoolID, IPVersion: 6, Prefixlen: 72})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(subnet.CIDR).To(Equal("fd00:10:0:1::/72"))
+
+	_, err = c.CreateSubnet(subnets.CreateOpts{NetworkID: other.ID, SubnetPoolID: subnetPoolID, IPVersion: 4})
+	g.Expect(capoerrors.IsInvalidError(err)).To(BeTrue(), "expected bad request for an IP version mismatch, got %v", err)
+}
+
+func Test_NetworkClient_QoSPolicies(t *testing.T) {
+	g := NewWithT(t)
+	cloud := NewCloud()
+	c := NewNetworkClient(cloud)
+
+	qosPolicyID := cloud.AddQoSPolicy("qos")
+	listed, err := c.ListQoSPolicy(policies.ListOpts{Name: "qos"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(listed).To(HaveLen(1))
+	g.Expect(listed[0].ID).To(Equal(qosPolicyID))
+
+	network, err := c.CreateNetwork(policies.NetworkCreateOptsExt{
+		CreateOptsBuilder: networks.CreateOpts{Name: "test"},
+		QoSPolicyID:       qosPolicyID,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	got, err := cloud.GetNetwork(network.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got["qos_policy_id"]).To(Equal(qosPolicyID))
+
+	_, err = c.CreatePort(policies.PortCreateOptsExt{
+		CreateOptsBuilder: ports.CreateOpts{NetworkID: network.ID, Name: "port"},
+		QoSPolicyID:       "missing",
+	})
+	g.Expect(capoerrors.IsNotFound(err)).To(BeTrue(), "expected not found for an unknown QoS policy, got %v", err)
+
+	// A QoS policy can't be deleted while it is in use
+	g.Expect(cloud.DeleteQoSPolicy(qosPolicyID)).NotTo(Succeed())
+	g.Expect(c.DeleteNetwork(network.ID)).To(Succeed())
+	g.Expect(cloud.DeleteQoSPolicy(qosPolicyID)).To(Succeed())
+}
+
+func Test_NetworkClient_DNSIntegration(t *testing.T) {
+	g := NewWithT(t)
+
+	// DNS attributes are rejected when dns-integration is not enabled
+	cloud := NewCloud()
+	c := NewNetworkClient(cloud)
+	network, err := c.CreateNetwork(networks.CreateOpts{Name: "test"})
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = c.CreatePort(dns.PortCreateOptsExt{
+		CreateOptsBuilder: ports.CreateOpts{NetworkID: network.ID, Name: "port"},
+		DNSName:           "server",
+	})
+	g.Expect(err).To(HaveOccurred())
+
+	cloud = NewCloud(WithNetworkExtensions("dns-integration", "router", "external-net"))
+	c = NewNetworkClient(cloud)
+	network, err = c.CreateNetwork(networks.CreateOpts{Name: "test"})
+	g.Expect(err).NotTo(HaveOccurred())
+	port, err := c.CreatePort(dns.PortCreateOptsExt{
+		CreateOptsBuilder: ports.CreateOpts{NetworkID: network.ID, Name: "port"},
+		DNSName:           "server",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	got, err := cloud.GetPort(port.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got["dns_name"]).To(Equal("server"))
+	g.Expect(got["dns_domain"]).To(Equal(""))
+
+	externalNetworkID, err := cloud.AddExternalNetwork("external", "172.24.4.0/24")
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = c.CreateFloatingIP(dns.FloatingIPCreateOptsExt{
+		CreateOptsBuilder: floatingips.CreateOpts{FloatingNetworkID: externalNetworkID},
+		DNSName:           "api",
+	})
+	g.Expect(err).To(HaveOccurred(), "dns_name requires dns_domain on a floating IP")
+	fip, err := c.CreateFloatingIP(dns.FloatingIPCreateOptsExt{
+		CreateOptsBuilder: floatingips.CreateOpts{FloatingNetworkID: externalNetworkID},
+		DNSName:           "api",
+		DNSDomain:         "example.com.",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	gotFIP, err := cloud.GetFloatingIP(fip.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(gotFIP["dns_name"]).To(Equal("api"))
+	g.Expect(gotFIP["dns_domain"]).To(Equal("example.com."))
+}
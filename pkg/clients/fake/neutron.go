@@ -0,0 +1,1500 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+const (
+	deviceOwnerRouterInterface = "network:router_interface"
+	deviceOwnerRouterGateway   = "network:router_gateway"
+	deviceOwnerFloatingIP      = "network:floatingip"
+	deviceOwnerOctavia         = "Octavia"
+	deviceOwnerComputePrefix   = "compute:"
+
+	defaultSecurityGroupName = "default"
+)
+
+// neutronTagResources maps the resource types used by the Neutron tags API to
+// the kinds stored in the Cloud.
+var neutronTagResources = map[string]string{
+	"networks":        kindNetwork,
+	"subnets":         kindSubnet,
+	"ports":           kindPort,
+	"routers":         kindRouter,
+	"security-groups": kindSecurityGroup,
+	"floatingips":     kindFloatingIP,
+	"trunks":          kindTrunk,
+}
+
+func (c *Cloud) newNeutronObject(req Object) Object {
+	ts := now()
+	obj := Object{
+		"description":     "",
+		"tags":            []any{},
+		"tenant_id":       c.projectID,
+		"project_id":      c.projectID,
+		"created_at":      ts,
+		"updated_at":      ts,
+		"revision_number": 1,
+	}
+	for k, v := range req {
+		obj[k] = v
+	}
+	delete(obj, "id")
+	return obj
+}
+
+// update merges req into the stored resource, skipping immutable fields.
+func update(obj, req Object, immutable ...string) {
+	for k, v := range req {
+		if k == "id" || slices.Contains(immutable, k) {
+			continue
+		}
+		obj[k] = v
+	}
+	obj["updated_at"] = now()
+	obj["revision_number"] = num(obj, "revision_number") + 1
+}
+
+func (c *Cloud) listResources(kind string, query url.Values, view func(Object) Object) ([]Object, error) {
+	filter, err := queryFilter(query)
+	if err != nil {
+		return nil, err
+	}
+	var out []Object
+	for _, obj := range c.list(kind, nil) {
+		if view != nil {
+			obj = view(obj)
+		}
+		if filter(obj) {
+			out = append(out, copyObject(obj))
+		}
+	}
+	return out, nil
+}
+
+func (c *Cloud) getResource(kind, id string, view func(Object) Object) (Object, error) {
+	obj, ok := c.observe(kind, id)
+	if !ok {
+		return nil, notFound(kind, id)
+	}
+	if view != nil {
+		obj = view(obj)
+	}
+	return copyObject(obj), nil
+}
+
+// Networks
+
+// CreateNetwork creates a network from the body of a Neutron create network
+// request.
+func (c *Cloud) CreateNetwork(req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.createNetwork(req)
+}
+
+func (c *Cloud) createNetwork(req Object) (Object, error) {
+	if err := c.checkQoSPolicy(req); err != nil {
+		return nil, err
+	}
+	obj := c.newNeutronObject(req)
+	setDefault(obj, "name", "")
+	setDefault(obj, "admin_state_up", true)
+	setDefault(obj, "shared", false)
+	setDefault(obj, "router:external", false)
+	setDefault(obj, "port_security_enabled", true)
+	setDefault(obj, "mtu", 1500)
+	setDefault(obj, "availability_zone_hints", []any{})
+	setDefault(obj, "qos_policy_id", nil)
+	obj["status"] = "ACTIVE"
+	c.add(kindNetwork, obj)
+	return copyObject(c.networkView(obj)), nil
+}
+
+func (c *Cloud) networkView(obj Object) Object {
+	obj = copyObject(obj)
+	var subnets []any
+	for _, subnet := range c.find(kindSubnet, func(o Object) bool { return str(o, "network_id") == str(obj, "id") }) {
+		subnets = append(subnets, str(subnet, "id"))
+	}
+	obj["subnets"] = append([]any{}, subnets...)
+	return obj
+}
+
+// GetNetwork returns a network.
+func (c *Cloud) GetNetwork(id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.getResource(kindNetwork, id, c.networkView)
+}
+
+// ListNetworks returns the networks matching a Neutron list query.
+func (c *Cloud) ListNetworks(query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.listResources(kindNetwork, query, c.networkView)
+}
+
+// UpdateNetwork updates a network from the body of a Neutron update network
+// request.
+func (c *Cloud) UpdateNetwork(id string, req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindNetwork, id)
+	if !ok {
+		return nil, notFound(kindNetwork, id)
+	}
+	if err := c.checkQoSPolicy(req); err != nil {
+		return nil, err
+	}
+	update(obj, req, "status", "subnets")
+	return copyObject(c.networkView(obj)), nil
+}
+
+// DeleteNetwork deletes a network and its subnets. It fails if any port other
+// than a DHCP port is still attached to the network.
+func (c *Cloud) DeleteNetwork(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.observe(kindNetwork, id); !ok {
+		return notFound(kindNetwork, id)
+	}
+	if len(c.find(kindPort, func(o Object) bool { return str(o, "network_id") == id })) > 0 {
+		return conflict(fmt.Sprintf("Unable to complete operation on network %s. There are one or more ports still in use on the network.", id))
+	}
+	for _, subnet := range c.find(kindSubnet, func(o Object) bool { return str(o, "network_id") == id }) {
+		c.removeSubnet(str(subnet, "id"))
+	}
+	c.remove(kindNetwork, id)
+	return nil
+}
+
+// Subnets
+
+// CreateSubnet creates a subnet from the body of a Neutron create subnet
+// request.
+func (c *Cloud) CreateSubnet(req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.createSubnet(req)
+}
+
+func (c *Cloud) createSubnet(req Object) (Object, error) {
+	networkID := str(req, "network_id")
+	if _, ok := c.lookup(kindNetwork, networkID); !ok {
+		return nil, notFound(kindNetwork, networkID)
+	}
+	ipVersion := num(req, "ip_version")
+	if ipVersion == 0 {
+		ipVersion = 4
+	}
+	var prefix netip.Prefix
+	if subnetPoolID := str(req, "subnetpool_id"); subnetPoolID != "" && str(req, "cidr") == "" {
+		var err error
+		prefix, err = c.allocateSubnetPoolPrefix(subnetPoolID, ipVersion, num(req, "prefixlen"))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		prefix, err = netip.ParsePrefix(str(req, "cidr"))
+		if err != nil {
+			return nil, badRequest(fmt.Sprintf("Invalid input for cidr. Reason: '%s' is not a valid IP subnet.", str(req, "cidr")))
+		}
+	}
+	if (ipVersion == 4) != prefix.Addr().Is4() {
+		return nil, badRequest(fmt.Sprintf("Cidr %s does not match ip_version %d", prefix, ipVersion))
+	}
+	for _, other := range c.find(kindSubnet, func(o Object) bool { return str(o, "network_id") == networkID }) {
+		otherPrefix, err := netip.ParsePrefix(str(other, "cidr"))
+		if err == nil && otherPrefix.Overlaps(prefix) {
+			return nil, badRequest(fmt.Sprintf("Invalid input for operation: Requested subnet with cidr: %s for network: %s overlaps with another subnet.", prefix, networkID))
+		}
+	}
+
+	obj := c.newNeutronObject(req)
+	delete(obj, "prefixlen")
+	obj["cidr"] = prefix.Masked().String()
+	obj["ip_version"] = ipVersion
+	setDefault(obj, "name", "")
+	setDefault(obj, "enable_dhcp", true)
+	setDefault(obj, "dns_nameservers", []any{})
+	setDefault(obj, "host_routes", []any{})
+	setDefault(obj, "ipv6_address_mode", "")
+	setDefault(obj, "ipv6_ra_mode", "")
+	setDefault(obj, "subnetpool_id", "")
+	if _, ok := req["gateway_ip"]; !ok {
+		obj["gateway_ip"] = defaultGateway(prefix)
+	} else if obj["gateway_ip"] == nil {
+		obj["gateway_ip"] = ""
+	}
+	if len(objects(obj, "allocation_pools")) == 0 {
+		obj["allocation_pools"] = []any{defaultAllocationPool(prefix, str(obj, "gateway_ip"))}
+	}
+	c.add(kindSubnet, obj)
+	return copyObject(obj), nil
+}
+
+// GetSubnet returns a subnet.
+func (c *Cloud) GetSubnet(id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.getResource(kindSubnet, id, nil)
+}
+
+// ListSubnets returns the subnets matching a Neutron list query.
+func (c *Cloud) ListSubnets(query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.listResources(kindSubnet, query, nil)
+}
+
+// UpdateSubnet updates a subnet from the body of a Neutron update subnet
+// request.
+func (c *Cloud) UpdateSubnet(id string, req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindSubnet, id)
+	if !ok {
+		return nil, notFound(kindSubnet, id)
+	}
+	update(obj, req, "network_id", "cidr", "ip_version")
+	return copyObject(obj), nil
+}
+
+// DeleteSubnet deletes a subnet. It fails if any port still has an address
+// allocated from the subnet.
+func (c *Cloud) DeleteSubnet(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.observe(kindSubnet, id); !ok {
+		return notFound(kindSubnet, id)
+	}
+	if len(c.portsOnSubnet(id)) > 0 {
+		return conflict(fmt.Sprintf("Unable to complete operation on subnet %s: One or more ports have an IP allocation from this subnet.", id))
+	}
+	c.removeSubnet(id)
+	return nil
+}
+
+func (c *Cloud) removeSubnet(id string) {
+	delete(c.allocations, id)
+	c.remove(kindSubnet, id)
+}
+
+func (c *Cloud) portsOnSubnet(subnetID string) []Object {
+	return c.find(kindPort, func(o Object) bool {
+		for _, ip := range objects(o, "fixed_ips") {
+			if str(ip, "subnet_id") == subnetID {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// Subnet pools
+
+// CreateSubnetPool creates a subnet pool from the body of a Neutron create
+// subnet pool request.
+func (c *Cloud) CreateSubnetPool(req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.createSubnetPool(req)
+}
+
+func (c *Cloud) createSubnetPool(req Object) (Object, error) {
+	prefixes := strs(req, "prefixes")
+	if len(prefixes) == 0 {
+		return nil, badRequest("Invalid input for prefixes. Reason: A subnet pool requires at least one prefix.")
+	}
+	ipVersion := 0
+	for _, p := range prefixes {
+		prefix, err := netip.ParsePrefix(p)
+		if err != nil {
+			return nil, badRequest(fmt.Sprintf("Invalid input for prefixes. Reason: '%s' is not a valid IP subnet.", p))
+		}
+		version := 4
+		if !prefix.Addr().Is4() {
+			version = 6
+		}
+		if ipVersion != 0 && version != ipVersion {
+			return nil, badRequest("Illegal subnetpool association: subnetpool prefixes must be of the same IP version.")
+		}
+		ipVersion = version
+	}
+
+	obj := c.newNeutronObject(req)
+	obj["ip_version"] = ipVersion
+	setDefault(obj, "name", "")
+	setDefault(obj, "shared", false)
+	setDefault(obj, "is_default", false)
+	setDefault(obj, "address_scope_id", nil)
+	setDefault(obj, "min_prefixlen", map[int]int{4: 8, 6: 64}[ipVersion])
+	setDefault(obj, "max_prefixlen", map[int]int{4: 32, 6: 128}[ipVersion])
+	setDefault(obj, "default_prefixlen", obj["min_prefixlen"])
+	c.add(kindSubnetPool, obj)
+	return copyObject(obj), nil
+}
+
+// GetSubnetPool returns a subnet pool.
+func (c *Cloud) GetSubnetPool(id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.getResource(kindSubnetPool, id, nil)
+}
+
+// ListSubnetPools returns the subnet pools matching a Neutron list query.
+func (c *Cloud) ListSubnetPools(query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.listResources(kindSubnetPool, query, nil)
+}
+
+// DeleteSubnetPool deletes a subnet pool. It fails if a subnet was allocated
+// from the pool.
+func (c *Cloud) DeleteSubnetPool(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.observe(kindSubnetPool, id); !ok {
+		return notFound(kindSubnetPool, id)
+	}
+	if len(c.find(kindSubnet, func(o Object) bool { return str(o, "subnetpool_id") == id })) > 0 {
+		return conflict(fmt.Sprintf("Subnet pool %s could not be deleted: it has one or more subnets.", id))
+	}
+	c.remove(kindSubnetPool, id)
+	return nil
+}
+
+// allocateSubnetPoolPrefix returns the first prefix of the given length in a
+// subnet pool which does not overlap a subnet allocated from the pool.
+func (c *Cloud) allocateSubnetPoolPrefix(subnetPoolID string, ipVersion, prefixLen int) (netip.Prefix, error) {
+	pool, ok := c.lookup(kindSubnetPool, subnetPoolID)
+	if !ok {
+		return netip.Prefix{}, notFound(kindSubnetPool, subnetPoolID)
+	}
+	if num(pool, "ip_version") != ipVersion {
+		return netip.Prefix{}, badRequest(fmt.Sprintf("Cannot allocate IPv%d subnet from IPv%d subnet pool", ipVersion, num(pool, "ip_version")))
+	}
+	if prefixLen == 0 {
+		prefixLen = num(pool, "default_prefixlen")
+	}
+	if prefixLen < num(pool, "min_prefixlen") || prefixLen > num(pool, "max_prefixlen") {
+		return netip.Prefix{}, badRequest(fmt.Sprintf("Illegal prefix bounds: prefixlen=%d, min_prefixlen=%d, max_prefixlen=%d.", prefixLen, num(pool, "min_prefixlen"), num(pool, "max_prefixlen")))
+	}
+
+	var allocated []netip.Prefix
+	for _, subnet := range c.find(kindSubnet, func(o Object) bool { return str(o, "subnetpool_id") == subnetPoolID }) {
+		if prefix, err := netip.ParsePrefix(str(subnet, "cidr")); err == nil {
+			allocated = append(allocated, prefix)
+		}
+	}
+	for _, p := range strs(pool, "prefixes") {
+		poolPrefix, err := netip.ParsePrefix(p)
+		if err != nil || poolPrefix.Bits() > prefixLen {
+			continue
+		}
+		last := lastAddr(poolPrefix)
+		for addr := poolPrefix.Masked().Addr(); addr.IsValid() && addr.Compare(last) <= 0; {
+			candidate := netip.PrefixFrom(addr, prefixLen)
+			if !slices.ContainsFunc(allocated, candidate.Overlaps) {
+				return candidate, nil
+			}
+			addr = lastAddr(candidate).Next()
+		}
+	}
+	return netip.Prefix{}, conflict(fmt.Sprintf("Insufficient prefix space to allocate subnet size /%d.", prefixLen))
+}
+
+// AddSubnetPool creates a subnet pool with the given prefixes, from which
+// subnets of defaultPrefixLen are allocated by default. It returns the ID of
+// the subnet pool.
+func (c *Cloud) AddSubnetPool(name string, prefixes []string, defaultPrefixLen int) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	values := make([]any, 0, len(prefixes))
+	for _, p := range prefixes {
+		values = append(values, p)
+	}
+	pool, err := c.createSubnetPool(Object{"name": name, "prefixes": values, "default_prefixlen": defaultPrefixLen})
+	if err != nil {
+		return "", err
+	}
+	return str(pool, "id"), nil
+}
+
+// QoS policies
+
+// CreateQoSPolicy creates a QoS policy from the body of a Neutron create QoS
+// policy request.
+func (c *Cloud) CreateQoSPolicy(req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.createQoSPolicy(req), nil
+}
+
+func (c *Cloud) createQoSPolicy(req Object) Object {
+	obj := c.newNeutronObject(req)
+	setDefault(obj, "name", "")
+	setDefault(obj, "shared", false)
+	setDefault(obj, "is_default", false)
+	setDefault(obj, "rules", []any{})
+	c.add(kindQoSPolicy, obj)
+	return copyObject(obj)
+}
+
+// GetQoSPolicy returns a QoS policy.
+func (c *Cloud) GetQoSPolicy(id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.getResource(kindQoSPolicy, id, nil)
+}
+
+// ListQoSPolicies returns the QoS policies matching a Neutron list query.
+func (c *Cloud) ListQoSPolicies(query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.listResources(kindQoSPolicy, query, nil)
+}
+
+// DeleteQoSPolicy deletes a QoS policy. It fails if the policy is applied to
+// a network or a port.
+func (c *Cloud) DeleteQoSPolicy(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.observe(kindQoSPolicy, id); !ok {
+		return notFound(kindQoSPolicy, id)
+	}
+	inUse := func(o Object) bool { return str(o, "qos_policy_id") == id }
+	if len(c.find(kindNetwork, inUse)) > 0 || len(c.find(kindPort, inUse)) > 0 {
+		return conflict(fmt.Sprintf("QoS Policy %s is used by network or port.", id))
+	}
+	c.remove(kindQoSPolicy, id)
+	return nil
+}
+
+// checkQoSPolicy returns an error if a request sets a QoS policy which does
+// not exist.
+func (c *Cloud) checkQoSPolicy(req Object) error {
+	if id := str(req, "qos_policy_id"); id != "" {
+		if _, ok := c.lookup(kindQoSPolicy, id); !ok {
+			return notFound(kindQoSPolicy, id)
+		}
+	}
+	return nil
+}
+
+// AddQoSPolicy creates a QoS policy with the given name. It returns the ID of
+// the QoS policy.
+func (c *Cloud) AddQoSPolicy(name string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return str(c.createQoSPolicy(Object{"name": name}), "id")
+}
+
+// Ports
+
+// CreatePort creates a port from the body of a Neutron create port request.
+func (c *Cloud) CreatePort(req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	port, err := c.createPort(req)
+	if err != nil {
+		return nil, err
+	}
+	return copyObject(c.portView(port)), nil
+}
+
+func (c *Cloud) createPort(req Object) (Object, error) {
+	networkID := str(req, "network_id")
+	network, ok := c.lookup(kindNetwork, networkID)
+	if !ok {
+		return nil, notFound(kindNetwork, networkID)
+	}
+	if err := c.checkQuota(QuotaPorts, 1); err != nil {
+		return nil, err
+	}
+	if err := c.checkQoSPolicy(req); err != nil {
+		return nil, err
+	}
+	if err := c.checkDNSAttributes(req); err != nil {
+		return nil, err
+	}
+
+	obj := c.newNeutronObject(req)
+	setDefault(obj, "name", "")
+	setDefault(obj, "admin_state_up", true)
+	setDefault(obj, "device_id", "")
+	setDefault(obj, "device_owner", "")
+	setDefault(obj, "allowed_address_pairs", []any{})
+	setDefault(obj, "port_security_enabled", boolOr(network, "port_security_enabled", true))
+	setDefault(obj, "binding:host_id", "")
+	setDefault(obj, "binding:vnic_type", "normal")
+	setDefault(obj, "binding:profile", Object{})
+	setDefault(obj, "binding:vif_type", "unbound")
+	setDefault(obj, "binding:vif_details", Object{})
+	setDefault(obj, "propagate_uplink_status", false)
+	setDefault(obj, "qos_policy_id", nil)
+	c.setDNSDefaults(obj)
+	if str(obj, "mac_address") == "" {
+		obj["mac_address"] = c.newMAC()
+	}
+	if _, ok := req["security_groups"]; !ok {
+		obj["security_groups"] = []any{}
+		if boolOr(obj, "port_security_enabled", true) && !strings.HasPrefix(str(obj, "device_owner"), "network:") {
+			obj["security_groups"] = []any{c.defaultSecurityGroupID()}
+		}
+	}
+	for _, sg := range strs(obj, "security_groups") {
+		if _, ok := c.lookup(kindSecurityGroup, sg); !ok {
+			return nil, notFound(kindSecurityGroup, sg)
+		}
+	}
+
+	fixedIPs, err := c.allocateFixedIPs(networkID, req["fixed_ips"], objects(req, "fixed_ips"))
+	if err != nil {
+		return nil, err
+	}
+	obj["fixed_ips"] = fixedIPs
+	c.add(kindPort, obj)
+	return obj, nil
+}
+
+// allocateFixedIPs allocates addresses for a port on network. If no fixed IPs
+// are requested it allocates one address from the first IPv4 subnet and one
+// from every IPv6 subnet, as Neutron does.
+func (c *Cloud) allocateFixedIPs(networkID string, raw any, requested []Object) ([]any, error) {
+	if raw == nil {
+		subnets := c.find(kindSubnet, func(o Object) bool { return str(o, "network_id") == networkID })
+		haveV4 := false
+		for _, subnet := range subnets {
+			if num(subnet, "ip_version") == 4 {
+				if haveV4 {
+					continue
+				}
+				haveV4 = true
+			}
+			requested = append(requested, Object{"subnet_id": str(subnet, "id")})
+		}
+	}
+
+	var fixedIPs []any
+	release := func() {
+		for _, ip := range fixedIPs {
+			ip := ip.(Object)
+			c.releaseIP(str(ip, "subnet_id"), str(ip, "ip_address"))
+		}
+	}
+	for _, req := range requested {
+		var subnet Object
+		if subnetID := str(req, "subnet_id"); subnetID != "" {
+			var ok bool
+			subnet, ok = c.lookup(kindSubnet, subnetID)
+			if !ok || str(subnet, "network_id") != networkID {
+				release()
+				return nil, badRequest(fmt.Sprintf("Invalid input for operation: Failed to create port on network %s, because fixed_ips included invalid subnet %s.", networkID, subnetID))
+			}
+		} else {
+			subnet = c.subnetContaining(networkID, str(req, "ip_address"))
+			if subnet == nil {
+				release()
+				return nil, badRequest(fmt.Sprintf("Invalid input for operation: IP address %s is not a valid IP for any subnet on network %s.", str(req, "ip_address"), networkID))
+			}
+		}
+		ip, err := c.allocateIP(subnet, str(req, "ip_address"))
+		if err != nil {
+			release()
+			return nil, err
+		}
+		fixedIPs = append(fixedIPs, Object{"subnet_id": str(subnet, "id"), "ip_address": ip})
+	}
+	return append([]any{}, fixedIPs...), nil
+}
+
+func (c *Cloud) releaseFixedIPs(port Object) {
+	for _, ip := range objects(port, "fixed_ips") {
+		c.releaseIP(str(ip, "subnet_id"), str(ip, "ip_address"))
+	}
+}
+
+// portView reports a port bound to an active server or router as ACTIVE.
+func (c *Cloud) portView(obj Object) Object {
+	obj = copyObject(obj)
+	status := "DOWN"
+	owner := str(obj, "device_owner")
+	switch {
+	case strings.HasPrefix(owner, deviceOwnerComputePrefix):
+		if server, ok := c.lookup(kindServer, str(obj, "device_id")); ok && str(server, "status") == serverStatusActive {
+			status = "ACTIVE"
+		}
+	case owner == deviceOwnerRouterInterface, owner == deviceOwnerRouterGateway, owner == deviceOwnerOctavia:
+		status = "ACTIVE"
+	}
+	obj["status"] = status
+	return obj
+}
+
+// GetPort returns a port.
+func (c *Cloud) GetPort(id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.getResource(kindPort, id, c.portView)
+}
+
+// ListPorts returns the ports matching a Neutron list query.
+func (c *Cloud) ListPorts(query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.listResources(kindPort, query, c.portView)
+}
+
+// UpdatePort updates a port from the body of a Neutron update port request.
+func (c *Cloud) UpdatePort(id string, req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindPort, id)
+	if !ok {
+		return nil, notFound(kindPort, id)
+	}
+	if err := c.checkQoSPolicy(req); err != nil {
+		return nil, err
+	}
+	for _, sg := range strs(req, "security_groups") {
+		if _, ok := c.lookup(kindSecurityGroup, sg); !ok {
+			return nil, notFound(kindSecurityGroup, sg)
+		}
+	}
+	if raw, ok := req["fixed_ips"]; ok {
+		old := obj["fixed_ips"]
+		c.releaseFixedIPs(obj)
+		fixedIPs, err := c.allocateFixedIPs(str(obj, "network_id"), raw, objects(req, "fixed_ips"))
+		if err != nil {
+			// Restore the previous allocations
+			obj["fixed_ips"] = old
+			for _, ip := range objects(obj, "fixed_ips") {
+				if subnet, ok := c.lookup(kindSubnet, str(ip, "subnet_id")); ok {
+					_, _ = c.allocateIP(subnet, str(ip, "ip_address"))
+				}
+			}
+			return nil, err
+		}
+		req = copyObject(req)
+		req["fixed_ips"] = fixedIPs
+	}
+	update(obj, req, "network_id", "status")
+	return copyObject(c.portView(obj)), nil
+}
+
+// DeletePort deletes a port, releasing its addresses and disassociating any
+// floating IP from it. It fails if the port is the parent of a trunk.
+func (c *Cloud) DeletePort(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	port, ok := c.observe(kindPort, id)
+	if !ok {
+		return notFound(kindPort, id)
+	}
+	if trunks := c.find(kindTrunk, func(o Object) bool { return str(o, "port_id") == id }); len(trunks) > 0 {
+		return conflict(fmt.Sprintf("Port %s is currently a parent port for trunk %s.", id, str(trunks[0], "id")))
+	}
+	c.deletePort(port)
+	return nil
+}
+
+func (c *Cloud) deletePort(port Object) {
+	id := str(port, "id")
+	for _, fip := range c.find(kindFloatingIP, func(o Object) bool { return str(o, "port_id") == id }) {
+		c.disassociateFloatingIP(fip)
+	}
+	for _, trunk := range c.find(kindTrunk, func(o Object) bool { return true }) {
+		trunk["sub_ports"] = subportsWithout(trunk, id)
+	}
+	c.releaseFixedIPs(port)
+	delete(c.novaOwnedPorts, id)
+	c.remove(kindPort, id)
+}
+
+// Security groups
+
+func (c *Cloud) defaultSecurityGroupID() string {
+	groups := c.find(kindSecurityGroup, func(o Object) bool { return str(o, "name") == defaultSecurityGroupName })
+	if len(groups) > 0 {
+		return str(groups[0], "id")
+	}
+	group := c.createSecurityGroup(Object{"name": defaultSecurityGroupName, "description": "Default security group"})
+	for _, ethertype := range []string{"IPv4", "IPv6"} {
+		c.add(kindSecurityGroupRule, c.newSecurityGroupRule(Object{
+			"security_group_id": str(group, "id"),
+			"direction":         "ingress",
+			"ethertype":         ethertype,
+			"remote_group_id":   str(group, "id"),
+		}))
+	}
+	return str(group, "id")
+}
+
+// CreateSecurityGroup creates a security group from the body of a Neutron
+// create security group request. As in Neutron, the new group allows all
+// egress traffic.
+func (c *Cloud) CreateSecurityGroup(req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return copyObject(c.securityGroupView(c.createSecurityGroup(req))), nil
+}
+
+func (c *Cloud) createSecurityGroup(req Object) Object {
+	obj := c.newNeutronObject(req)
+	setDefault(obj, "name", "")
+	setDefault(obj, "stateful", true)
+	delete(obj, "security_group_rules")
+	c.add(kindSecurityGroup, obj)
+	for _, ethertype := range []string{"IPv4", "IPv6"} {
+		c.add(kindSecurityGroupRule, c.newSecurityGroupRule(Object{
+			"security_group_id": str(obj, "id"),
+			"direction":         "egress",
+			"ethertype":         ethertype,
+		}))
+	}
+	return obj
+}
+
+func (c *Cloud) securityGroupView(obj Object) Object {
+	obj = copyObject(obj)
+	rules := []any{}
+	for _, rule := range c.find(kindSecurityGroupRule, func(o Object) bool { return str(o, "security_group_id") == str(obj, "id") }) {
+		rules = append(rules, copyObject(rule))
+	}
+	obj["security_group_rules"] = rules
+	return obj
+}
+
+// GetSecurityGroup returns a security group and its rules.
+func (c *Cloud) GetSecurityGroup(id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.getResource(kindSecurityGroup, id, c.securityGroupView)
+}
+
+// ListSecurityGroups returns the security groups matching a Neutron list query.
+func (c *Cloud) ListSecurityGroups(query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.listResources(kindSecurityGroup, query, c.securityGroupView)
+}
+
+// UpdateSecurityGroup updates a security group from the body of a Neutron
+// update security group request.
+func (c *Cloud) UpdateSecurityGroup(id string, req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindSecurityGroup, id)
+	if !ok {
+		return nil, notFound(kindSecurityGroup, id)
+	}
+	update(obj, req, "security_group_rules")
+	return copyObject(c.securityGroupView(obj)), nil
+}
+
+// DeleteSecurityGroup deletes a security group and its rules, and any rule of
+// another group which references it. It fails if the group is used by a port.
+func (c *Cloud) DeleteSecurityGroup(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.observe(kindSecurityGroup, id); !ok {
+		return notFound(kindSecurityGroup, id)
+	}
+	if ports := c.find(kindPort, func(o Object) bool { return slices.Contains(strs(o, "security_groups"), id) }); len(ports) > 0 {
+		return conflict(fmt.Sprintf("Security Group %s in use.", id))
+	}
+	for _, rule := range c.find(kindSecurityGroupRule, func(o Object) bool {
+		return str(o, "security_group_id") == id || str(o, "remote_group_id") == id
+	}) {
+		c.remove(kindSecurityGroupRule, str(rule, "id"))
+	}
+	c.remove(kindSecurityGroup, id)
+	return nil
+}
+
+func (c *Cloud) newSecurityGroupRule(req Object) Object {
+	obj := c.newNeutronObject(req)
+	setDefault(obj, "ethertype", "IPv4")
+	for _, key := range []string{"protocol", "port_range_min", "port_range_max", "remote_ip_prefix", "remote_group_id", "remote_address_group_id"} {
+		setDefault(obj, key, nil)
+	}
+	return obj
+}
+
+// CreateSecurityGroupRule creates a security group rule from the body of a
+// Neutron create security group rule request. It fails if an identical rule
+// already exists.
+func (c *Cloud) CreateSecurityGroupRule(req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	groupID := str(req, "security_group_id")
+	if _, ok := c.lookup(kindSecurityGroup, groupID); !ok {
+		return nil, notFound(kindSecurityGroup, groupID)
+	}
+	if remote := str(req, "remote_group_id"); remote != "" {
+		if _, ok := c.lookup(kindSecurityGroup, remote); !ok {
+			return nil, notFound(kindSecurityGroup, remote)
+		}
+	}
+	obj := c.newSecurityGroupRule(req)
+	for _, existing := range c.find(kindSecurityGroupRule, func(o Object) bool { return str(o, "security_group_id") == groupID }) {
+		if sameRule(existing, obj) {
+			return nil, conflict(fmt.Sprintf("Security group rule already exists. Rule id is %s.", str(existing, "id")))
+		}
+	}
+	c.add(kindSecurityGroupRule, obj)
+	return copyObject(obj), nil
+}
+
+func sameRule(a, b Object) bool {
+	for _, key := range []string{"direction", "ethertype", "protocol", "port_range_min", "port_range_max", "remote_ip_prefix", "remote_group_id", "remote_address_group_id"} {
+		if fmt.Sprint(a[key]) != fmt.Sprint(b[key]) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetSecurityGroupRule returns a security group rule.
+func (c *Cloud) GetSecurityGroupRule(id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.getResource(kindSecurityGroupRule, id, nil)
+}
+
+// ListSecurityGroupRules returns the security group rules matching a Neutron
+// list query.
+func (c *Cloud) ListSecurityGroupRules(query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.listResources(kindSecurityGroupRule, query, nil)
+}
+
+// DeleteSecurityGroupRule deletes a security group rule.
+func (c *Cloud) DeleteSecurityGroupRule(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.observe(kindSecurityGroupRule, id); !ok {
+		return notFound(kindSecurityGroupRule, id)
+	}
+	c.remove(kindSecurityGroupRule, id)
+	return nil
+}
+
+// Routers
+
+// CreateRouter creates a router from the body of a Neutron create router
+// request, allocating an address on the external network if a gateway is
+// given.
+func (c *Cloud) CreateRouter(req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj := c.newNeutronObject(req)
+	setDefault(obj, "name", "")
+	setDefault(obj, "admin_state_up", true)
+	setDefault(obj, "routes", []any{})
+	setDefault(obj, "distributed", false)
+	setDefault(obj, "ha", false)
+	obj["status"] = "ACTIVE"
+	delete(obj, "external_gateway_info")
+	c.add(kindRouter, obj)
+	if gw, ok := req["external_gateway_info"].(Object); ok {
+		if err := c.setRouterGateway(obj, gw); err != nil {
+			c.remove(kindRouter, str(obj, "id"))
+			return nil, err
+		}
+	}
+	return copyObject(obj), nil
+}
+
+func (c *Cloud) setRouterGateway(router, gw Object) error {
+	routerID := str(router, "id")
+	networkID := str(gw, "network_id")
+	network, ok := c.lookup(kindNetwork, networkID)
+	if !ok {
+		return notFound(kindNetwork, networkID)
+	}
+	if !boolOr(network, "router:external", false) {
+		return badRequest(fmt.Sprintf("Bad router request: Network %s is not an external network.", networkID))
+	}
+	c.clearRouterGateway(router)
+	port, err := c.createPort(Object{
+		"network_id":      networkID,
+		"device_id":       routerID,
+		"device_owner":    deviceOwnerRouterGateway,
+		"security_groups": []any{},
+		"fixed_ips":       gw["external_fixed_ips"],
+	})
+	if err != nil {
+		return err
+	}
+	router["external_gateway_info"] = Object{
+		"network_id":         networkID,
+		"enable_snat":        boolOr(gw, "enable_snat", true),
+		"external_fixed_ips": port["fixed_ips"],
+	}
+	return nil
+}
+
+func (c *Cloud) clearRouterGateway(router Object) {
+	routerID := str(router, "id")
+	for _, port := range c.find(kindPort, func(o Object) bool {
+		return str(o, "device_id") == routerID && str(o, "device_owner") == deviceOwnerRouterGateway
+	}) {
+		c.deletePort(port)
+	}
+	router["external_gateway_info"] = nil
+}
+
+// GetRouter returns a router.
+func (c *Cloud) GetRouter(id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.getResource(kindRouter, id, nil)
+}
+
+// ListRouters returns the routers matching a Neutron list query.
+func (c *Cloud) ListRouters(query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.listResources(kindRouter, query, nil)
+}
+
+// UpdateRouter updates a router from the body of a Neutron update router
+// request.
+func (c *Cloud) UpdateRouter(id string, req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindRouter, id)
+	if !ok {
+		return nil, notFound(kindRouter, id)
+	}
+	if raw, ok := req["external_gateway_info"]; ok {
+		if gw, ok := raw.(Object); ok && len(gw) > 0 {
+			if err := c.setRouterGateway(obj, gw); err != nil {
+				return nil, err
+			}
+		} else {
+			c.clearRouterGateway(obj)
+		}
+	}
+	update(obj, req, "status", "external_gateway_info")
+	return copyObject(obj), nil
+}
+
+// DeleteRouter deletes a router and its gateway port. It fails if the router
+// still has interfaces.
+func (c *Cloud) DeleteRouter(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindRouter, id)
+	if !ok {
+		return notFound(kindRouter, id)
+	}
+	if len(c.routerInterfaces(id)) > 0 {
+		return conflict(fmt.Sprintf("Router %s still has ports", id))
+	}
+	c.clearRouterGateway(obj)
+	c.remove(kindRouter, id)
+	return nil
+}
+
+func (c *Cloud) routerInterfaces(routerID string) []Object {
+	return c.find(kindPort, func(o Object) bool {
+		return str(o, "device_id") == routerID && str(o, "device_owner") == deviceOwnerRouterInterface
+	})
+}
+
+// AddRouterInterface attaches a subnet or an existing port to a router.
+func (c *Cloud) AddRouterInterface(id string, req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.observe(kindRouter, id); !ok {
+		return nil, notFound(kindRouter, id)
+	}
+
+	var port Object
+	if portID := str(req, "port_id"); portID != "" {
+		var ok bool
+		port, ok = c.lookup(kindPort, portID)
+		if !ok {
+			return nil, notFound(kindPort, portID)
+		}
+		if str(port, "device_id") != "" {
+			return nil, conflict(fmt.Sprintf("Port %s is already in use.", portID))
+		}
+		port["device_id"] = id
+		port["device_owner"] = deviceOwnerRouterInterface
+	} else {
+		subnetID := str(req, "subnet_id")
+		subnet, ok := c.lookup(kindSubnet, subnetID)
+		if !ok {
+			return nil, notFound(kindSubnet, subnetID)
+		}
+		if str(subnet, "gateway_ip") == "" {
+			return nil, badRequest(fmt.Sprintf("Bad router request: Subnet for router interface must have a gateway IP."))
+		}
+		for _, existing := range c.routerInterfaces(id) {
+			for _, ip := range objects(existing, "fixed_ips") {
+				if str(ip, "subnet_id") == subnetID {
+					return nil, badRequest(fmt.Sprintf("Bad router request: Router already has a port on subnet %s.", subnetID))
+				}
+			}
+		}
+		var err error
+		port, err = c.createPort(Object{
+			"network_id":      str(subnet, "network_id"),
+			"device_id":       id,
+			"device_owner":    deviceOwnerRouterInterface,
+			"security_groups": []any{},
+			"fixed_ips":       []any{Object{"subnet_id": subnetID, "ip_address": str(subnet, "gateway_ip")}},
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var subnetIDs []any
+	for _, ip := range objects(port, "fixed_ips") {
+		subnetIDs = append(subnetIDs, str(ip, "subnet_id"))
+	}
+	info := Object{
+		"id":         id,
+		"port_id":    str(port, "id"),
+		"tenant_id":  c.projectID,
+		"project_id": c.projectID,
+		"subnet_ids": subnetIDs,
+	}
+	if len(subnetIDs) > 0 {
+		info["subnet_id"] = subnetIDs[0]
+	}
+	return info, nil
+}
+
+// RemoveRouterInterface detaches a subnet or port from a router, deleting the
+// interface port.
+func (c *Cloud) RemoveRouterInterface(id string, req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.observe(kindRouter, id); !ok {
+		return nil, notFound(kindRouter, id)
+	}
+	portID := str(req, "port_id")
+	subnetID := str(req, "subnet_id")
+	for _, port := range c.routerInterfaces(id) {
+		match := portID != "" && str(port, "id") == portID
+		for _, ip := range objects(port, "fixed_ips") {
+			if subnetID != "" && str(ip, "subnet_id") == subnetID {
+				match = true
+			}
+		}
+		if !match {
+			continue
+		}
+		info := Object{
+			"id":         id,
+			"port_id":    str(port, "id"),
+			"tenant_id":  c.projectID,
+			"project_id": c.projectID,
+		}
+		if ips := objects(port, "fixed_ips"); len(ips) > 0 {
+			info["subnet_id"] = str(ips[0], "subnet_id")
+		}
+		c.deletePort(port)
+		return info, nil
+	}
+	return nil, notFound("router interface", fmt.Sprintf("for router %s", id))
+}
+
+// Floating IPs
+
+// CreateFloatingIP allocates a floating IP from the body of a Neutron create
+// floating IP request, associating it with a port if one is given.
+func (c *Cloud) CreateFloatingIP(req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	networkID := str(req, "floating_network_id")
+	network, ok := c.lookup(kindNetwork, networkID)
+	if !ok {
+		return nil, notFound(kindNetwork, networkID)
+	}
+	if !boolOr(network, "router:external", false) {
+		return nil, badRequest(fmt.Sprintf("Bad floatingip request: Network %s is not a valid external network.", networkID))
+	}
+	if err := c.checkQuota(QuotaFloatingIPs, 1); err != nil {
+		return nil, err
+	}
+	if err := c.checkDNSAttributes(req); err != nil {
+		return nil, err
+	}
+	if (str(req, "dns_name") == "") != (str(req, "dns_domain") == "") {
+		return nil, badRequest("Invalid input for operation: dns_name and dns_domain must be specified together.")
+	}
+
+	var fixedIPs any
+	if ip := str(req, "floating_ip_address"); ip != "" {
+		fixedIPs = []any{Object{"ip_address": ip}}
+	}
+	if subnetID := str(req, "subnet_id"); subnetID != "" {
+		fixedIPs = []any{Object{"subnet_id": subnetID, "ip_address": str(req, "floating_ip_address")}}
+	}
+	port, err := c.createPort(Object{
+		"network_id":      networkID,
+		"device_owner":    deviceOwnerFloatingIP,
+		"security_groups": []any{},
+		"fixed_ips":       fixedIPs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ips := objects(port, "fixed_ips")
+	if len(ips) == 0 {
+		c.deletePort(port)
+		return nil, conflict(fmt.Sprintf("No more IP addresses available on network %s.", networkID))
+	}
+
+	obj := c.newNeutronObject(req)
+	obj["floating_ip_address"] = str(ips[0], "ip_address")
+	obj["port_id"] = nil
+	obj["fixed_ip_address"] = nil
+	obj["router_id"] = nil
+	obj["status"] = "DOWN"
+	delete(obj, "subnet_id")
+	c.setDNSDefaults(obj)
+	c.add(kindFloatingIP, obj)
+	port["device_id"] = str(obj, "id")
+
+	if portID := str(req, "port_id"); portID != "" {
+		if err := c.associateFloatingIP(obj, portID, str(req, "fixed_ip_address")); err != nil {
+			c.deletePort(port)
+			c.remove(kindFloatingIP, str(obj, "id"))
+			return nil, err
+		}
+	}
+	return copyObject(obj), nil
+}
+
+// associateFloatingIP associates fip with a port. As in Neutron, the port's
+// network must be connected by a router to the floating IP's network.
+func (c *Cloud) associateFloatingIP(fip Object, portID, fixedIP string) error {
+	port, ok := c.lookup(kindPort, portID)
+	if !ok {
+		return notFound(kindPort, portID)
+	}
+	ips := objects(port, "fixed_ips")
+	if len(ips) == 0 {
+		return badRequest(fmt.Sprintf("Bad floatingip request: Port %s does not have any IP addresses on it.", portID))
+	}
+	if fixedIP == "" {
+		fixedIP = str(ips[0], "ip_address")
+	}
+	var subnetID string
+	for _, ip := range ips {
+		if str(ip, "ip_address") == fixedIP {
+			subnetID = str(ip, "subnet_id")
+		}
+	}
+	if subnetID == "" {
+		return badRequest(fmt.Sprintf("Bad floatingip request: Port %s does not have fixed ip %s.", portID, fixedIP))
+	}
+
+	routerID := ""
+	for _, iface := range c.find(kindPort, func(o Object) bool { return str(o, "device_owner") == deviceOwnerRouterInterface }) {
+		for _, ip := range objects(iface, "fixed_ips") {
+			if str(ip, "subnet_id") != subnetID {
+				continue
+			}
+			router, ok := c.lookup(kindRouter, str(iface, "device_id"))
+			if !ok {
+				continue
+			}
+			if gw, ok := router["external_gateway_info"].(Object); ok && str(gw, "network_id") == str(fip, "floating_network_id") {
+				routerID = str(router, "id")
+			}
+		}
+	}
+	if routerID == "" {
+		return notFound("external gateway", fmt.Sprintf("External network %s is not reachable from subnet %s.", str(fip, "floating_network_id"), subnetID))
+	}
+
+	fip["port_id"] = portID
+	fip["fixed_ip_address"] = fixedIP
+	fip["router_id"] = routerID
+	fip["updated_at"] = now()
+	c.transition(kindFloatingIP, str(fip, "id"), nil, Object{"status": "ACTIVE"})
+	return nil
+}
+
+func (c *Cloud) disassociateFloatingIP(fip Object) {
+	fip["port_id"] = nil
+	fip["fixed_ip_address"] = nil
+	fip["router_id"] = nil
+	fip["updated_at"] = now()
+	c.transition(kindFloatingIP, str(fip, "id"), nil, Object{"status": "DOWN"})
+}
+
+// GetFloatingIP returns a floating IP.
+func (c *Cloud) GetFloatingIP(id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.getResource(kindFloatingIP, id, nil)
+}
+
+// ListFloatingIPs returns the floating IPs matching a Neutron list query.
+func (c *Cloud) ListFloatingIPs(query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.listResources(kindFloatingIP, query, nil)
+}
+
+// UpdateFloatingIP updates a floating IP from the body of a Neutron update
+// floating IP request. Setting port_id associates or disassociates it.
+func (c *Cloud) UpdateFloatingIP(id string, req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindFloatingIP, id)
+	if !ok {
+		return nil, notFound(kindFloatingIP, id)
+	}
+	if raw, ok := req["port_id"]; ok {
+		if portID, _ := raw.(string); portID != "" {
+			if err := c.associateFloatingIP(obj, portID, str(req, "fixed_ip_address")); err != nil {
+				return nil, err
+			}
+		} else {
+			c.disassociateFloatingIP(obj)
+		}
+	}
+	if _, ok := req["description"]; ok {
+		obj["description"] = req["description"]
+	}
+	return copyObject(obj), nil
+}
+
+// DeleteFloatingIP releases a floating IP.
+func (c *Cloud) DeleteFloatingIP(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.observe(kindFloatingIP, id); !ok {
+		return notFound(kindFloatingIP, id)
+	}
+	for _, port := range c.find(kindPort, func(o Object) bool {
+		return str(o, "device_owner") == deviceOwnerFloatingIP && str(o, "device_id") == id
+	}) {
+		c.deletePort(port)
+	}
+	c.remove(kindFloatingIP, id)
+	return nil
+}
+
+// Trunks
+
+// CreateTrunk creates a trunk from the body of a Neutron create trunk request.
+func (c *Cloud) CreateTrunk(req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	portID := str(req, "port_id")
+	if _, ok := c.lookup(kindPort, portID); !ok {
+		return nil, notFound(kindPort, portID)
+	}
+	if trunks := c.find(kindTrunk, func(o Object) bool { return str(o, "port_id") == portID }); len(trunks) > 0 {
+		return nil, conflict(fmt.Sprintf("Port %s is currently in use and is not eligible for use as a parent port.", portID))
+	}
+	for _, subport := range objects(req, "sub_ports") {
+		if _, ok := c.lookup(kindPort, str(subport, "port_id")); !ok {
+			return nil, notFound(kindPort, str(subport, "port_id"))
+		}
+	}
+	obj := c.newNeutronObject(req)
+	setDefault(obj, "name", "")
+	setDefault(obj, "admin_state_up", true)
+	setDefault(obj, "sub_ports", []any{})
+	c.add(kindTrunk, obj)
+	return copyObject(c.trunkView(obj)), nil
+}
+
+// trunkView reports a trunk as ACTIVE once its parent port is bound.
+func (c *Cloud) trunkView(obj Object) Object {
+	obj = copyObject(obj)
+	obj["status"] = "DOWN"
+	if port, ok := c.lookup(kindPort, str(obj, "port_id")); ok && str(port, "device_id") != "" {
+		obj["status"] = "ACTIVE"
+	}
+	return obj
+}
+
+// ListTrunks returns the trunks matching a Neutron list query.
+func (c *Cloud) ListTrunks(query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.listResources(kindTrunk, query, c.trunkView)
+}
+
+// GetTrunk returns a trunk.
+func (c *Cloud) GetTrunk(id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.getResource(kindTrunk, id, c.trunkView)
+}
+
+// DeleteTrunk deletes a trunk. It fails if the parent port is bound to a
+// server.
+func (c *Cloud) DeleteTrunk(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindTrunk, id)
+	if !ok {
+		return notFound(kindTrunk, id)
+	}
+	if port, ok := c.lookup(kindPort, str(obj, "port_id")); ok && strings.HasPrefix(str(port, "device_owner"), deviceOwnerComputePrefix) {
+		return conflict(fmt.Sprintf("Trunk %s is currently in use.", id))
+	}
+	c.remove(kindTrunk, id)
+	return nil
+}
+
+// ListTrunkSubports returns the subports of a trunk.
+func (c *Cloud) ListTrunkSubports(id string) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindTrunk, id)
+	if !ok {
+		return nil, notFound(kindTrunk, id)
+	}
+	return copyObjects(objects(obj, "sub_ports")), nil
+}
+
+// RemoveSubports removes subports from a trunk.
+func (c *Cloud) RemoveSubports(id string, req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindTrunk, id)
+	if !ok {
+		return nil, notFound(kindTrunk, id)
+	}
+	for _, subport := range objects(req, "sub_ports") {
+		portID := str(subport, "port_id")
+		remaining := subportsWithout(obj, portID)
+		if len(remaining) == len(objects(obj, "sub_ports")) {
+			return nil, notFound("subport", portID)
+		}
+		obj["sub_ports"] = remaining
+	}
+	return copyObject(c.trunkView(obj)), nil
+}
+
+func subportsWithout(trunk Object, portID string) []any {
+	remaining := []any{}
+	for _, subport := range objects(trunk, "sub_ports") {
+		if str(subport, "port_id") != portID {
+			remaining = append(remaining, subport)
+		}
+	}
+	return remaining
+}
+
+// Extensions and tags
+
+// ListNetworkExtensions returns the enabled Neutron extensions.
+func (c *Cloud) ListNetworkExtensions() []Object {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return copyObjects(c.list(kindNetworkExtension, nil))
+}
+
+// hasNetworkExtension returns true if the Neutron extension with the given
+// alias is enabled.
+func (c *Cloud) hasNetworkExtension(alias string) bool {
+	return len(c.find(kindNetworkExtension, func(o Object) bool { return str(o, "alias") == alias })) > 0
+}
+
+// checkDNSAttributes returns an error if a request sets DNS attributes but
+// the dns-integration extension is not enabled.
+func (c *Cloud) checkDNSAttributes(req Object) error {
+	if c.hasNetworkExtension("dns-integration") {
+		return nil
+	}
+	for _, attr := range []string{"dns_name", "dns_domain"} {
+		if _, ok := req[attr]; ok {
+			return badRequest(fmt.Sprintf("Unrecognized attribute(s) '%s'", attr))
+		}
+	}
+	return nil
+}
+
+// setDNSDefaults sets the DNS attributes of a port or floating IP when the
+// dns-integration extension is enabled.
+func (c *Cloud) setDNSDefaults(obj Object) {
+	if c.hasNetworkExtension("dns-integration") {
+		setDefault(obj, "dns_name", "")
+		setDefault(obj, "dns_domain", "")
+	}
+}
+
+// ReplaceAllTags replaces the tags of a Neutron resource. resourceType is the
+// plural resource name used by the Neutron tags API, for example "ports".
+func (c *Cloud) ReplaceAllTags(resourceType, id string, tags []string) ([]string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	kind, ok := neutronTagResources[resourceType]
+	if !ok {
+		return nil, notFound("resource type", resourceType)
+	}
+	obj, ok := c.observe(kind, id)
+	if !ok {
+		return nil, notFound(kind, id)
+	}
+	values := make([]any, 0, len(tags))
+	for _, t := range tags {
+		values = append(values, t)
+	}
+	obj["tags"] = values
+	obj["updated_at"] = now()
+	return append([]string{}, tags...), nil
+}
+
+// AddExternalNetwork creates an external network with a single subnet, from
+// which routers and floating IPs allocate addresses. It returns the ID of the
+// network.
+func (c *Cloud) AddExternalNetwork(name, cidr string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	network, err := c.createNetwork(Object{"name": name, "router:external": true})
+	if err != nil {
+		return "", err
+	}
+	if _, err := c.createSubnet(Object{"network_id": str(network, "id"), "name": name, "cidr": cidr}); err != nil {
+		return "", err
+	}
+	return str(network, "id"), nil
+}
+
+func setDefault(obj Object, key string, value any) {
+	if _, ok := obj[key]; !ok {
+		obj[key] = value
+	}
+}
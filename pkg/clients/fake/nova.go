@@ -0,0 +1,514 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+const (
+	serverStatusBuild  = "BUILD"
+	serverStatusActive = "ACTIVE"
+
+	fakeHypervisor = "fake-compute-0"
+)
+
+// AddFlavor adds a flavor to the Cloud and returns its ID.
+func (c *Cloud) AddFlavor(name string, vcpus, ramMiB, diskGiB int) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj := c.add(kindFlavor, Object{
+		"name":                       name,
+		"vcpus":                      vcpus,
+		"ram":                        ramMiB,
+		"disk":                       diskGiB,
+		"swap":                       0,
+		"rxtx_factor":                1.0,
+		"os-flavor-access:is_public": true,
+		"OS-FLV-EXT-DATA:ephemeral":  0,
+		"description":                "",
+		"extra_specs":                Object{},
+	})
+	return str(obj, "id")
+}
+
+// AddAvailabilityZone adds a compute availability zone to the Cloud.
+func (c *Cloud) AddAvailabilityZone(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.add(kindAvailabilityZone, Object{
+		"id":       name,
+		"zoneName": name,
+		"zoneState": Object{
+			"available": true,
+		},
+		"hosts": nil,
+	})
+}
+
+// AddServerGroup adds a server group with the given policy, for example
+// "anti-affinity", and returns its ID.
+func (c *Cloud) AddServerGroup(name, policy string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj := c.add(kindServerGroup, Object{
+		"name":       name,
+		"policies":   []any{policy},
+		"policy":     policy,
+		"members":    []any{},
+		"metadata":   Object{},
+		"project_id": c.projectID,
+		"user_id":    DefaultUserID,
+	})
+	return str(obj, "id")
+}
+
+// ListFlavors returns all flavors.
+func (c *Cloud) ListFlavors() []Object {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return copyObjects(c.list(kindFlavor, nil))
+}
+
+// GetFlavor returns a flavor.
+func (c *Cloud) GetFlavor(id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindFlavor, id)
+	if !ok {
+		return nil, notFound(kindFlavor, id)
+	}
+	return copyObject(obj), nil
+}
+
+// ListAvailabilityZones returns all compute availability zones.
+func (c *Cloud) ListAvailabilityZones() []Object {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return copyObjects(c.list(kindAvailabilityZone, nil))
+}
+
+// ListServerGroups returns all server groups.
+func (c *Cloud) ListServerGroups() []Object {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return copyObjects(c.list(kindServerGroup, nil))
+}
+
+// CreateServer boots a server from the body of a Nova create server request,
+// including any "os:scheduler_hints". The server starts in BUILD and becomes
+// ACTIVE after the transition delay. Ports passed by ID are bound to the
+// server, block devices are created or attached, and the server is added to
+// the server group given in the scheduler hints.
+func (c *Cloud) CreateServer(body map[string]any) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	req, err := unwrap(body, "server")
+	if err != nil {
+		return nil, err
+	}
+	hints, err := toObject(body["os:scheduler_hints"])
+	if err != nil {
+		return nil, err
+	}
+
+	name := str(req, "name")
+	if name == "" {
+		return nil, badRequest("Invalid input for field/attribute name.")
+	}
+	flavorID := str(req, "flavorRef")
+	flavor, ok := c.lookup(kindFlavor, flavorID)
+	if !ok {
+		return nil, badRequest(fmt.Sprintf("Flavor %s could not be found.", flavorID))
+	}
+	for resource, requested := range map[string]int{QuotaInstances: 1, QuotaCores: num(flavor, "vcpus"), QuotaRAM: num(flavor, "ram")} {
+		if err := c.checkQuota(resource, requested); err != nil {
+			return nil, err
+		}
+	}
+	az := str(req, "availability_zone")
+	if az == "" {
+		az = DefaultAvailabilityZone
+	}
+	if _, ok := c.lookup(kindAvailabilityZone, az); !ok {
+		return nil, badRequest(fmt.Sprintf("The requested availability zone is not available: %s", az))
+	}
+
+	bdms := objects(req, "block_device_mapping_v2")
+	imageID := str(req, "imageRef")
+	bootFromVolume := slices.ContainsFunc(bdms, func(bdm Object) bool {
+		return num(bdm, "boot_index") == 0 && str(bdm, "destination_type") == "volume"
+	})
+	if !bootFromVolume {
+		image, ok := c.lookup(kindImage, imageID)
+		if !ok {
+			return nil, badRequest(fmt.Sprintf("Image %s could not be found.", imageID))
+		}
+		if str(image, "status") != imageStatusActive {
+			return nil, badRequest(fmt.Sprintf("Image %s is not active.", imageID))
+		}
+	}
+
+	var group Object
+	if groupID := str(hints, "group"); groupID != "" {
+		group, ok = c.lookup(kindServerGroup, groupID)
+		if !ok {
+			return nil, badRequest(fmt.Sprintf("Server group %s could not be found.", groupID))
+		}
+	}
+
+	// Validate requested ports before making any changes
+	for _, network := range objects(req, "networks") {
+		if portID := str(network, "port"); portID != "" {
+			port, ok := c.lookup(kindPort, portID)
+			if !ok {
+				return nil, badRequest(fmt.Sprintf("Port id %s could not be found.", portID))
+			}
+			if str(port, "device_id") != "" {
+				return nil, conflict(fmt.Sprintf("Port %s is still in use.", portID))
+			}
+		} else if networkID := str(network, "uuid"); networkID != "" {
+			if _, ok := c.lookup(kindNetwork, networkID); !ok {
+				return nil, badRequest(fmt.Sprintf("Network %s could not be found.", networkID))
+			}
+		}
+	}
+
+	ts := now()
+	server := Object{
+		"name":                                 name,
+		"tenant_id":                            c.projectID,
+		"user_id":                              DefaultUserID,
+		"created":                              ts,
+		"updated":                              ts,
+		"hostId":                               "",
+		"status":                               serverStatusBuild,
+		"progress":                             0,
+		"accessIPv4":                           "",
+		"accessIPv6":                           "",
+		"image":                                "",
+		"flavor":                               Object{"id": flavorID, "original_name": str(flavor, "name"), "vcpus": flavor["vcpus"], "ram": flavor["ram"], "disk": flavor["disk"]},
+		"metadata":                             Object{},
+		"links":                                []any{},
+		"key_name":                             str(req, "key_name"),
+		"security_groups":                      []any{},
+		"os-extended-volumes:volumes_attached": []any{},
+		"tags":                                 []any{},
+		"OS-EXT-SRV-ATTR:host":                 "",
+		"OS-EXT-SRV-ATTR:hypervisor_hostname":  "",
+		"OS-EXT-STS:task_state":                "spawning",
+		"OS-EXT-STS:vm_state":                  "building",
+		"OS-EXT-STS:power_state":               0,
+		"OS-EXT-AZ:availability_zone":          az,
+		"OS-DCF:diskConfig":                    "MANUAL",
+		"config_drive":                         "",
+	}
+	if imageID != "" {
+		server["image"] = Object{"id": imageID}
+	}
+	if metadata, ok := req["metadata"].(Object); ok {
+		server["metadata"] = metadata
+	}
+	if tags, ok := req["tags"].([]any); ok {
+		server["tags"] = tags
+	}
+	if userData := str(req, "user_data"); userData != "" {
+		server["OS-EXT-SRV-ATTR:user_data"] = userData
+	}
+	for _, sg := range objects(req, "security_groups") {
+		server["security_groups"] = append(server["security_groups"].([]any), Object{"name": str(sg, "name")})
+	}
+	c.add(kindServer, server)
+	serverID := str(server, "id")
+
+	attached, err := c.attachBlockDevices(server, bdms, az)
+	if err != nil {
+		c.remove(kindServer, serverID)
+		return nil, err
+	}
+	server["os-extended-volumes:volumes_attached"] = attached
+
+	for _, network := range objects(req, "networks") {
+		port, ok := c.lookup(kindPort, str(network, "port"))
+		if !ok {
+			port, err = c.createPort(Object{"network_id": str(network, "uuid"), "fixed_ips": fixedIPRequest(network)})
+			if err != nil {
+				return nil, err
+			}
+			c.novaOwnedPorts[str(port, "id")] = true
+		}
+		port["device_id"] = serverID
+		port["device_owner"] = deviceOwnerComputePrefix + az
+		port["binding:host_id"] = fakeHypervisor
+		port["binding:vif_type"] = "ovs"
+	}
+
+	if group != nil {
+		group["members"] = append(group["members"].([]any), serverID)
+	}
+
+	c.transition(kindServer, serverID, nil, Object{
+		"status":                              serverStatusActive,
+		"progress":                            100,
+		"hostId":                              "fake-host-id",
+		"OS-EXT-SRV-ATTR:host":                fakeHypervisor,
+		"OS-EXT-SRV-ATTR:hypervisor_hostname": fakeHypervisor,
+		"OS-EXT-STS:task_state":               nil,
+		"OS-EXT-STS:vm_state":                 "active",
+		"OS-EXT-STS:power_state":              1,
+	})
+	return copyObject(c.serverView(server)), nil
+}
+
+func fixedIPRequest(network Object) any {
+	if ip := str(network, "fixed_ip"); ip != "" {
+		return []any{Object{"ip_address": ip}}
+	}
+	return nil
+}
+
+// attachBlockDevices creates or attaches the volumes described by a server's
+// block device mappings, returning the server's volumes_attached list.
+func (c *Cloud) attachBlockDevices(server Object, bdms []Object, az string) ([]any, error) {
+	serverID := str(server, "id")
+	attached := []any{}
+	for _, bdm := range bdms {
+		if str(bdm, "destination_type") != "volume" {
+			continue
+		}
+		var volume Object
+		switch str(bdm, "source_type") {
+		case "volume":
+			var ok bool
+			volume, ok = c.lookup(kindVolume, str(bdm, "uuid"))
+			if !ok {
+				return nil, badRequest(fmt.Sprintf("Volume %s could not be found.", str(bdm, "uuid")))
+			}
+			if str(volume, "status") != volumeStatusAvailable && !(boolOr(volume, "multiattach", false) && str(volume, "status") == volumeStatusInUse) {
+				return nil, badRequest(fmt.Sprintf("Volume %s status must be available, but current status is: %s.", str(volume, "id"), str(volume, "status")))
+			}
+		case "image", "blank":
+			req := Object{"size": bdm["volume_size"], "availability_zone": az}
+			if str(bdm, "source_type") == "image" {
+				req["imageRef"] = str(bdm, "uuid")
+			}
+			if volumeType := str(bdm, "volume_type"); volumeType != "" {
+				req["volume_type"] = volumeType
+			}
+			var err error
+			volume, err = c.createVolume(req)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			continue
+		}
+		c.attachVolume(volume, serverID)
+		attached = append(attached, Object{
+			"id":                    str(volume, "id"),
+			"delete_on_termination": boolOr(bdm, "delete_on_termination", false),
+		})
+	}
+	return attached, nil
+}
+
+// serverView reports the addresses of all ports bound to the server, grouped
+// by network name as Nova does.
+func (c *Cloud) serverView(obj Object) Object {
+	obj = copyObject(obj)
+	addresses := Object{}
+	for _, port := range c.serverPorts(str(obj, "id")) {
+		network, _ := c.lookup(kindNetwork, str(port, "network_id"))
+		networkName := str(network, "name")
+		addrs, _ := addresses[networkName].([]any)
+		for _, ip := range objects(port, "fixed_ips") {
+			addrs = append(addrs, novaAddress(str(ip, "ip_address"), "fixed", str(port, "mac_address")))
+		}
+		for _, fip := range c.find(kindFloatingIP, func(o Object) bool { return str(o, "port_id") == str(port, "id") }) {
+			addrs = append(addrs, novaAddress(str(fip, "floating_ip_address"), "floating", str(port, "mac_address")))
+		}
+		addresses[networkName] = addrs
+	}
+	obj["addresses"] = addresses
+	return obj
+}
+
+func novaAddress(ip, ipType, mac string) Object {
+	version := 4
+	if addr, err := netip.ParseAddr(ip); err == nil && addr.Is6() {
+		version = 6
+	}
+	return Object{
+		"addr":                    ip,
+		"version":                 version,
+		"OS-EXT-IPS:type":         ipType,
+		"OS-EXT-IPS-MAC:mac_addr": mac,
+	}
+}
+
+func (c *Cloud) serverPorts(serverID string) []Object {
+	return c.find(kindPort, func(o Object) bool {
+		return str(o, "device_id") == serverID && strings.HasPrefix(str(o, "device_owner"), deviceOwnerComputePrefix)
+	})
+}
+
+// GetServer returns a server.
+func (c *Cloud) GetServer(id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindServer, id)
+	if !ok {
+		return nil, notFound(kindServer, id)
+	}
+	return copyObject(c.serverView(obj)), nil
+}
+
+// ListServers returns the servers matching a Nova list query. As in Nova, the
+// name parameter is a regular expression.
+func (c *Cloud) ListServers(query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	filter, err := queryFilter(query, "name")
+	if err != nil {
+		return nil, err
+	}
+	var out []Object
+	for _, obj := range c.list(kindServer, nil) {
+		obj = c.serverView(obj)
+		if filter(obj) {
+			out = append(out, obj)
+		}
+	}
+	return out, nil
+}
+
+// DeleteServer deletes a server. The server remains visible with a task state
+// of deleting for the transition delay. Once it is gone, ports passed to the
+// server at boot are unbound, ports created by Nova are deleted, and volumes
+// are detached or deleted according to their delete_on_termination flag.
+func (c *Cloud) DeleteServer(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindServer, id)
+	if !ok {
+		return notFound(kindServer, id)
+	}
+	if str(obj, "OS-EXT-STS:task_state") == "deleting" {
+		return nil
+	}
+	c.scheduleRemoval(kindServer, id, Object{"OS-EXT-STS:task_state": "deleting"}, func() {
+		c.cleanupServer(obj)
+	})
+	return nil
+}
+
+func (c *Cloud) cleanupServer(server Object) {
+	serverID := str(server, "id")
+	for _, port := range c.serverPorts(serverID) {
+		if c.novaOwnedPorts[str(port, "id")] {
+			c.deletePort(port)
+			continue
+		}
+		unbindPort(port)
+	}
+	for _, attachment := range objects(server, "os-extended-volumes:volumes_attached") {
+		volume, ok := c.lookup(kindVolume, str(attachment, "id"))
+		if !ok {
+			continue
+		}
+		c.detachVolume(volume, serverID)
+		if boolOr(attachment, "delete_on_termination", false) {
+			c.remove(kindVolume, str(volume, "id"))
+		}
+	}
+	for _, group := range c.find(kindServerGroup, func(o Object) bool { return slices.Contains(strs(o, "members"), serverID) }) {
+		members := []any{}
+		for _, m := range strs(group, "members") {
+			if m != serverID {
+				members = append(members, m)
+			}
+		}
+		group["members"] = members
+	}
+	delete(c.consoleOutput, serverID)
+}
+
+func unbindPort(port Object) {
+	port["device_id"] = ""
+	port["device_owner"] = ""
+	port["binding:host_id"] = ""
+	port["binding:vif_type"] = "unbound"
+	port["updated_at"] = now()
+}
+
+// ListAttachedInterfaces returns the interfaces of a server.
+func (c *Cloud) ListAttachedInterfaces(serverID string) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.observe(kindServer, serverID); !ok {
+		return nil, notFound(kindServer, serverID)
+	}
+	var out []Object
+	for _, port := range c.serverPorts(serverID) {
+		port = c.portView(port)
+		out = append(out, Object{
+			"port_state": str(port, "status"),
+			"port_id":    str(port, "id"),
+			"net_id":     str(port, "network_id"),
+			"mac_addr":   str(port, "mac_address"),
+			"fixed_ips":  port["fixed_ips"],
+		})
+	}
+	return out, nil
+}
+
+// DeleteAttachedInterface detaches a port from a server.
+func (c *Cloud) DeleteAttachedInterface(serverID, portID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.observe(kindServer, serverID); !ok {
+		return notFound(kindServer, serverID)
+	}
+	for _, port := range c.serverPorts(serverID) {
+		if str(port, "id") != portID {
+			continue
+		}
+		if c.novaOwnedPorts[str(port, "id")] {
+			c.deletePort(port)
+		} else {
+			unbindPort(port)
+		}
+		return nil
+	}
+	return notFound("port interface", portID)
+}
+
+// GetConsoleOutput returns the console log of a server, as set with
+// SetConsoleOutput.
+func (c *Cloud) GetConsoleOutput(serverID string) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.observe(kindServer, serverID); !ok {
+		return "", notFound(kindServer, serverID)
+	}
+	return c.consoleOutput[serverID], nil
+}
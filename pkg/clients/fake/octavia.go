@@ -0,0 +1,991 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+const (
+	lbStatusActive        = "ACTIVE"
+	lbStatusPendingCreate = "PENDING_CREATE"
+	lbStatusPendingUpdate = "PENDING_UPDATE"
+	lbStatusPendingDelete = "PENDING_DELETE"
+
+	lbOperatingOnline    = "ONLINE"
+	lbOperatingOffline   = "OFFLINE"
+	lbOperatingNoMonitor = "NO_MONITOR"
+
+	lbProviderAmphora = "amphora"
+	lbProviderOVN     = "ovn"
+
+	lbAlgorithmSourceIPPort = "SOURCE_IP_PORT"
+
+	listenerProtocolTerminatedHTTPS = "TERMINATED_HTTPS"
+)
+
+// listenerPoolProtocols are the pool protocols Octavia allows for the
+// default pool of a listener, by listener protocol.
+var listenerPoolProtocols = map[string][]string{
+	"HTTP":                          {"HTTP", "PROXY", "PROXYV2"},
+	"HTTPS":                         {"HTTPS", "PROXY", "PROXYV2", "TCP"},
+	listenerProtocolTerminatedHTTPS: {"HTTP", "PROXY", "PROXYV2"},
+	"TCP":                           {"HTTP", "HTTPS", "PROXY", "PROXYV2", "TCP"},
+	"UDP":                           {"UDP"},
+	"SCTP":                          {"SCTP"},
+}
+
+// octaviaVersions are the Octavia API versions reported by the Cloud. The
+// last is the current version.
+var octaviaVersions = []string{
+	"v2.0", "v2.1", "v2.2", "v2.3", "v2.4", "v2.5", "v2.6", "v2.7", "v2.8", "v2.9",
+	"v2.10", "v2.11", "v2.12", "v2.13", "v2.14", "v2.15", "v2.16", "v2.17", "v2.18", "v2.19",
+	"v2.20", "v2.21", "v2.22", "v2.23", "v2.24", "v2.25", "v2.26", "v2.27",
+}
+
+// ovnProtocols are the listener and pool protocols supported by the OVN
+// provider.
+var ovnProtocols = []string{"TCP", "UDP", "SCTP"}
+
+// ovnMonitorTypes are the health monitor types supported by the OVN provider.
+var ovnMonitorTypes = []string{"TCP", "UDP-CONNECT", "SCTP"}
+
+func notImplemented(provider, message string) error {
+	return APIError(http.StatusNotImplemented, fmt.Sprintf("Provider '%s' does not support a requested option: %s", provider, message))
+}
+
+func (c *Cloud) newOctaviaObject(req Object) Object {
+	ts := nowNoZ()
+	obj := Object{
+		"name":                "",
+		"description":         "",
+		"admin_state_up":      true,
+		"project_id":          c.projectID,
+		"tags":                []any{},
+		"provisioning_status": lbStatusPendingCreate,
+		"operating_status":    lbOperatingOffline,
+		"created_at":          ts,
+		"updated_at":          ts,
+	}
+	for k, v := range req {
+		obj[k] = v
+	}
+	delete(obj, "id")
+	return obj
+}
+
+// octaviaUpdate merges req into the stored resource, skipping immutable
+// fields.
+func octaviaUpdate(obj, req Object, immutable ...string) {
+	for k, v := range req {
+		if k == "id" || slices.Contains(immutable, k) {
+			continue
+		}
+		obj[k] = v
+	}
+	obj["updated_at"] = nowNoZ()
+}
+
+// AddLoadBalancerFlavor adds an enabled Octavia flavor to the Cloud and
+// returns its ID.
+func (c *Cloud) AddLoadBalancerFlavor(name string) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj := c.add(kindLBFlavor, Object{
+		"name":              name,
+		"description":       "",
+		"enabled":           true,
+		"flavor_profile_id": c.newID(),
+	})
+	return str(obj, "id")
+}
+
+// SetPoolMemberOperatingStatus sets the operating status reported for an
+// enabled, provisioned load balancer member, as a health monitor would.
+func (c *Cloud) SetPoolMemberOperatingStatus(memberID, operatingStatus string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.memberOperatingStatus[memberID] = operatingStatus
+}
+
+// ListLoadBalancerProviders returns the Octavia providers.
+func (c *Cloud) ListLoadBalancerProviders() []Object {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := []Object{}
+	for _, provider := range c.list(kindLBProvider, nil) {
+		out = append(out, Object{"name": str(provider, "name"), "description": str(provider, "description")})
+	}
+	return out
+}
+
+// ListLoadBalancerFlavors returns the Octavia flavors.
+func (c *Cloud) ListLoadBalancerFlavors() []Object {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return copyObjects(c.list(kindLBFlavor, nil))
+}
+
+// ListOctaviaVersions returns the Octavia API versions.
+func (c *Cloud) ListOctaviaVersions() []Object {
+	out := make([]Object, len(octaviaVersions))
+	for i, v := range octaviaVersions {
+		status := "SUPPORTED"
+		if i == len(octaviaVersions)-1 {
+			status = "CURRENT"
+		}
+		out[i] = Object{"id": v, "status": status}
+	}
+	return out
+}
+
+// mutableLoadBalancer returns a load balancer after checking that it can be
+// modified. Octavia rejects changes to a load balancer and its children while
+// it is in a PENDING state.
+func (c *Cloud) mutableLoadBalancer(id string) (Object, error) {
+	lb, ok := c.lookup(kindLoadBalancer, id)
+	if !ok {
+		return nil, notFound(kindLoadBalancer, id)
+	}
+	if str(lb, "provisioning_status") != lbStatusActive {
+		return nil, conflict(fmt.Sprintf("Load Balancer %s is immutable and cannot be updated.", id))
+	}
+	return lb, nil
+}
+
+// updatingLoadBalancer puts a load balancer into PENDING_UPDATE while a change
+// to one of its children is applied. Pending changes to its children complete
+// when the load balancer returns to ACTIVE.
+func (c *Cloud) updatingLoadBalancer(id string) {
+	c.transitionThen(kindLoadBalancer, id,
+		Object{"provisioning_status": lbStatusPendingUpdate},
+		Object{"provisioning_status": lbStatusActive},
+		func() { c.completeChildren(id) })
+}
+
+// completeChildren completes the pending transitions of all children of a
+// load balancer.
+func (c *Cloud) completeChildren(lbID string) {
+	for _, kind := range []string{kindListener, kindPool, kindMember, kindMonitor} {
+		for _, id := range c.all(kind) {
+			obj, ok := c.lookup(kind, id)
+			if ok && c.childLoadBalancerID(obj) == lbID {
+				c.complete(kind, id)
+			}
+		}
+	}
+}
+
+// childLoadBalancerID returns the ID of the load balancer a listener, pool,
+// member or health monitor belongs to.
+func (c *Cloud) childLoadBalancerID(obj Object) string {
+	if lbID := str(obj, "loadbalancer_id"); lbID != "" {
+		return lbID
+	}
+	pool, ok := c.lookup(kindPool, str(obj, "pool_id"))
+	if !ok {
+		return ""
+	}
+	return str(pool, "loadbalancer_id")
+}
+
+// provisionChild moves a newly created or updated child of a load balancer
+// to ACTIVE after the transition delay.
+func (c *Cloud) provisionChild(kind, id, pendingStatus, operatingStatus string) {
+	c.transition(kind, id,
+		Object{"provisioning_status": pendingStatus},
+		Object{"provisioning_status": lbStatusActive, "operating_status": operatingStatus})
+}
+
+// Load balancers
+
+// CreateLoadBalancer creates a load balancer from the body of an Octavia
+// create load balancer request. A VIP port is allocated immediately, and the
+// load balancer is PENDING_CREATE for the transition delay.
+func (c *Cloud) CreateLoadBalancer(req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	provider := str(req, "provider")
+	if provider == "" {
+		provider = lbProviderAmphora
+	}
+	if _, ok := c.lookup(kindLBProvider, provider); !ok {
+		return nil, badRequest(fmt.Sprintf("Provider '%s' is not enabled.", provider))
+	}
+	if flavorID := str(req, "flavor_id"); flavorID != "" {
+		if _, ok := c.lookup(kindLBFlavor, flavorID); !ok {
+			return nil, badRequest(fmt.Sprintf("Validation failure: Invalid flavor_id %s.", flavorID))
+		}
+	}
+
+	obj := c.newOctaviaObject(req)
+	obj["provider"] = provider
+	obj["id"] = c.newID()
+	setDefault(obj, "flavor_id", "")
+	setDefault(obj, "availability_zone", "")
+	setDefault(obj, "vip_qos_policy_id", "")
+
+	port, err := c.allocateVIP(obj, req)
+	if err != nil {
+		return nil, err
+	}
+	fixedIPs := objects(port, "fixed_ips")
+	obj["vip_port_id"] = str(port, "id")
+	obj["vip_network_id"] = str(port, "network_id")
+	obj["vip_subnet_id"] = str(fixedIPs[0], "subnet_id")
+	obj["vip_address"] = str(fixedIPs[0], "ip_address")
+	additionalVIPs := []any{}
+	for _, ip := range fixedIPs[1:] {
+		additionalVIPs = append(additionalVIPs, Object{"subnet_id": str(ip, "subnet_id"), "ip_address": str(ip, "ip_address")})
+	}
+	obj["additional_vips"] = additionalVIPs
+
+	c.add(kindLoadBalancer, obj)
+	c.provisionChild(kindLoadBalancer, str(obj, "id"), lbStatusPendingCreate, lbOperatingOnline)
+	return copyObject(c.loadBalancerView(obj)), nil
+}
+
+// allocateVIP returns the VIP port of a new load balancer. If the request
+// does not name an existing port, Octavia creates one on the requested
+// subnet or network, with an address on each additional VIP subnet.
+func (c *Cloud) allocateVIP(lb, req Object) (Object, error) {
+	if portID := str(req, "vip_port_id"); portID != "" {
+		port, ok := c.lookup(kindPort, portID)
+		if !ok {
+			return nil, badRequest(fmt.Sprintf("Validation failure: Port %s could not be found.", portID))
+		}
+		if len(objects(port, "fixed_ips")) == 0 {
+			return nil, badRequest(fmt.Sprintf("Validation failure: Port %s has no fixed IPs.", portID))
+		}
+		return port, nil
+	}
+
+	networkID := str(req, "vip_network_id")
+	subnetID := str(req, "vip_subnet_id")
+	if subnetID != "" {
+		subnet, ok := c.lookup(kindSubnet, subnetID)
+		if !ok {
+			return nil, badRequest(fmt.Sprintf("Validation failure: Subnet %s could not be found.", subnetID))
+		}
+		if networkID != "" && networkID != str(subnet, "network_id") {
+			return nil, badRequest("Validation failure: vip_subnet_id is not on vip_network_id.")
+		}
+		networkID = str(subnet, "network_id")
+	}
+	if networkID == "" {
+		return nil, badRequest("Validation failure: VIP must contain one of: vip_port_id, vip_network_id, vip_subnet_id.")
+	}
+	if _, ok := c.lookup(kindNetwork, networkID); !ok {
+		return nil, badRequest(fmt.Sprintf("Validation failure: Network %s could not be found.", networkID))
+	}
+	if subnetID == "" {
+		subnets := c.find(kindSubnet, func(o Object) bool { return str(o, "network_id") == networkID })
+		if len(subnets) == 0 {
+			return nil, badRequest(fmt.Sprintf("Validation failure: Supplied network %s does not contain any subnets.", networkID))
+		}
+		// Octavia prefers an IPv4 subnet
+		subnetID = str(subnets[0], "id")
+		for _, subnet := range subnets {
+			if num(subnet, "ip_version") == 4 {
+				subnetID = str(subnet, "id")
+				break
+			}
+		}
+	}
+
+	fixedIPs := []any{Object{"subnet_id": subnetID}}
+	if address := str(req, "vip_address"); address != "" {
+		fixedIPs[0] = Object{"subnet_id": subnetID, "ip_address": address}
+	}
+	for _, vip := range objects(req, "additional_vips") {
+		subnet, ok := c.lookup(kindSubnet, str(vip, "subnet_id"))
+		if !ok || str(subnet, "network_id") != networkID {
+			return nil, badRequest(fmt.Sprintf("Validation failure: Additional VIP subnet %s is not on the VIP network.", str(vip, "subnet_id")))
+		}
+		fixedIPs = append(fixedIPs, vip)
+	}
+
+	lbID := str(lb, "id")
+	return c.createPort(Object{
+		"name":                  "octavia-lb-" + lbID,
+		"network_id":            networkID,
+		"fixed_ips":             fixedIPs,
+		"device_owner":          deviceOwnerOctavia,
+		"device_id":             "lb-" + lbID,
+		"admin_state_up":        false,
+		"port_security_enabled": true,
+		"security_groups":       []any{},
+	})
+}
+
+// loadBalancerView adds the listeners and pools of a load balancer.
+func (c *Cloud) loadBalancerView(obj Object) Object {
+	obj = copyObject(obj)
+	id := str(obj, "id")
+	var listenerIDs, poolIDs []string
+	for _, listener := range c.find(kindListener, func(o Object) bool { return str(o, "loadbalancer_id") == id }) {
+		listenerIDs = append(listenerIDs, str(listener, "id"))
+	}
+	for _, pool := range c.find(kindPool, func(o Object) bool { return str(o, "loadbalancer_id") == id }) {
+		poolIDs = append(poolIDs, str(pool, "id"))
+	}
+	obj["listeners"] = idRefs(listenerIDs...)
+	obj["pools"] = idRefs(poolIDs...)
+	return obj
+}
+
+// GetLoadBalancer returns a load balancer.
+func (c *Cloud) GetLoadBalancer(id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.getResource(kindLoadBalancer, id, c.loadBalancerView)
+}
+
+// GetLoadBalancerStatuses returns the status tree of a load balancer: its
+// listeners, their default pools and the members of these pools.
+func (c *Cloud) GetLoadBalancerStatuses(id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	lb, ok := c.observe(kindLoadBalancer, id)
+	if !ok {
+		return nil, notFound(kindLoadBalancer, id)
+	}
+
+	statusOf := func(obj Object) Object {
+		return Object{
+			"id":                  str(obj, "id"),
+			"name":                str(obj, "name"),
+			"provisioning_status": str(obj, "provisioning_status"),
+			"operating_status":    str(obj, "operating_status"),
+		}
+	}
+	listeners := []any{}
+	for _, listener := range c.list(kindListener, func(o Object) bool { return str(o, "loadbalancer_id") == id }) {
+		pools := []any{}
+		if pool, ok := c.observe(kindPool, str(listener, "default_pool_id")); ok {
+			members := []any{}
+			for _, member := range c.list(kindMember, func(o Object) bool { return str(o, "pool_id") == str(pool, "id") }) {
+				member = c.memberView(member)
+				memberStatus := statusOf(member)
+				memberStatus["address"] = str(member, "address")
+				memberStatus["protocol_port"] = num(member, "protocol_port")
+				members = append(members, memberStatus)
+			}
+			poolStatus := statusOf(pool)
+			poolStatus["members"] = members
+			pools = append(pools, poolStatus)
+		}
+		listenerStatus := statusOf(listener)
+		listenerStatus["pools"] = pools
+		listeners = append(listeners, listenerStatus)
+	}
+	lbStatus := statusOf(lb)
+	lbStatus["listeners"] = listeners
+	return Object{"loadbalancer": lbStatus}, nil
+}
+
+// ListLoadBalancers returns the load balancers matching an Octavia list query.
+func (c *Cloud) ListLoadBalancers(query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.listResources(kindLoadBalancer, query, c.loadBalancerView)
+}
+
+// UpdateLoadBalancer updates a load balancer from the body of an Octavia
+// update load balancer request.
+func (c *Cloud) UpdateLoadBalancer(id string, req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindLoadBalancer, id)
+	if !ok {
+		return nil, notFound(kindLoadBalancer, id)
+	}
+	if _, err := c.mutableLoadBalancer(id); err != nil {
+		return nil, err
+	}
+	octaviaUpdate(obj, req, "vip_address", "vip_port_id", "vip_subnet_id", "vip_network_id", "provider", "flavor_id", "availability_zone", "provisioning_status", "operating_status")
+	c.updatingLoadBalancer(id)
+	return copyObject(c.loadBalancerView(obj)), nil
+}
+
+// DeleteLoadBalancer deletes a load balancer. Unless the query sets cascade
+// it fails if the load balancer has listeners or pools. The load balancer is
+// PENDING_DELETE for the transition delay, after which its children and VIP
+// port are deleted.
+func (c *Cloud) DeleteLoadBalancer(id string, query url.Values) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	lb, ok := c.observe(kindLoadBalancer, id)
+	if !ok {
+		return notFound(kindLoadBalancer, id)
+	}
+	if str(lb, "provisioning_status") == lbStatusPendingDelete {
+		return nil
+	}
+	if _, err := c.mutableLoadBalancer(id); err != nil {
+		return err
+	}
+	view := c.loadBalancerView(lb)
+	if query.Get("cascade") != "true" && (len(objects(view, "listeners")) > 0 || len(objects(view, "pools")) > 0) {
+		return badRequest(fmt.Sprintf("Cannot delete Load Balancer %s - it has children", id))
+	}
+
+	c.scheduleRemoval(kindLoadBalancer, id, Object{"provisioning_status": lbStatusPendingDelete}, func() {
+		for _, listener := range c.find(kindListener, func(o Object) bool { return str(o, "loadbalancer_id") == id }) {
+			c.remove(kindListener, str(listener, "id"))
+		}
+		for _, pool := range c.find(kindPool, func(o Object) bool { return str(o, "loadbalancer_id") == id }) {
+			c.removePool(pool)
+		}
+		if port, ok := c.lookup(kindPort, str(lb, "vip_port_id")); ok && str(port, "device_owner") == deviceOwnerOctavia {
+			c.deletePort(port)
+		}
+	})
+	return nil
+}
+
+// Listeners
+
+// CreateListener creates a listener from the body of an Octavia create
+// listener request.
+func (c *Cloud) CreateListener(req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	lbID := str(req, "loadbalancer_id")
+	lb, err := c.mutableLoadBalancer(lbID)
+	if err != nil {
+		return nil, err
+	}
+	protocol := str(req, "protocol")
+	port := num(req, "protocol_port")
+	if err := c.validateListener(lb, req); err != nil {
+		return nil, err
+	}
+	if err := c.validateTLSContainerRef(protocol, req); err != nil {
+		return nil, err
+	}
+	for _, listener := range c.find(kindListener, func(o Object) bool { return str(o, "loadbalancer_id") == lbID }) {
+		if num(listener, "protocol_port") == port {
+			return nil, conflict(fmt.Sprintf("Another Listener on this Load Balancer is already using protocol_port %d", port))
+		}
+	}
+
+	obj := c.newOctaviaObject(req)
+	obj["protocol"] = protocol
+	obj["loadbalancers"] = idRefs(lbID)
+	setDefault(obj, "default_pool_id", "")
+	setDefault(obj, "connection_limit", -1)
+	setDefault(obj, "allowed_cidrs", nil)
+	setDefault(obj, "insert_headers", Object{})
+	setDefault(obj, "timeout_client_data", 50000)
+	setDefault(obj, "timeout_member_data", 50000)
+	setDefault(obj, "timeout_member_connect", 5000)
+	setDefault(obj, "timeout_tcp_inspect", 0)
+	setDefault(obj, "default_tls_container_ref", "")
+	setDefault(obj, "sni_container_refs", []any{})
+	if poolID := str(obj, "default_pool_id"); poolID != "" {
+		pool, ok := c.lookup(kindPool, poolID)
+		if !ok || str(pool, "loadbalancer_id") != lbID {
+			return nil, notFound(kindPool, poolID)
+		}
+	}
+	c.add(kindListener, obj)
+	c.provisionChild(kindListener, str(obj, "id"), lbStatusPendingCreate, lbOperatingOnline)
+	c.updatingLoadBalancer(lbID)
+	return copyObject(c.listenerView(obj)), nil
+}
+
+// validateListener rejects listener options which are not supported by the
+// provider of the load balancer.
+func (c *Cloud) validateListener(lb, req Object) error {
+	if str(lb, "provider") != lbProviderOVN {
+		return nil
+	}
+	if protocol, ok := req["protocol"]; ok && !slices.Contains(ovnProtocols, fmt.Sprint(protocol)) {
+		return notImplemented(lbProviderOVN, fmt.Sprintf("OVN provider does not support %s protocol", protocol))
+	}
+	if cidrs := strs(req, "allowed_cidrs"); len(cidrs) > 0 {
+		return notImplemented(lbProviderOVN, "OVN provider does not support allowed_cidrs option")
+	}
+	return nil
+}
+
+// validateTLSContainerRef checks the certificate of a listener: it is
+// required for TERMINATED_HTTPS listeners, and must refer to a certificate
+// container in Barbican.
+func (c *Cloud) validateTLSContainerRef(protocol string, req Object) error {
+	ref := str(req, "default_tls_container_ref")
+	if protocol != listenerProtocolTerminatedHTTPS {
+		if ref != "" {
+			return badRequest("Validation failure: Certificate container references are only allowed on TERMINATED_HTTPS protocol listeners.")
+		}
+		return nil
+	}
+	if ref == "" {
+		return badRequest("Validation failure: An SNI or default certificate container reference must be provided for TERMINATED_HTTPS listeners.")
+	}
+	if _, ok := c.certificateContainer(ref); !ok {
+		return badRequest(fmt.Sprintf("Could not retrieve certificate: ['%s']", ref))
+	}
+	return nil
+}
+
+// listenerView adds the pools used by a listener.
+func (c *Cloud) listenerView(obj Object) Object {
+	obj = copyObject(obj)
+	var poolIDs []string
+	if poolID := str(obj, "default_pool_id"); poolID != "" {
+		poolIDs = append(poolIDs, poolID)
+	}
+	obj["pools"] = idRefs(poolIDs...)
+	return obj
+}
+
+// GetListener returns a listener.
+func (c *Cloud) GetListener(id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.getResource(kindListener, id, c.listenerView)
+}
+
+// ListListeners returns the listeners matching an Octavia list query.
+func (c *Cloud) ListListeners(query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.listResources(kindListener, query, c.listenerView)
+}
+
+// UpdateListener updates a listener from the body of an Octavia update
+// listener request.
+func (c *Cloud) UpdateListener(id string, req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindListener, id)
+	if !ok {
+		return nil, notFound(kindListener, id)
+	}
+	lbID := str(obj, "loadbalancer_id")
+	lb, err := c.mutableLoadBalancer(lbID)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.validateListener(lb, req); err != nil {
+		return nil, err
+	}
+	if _, ok := req["default_tls_container_ref"]; ok {
+		if err := c.validateTLSContainerRef(str(obj, "protocol"), req); err != nil {
+			return nil, err
+		}
+	}
+	if poolID := str(req, "default_pool_id"); poolID != "" {
+		pool, ok := c.lookup(kindPool, poolID)
+		if !ok || str(pool, "loadbalancer_id") != lbID {
+			return nil, notFound(kindPool, poolID)
+		}
+	}
+	octaviaUpdate(obj, req, "loadbalancer_id", "loadbalancers", "protocol", "protocol_port", "provisioning_status", "operating_status")
+	c.provisionChild(kindListener, id, lbStatusPendingUpdate, str(obj, "operating_status"))
+	c.updatingLoadBalancer(lbID)
+	return copyObject(c.listenerView(obj)), nil
+}
+
+// DeleteListener deletes a listener.
+func (c *Cloud) DeleteListener(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindListener, id)
+	if !ok {
+		return notFound(kindListener, id)
+	}
+	lbID := str(obj, "loadbalancer_id")
+	if _, err := c.mutableLoadBalancer(lbID); err != nil {
+		return err
+	}
+	c.scheduleRemoval(kindListener, id, Object{"provisioning_status": lbStatusPendingDelete}, nil)
+	c.updatingLoadBalancer(lbID)
+	return nil
+}
+
+// Pools
+
+// CreatePool creates a pool from the body of an Octavia create pool request.
+// If the request names a listener the pool becomes its default pool.
+func (c *Cloud) CreatePool(req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var listener Object
+	lbID := str(req, "loadbalancer_id")
+	if listenerID := str(req, "listener_id"); listenerID != "" {
+		var ok bool
+		listener, ok = c.lookup(kindListener, listenerID)
+		if !ok {
+			return nil, notFound(kindListener, listenerID)
+		}
+		if str(listener, "default_pool_id") != "" {
+			return nil, conflict(fmt.Sprintf("Listener %s is already using a default pool", listenerID))
+		}
+		lbID = str(listener, "loadbalancer_id")
+	}
+	if lbID == "" {
+		return nil, badRequest("Validation failure: loadbalancer_id or listener_id must be specified.")
+	}
+	lb, err := c.mutableLoadBalancer(lbID)
+	if err != nil {
+		return nil, err
+	}
+	if listener != nil {
+		protocol := str(req, "protocol")
+		if !slices.Contains(listenerPoolProtocols[str(listener, "protocol")], protocol) {
+			return nil, badRequest(fmt.Sprintf("Validation failure: The pool protocol '%s' is invalid while the listener protocol is '%s'.", protocol, str(listener, "protocol")))
+		}
+	}
+	if str(lb, "provider") == lbProviderOVN {
+		if algorithm := str(req, "lb_algorithm"); algorithm != lbAlgorithmSourceIPPort {
+			return nil, notImplemented(lbProviderOVN, fmt.Sprintf("OVN provider does not support %s algorithm", algorithm))
+		}
+		if protocol := str(req, "protocol"); !slices.Contains(ovnProtocols, protocol) {
+			return nil, notImplemented(lbProviderOVN, fmt.Sprintf("OVN provider does not support %s protocol", protocol))
+		}
+	}
+
+	obj := c.newOctaviaObject(req)
+	delete(obj, "listener_id")
+	obj["loadbalancer_id"] = lbID
+	obj["loadbalancers"] = idRefs(lbID)
+	obj["healthmonitor_id"] = ""
+	setDefault(obj, "session_persistence", nil)
+	setDefault(obj, "tls_enabled", false)
+	c.add(kindPool, obj)
+	if listener != nil {
+		listener["default_pool_id"] = str(obj, "id")
+	}
+	c.provisionChild(kindPool, str(obj, "id"), lbStatusPendingCreate, lbOperatingOnline)
+	c.updatingLoadBalancer(lbID)
+	return copyObject(c.poolView(obj)), nil
+}
+
+// poolView adds the listeners, members and health monitor of a pool.
+func (c *Cloud) poolView(obj Object) Object {
+	obj = copyObject(obj)
+	id := str(obj, "id")
+	var listenerIDs, memberIDs []string
+	for _, listener := range c.find(kindListener, func(o Object) bool { return str(o, "default_pool_id") == id }) {
+		listenerIDs = append(listenerIDs, str(listener, "id"))
+	}
+	for _, member := range c.find(kindMember, func(o Object) bool { return str(o, "pool_id") == id }) {
+		memberIDs = append(memberIDs, str(member, "id"))
+	}
+	obj["listeners"] = idRefs(listenerIDs...)
+	obj["members"] = idRefs(memberIDs...)
+	if monitors := c.find(kindMonitor, func(o Object) bool { return str(o, "pool_id") == id }); len(monitors) > 0 {
+		obj["healthmonitor_id"] = str(monitors[0], "id")
+	} else {
+		obj["healthmonitor_id"] = ""
+	}
+	return obj
+}
+
+// GetPool returns a pool.
+func (c *Cloud) GetPool(id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.getResource(kindPool, id, c.poolView)
+}
+
+// ListPools returns the pools matching an Octavia list query.
+func (c *Cloud) ListPools(query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.listResources(kindPool, query, c.poolView)
+}
+
+// UpdatePool updates a pool from the body of an Octavia update pool request.
+func (c *Cloud) UpdatePool(id string, req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindPool, id)
+	if !ok {
+		return nil, notFound(kindPool, id)
+	}
+	_, lbID, err := c.mutablePool(id)
+	if err != nil {
+		return nil, err
+	}
+	octaviaUpdate(obj, req, "loadbalancer_id", "loadbalancers", "listener_id", "listeners", "protocol", "provisioning_status", "operating_status")
+	c.provisionChild(kindPool, id, lbStatusPendingUpdate, str(obj, "operating_status"))
+	c.updatingLoadBalancer(lbID)
+	return copyObject(c.poolView(obj)), nil
+}
+
+// DeletePool deletes a pool with its members and health monitor.
+func (c *Cloud) DeletePool(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindPool, id)
+	if !ok {
+		return notFound(kindPool, id)
+	}
+	lbID := str(obj, "loadbalancer_id")
+	if _, err := c.mutableLoadBalancer(lbID); err != nil {
+		return err
+	}
+	c.scheduleRemoval(kindPool, id, Object{"provisioning_status": lbStatusPendingDelete}, func() { c.removePool(obj) })
+	c.updatingLoadBalancer(lbID)
+	return nil
+}
+
+func (c *Cloud) removePool(pool Object) {
+	id := str(pool, "id")
+	for _, member := range c.find(kindMember, func(o Object) bool { return str(o, "pool_id") == id }) {
+		c.remove(kindMember, str(member, "id"))
+	}
+	for _, monitor := range c.find(kindMonitor, func(o Object) bool { return str(o, "pool_id") == id }) {
+		c.remove(kindMonitor, str(monitor, "id"))
+	}
+	for _, listener := range c.find(kindListener, func(o Object) bool { return str(o, "default_pool_id") == id }) {
+		listener["default_pool_id"] = ""
+	}
+	c.remove(kindPool, id)
+}
+
+// Members
+
+// memberView reports the operating status of a provisioned member. Members
+// of a pool without a health monitor are not monitored, and disabled members
+// are offline. Otherwise the status set by SetPoolMemberOperatingStatus is
+// reported, if any.
+func (c *Cloud) memberView(obj Object) Object {
+	obj = copyObject(obj)
+	if str(obj, "provisioning_status") != lbStatusActive {
+		return obj
+	}
+	poolID := str(obj, "pool_id")
+	switch {
+	case !boolOr(obj, "admin_state_up", true):
+		obj["operating_status"] = lbOperatingOffline
+	case c.memberOperatingStatus[str(obj, "id")] != "":
+		obj["operating_status"] = c.memberOperatingStatus[str(obj, "id")]
+	case len(c.find(kindMonitor, func(o Object) bool { return str(o, "pool_id") == poolID })) > 0:
+		obj["operating_status"] = lbOperatingOnline
+	default:
+		obj["operating_status"] = lbOperatingNoMonitor
+	}
+	return obj
+}
+
+// mutablePool returns the pool and the ID of its load balancer, after
+// checking that the load balancer can be modified.
+func (c *Cloud) mutablePool(poolID string) (Object, string, error) {
+	pool, ok := c.lookup(kindPool, poolID)
+	if !ok {
+		return nil, "", notFound(kindPool, poolID)
+	}
+	lbID := str(pool, "loadbalancer_id")
+	if _, err := c.mutableLoadBalancer(lbID); err != nil {
+		return nil, "", err
+	}
+	return pool, lbID, nil
+}
+
+// CreatePoolMember adds a member to a pool from the body of an Octavia create
+// member request.
+func (c *Cloud) CreatePoolMember(poolID string, req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, lbID, err := c.mutablePool(poolID)
+	if err != nil {
+		return nil, err
+	}
+	address := str(req, "address")
+	port := num(req, "protocol_port")
+	for _, member := range c.find(kindMember, func(o Object) bool { return str(o, "pool_id") == poolID }) {
+		if str(member, "address") == address && num(member, "protocol_port") == port {
+			return nil, conflict(fmt.Sprintf("Duplicate member with address %s and port %d", address, port))
+		}
+	}
+	if subnetID := str(req, "subnet_id"); subnetID != "" {
+		if _, ok := c.lookup(kindSubnet, subnetID); !ok {
+			return nil, badRequest(fmt.Sprintf("Validation failure: Subnet %s could not be found.", subnetID))
+		}
+	}
+
+	obj := c.newOctaviaObject(req)
+	obj["pool_id"] = poolID
+	setDefault(obj, "weight", 1)
+	setDefault(obj, "backup", false)
+	setDefault(obj, "subnet_id", "")
+	setDefault(obj, "monitor_address", nil)
+	setDefault(obj, "monitor_port", nil)
+	c.add(kindMember, obj)
+	c.provisionChild(kindMember, str(obj, "id"), lbStatusPendingCreate, lbOperatingNoMonitor)
+	c.updatingLoadBalancer(lbID)
+	return copyObject(c.memberView(obj)), nil
+}
+
+// GetPoolMember returns a member of a pool.
+func (c *Cloud) GetPoolMember(poolID, id string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindMember, id)
+	if !ok || str(obj, "pool_id") != poolID {
+		return nil, notFound(kindMember, id)
+	}
+	return copyObject(c.memberView(obj)), nil
+}
+
+// ListPoolMembers returns the members of a pool matching an Octavia list
+// query.
+func (c *Cloud) ListPoolMembers(poolID string, query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.lookup(kindPool, poolID); !ok {
+		return nil, notFound(kindPool, poolID)
+	}
+	filter, err := queryFilter(query)
+	if err != nil {
+		return nil, err
+	}
+	var out []Object
+	for _, member := range c.list(kindMember, func(o Object) bool { return str(o, "pool_id") == poolID }) {
+		member = c.memberView(member)
+		if filter(member) {
+			out = append(out, copyObject(member))
+		}
+	}
+	return out, nil
+}
+
+// UpdatePoolMember updates a member of a pool from the body of an Octavia
+// update member request.
+func (c *Cloud) UpdatePoolMember(poolID, id string, req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindMember, id)
+	if !ok || str(obj, "pool_id") != poolID {
+		return nil, notFound(kindMember, id)
+	}
+	_, lbID, err := c.mutablePool(poolID)
+	if err != nil {
+		return nil, err
+	}
+	octaviaUpdate(obj, req, "pool_id", "address", "protocol_port", "subnet_id", "provisioning_status", "operating_status")
+	c.provisionChild(kindMember, id, lbStatusPendingUpdate, str(obj, "operating_status"))
+	c.updatingLoadBalancer(lbID)
+	return copyObject(c.memberView(obj)), nil
+}
+
+// DeletePoolMember removes a member from a pool.
+func (c *Cloud) DeletePoolMember(poolID, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindMember, id)
+	if !ok || str(obj, "pool_id") != poolID {
+		return notFound(kindMember, id)
+	}
+	_, lbID, err := c.mutablePool(poolID)
+	if err != nil {
+		return err
+	}
+	c.scheduleRemoval(kindMember, id, Object{"provisioning_status": lbStatusPendingDelete}, nil)
+	c.updatingLoadBalancer(lbID)
+	return nil
+}
+
+// Health monitors
+
+// CreateMonitor creates a health monitor from the body of an Octavia create
+// health monitor request.
+func (c *Cloud) CreateMonitor(req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	poolID := str(req, "pool_id")
+	_, lbID, err := c.mutablePool(poolID)
+	if err != nil {
+		return nil, err
+	}
+	if monitors := c.find(kindMonitor, func(o Object) bool { return str(o, "pool_id") == poolID }); len(monitors) > 0 {
+		return nil, conflict(fmt.Sprintf("Pool %s already has a health monitor", poolID))
+	}
+	lb, _ := c.lookup(kindLoadBalancer, lbID)
+	monitorType := str(req, "type")
+	if str(lb, "provider") == lbProviderOVN && !slices.Contains(ovnMonitorTypes, monitorType) {
+		return nil, notImplemented(lbProviderOVN, fmt.Sprintf("OVN provider does not support %s health monitor type", monitorType))
+	}
+
+	obj := c.newOctaviaObject(req)
+	obj["pools"] = idRefs(poolID)
+	setDefault(obj, "max_retries_down", 3)
+	if strings.HasPrefix(monitorType, "HTTP") {
+		setDefault(obj, "http_method", "GET")
+		setDefault(obj, "url_path", "/")
+		setDefault(obj, "expected_codes", "200")
+	}
+	c.add(kindMonitor, obj)
+	c.provisionChild(kindMonitor, str(obj, "id"), lbStatusPendingCreate, lbOperatingOnline)
+	c.updatingLoadBalancer(lbID)
+	return copyObject(obj), nil
+}
+
+// ListMonitors returns the health monitors matching an Octavia list query.
+func (c *Cloud) ListMonitors(query url.Values) ([]Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.listResources(kindMonitor, query, nil)
+}
+
+// UpdateMonitor updates a health monitor from the body of an Octavia update
+// health monitor request.
+func (c *Cloud) UpdateMonitor(id string, req Object) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindMonitor, id)
+	if !ok {
+		return nil, notFound(kindMonitor, id)
+	}
+	_, lbID, err := c.mutablePool(str(obj, "pool_id"))
+	if err != nil {
+		return nil, err
+	}
+	octaviaUpdate(obj, req, "pool_id", "pools", "type", "provisioning_status", "operating_status")
+	c.provisionChild(kindMonitor, id, lbStatusPendingUpdate, str(obj, "operating_status"))
+	c.updatingLoadBalancer(lbID)
+	return copyObject(obj), nil
+}
+
+// DeleteMonitor deletes a health monitor.
+func (c *Cloud) DeleteMonitor(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	obj, ok := c.observe(kindMonitor, id)
+	if !ok {
+		return notFound(kindMonitor, id)
+	}
+	_, lbID, err := c.mutablePool(str(obj, "pool_id"))
+	if err != nil {
+		return err
+	}
+	c.scheduleRemoval(kindMonitor, id, Object{"provisioning_status": lbStatusPendingDelete}, nil)
+	c.updatingLoadBalancer(lbID)
+	return nil
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Names of the quotas which can be limited with WithQuota and SetQuota, as
+// used by the OpenStack API of the service which enforces them.
+const (
+	QuotaInstances   = "instances"
+	QuotaCores       = "cores"
+	QuotaRAM         = "ram"
+	QuotaPorts       = "port"
+	QuotaFloatingIPs = "floatingip"
+	QuotaVolumes     = "volumes"
+	QuotaGigabytes   = "gigabytes"
+)
+
+// WithQuota limits a quota of the project. Quotas which are not limited are
+// unlimited.
+func WithQuota(resource string, limit int) Option {
+	return func(c *Cloud) {
+		c.quotas[resource] = limit
+	}
+}
+
+// SetQuota limits a quota of the project. A limit of -1 makes it unlimited.
+func (c *Cloud) SetQuota(resource string, limit int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.quotas[resource] = limit
+}
+
+func (c *Cloud) quotaLimit(resource string) int {
+	if limit, ok := c.quotas[resource]; ok {
+		return limit
+	}
+	return -1
+}
+
+// quotaInUse returns the amount of a quota used by the resources which exist
+// in the Cloud.
+func (c *Cloud) quotaInUse(resource string) int {
+	sum := func(kind string, value func(Object) int) int {
+		total := 0
+		for _, obj := range c.resources[kind] {
+			total += value(obj.obj)
+		}
+		return total
+	}
+	flavorValue := func(key string) func(Object) int {
+		return func(server Object) int {
+			flavor, _ := server["flavor"].(Object)
+			return num(flavor, key)
+		}
+	}
+
+	switch resource {
+	case QuotaInstances:
+		return len(c.resources[kindServer])
+	case QuotaCores:
+		return sum(kindServer, flavorValue("vcpus"))
+	case QuotaRAM:
+		return sum(kindServer, flavorValue("ram"))
+	case QuotaPorts:
+		return len(c.resources[kindPort])
+	case QuotaFloatingIPs:
+		return len(c.resources[kindFloatingIP])
+	case QuotaVolumes:
+		return len(c.resources[kindVolume])
+	case QuotaGigabytes:
+		return sum(kindVolume, func(volume Object) int { return num(volume, "size") })
+	}
+	return 0
+}
+
+// checkQuota returns the error the service enforcing a quota returns when
+// creating a resource would exceed it.
+func (c *Cloud) checkQuota(resource string, requested int) error {
+	limit := c.quotaLimit(resource)
+	if limit < 0 {
+		return nil
+	}
+	used := c.quotaInUse(resource)
+	if used+requested <= limit {
+		return nil
+	}
+	switch resource {
+	case QuotaPorts, QuotaFloatingIPs:
+		return APIError(http.StatusConflict, fmt.Sprintf("Quota exceeded for resources: ['%s'].", resource))
+	case QuotaVolumes, QuotaGigabytes:
+		return APIError(http.StatusRequestEntityTooLarge, fmt.Sprintf("VolumeLimitExceeded: Maximum number of %s allowed (%d) exceeded for quota '%s'.", resource, limit, resource))
+	default:
+		return APIError(http.StatusForbidden, fmt.Sprintf("Quota exceeded for %s: Requested %d, but already used %d of %d %s", resource, requested, used, limit, resource))
+	}
+}
+
+// checkQuotaProject returns the error returned to a user who is not an
+// administrator when reading the quotas of another project.
+func (c *Cloud) checkQuotaProject(projectID string) error {
+	if projectID != c.projectID {
+		return APIError(http.StatusForbidden, fmt.Sprintf("Policy doesn't allow reading the quotas of project %s.", projectID))
+	}
+	return nil
+}
+
+// GetComputeQuotaDetail returns the Nova quota set of the project, with usage.
+func (c *Cloud) GetComputeQuotaDetail(projectID string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkQuotaProject(projectID); err != nil {
+		return nil, err
+	}
+	obj := Object{"id": c.projectID}
+	for _, resource := range []string{QuotaInstances, QuotaCores, QuotaRAM} {
+		obj[resource] = Object{"in_use": c.quotaInUse(resource), "reserved": 0, "limit": c.quotaLimit(resource)}
+	}
+	return obj, nil
+}
+
+// GetNetworkQuotaDetail returns the Neutron quotas of the project, with usage.
+func (c *Cloud) GetNetworkQuotaDetail(projectID string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkQuotaProject(projectID); err != nil {
+		return nil, err
+	}
+	obj := Object{}
+	for _, resource := range []string{QuotaPorts, QuotaFloatingIPs} {
+		obj[resource] = Object{"used": c.quotaInUse(resource), "reserved": 0, "limit": c.quotaLimit(resource)}
+	}
+	return obj, nil
+}
+
+// GetVolumeQuotaUsage returns the Cinder quota set of the project, with usage.
+func (c *Cloud) GetVolumeQuotaUsage(projectID string) (Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkQuotaProject(projectID); err != nil {
+		return nil, err
+	}
+	obj := Object{"id": c.projectID}
+	for _, resource := range []string{QuotaVolumes, QuotaGigabytes} {
+		obj[resource] = Object{"in_use": c.quotaInUse(resource), "allocated": 0, "reserved": 0, "limit": c.quotaLimit(resource)}
+	}
+	return obj, nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"net/http"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+)
+
+func (s *Simulator) registerCompute() {
+	const prefix = "/compute/v2.1/"
+
+	s.handleVersions("GET "+prefix+"{$}", func(r *http.Request) fake.Object {
+		return fake.Object{"version": fake.Object{
+			"id":          "v2.1",
+			"status":      "CURRENT",
+			"version":     fake.MaxNovaMicroversion,
+			"min_version": fake.MinNovaMicroversion,
+			"links": []fake.Object{
+				{"rel": "self", "href": baseURL(r) + prefix},
+			},
+		}}
+	})
+
+	s.handle("GET "+prefix+"os-availability-zone", func(*http.Request) result {
+		return many("availabilityZoneInfo", s.cloud.ListAvailabilityZones(), nil)
+	})
+
+	s.handle("GET "+prefix+"flavors/detail", func(*http.Request) result {
+		return many("flavors", s.cloud.ListFlavors(), nil)
+	})
+	s.handle("GET "+prefix+"flavors/{id}", func(r *http.Request) result {
+		obj, err := s.cloud.GetFlavor(r.PathValue("id"))
+		return one(http.StatusOK, "flavor", obj, err)
+	})
+
+	s.handle("GET "+prefix+"os-server-groups", func(*http.Request) result {
+		return many("server_groups", s.cloud.ListServerGroups(), nil)
+	})
+
+	s.handle("POST "+prefix+"servers", func(r *http.Request) result {
+		body, err := decodeBody(r)
+		if err != nil {
+			return result{err: err}
+		}
+		obj, err := s.cloud.CreateServer(body)
+		return one(http.StatusAccepted, "server", obj, err)
+	})
+	s.handle("GET "+prefix+"servers/detail", func(r *http.Request) result {
+		objs, err := s.cloud.ListServers(r.URL.Query())
+		return many("servers", objs, err)
+	})
+	s.handle("GET "+prefix+"servers/{id}", func(r *http.Request) result {
+		obj, err := s.cloud.GetServer(r.PathValue("id"))
+		return one(http.StatusOK, "server", obj, err)
+	})
+	s.handle("DELETE "+prefix+"servers/{id}", func(r *http.Request) result {
+		return empty(http.StatusNoContent, s.cloud.DeleteServer(r.PathValue("id")))
+	})
+	s.handle("POST "+prefix+"servers/{id}/action", func(r *http.Request) result {
+		body, err := decodeBody(r)
+		if err != nil {
+			return result{err: err}
+		}
+		if _, ok := body["os-getConsoleOutput"]; !ok {
+			return result{err: fake.APIError(http.StatusBadRequest, "Unsupported server action.")}
+		}
+		output, err := s.cloud.GetConsoleOutput(r.PathValue("id"))
+		return raw(http.StatusOK, fake.Object{"output": output}, err)
+	})
+
+	s.handle("GET "+prefix+"servers/{id}/os-interface", func(r *http.Request) result {
+		objs, err := s.cloud.ListAttachedInterfaces(r.PathValue("id"))
+		return many("interfaceAttachments", objs, err)
+	})
+	s.handle("DELETE "+prefix+"servers/{id}/os-interface/{portID}", func(r *http.Request) result {
+		return empty(http.StatusAccepted, s.cloud.DeleteAttachedInterface(r.PathValue("id"), r.PathValue("portID")))
+	})
+
+	s.handle("GET "+prefix+"os-quota-sets/{project_id}/detail", func(r *http.Request) result {
+		obj, err := s.cloud.GetComputeQuotaDetail(r.PathValue("project_id"))
+		return one(http.StatusOK, "quota_set", obj, err)
+	})
+}
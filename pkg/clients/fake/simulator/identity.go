@@ -0,0 +1,366 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gophercloud/gophercloud/v2"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+)
+
+const (
+	userID   = fake.DefaultUserID
+	domainID = "default"
+)
+
+// services are the entries of the service catalog: the service type, its
+// name, and the path of its endpoint relative to the simulator.
+var services = []struct {
+	serviceType string
+	name        string
+	path        string
+}{
+	{"identity", "keystone", "/identity"},
+	{"compute", "nova", "/compute/v2.1"},
+	{"network", "neutron", "/network"},
+	{"block-storage", "cinderv3", "/volume/v3/{project_id}"},
+	{"image", "glance", "/image"},
+	{"load-balancer", "octavia", "/load-balancer"},
+	{"key-manager", "barbican", "/key-manager"},
+}
+
+func (s *Simulator) registerIdentity() {
+	versions := func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusMultipleChoices, fake.Object{
+			"versions": fake.Object{
+				"values": []fake.Object{identityVersion(r)},
+			},
+		})
+	}
+	s.mux.HandleFunc("GET /identity", versions)
+	s.mux.HandleFunc("GET /identity/{$}", versions)
+	s.mux.HandleFunc("GET /identity/v3/{$}", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, fake.Object{"version": identityVersion(r)})
+	})
+	s.mux.HandleFunc("POST /identity/v3/auth/tokens", s.createToken)
+	s.mux.HandleFunc("GET /identity/v3/auth/tokens", s.getToken)
+	s.mux.HandleFunc("POST /identity/v3/OS-FEDERATION/identity_providers/{idp}/protocols/{protocol}/auth", s.federatedAuth)
+
+	const prefix = "/identity/v3/users/{user_id}/"
+	s.handle("POST "+prefix+"application_credentials", func(r *http.Request) result {
+		// Keystone only permits unrestricted application credentials to create other application credentials
+		if credential := s.issued(r.Header.Get("X-Auth-Token")).applicationCredential; credential != nil && !boolValue(credential, "unrestricted") {
+			return result{err: fake.APIError(http.StatusForbidden, "You are not authorized to perform the requested action: identity:create_application_credential.")}
+		}
+		body, err := decodeBody(r)
+		if err != nil {
+			return result{err: err}
+		}
+		obj, err := s.cloud.CreateApplicationCredential(r.PathValue("user_id"), body)
+		return one(http.StatusCreated, "application_credential", obj, err)
+	})
+	s.handle("GET "+prefix+"application_credentials", func(r *http.Request) result {
+		objs, err := s.cloud.ListApplicationCredentials(r.PathValue("user_id"), r.URL.Query())
+		return many("application_credentials", objs, err)
+	})
+	s.handle("GET "+prefix+"application_credentials/{id}", func(r *http.Request) result {
+		obj, err := s.cloud.GetApplicationCredential(r.PathValue("user_id"), r.PathValue("id"))
+		return one(http.StatusOK, "application_credential", obj, err)
+	})
+	s.handle("DELETE "+prefix+"application_credentials/{id}", func(r *http.Request) result {
+		return empty(http.StatusNoContent, s.cloud.DeleteApplicationCredential(r.PathValue("user_id"), r.PathValue("id")))
+	})
+}
+
+func identityVersion(r *http.Request) fake.Object {
+	return fake.Object{
+		"id":      "v3.14",
+		"status":  "stable",
+		"updated": "2020-04-07T00:00:00Z",
+		"links": []fake.Object{
+			{"rel": "self", "href": baseURL(r) + "/identity/v3/"},
+		},
+	}
+}
+
+// issuedToken describes a token issued by Keystone.
+type issuedToken struct {
+	methods   []string
+	issuedAt  time.Time
+	expiresAt time.Time
+
+	// applicationCredential is the application credential the token was
+	// issued for, if any. The token is revoked when it is deleted.
+	applicationCredential fake.Object
+
+	// unscoped is true for a token issued by federated authentication,
+	// which can only be used to obtain a scoped token.
+	unscoped bool
+
+	// trustID is the trust the token is scoped to, if any.
+	trustID string
+}
+
+// federation is an identity provider federated with Keystone.
+type federation struct {
+	identityProvider string
+	protocol         string
+	token            string
+}
+
+// federatedAuth exchanges a bearer token issued by a federated identity
+// provider for an unscoped token, as Keystone does with mod_auth_openidc.
+func (s *Simulator) federatedAuth(w http.ResponseWriter, r *http.Request) {
+	f := s.federation
+	if f == nil || r.PathValue("idp") != f.identityProvider || r.PathValue("protocol") != f.protocol {
+		writeError(w, fake.APIError(http.StatusNotFound, "Could not find federated protocol "+r.PathValue("protocol")+" for identity provider "+r.PathValue("idp")+"."))
+		return
+	}
+	if r.Header.Get("Authorization") != "Bearer "+f.token {
+		writeError(w, fake.APIError(http.StatusUnauthorized, "The request you have made requires authentication."))
+		return
+	}
+
+	token := &issuedToken{
+		methods:  []string{"mapped"},
+		issuedAt: time.Now().UTC(),
+		unscoped: true,
+	}
+	token.expiresAt = token.issuedAt.Add(s.tokenTTL)
+	id := s.issue(token)
+
+	w.Header().Set("X-Subject-Token", id)
+	writeJSON(w, http.StatusCreated, fake.Object{"token": s.token(r, token)})
+}
+
+// createToken authenticates a request with the password, token or
+// application credential method and issues a project scoped token.
+func (s *Simulator) createToken(w http.ResponseWriter, r *http.Request) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	identity := object(object(body, "auth"), "identity")
+	methods := stringList(identity, "methods")
+
+	token := &issuedToken{
+		methods:  methods,
+		issuedAt: time.Now().UTC(),
+	}
+	token.expiresAt = token.issuedAt.Add(s.tokenTTL)
+	token.trustID = stringValue(object(object(object(body, "auth"), "scope"), "OS-TRUST:trust"), "id")
+
+	authenticated := false
+	switch {
+	case slices.Contains(methods, "password"):
+		user := object(object(identity, "password"), "user")
+		authenticated = (user["name"] == s.username || user["id"] == userID) && user["password"] == s.password
+	case slices.Contains(methods, "token"):
+		id, _ := object(identity, "token")["id"].(string)
+		if s.validToken(id) {
+			authenticated = true
+			// A token issued from another token keeps its restrictions and expiry
+			previous := s.issued(id)
+			token.applicationCredential = previous.applicationCredential
+			token.expiresAt = previous.expiresAt
+		}
+	case slices.Contains(methods, "application_credential"):
+		credential := object(identity, "application_credential")
+		id, _ := credential["id"].(string)
+		name, _ := credential["name"].(string)
+		secret, _ := credential["secret"].(string)
+		obj, err := s.cloud.AuthenticateApplicationCredential(id, name, secret)
+		if err == nil {
+			authenticated = true
+			token.applicationCredential = obj
+			// Tokens issued for an application credential expire with it
+			if expiresAt, err := time.Parse(gophercloud.RFC3339MilliNoZ, stringValue(obj, "expires_at")); err == nil && expiresAt.Before(token.expiresAt) {
+				token.expiresAt = expiresAt
+			}
+		}
+	}
+	if !authenticated {
+		writeError(w, fake.APIError(http.StatusUnauthorized, "The request you have made requires authentication."))
+		return
+	}
+
+	id := s.issue(token)
+	w.Header().Set("X-Subject-Token", id)
+	writeJSON(w, http.StatusCreated, fake.Object{"token": s.token(r, token)})
+}
+
+// issue records token and returns its ID.
+func (s *Simulator) issue(token *issuedToken) string {
+	id := strings.ReplaceAll(uuid.New().String(), "-", "")
+	s.mu.Lock()
+	s.tokens[id] = token
+	s.mu.Unlock()
+	return id
+}
+
+// getToken validates the token in the X-Subject-Token header.
+func (s *Simulator) getToken(w http.ResponseWriter, r *http.Request) {
+	if !s.validToken(r.Header.Get("X-Auth-Token")) {
+		writeError(w, fake.APIError(http.StatusUnauthorized, "The request you have made requires authentication."))
+		return
+	}
+	id := r.Header.Get("X-Subject-Token")
+	if !s.validToken(id) {
+		writeError(w, fake.APIError(http.StatusNotFound, "Could not find token: "+id+"."))
+		return
+	}
+	w.Header().Set("X-Subject-Token", id)
+	writeJSON(w, http.StatusOK, fake.Object{"token": s.token(r, s.issued(id))})
+}
+
+func (s *Simulator) token(r *http.Request, token *issuedToken) fake.Object {
+	projectID := s.cloud.ProjectID()
+	domain := fake.Object{"id": domainID, "name": DefaultDomainName}
+
+	if token.unscoped {
+		return fake.Object{
+			"methods":    token.methods,
+			"issued_at":  token.issuedAt.Format(time.RFC3339Nano),
+			"expires_at": token.expiresAt.Format(time.RFC3339Nano),
+			"user": fake.Object{
+				"id":     userID,
+				"name":   s.username,
+				"domain": domain,
+			},
+		}
+	}
+
+	catalog := make([]fake.Object, 0, len(services))
+	for _, service := range services {
+		url := baseURL(r) + strings.ReplaceAll(service.path, "{project_id}", projectID)
+		endpoints := []fake.Object{}
+		for _, iface := range []string{"public", "internal", "admin"} {
+			endpoints = append(endpoints, fake.Object{
+				"id":        service.name + "-" + iface,
+				"interface": iface,
+				"region":    s.region,
+				"region_id": s.region,
+				"url":       url,
+			})
+		}
+		catalog = append(catalog, fake.Object{
+			"id":        service.name,
+			"type":      service.serviceType,
+			"name":      service.name,
+			"endpoints": endpoints,
+		})
+	}
+
+	body := fake.Object{
+		"methods":    token.methods,
+		"issued_at":  token.issuedAt.Format(time.RFC3339Nano),
+		"expires_at": token.expiresAt.Format(time.RFC3339Nano),
+		"user": fake.Object{
+			"id":     userID,
+			"name":   s.username,
+			"domain": domain,
+		},
+		"project": fake.Object{
+			"id":     projectID,
+			"name":   s.projectName,
+			"domain": domain,
+		},
+		"roles": []fake.Object{
+			{"id": "admin", "name": "admin"},
+			{"id": "member", "name": "member"},
+			{"id": "reader", "name": "reader"},
+		},
+		"catalog": catalog,
+	}
+	if token.trustID != "" {
+		body["OS-TRUST:trust"] = fake.Object{
+			"id":            token.trustID,
+			"trustee_user":  fake.Object{"id": userID},
+			"impersonation": false,
+		}
+	}
+	if credential := token.applicationCredential; credential != nil {
+		body["roles"] = credential["roles"]
+		body["application_credential"] = fake.Object{
+			"id":         credential["id"],
+			"name":       credential["name"],
+			"restricted": !boolValue(credential, "unrestricted"),
+		}
+	}
+	return body
+}
+
+// validToken returns true if id is an issued token which has not expired or
+// been revoked.
+func (s *Simulator) validToken(id string) bool {
+	token := s.issued(id)
+	if token == nil {
+		return false
+	}
+	revoked := false
+	if credential := token.applicationCredential; credential != nil {
+		_, err := s.cloud.GetApplicationCredential(userID, stringValue(credential, "id"))
+		revoked = err != nil
+	}
+	if revoked || time.Now().After(token.expiresAt) {
+		s.mu.Lock()
+		delete(s.tokens, id)
+		s.mu.Unlock()
+		return false
+	}
+	return true
+}
+
+// issued returns the token with the given ID, or nil if it was not issued.
+func (s *Simulator) issued(id string) *issuedToken {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.tokens[id]
+}
+
+func object(obj fake.Object, key string) fake.Object {
+	v, _ := obj[key].(map[string]any)
+	return v
+}
+
+func stringValue(obj fake.Object, key string) string {
+	v, _ := obj[key].(string)
+	return v
+}
+
+func boolValue(obj fake.Object, key string) bool {
+	v, _ := obj[key].(bool)
+	return v
+}
+
+func stringList(obj fake.Object, key string) []string {
+	var out []string
+	values, _ := obj[key].([]any)
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"net/http"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+)
+
+func (s *Simulator) registerImage() {
+	const prefix = "/image/v2/"
+
+	s.handleVersions("GET /image/{$}", func(r *http.Request) fake.Object {
+		return fake.Object{"versions": []fake.Object{versionDocument(r, "v2.16", prefix)}}
+	})
+
+	s.handle("POST "+prefix+"images", func(r *http.Request) result {
+		body, err := decodeBody(r)
+		if err != nil {
+			return result{err: err}
+		}
+		obj, err := s.cloud.CreateImage(body)
+		return raw(http.StatusCreated, obj, err)
+	})
+	s.handle("GET "+prefix+"images", func(r *http.Request) result {
+		objs, err := s.cloud.ListImages(r.URL.Query())
+		return many("images", objs, err)
+	})
+	s.handle("GET "+prefix+"images/{id}", func(r *http.Request) result {
+		obj, err := s.cloud.GetImage(r.PathValue("id"))
+		return raw(http.StatusOK, obj, err)
+	})
+	s.handle("DELETE "+prefix+"images/{id}", func(r *http.Request) result {
+		return empty(http.StatusNoContent, s.cloud.DeleteImage(r.PathValue("id")))
+	})
+	s.handle("PUT "+prefix+"images/{id}/file", func(r *http.Request) result {
+		return empty(http.StatusNoContent, s.cloud.UploadImageData(r.PathValue("id"), r.Body))
+	})
+	s.handle("GET "+prefix+"info/import", func(*http.Request) result {
+		return raw(http.StatusOK, s.cloud.GetImportInfo(), nil)
+	})
+	s.handle("POST "+prefix+"images/{id}/import", func(r *http.Request) result {
+		body, err := decodeBody(r)
+		if err != nil {
+			return result{err: err}
+		}
+		return empty(http.StatusAccepted, s.cloud.ImportImage(r.PathValue("id"), body))
+	})
+}
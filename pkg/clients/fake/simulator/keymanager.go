@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"net/http"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+)
+
+func (s *Simulator) registerKeyManager() {
+	const prefix = "/key-manager/v1/"
+
+	s.handleVersions("GET /key-manager/{$}", func(r *http.Request) fake.Object {
+		return fake.Object{"versions": fake.Object{"values": []fake.Object{versionDocument(r, "v1", prefix)}}}
+	})
+
+	// Barbican request and response bodies are not wrapped in the resource
+	// type, and creating a resource only returns its reference.
+	s.handle("POST "+prefix+"secrets", func(r *http.Request) result {
+		body, err := decodeBody(r)
+		if err != nil {
+			return result{err: err}
+		}
+		obj, err := s.cloud.CreateSecret(body)
+		return raw(http.StatusCreated, obj, err)
+	})
+	s.handle("DELETE "+prefix+"secrets/{id}", func(r *http.Request) result {
+		return empty(http.StatusNoContent, s.cloud.DeleteSecret(r.PathValue("id")))
+	})
+	s.handle("POST "+prefix+"containers", func(r *http.Request) result {
+		body, err := decodeBody(r)
+		if err != nil {
+			return result{err: err}
+		}
+		obj, err := s.cloud.CreateContainer(body)
+		return raw(http.StatusCreated, obj, err)
+	})
+	s.handle("GET "+prefix+"containers", func(r *http.Request) result {
+		objs, err := s.cloud.ListContainers(r.URL.Query())
+		if err != nil {
+			return result{err: err}
+		}
+		if objs == nil {
+			objs = []fake.Object{}
+		}
+		return raw(http.StatusOK, fake.Object{"containers": objs, "total": len(objs)}, nil)
+	})
+	s.handle("DELETE "+prefix+"containers/{id}", func(r *http.Request) result {
+		return empty(http.StatusNoContent, s.cloud.DeleteContainer(r.PathValue("id")))
+	})
+}
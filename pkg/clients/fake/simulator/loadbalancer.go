@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"net/http"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+)
+
+func (s *Simulator) registerLoadBalancer() {
+	const prefix = "/load-balancer/v2.0/lbaas/"
+
+	s.handleVersions("GET /load-balancer/{$}", func(*http.Request) fake.Object {
+		return fake.Object{"versions": s.cloud.ListOctaviaVersions()}
+	})
+
+	for _, res := range []resource{
+		{
+			path: prefix + "loadbalancers", key: "loadbalancer", plural: "loadbalancers",
+			create: s.cloud.CreateLoadBalancer, list: s.cloud.ListLoadBalancers, get: s.cloud.GetLoadBalancer,
+			update: s.cloud.UpdateLoadBalancer,
+		},
+		{
+			path: prefix + "listeners", key: "listener", plural: "listeners",
+			create: s.cloud.CreateListener, list: s.cloud.ListListeners, get: s.cloud.GetListener,
+			update: s.cloud.UpdateListener, delete: s.cloud.DeleteListener,
+		},
+		{
+			path: prefix + "pools", key: "pool", plural: "pools",
+			create: s.cloud.CreatePool, list: s.cloud.ListPools, get: s.cloud.GetPool,
+			update: s.cloud.UpdatePool, delete: s.cloud.DeletePool,
+		},
+		{
+			path: prefix + "healthmonitors", key: "healthmonitor", plural: "healthmonitors",
+			create: s.cloud.CreateMonitor, list: s.cloud.ListMonitors,
+			update: s.cloud.UpdateMonitor, delete: s.cloud.DeleteMonitor,
+		},
+	} {
+		s.registerResource(res)
+	}
+
+	// Deleting a load balancer takes a cascade query parameter
+	s.handle("DELETE "+prefix+"loadbalancers/{id}", func(r *http.Request) result {
+		return empty(http.StatusNoContent, s.cloud.DeleteLoadBalancer(r.PathValue("id"), r.URL.Query()))
+	})
+
+	s.handle("GET "+prefix+"loadbalancers/{id}/status", func(r *http.Request) result {
+		obj, err := s.cloud.GetLoadBalancerStatuses(r.PathValue("id"))
+		return one(http.StatusOK, "statuses", obj, err)
+	})
+
+	// Members are a sub-resource of their pool
+	s.handle("POST "+prefix+"pools/{poolID}/members", func(r *http.Request) result {
+		req, err := decodeResource(r, "member")
+		if err != nil {
+			return result{err: err}
+		}
+		obj, err := s.cloud.CreatePoolMember(r.PathValue("poolID"), req)
+		return one(http.StatusCreated, "member", obj, err)
+	})
+	s.handle("GET "+prefix+"pools/{poolID}/members", func(r *http.Request) result {
+		objs, err := s.cloud.ListPoolMembers(r.PathValue("poolID"), r.URL.Query())
+		return many("members", objs, err)
+	})
+	s.handle("GET "+prefix+"pools/{poolID}/members/{id}", func(r *http.Request) result {
+		obj, err := s.cloud.GetPoolMember(r.PathValue("poolID"), r.PathValue("id"))
+		return one(http.StatusOK, "member", obj, err)
+	})
+	s.handle("PUT "+prefix+"pools/{poolID}/members/{id}", func(r *http.Request) result {
+		req, err := decodeResource(r, "member")
+		if err != nil {
+			return result{err: err}
+		}
+		obj, err := s.cloud.UpdatePoolMember(r.PathValue("poolID"), r.PathValue("id"), req)
+		return one(http.StatusOK, "member", obj, err)
+	})
+	s.handle("DELETE "+prefix+"pools/{poolID}/members/{id}", func(r *http.Request) result {
+		return empty(http.StatusNoContent, s.cloud.DeletePoolMember(r.PathValue("poolID"), r.PathValue("id")))
+	})
+
+	s.handle("GET "+prefix+"providers", func(*http.Request) result {
+		return many("providers", s.cloud.ListLoadBalancerProviders(), nil)
+	})
+	s.handle("GET "+prefix+"flavors", func(*http.Request) result {
+		return many("flavors", s.cloud.ListLoadBalancerFlavors(), nil)
+	})
+}
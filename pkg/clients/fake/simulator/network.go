@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"net/http"
+	"net/url"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+)
+
+// resource describes the operations of a REST resource which follows the
+// Neutron and Octavia conventions: POST and GET on the collection, and GET,
+// PUT and DELETE on a member, with the resource wrapped in its singular or
+// plural name. Operations which are nil are not served.
+type resource struct {
+	path   string
+	key    string
+	plural string
+
+	create func(fake.Object) (fake.Object, error)
+	list   func(url.Values) ([]fake.Object, error)
+	get    func(string) (fake.Object, error)
+	update func(string, fake.Object) (fake.Object, error)
+	delete func(string) error
+}
+
+func (s *Simulator) registerResource(res resource) {
+	if res.create != nil {
+		s.handle("POST "+res.path, func(r *http.Request) result {
+			req, err := decodeResource(r, res.key)
+			if err != nil {
+				return result{err: err}
+			}
+			obj, err := res.create(req)
+			return one(http.StatusCreated, res.key, obj, err)
+		})
+	}
+	if res.list != nil {
+		s.handle("GET "+res.path, func(r *http.Request) result {
+			objs, err := res.list(r.URL.Query())
+			return many(res.plural, objs, err)
+		})
+	}
+	if res.get != nil {
+		s.handle("GET "+res.path+"/{id}", func(r *http.Request) result {
+			obj, err := res.get(r.PathValue("id"))
+			return one(http.StatusOK, res.key, obj, err)
+		})
+	}
+	if res.update != nil {
+		s.handle("PUT "+res.path+"/{id}", func(r *http.Request) result {
+			req, err := decodeResource(r, res.key)
+			if err != nil {
+				return result{err: err}
+			}
+			obj, err := res.update(r.PathValue("id"), req)
+			return one(http.StatusOK, res.key, obj, err)
+		})
+	}
+	if res.delete != nil {
+		s.handle("DELETE "+res.path+"/{id}", func(r *http.Request) result {
+			return empty(http.StatusNoContent, res.delete(r.PathValue("id")))
+		})
+	}
+}
+
+func (s *Simulator) registerNetwork() {
+	const prefix = "/network/v2.0/"
+
+	s.handleVersions("GET /network/{$}", func(r *http.Request) fake.Object {
+		return fake.Object{"versions": []fake.Object{versionDocument(r, "v2.0", prefix)}}
+	})
+
+	for _, res := range []resource{
+		{
+			path: prefix + "networks", key: "network", plural: "networks",
+			create: s.cloud.CreateNetwork, list: s.cloud.ListNetworks, get: s.cloud.GetNetwork,
+			update: s.cloud.UpdateNetwork, delete: s.cloud.DeleteNetwork,
+		},
+		{
+			path: prefix + "subnets", key: "subnet", plural: "subnets",
+			create: s.cloud.CreateSubnet, list: s.cloud.ListSubnets, get: s.cloud.GetSubnet,
+			update: s.cloud.UpdateSubnet, delete: s.cloud.DeleteSubnet,
+		},
+		{
+			path: prefix + "subnetpools", key: "subnetpool", plural: "subnetpools",
+			create: s.cloud.CreateSubnetPool, list: s.cloud.ListSubnetPools, get: s.cloud.GetSubnetPool,
+			delete: s.cloud.DeleteSubnetPool,
+		},
+		{
+			path: prefix + "qos/policies", key: "policy", plural: "policies",
+			create: s.cloud.CreateQoSPolicy, list: s.cloud.ListQoSPolicies, get: s.cloud.GetQoSPolicy,
+			delete: s.cloud.DeleteQoSPolicy,
+		},
+		{
+			path: prefix + "ports", key: "port", plural: "ports",
+			create: s.cloud.CreatePort, list: s.cloud.ListPorts, get: s.cloud.GetPort,
+			update: s.cloud.UpdatePort, delete: s.cloud.DeletePort,
+		},
+		{
+			path: prefix + "security-groups", key: "security_group", plural: "security_groups",
+			create: s.cloud.CreateSecurityGroup, list: s.cloud.ListSecurityGroups, get: s.cloud.GetSecurityGroup,
+			update: s.cloud.UpdateSecurityGroup, delete: s.cloud.DeleteSecurityGroup,
+		},
+		{
+			path: prefix + "security-group-rules", key: "security_group_rule", plural: "security_group_rules",
+			create: s.cloud.CreateSecurityGroupRule, list: s.cloud.ListSecurityGroupRules, get: s.cloud.GetSecurityGroupRule,
+			delete: s.cloud.DeleteSecurityGroupRule,
+		},
+		{
+			path: prefix + "routers", key: "router", plural: "routers",
+			create: s.cloud.CreateRouter, list: s.cloud.ListRouters, get: s.cloud.GetRouter,
+			update: s.cloud.UpdateRouter, delete: s.cloud.DeleteRouter,
+		},
+		{
+			path: prefix + "floatingips", key: "floatingip", plural: "floatingips",
+			create: s.cloud.CreateFloatingIP, list: s.cloud.ListFloatingIPs, get: s.cloud.GetFloatingIP,
+			update: s.cloud.UpdateFloatingIP, delete: s.cloud.DeleteFloatingIP,
+		},
+		{
+			path: prefix + "trunks", key: "trunk", plural: "trunks",
+			create: s.cloud.CreateTrunk, list: s.cloud.ListTrunks, get: s.cloud.GetTrunk,
+			delete: s.cloud.DeleteTrunk,
+		},
+	} {
+		s.registerResource(res)
+	}
+
+	routerInterface := func(op func(string, fake.Object) (fake.Object, error)) operation {
+		return func(r *http.Request) result {
+			body, err := decodeBody(r)
+			if err != nil {
+				return result{err: err}
+			}
+			obj, err := op(r.PathValue("id"), body)
+			return raw(http.StatusOK, obj, err)
+		}
+	}
+	s.handle("PUT "+prefix+"routers/{id}/add_router_interface", routerInterface(s.cloud.AddRouterInterface))
+	s.handle("PUT "+prefix+"routers/{id}/remove_router_interface", routerInterface(s.cloud.RemoveRouterInterface))
+
+	s.handle("GET "+prefix+"trunks/{id}/get_subports", func(r *http.Request) result {
+		objs, err := s.cloud.ListTrunkSubports(r.PathValue("id"))
+		return many("sub_ports", objs, err)
+	})
+	s.handle("PUT "+prefix+"trunks/{id}/remove_subports", func(r *http.Request) result {
+		body, err := decodeBody(r)
+		if err != nil {
+			return result{err: err}
+		}
+		obj, err := s.cloud.RemoveSubports(r.PathValue("id"), body)
+		return raw(http.StatusOK, obj, err)
+	})
+
+	s.handle("GET "+prefix+"extensions", func(*http.Request) result {
+		return many("extensions", s.cloud.ListNetworkExtensions(), nil)
+	})
+	s.handle("GET "+prefix+"quotas/{project_id}/details.json", func(r *http.Request) result {
+		obj, err := s.cloud.GetNetworkQuotaDetail(r.PathValue("project_id"))
+		return one(http.StatusOK, "quota", obj, err)
+	})
+
+	s.handle("PUT "+prefix+"{type}/{id}/tags", func(r *http.Request) result {
+		body, err := decodeBody(r)
+		if err != nil {
+			return result{err: err}
+		}
+		tags, err := s.cloud.ReplaceAllTags(r.PathValue("type"), r.PathValue("id"), stringList(body, "tags"))
+		return raw(http.StatusOK, fake.Object{"tags": tags}, err)
+	})
+}
@@ -0,0 +1,310 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package simulator serves the state of a fake.Cloud over the OpenStack REST
+// APIs used by CAPO: Keystone v3, Nova, Neutron, Cinder, Glance and Octavia.
+//
+// All services are served from a single listener under the path prefixes
+// used by DevStack, for example /compute/v2.1 and /network/v2.0. Keystone
+// returns a service catalog pointing at the address the client used to
+// reach it, so a clouds.yaml containing only the auth URL and credentials is
+// enough to use every service through gophercloud.
+package simulator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/v2"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+)
+
+const (
+	// DefaultRegion is the region of every endpoint in the service catalog.
+	DefaultRegion = "RegionOne"
+
+	// DefaultUsername and DefaultPassword are the credentials accepted by
+	// Keystone unless WithCredentials is used.
+	DefaultUsername = "admin"
+	DefaultPassword = "secret"
+
+	// DefaultProjectName is the name of the project tokens are scoped to.
+	DefaultProjectName = "demo"
+
+	// DefaultDomainName is the domain of the user and the project.
+	DefaultDomainName = "Default"
+
+	// DefaultTokenTTL is the lifetime of an issued token.
+	DefaultTokenTTL = time.Hour
+)
+
+// Option configures a Simulator.
+type Option func(*Simulator)
+
+// WithRegion sets the region of the endpoints in the service catalog.
+func WithRegion(region string) Option {
+	return func(s *Simulator) {
+		s.region = region
+	}
+}
+
+// WithCredentials sets the username and password accepted by Keystone.
+func WithCredentials(username, password string) Option {
+	return func(s *Simulator) {
+		s.username = username
+		s.password = password
+	}
+}
+
+// WithProjectName sets the name of the project tokens are scoped to. The
+// project ID is the project ID of the Cloud.
+func WithProjectName(name string) Option {
+	return func(s *Simulator) {
+		s.projectName = name
+	}
+}
+
+// WithTokenTTL sets the lifetime of issued tokens.
+func WithTokenTTL(ttl time.Duration) Option {
+	return func(s *Simulator) {
+		s.tokenTTL = ttl
+	}
+}
+
+// WithFederatedToken makes Keystone exchange bearer token, presented to the
+// federated identityProvider and protocol, for an unscoped token.
+func WithFederatedToken(identityProvider, protocol, token string) Option {
+	return func(s *Simulator) {
+		s.federation = &federation{
+			identityProvider: identityProvider,
+			protocol:         protocol,
+			token:            token,
+		}
+	}
+}
+
+// WithLogger sets the logger used to log requests.
+func WithLogger(logger logr.Logger) Option {
+	return func(s *Simulator) {
+		s.logger = logger
+	}
+}
+
+// Simulator is an http.Handler serving the OpenStack APIs for a fake.Cloud.
+type Simulator struct {
+	cloud *fake.Cloud
+	mux   *http.ServeMux
+
+	region      string
+	username    string
+	password    string
+	projectName string
+	tokenTTL    time.Duration
+	federation  *federation
+	logger      logr.Logger
+
+	mu     sync.Mutex
+	tokens map[string]*issuedToken
+}
+
+var _ http.Handler = &Simulator{}
+
+// New returns a Simulator serving cloud.
+func New(cloud *fake.Cloud, opts ...Option) *Simulator {
+	s := &Simulator{
+		cloud:       cloud,
+		mux:         http.NewServeMux(),
+		region:      DefaultRegion,
+		username:    DefaultUsername,
+		password:    DefaultPassword,
+		projectName: DefaultProjectName,
+		tokenTTL:    DefaultTokenTTL,
+		logger:      logr.Discard(),
+		tokens:      map[string]*issuedToken{},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	s.registerIdentity()
+	s.registerCompute()
+	s.registerNetwork()
+	s.registerVolume()
+	s.registerImage()
+	s.registerLoadBalancer()
+	s.registerKeyManager()
+	return s
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Simulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rw := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+	s.mux.ServeHTTP(rw, r)
+	s.logger.V(4).Info("Request", "method", r.Method, "path", r.URL.Path, "query", r.URL.RawQuery, "status", rw.status)
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// result is the outcome of an API operation: the status code and the
+// response body, or an error.
+type result struct {
+	status int
+	body   any
+	err    error
+}
+
+// operation is the implementation of an API operation.
+type operation func(r *http.Request) result
+
+// handle registers an API operation which requires a valid token.
+func (s *Simulator) handle(pattern string, op operation) {
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get("X-Auth-Token")
+		if !s.validToken(id) || s.issued(id).unscoped {
+			writeError(w, fake.APIError(http.StatusUnauthorized, "The request you have made requires authentication."))
+			return
+		}
+		writeResult(w, op(r))
+	})
+}
+
+// handleVersions registers a version discovery document. Like the real
+// services, these do not require a token.
+func (s *Simulator) handleVersions(pattern string, versions func(r *http.Request) fake.Object) {
+	s.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, versions(r))
+	})
+}
+
+// one returns a single resource wrapped in key, as most OpenStack APIs do.
+func one(status int, key string, obj fake.Object, err error) result {
+	if err != nil {
+		return result{err: err}
+	}
+	return result{status: status, body: fake.Object{key: obj}}
+}
+
+// many returns a list of resources wrapped in key.
+func many(key string, objs []fake.Object, err error) result {
+	if err != nil {
+		return result{err: err}
+	}
+	if objs == nil {
+		objs = []fake.Object{}
+	}
+	return result{status: http.StatusOK, body: fake.Object{key: objs}}
+}
+
+// raw returns a response body which is not wrapped, as Glance does.
+func raw(status int, body any, err error) result {
+	if err != nil {
+		return result{err: err}
+	}
+	return result{status: status, body: body}
+}
+
+// empty returns a response without a body.
+func empty(status int, err error) result {
+	return result{status: status, err: err}
+}
+
+func writeResult(w http.ResponseWriter, res result) {
+	if res.err != nil {
+		writeError(w, res.err)
+		return
+	}
+	if res.body == nil {
+		w.WriteHeader(res.status)
+		return
+	}
+	writeJSON(w, res.status, res.body)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// writeError writes an error returned by the Cloud with the status code and
+// body it describes.
+func writeError(w http.ResponseWriter, err error) {
+	var apiErr gophercloud.ErrUnexpectedResponseCode
+	if !errors.As(err, &apiErr) {
+		apiErr = fake.APIError(http.StatusInternalServerError, err.Error()).(gophercloud.ErrUnexpectedResponseCode)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(apiErr.Actual)
+	_, _ = w.Write(apiErr.Body)
+}
+
+// decodeBody decodes a JSON request body.
+func decodeBody(r *http.Request) (fake.Object, error) {
+	body := fake.Object{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, fake.APIError(http.StatusBadRequest, fmt.Sprintf("invalid request body: %v", err))
+	}
+	return body, nil
+}
+
+// decodeResource decodes a JSON request body containing a single resource
+// wrapped in key, for example {"port": {...}}.
+func decodeResource(r *http.Request, key string) (fake.Object, error) {
+	body, err := decodeBody(r)
+	if err != nil {
+		return nil, err
+	}
+	obj, ok := body[key].(map[string]any)
+	if !ok {
+		return nil, fake.APIError(http.StatusBadRequest, fmt.Sprintf("request body does not contain %q", key))
+	}
+	return obj, nil
+}
+
+// versionDocument returns the description of the current version of a service
+// in a version discovery document.
+func versionDocument(r *http.Request, id, path string) fake.Object {
+	return fake.Object{
+		"id":     id,
+		"status": "CURRENT",
+		"links": []fake.Object{
+			{"rel": "self", "href": baseURL(r) + path},
+		},
+	}
+}
+
+// baseURL returns the URL of the simulator as seen by the client.
+func baseURL(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	return scheme + "://" + r.Host
+}
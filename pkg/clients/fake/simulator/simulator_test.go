@@ -0,0 +1,422 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	computequotasets "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/quotasets"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/applicationcredentials"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/tokens"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/secrets"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/quotas"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+func newTestCloud(authURL string) clientconfig.Cloud {
+	return clientconfig.Cloud{
+		AuthInfo: &clientconfig.AuthInfo{
+			AuthURL:           authURL,
+			Username:          DefaultUsername,
+			Password:          DefaultPassword,
+			ProjectName:       DefaultProjectName,
+			UserDomainName:    DefaultDomainName,
+			ProjectDomainName: DefaultDomainName,
+		},
+		RegionName: DefaultRegion,
+	}
+}
+
+func Test_Simulator_Authenticate(t *testing.T) {
+	cloud := fake.NewCloud()
+	server := httptest.NewServer(New(cloud))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		authURL string
+		modify  func(*clientconfig.Cloud)
+		wantErr bool
+	}{
+		{
+			name:    "versioned auth URL",
+			authURL: server.URL + "/identity/v3",
+		},
+		{
+			name:    "unversioned auth URL",
+			authURL: server.URL + "/identity",
+		},
+		{
+			name:    "wrong password",
+			authURL: server.URL + "/identity/v3",
+			modify:  func(c *clientconfig.Cloud) { c.AuthInfo.Password = "wrong" },
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			c := newTestCloud(tt.authURL)
+			if tt.modify != nil {
+				tt.modify(&c)
+			}
+			_, _, projectID, err := scope.NewProviderClient(c, "", nil, logr.Discard())
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(projectID).To(Equal(cloud.ProjectID()))
+		})
+	}
+}
+
+func Test_Simulator_Clients(t *testing.T) {
+	g := NewWithT(t)
+	cloud := fake.NewCloud(fake.WithTransitionDelay(0))
+	flavorID := cloud.AddFlavor("m1.small", 1, 2048, 20)
+	cloud.AddImage("ubuntu")
+	server := httptest.NewServer(New(cloud))
+	defer server.Close()
+
+	providerClient, clientOpts, _, err := scope.NewProviderClient(newTestCloud(server.URL+"/identity/v3"), "", nil, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	networkClient, err := clients.NewNetworkClient(providerClient, clientOpts)
+	g.Expect(err).NotTo(HaveOccurred())
+	computeClient, err := clients.NewComputeClient(providerClient, clientOpts)
+	g.Expect(err).NotTo(HaveOccurred())
+	imageClient, err := clients.NewImageClient(providerClient, clientOpts)
+	g.Expect(err).NotTo(HaveOccurred())
+	lbClient, err := clients.NewLbClient(providerClient, clientOpts)
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = clients.NewVolumeClient(providerClient, clientOpts)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	network, err := networkClient.CreateNetwork(networks.CreateOpts{Name: "test"})
+	g.Expect(err).NotTo(HaveOccurred())
+	subnet, err := networkClient.CreateSubnet(subnets.CreateOpts{NetworkID: network.ID, CIDR: "10.0.0.0/24", IPVersion: 4})
+	g.Expect(err).NotTo(HaveOccurred())
+	tags, err := networkClient.ReplaceAllAttributesTags("networks", network.ID, attributestags.ReplaceAllOpts{Tags: []string{"cluster"}})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(tags).To(ConsistOf("cluster"))
+
+	port, err := networkClient.CreatePort(ports.CreateOpts{NetworkID: network.ID})
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = networkClient.GetPort("missing")
+	g.Expect(capoerrors.IsNotFound(err)).To(BeTrue(), "expected not found, got %v", err)
+
+	imageList, err := imageClient.ListImages(images.ListOpts{Name: "ubuntu"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(imageList).To(HaveLen(1))
+
+	created, err := computeClient.CreateServer(servers.CreateOpts{
+		Name:      "server",
+		FlavorRef: flavorID,
+		ImageRef:  imageList[0].ID,
+		Networks:  []servers.Network{{Port: port.ID}},
+	}, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	instance, err := computeClient.GetServer(created.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(instance.Status).To(Equal("ACTIVE"))
+	interfaces, err := computeClient.ListAttachedInterfaces(instance.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(interfaces).To(HaveLen(1))
+
+	lb, err := lbClient.CreateLoadBalancer(loadbalancers.CreateOpts{Name: "lb", VipSubnetID: subnet.ID})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(lb.ProvisioningStatus).To(Equal("ACTIVE"))
+	_, err = lbClient.CreateListener(listeners.CreateOpts{LoadbalancerID: lb.ID, Protocol: listeners.ProtocolTCP, ProtocolPort: 6443})
+	g.Expect(err).NotTo(HaveOccurred())
+	versions, err := lbClient.ListOctaviaVersions()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(versions).NotTo(BeEmpty())
+
+	g.Expect(lbClient.DeleteLoadBalancer(lb.ID, loadbalancers.DeleteOpts{Cascade: true})).To(Succeed())
+	g.Expect(computeClient.DeleteServer(instance.ID)).To(Succeed())
+	g.Expect(cloud.Count("loadbalancer")).To(Equal(0))
+	g.Expect(cloud.Count("server")).To(Equal(0))
+}
+
+func Test_Simulator_TLSListener(t *testing.T) {
+	g := NewWithT(t)
+	cloud := fake.NewCloud(fake.WithTransitionDelay(0))
+	server := httptest.NewServer(New(cloud))
+	defer server.Close()
+
+	providerClient, clientOpts, _, err := scope.NewProviderClient(newTestCloud(server.URL+"/identity/v3"), "", nil, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+	networkClient, err := clients.NewNetworkClient(providerClient, clientOpts)
+	g.Expect(err).NotTo(HaveOccurred())
+	lbClient, err := clients.NewLbClient(providerClient, clientOpts)
+	g.Expect(err).NotTo(HaveOccurred())
+	keyManagerClient, err := clients.NewKeyManagerClient(providerClient, clientOpts)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	secret, err := keyManagerClient.CreateSecret(secrets.CreateOpts{
+		Name:               "certificate",
+		SecretType:         secrets.CertificateSecret,
+		Payload:            "-----BEGIN CERTIFICATE-----",
+		PayloadContentType: "text/plain",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	container, err := keyManagerClient.CreateContainer(containers.CreateOpts{
+		Name:       "tls",
+		Type:       containers.CertificateContainer,
+		SecretRefs: []containers.SecretRef{{Name: "certificate", SecretRef: secret.SecretRef}},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	list, err := keyManagerClient.ListContainers(containers.ListOpts{Name: "tls"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(list).To(HaveLen(1))
+	g.Expect(list[0].ContainerRef).To(Equal(container.ContainerRef))
+
+	network, err := networkClient.CreateNetwork(networks.CreateOpts{Name: "test"})
+	g.Expect(err).NotTo(HaveOccurred())
+	subnet, err := networkClient.CreateSubnet(subnets.CreateOpts{NetworkID: network.ID, CIDR: "10.0.0.0/24", IPVersion: 4})
+	g.Expect(err).NotTo(HaveOccurred())
+	lb, err := lbClient.CreateLoadBalancer(loadbalancers.CreateOpts{Name: "lb", VipSubnetID: subnet.ID})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// A TERMINATED_HTTPS listener requires an existing certificate container
+	_, err = lbClient.CreateListener(listeners.CreateOpts{LoadbalancerID: lb.ID, Protocol: listeners.ProtocolTerminatedHTTPS, ProtocolPort: 6443})
+	g.Expect(gophercloud.ResponseCodeIs(err, http.StatusBadRequest)).To(BeTrue(), "expected bad request, got %v", err)
+	listener, err := lbClient.CreateListener(listeners.CreateOpts{
+		LoadbalancerID:         lb.ID,
+		Protocol:               listeners.ProtocolTerminatedHTTPS,
+		ProtocolPort:           6443,
+		DefaultTlsContainerRef: container.ContainerRef,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(listener.DefaultTlsContainerRef).To(Equal(container.ContainerRef))
+
+	parts := strings.Split(container.ContainerRef, "/")
+	g.Expect(keyManagerClient.DeleteContainer(parts[len(parts)-1])).To(Succeed())
+	g.Expect(cloud.Count("container")).To(Equal(0))
+}
+
+func Test_Simulator_Quotas(t *testing.T) {
+	g := NewWithT(t)
+	cloud := fake.NewCloud(fake.WithTransitionDelay(0),
+		fake.WithQuota(fake.QuotaCores, 2), fake.WithQuota(fake.QuotaPorts, 1), fake.WithQuota(fake.QuotaGigabytes, 10))
+	flavorID := cloud.AddFlavor("m1.medium", 2, 4096, 20)
+	cloud.AddImage("ubuntu")
+	server := httptest.NewServer(New(cloud))
+	defer server.Close()
+
+	providerClient, clientOpts, projectID, err := scope.NewProviderClient(newTestCloud(server.URL+"/identity/v3"), "", nil, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+	networkClient, err := clients.NewNetworkClient(providerClient, clientOpts)
+	g.Expect(err).NotTo(HaveOccurred())
+	computeClient, err := clients.NewComputeClient(providerClient, clientOpts)
+	g.Expect(err).NotTo(HaveOccurred())
+	volumeClient, err := clients.NewVolumeClient(providerClient, clientOpts)
+	g.Expect(err).NotTo(HaveOccurred())
+	imageClient, err := clients.NewImageClient(providerClient, clientOpts)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	network, err := networkClient.CreateNetwork(networks.CreateOpts{Name: "test"})
+	g.Expect(err).NotTo(HaveOccurred())
+	port, err := networkClient.CreatePort(ports.CreateOpts{NetworkID: network.ID})
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = networkClient.CreatePort(ports.CreateOpts{NetworkID: network.ID})
+	g.Expect(gophercloud.ResponseCodeIs(err, http.StatusConflict)).To(BeTrue(), "expected conflict, got %v", err)
+
+	imageList, err := imageClient.ListImages(images.ListOpts{Name: "ubuntu"})
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = computeClient.CreateServer(servers.CreateOpts{
+		Name:      "server",
+		FlavorRef: flavorID,
+		ImageRef:  imageList[0].ID,
+		Networks:  []servers.Network{{Port: port.ID}},
+	}, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	computeQuota, err := computeClient.GetQuotaSetDetail(projectID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(computeQuota.Cores).To(Equal(computequotasets.QuotaDetail{InUse: 2, Limit: 2}))
+	g.Expect(computeQuota.Instances).To(Equal(computequotasets.QuotaDetail{InUse: 1, Limit: -1}))
+	g.Expect(computeQuota.RAM.InUse).To(Equal(4096))
+
+	networkQuota, err := networkClient.GetQuotaDetail(projectID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(networkQuota.Port).To(Equal(quotas.QuotaDetail{Used: 1, Limit: 1}))
+	g.Expect(networkQuota.FloatingIP.Limit).To(Equal(-1))
+
+	volumeQuota, err := volumeClient.GetQuotaSetUsage(projectID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(volumeQuota.Gigabytes.Limit).To(Equal(10))
+	g.Expect(volumeQuota.Volumes.InUse).To(Equal(0))
+
+	_, err = computeClient.GetQuotaSetDetail("other-project")
+	g.Expect(gophercloud.ResponseCodeIs(err, http.StatusForbidden)).To(BeTrue(), "expected forbidden, got %v", err)
+}
+
+func Test_Simulator_ApplicationCredential(t *testing.T) {
+	g := NewWithT(t)
+	cloud := fake.NewCloud()
+	server := httptest.NewServer(New(cloud))
+	defer server.Close()
+	authURL := server.URL + "/identity/v3"
+
+	providerClient, clientOpts, _, err := scope.NewProviderClient(newTestCloud(authURL), "", nil, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+	identityClient, err := clients.NewIdentityClient(providerClient, clientOpts)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	unrestricted, err := identityClient.CreateApplicationCredential(cloud.UserID(), applicationcredentials.CreateOpts{Name: "unrestricted", Unrestricted: true})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(unrestricted.Secret).NotTo(BeEmpty())
+
+	applicationCredentialCloud := func(credential *applicationcredentials.ApplicationCredential) clientconfig.Cloud {
+		return clientconfig.Cloud{
+			AuthType: clientconfig.AuthV3ApplicationCredential,
+			AuthInfo: &clientconfig.AuthInfo{
+				AuthURL:                     authURL,
+				ApplicationCredentialID:     credential.ID,
+				ApplicationCredentialSecret: credential.Secret,
+			},
+			RegionName: DefaultRegion,
+		}
+	}
+
+	// An unrestricted application credential can create another application credential
+	providerClient, clientOpts, projectID, err := scope.NewProviderClient(applicationCredentialCloud(unrestricted), "", nil, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(projectID).To(Equal(cloud.ProjectID()))
+	identityClient, err = clients.NewIdentityClient(providerClient, clientOpts)
+	g.Expect(err).NotTo(HaveOccurred())
+	restricted, err := identityClient.CreateApplicationCredential(cloud.UserID(), applicationcredentials.CreateOpts{Name: "restricted"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// A restricted application credential can not
+	providerClient, clientOpts, _, err = scope.NewProviderClient(applicationCredentialCloud(restricted), "", nil, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+	identityClient, err = clients.NewIdentityClient(providerClient, clientOpts)
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = identityClient.CreateApplicationCredential(cloud.UserID(), applicationcredentials.CreateOpts{Name: "forbidden"})
+	g.Expect(err).To(HaveOccurred())
+
+	// A token issued for an application credential can be used until the credential is deleted
+	token := providerClient.Token()
+	tokenCloud := clientconfig.Cloud{
+		AuthType: clientconfig.AuthV3Token,
+		AuthInfo: &clientconfig.AuthInfo{
+			AuthURL: authURL,
+			Token:   token,
+		},
+		RegionName: DefaultRegion,
+	}
+	_, _, projectID, err = scope.NewProviderClient(tokenCloud, "", nil, logr.Discard())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(projectID).To(Equal(cloud.ProjectID()))
+
+	g.Expect(cloud.DeleteApplicationCredential(cloud.UserID(), restricted.ID)).To(Succeed())
+	_, _, _, err = scope.NewProviderClient(tokenCloud, "", nil, logr.Discard())
+	g.Expect(err).To(HaveOccurred())
+	_, _, _, err = scope.NewProviderClient(applicationCredentialCloud(restricted), "", nil, logr.Discard())
+	g.Expect(err).To(HaveOccurred())
+}
+
+func Test_Simulator_FederatedToken(t *testing.T) {
+	g := NewWithT(t)
+	cloud := fake.NewCloud()
+	server := httptest.NewServer(New(cloud, WithFederatedToken("kubernetes", "openid", "sa-token")))
+	defer server.Close()
+	authURL := server.URL + "/identity/v3"
+
+	exchange := func(path, bearer string) (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodPost, authURL+path, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+bearer)
+		return http.DefaultClient.Do(req)
+	}
+
+	resp, err := exchange("/OS-FEDERATION/identity_providers/kubernetes/protocols/openid/auth", "wrong")
+	g.Expect(err).NotTo(HaveOccurred())
+	resp.Body.Close()
+	g.Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+
+	resp, err = exchange("/OS-FEDERATION/identity_providers/other/protocols/openid/auth", "sa-token")
+	g.Expect(err).NotTo(HaveOccurred())
+	resp.Body.Close()
+	g.Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+
+	resp, err = exchange("/OS-FEDERATION/identity_providers/kubernetes/protocols/openid/auth", "sa-token")
+	g.Expect(err).NotTo(HaveOccurred())
+	resp.Body.Close()
+	g.Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+	unscoped := resp.Header.Get("X-Subject-Token")
+	g.Expect(unscoped).NotTo(BeEmpty())
+
+	// The unscoped token can only be used to obtain a scoped token
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/compute/v2.1/servers/detail", nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	req.Header.Set("X-Auth-Token", unscoped)
+	resp, err = http.DefaultClient.Do(req)
+	g.Expect(err).NotTo(HaveOccurred())
+	resp.Body.Close()
+	g.Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+
+	providerClient, err := openstack.NewClient(authURL)
+	g.Expect(err).NotTo(HaveOccurred())
+	err = openstack.Authenticate(context.TODO(), providerClient, gophercloud.AuthOptions{
+		IdentityEndpoint: authURL,
+		TokenID:          unscoped,
+		Scope:            &gophercloud.AuthScope{TrustID: "trust-id"},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	result, ok := providerClient.GetAuthResult().(tokens.CreateResult)
+	g.Expect(ok).To(BeTrue())
+	var token struct {
+		Trust struct {
+			ID string `json:"id"`
+		} `json:"OS-TRUST:trust"`
+	}
+	g.Expect(result.ExtractInto(&token)).To(Succeed())
+	g.Expect(token.Trust.ID).To(Equal("trust-id"))
+
+	computeClient, err := clients.NewComputeClient(providerClient, &clientconfig.ClientOpts{RegionName: DefaultRegion})
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = computeClient.ListServers(nil)
+	g.Expect(err).NotTo(HaveOccurred())
+}
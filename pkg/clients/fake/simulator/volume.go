@@ -0,0 +1,49 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package simulator
+
+import (
+	"net/http"
+)
+
+func (s *Simulator) registerVolume() {
+	const prefix = "/volume/v3/{project_id}/"
+
+	s.handle("POST "+prefix+"volumes", func(r *http.Request) result {
+		body, err := decodeBody(r)
+		if err != nil {
+			return result{err: err}
+		}
+		obj, err := s.cloud.CreateVolume(body)
+		return one(http.StatusAccepted, "volume", obj, err)
+	})
+	s.handle("GET "+prefix+"volumes/detail", func(r *http.Request) result {
+		objs, err := s.cloud.ListVolumes(r.URL.Query())
+		return many("volumes", objs, err)
+	})
+	s.handle("GET "+prefix+"volumes/{id}", func(r *http.Request) result {
+		obj, err := s.cloud.GetVolume(r.PathValue("id"))
+		return one(http.StatusOK, "volume", obj, err)
+	})
+	s.handle("DELETE "+prefix+"volumes/{id}", func(r *http.Request) result {
+		return empty(http.StatusAccepted, s.cloud.DeleteVolume(r.PathValue("id"), r.URL.Query()))
+	})
+	s.handle("GET "+prefix+"os-quota-sets/{id}", func(r *http.Request) result {
+		obj, err := s.cloud.GetVolumeQuotaUsage(r.PathValue("id"))
+		return one(http.StatusOK, "quota_set", obj, err)
+	})
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"net/url"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/quotasets"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+)
+
+type volumeClient struct {
+	cloud *Cloud
+}
+
+var _ clients.VolumeClient = volumeClient{}
+
+// NewVolumeClient returns a VolumeClient backed by cloud.
+func NewVolumeClient(cloud *Cloud) clients.VolumeClient {
+	return volumeClient{cloud: cloud}
+}
+
+func (c volumeClient) ListVolumes(opts volumes.ListOptsBuilder) ([]volumes.Volume, error) {
+	query, err := listQuery(opts.ToVolumeListQuery())
+	if err != nil {
+		return nil, err
+	}
+	return decodeList[volumes.Volume](c.cloud.ListVolumes(query))
+}
+
+func (c volumeClient) CreateVolume(opts volumes.CreateOptsBuilder) (*volumes.Volume, error) {
+	body, err := opts.ToVolumeCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	return decode[volumes.Volume](c.cloud.CreateVolume(body))
+}
+
+func (c volumeClient) DeleteVolume(volumeID string, opts volumes.DeleteOptsBuilder) error {
+	query := url.Values{}
+	if opts != nil {
+		var err error
+		query, err = listQuery(opts.ToVolumeDeleteQuery())
+		if err != nil {
+			return err
+		}
+	}
+	return c.cloud.DeleteVolume(volumeID, query)
+}
+
+func (c volumeClient) GetVolume(volumeID string) (*volumes.Volume, error) {
+	return decode[volumes.Volume](c.cloud.GetVolume(volumeID))
+}
+
+func (c volumeClient) GetQuotaSetUsage(projectID string) (*quotasets.QuotaUsageSet, error) {
+	return decode[quotasets.QuotaUsageSet](c.cloud.GetVolumeQuotaUsage(projectID))
+}
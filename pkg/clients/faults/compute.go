@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package faults
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/attachinterfaces"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/availabilityzones"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/quotasets"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servergroups"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+)
+
+type computeClient struct {
+	client   clients.ComputeClient
+	injector *Injector
+}
+
+var _ clients.ComputeClient = computeClient{}
+
+// NewComputeClient returns a ComputeClient which injects faults into calls to client.
+func NewComputeClient(client clients.ComputeClient, injector *Injector) clients.ComputeClient {
+	return computeClient{client: client, injector: injector}
+}
+
+func (c computeClient) ListAvailabilityZones() ([]availabilityzones.AvailabilityZone, error) {
+	return read(c.injector, ServiceCompute, "ListAvailabilityZones", func() ([]availabilityzones.AvailabilityZone, error) {
+		return c.client.ListAvailabilityZones()
+	})
+}
+
+func (c computeClient) ListFlavors() ([]flavors.Flavor, error) {
+	return read(c.injector, ServiceCompute, "ListFlavors", func() ([]flavors.Flavor, error) {
+		return c.client.ListFlavors()
+	})
+}
+
+func (c computeClient) GetFlavor(flavorID string) (*flavors.Flavor, error) {
+	return read(c.injector, ServiceCompute, "GetFlavor", func() (*flavors.Flavor, error) {
+		return c.client.GetFlavor(flavorID)
+	}, flavorID)
+}
+
+func (c computeClient) CreateServer(createOpts servers.CreateOptsBuilder, schedulerHints servers.SchedulerHintOptsBuilder) (*servers.Server, error) {
+	return call(c.injector, ServiceCompute, "CreateServer", func() (*servers.Server, error) {
+		return c.client.CreateServer(createOpts, schedulerHints)
+	})
+}
+
+func (c computeClient) DeleteServer(serverID string) error {
+	return callErr(c.injector, ServiceCompute, "DeleteServer", func() error {
+		return c.client.DeleteServer(serverID)
+	})
+}
+
+func (c computeClient) GetServer(serverID string) (*servers.Server, error) {
+	return read(c.injector, ServiceCompute, "GetServer", func() (*servers.Server, error) {
+		return c.client.GetServer(serverID)
+	}, serverID)
+}
+
+func (c computeClient) ListServers(listOpts servers.ListOptsBuilder) ([]servers.Server, error) {
+	return read(c.injector, ServiceCompute, "ListServers", func() ([]servers.Server, error) {
+		return c.client.ListServers(listOpts)
+	}, listOpts)
+}
+
+func (c computeClient) ListAttachedInterfaces(serverID string) ([]attachinterfaces.Interface, error) {
+	return read(c.injector, ServiceCompute, "ListAttachedInterfaces", func() ([]attachinterfaces.Interface, error) {
+		return c.client.ListAttachedInterfaces(serverID)
+	}, serverID)
+}
+
+func (c computeClient) DeleteAttachedInterface(serverID string, portID string) error {
+	return callErr(c.injector, ServiceCompute, "DeleteAttachedInterface", func() error {
+		return c.client.DeleteAttachedInterface(serverID, portID)
+	})
+}
+
+func (c computeClient) ListServerGroups() ([]servergroups.ServerGroup, error) {
+	return read(c.injector, ServiceCompute, "ListServerGroups", func() ([]servergroups.ServerGroup, error) {
+		return c.client.ListServerGroups()
+	})
+}
+
+func (c computeClient) GetConsoleOutput(serverID string) (string, error) {
+	return read(c.injector, ServiceCompute, "GetConsoleOutput", func() (string, error) {
+		return c.client.GetConsoleOutput(serverID)
+	}, serverID)
+}
+
+func (c computeClient) GetQuotaSetDetail(projectID string) (*quotasets.QuotaDetailSet, error) {
+	return read(c.injector, ServiceCompute, "GetQuotaSetDetail", func() (*quotasets.QuotaDetailSet, error) {
+		return c.client.GetQuotaSetDetail(projectID)
+	}, projectID)
+}
+
+func (c computeClient) WithMicroversion(required string) (clients.ComputeClient, error) {
+	client, err := c.client.WithMicroversion(required)
+	if err != nil {
+		return nil, err
+	}
+	return computeClient{client: client, injector: c.injector}, nil
+}
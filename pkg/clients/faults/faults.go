@@ -0,0 +1,313 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package faults wraps the OpenStack service clients defined in pkg/clients to
+// inject latency, API errors and stale reads according to a set of rules.
+//
+// It is intended for testing that reconcilers are idempotent: that a
+// reconcile which is interrupted by an error at any point, including after a
+// resource was created but before the response was received, converges when
+// it is retried.
+package faults
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// Services which rules can be restricted to.
+const (
+	ServiceCompute      = "compute"
+	ServiceNetwork      = "network"
+	ServiceVolume       = "volume"
+	ServiceImage        = "image"
+	ServiceLoadBalancer = "loadbalancer"
+	ServiceIdentity     = "identity"
+	ServiceKeyManager   = "keymanager"
+)
+
+// Rule describes a fault which is injected into matching client calls.
+type Rule struct {
+	// Service restricts the rule to the methods of a single service client:
+	// compute, network, volume, image, loadbalancer, identity or keymanager.
+	// If empty the rule applies to all services.
+	Service string `json:"service,omitempty"`
+
+	// Method is a glob matched against the name of the client method, for
+	// example "CreatePort" or "Get*". If empty the rule applies to all
+	// methods.
+	Method string `json:"method,omitempty"`
+
+	// Probability is the probability that the rule fires on a matching call,
+	// between 0 and 1. If zero the rule fires on every matching call.
+	Probability float64 `json:"probability,omitempty"`
+
+	// Limit is the maximum number of times the rule fires. If zero the rule
+	// fires without limit.
+	Limit int `json:"limit,omitempty"`
+
+	// Latency is added to the call when the rule fires.
+	Latency metav1.Duration `json:"latency,omitempty"`
+
+	// StatusCode is the HTTP status code of an API error returned when the
+	// rule fires, for example 409 or 503. If zero no error is returned.
+	StatusCode int `json:"statusCode,omitempty"`
+
+	// AfterCall makes the call to OpenStack before returning the error, as
+	// if the response was lost. This simulates, for example, a port which was
+	// created although the client saw an error.
+	AfterCall bool `json:"afterCall,omitempty"`
+
+	// StaleRead makes a read method return the result of the previous
+	// identical call instead of the current state, as if it was served by a
+	// lagging replica. It has no effect on methods which modify state, or if
+	// there has been no previous call.
+	StaleRead bool `json:"staleRead,omitempty"`
+}
+
+// Config is the configuration of an Injector.
+type Config struct {
+	// Seed seeds the random source used to evaluate rule probabilities. If
+	// zero the source is seeded from the current time.
+	Seed int64 `json:"seed,omitempty"`
+
+	// Rules are evaluated in order, and the first matching rule which fires
+	// is applied to the call.
+	Rules []Rule `json:"rules"`
+}
+
+// LoadConfig reads a Config from a YAML file.
+func LoadConfig(file string) (*Config, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	config := &Config{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("parsing fault injection config %s: %w", file, err)
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("invalid fault injection config %s: %w", file, err)
+	}
+	return config, nil
+}
+
+func (c *Config) validate() error {
+	for i, rule := range c.Rules {
+		switch rule.Service {
+		case "", ServiceCompute, ServiceNetwork, ServiceVolume, ServiceImage, ServiceLoadBalancer, ServiceIdentity, ServiceKeyManager:
+		default:
+			return fmt.Errorf("rules[%d]: unknown service %q", i, rule.Service)
+		}
+		if _, err := path.Match(rule.Method, ""); err != nil {
+			return fmt.Errorf("rules[%d]: invalid method pattern %q: %w", i, rule.Method, err)
+		}
+		if rule.Probability < 0 || rule.Probability > 1 {
+			return fmt.Errorf("rules[%d]: probability must be between 0 and 1", i)
+		}
+		if rule.StatusCode != 0 && (rule.StatusCode < 400 || rule.StatusCode > 599) {
+			return fmt.Errorf("rules[%d]: statusCode must be an HTTP error status", i)
+		}
+	}
+	return nil
+}
+
+// Injector decides which faults to inject into client calls. An Injector is
+// safe for concurrent use, and may be shared by any number of clients.
+type Injector struct {
+	mu     sync.Mutex
+	rules  []Rule
+	fired  []int
+	random *rand.Rand
+
+	// reads holds the result of the last read of each method which a
+	// StaleRead rule may still fire on, by readKey.
+	reads map[string]any
+
+	// sleep is replaced in tests.
+	sleep func(time.Duration)
+}
+
+// NewInjector returns an Injector applying the rules in config.
+func NewInjector(config Config) *Injector {
+	seed := config.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return &Injector{
+		rules:  config.Rules,
+		fired:  make([]int, len(config.Rules)),
+		random: rand.New(rand.NewSource(seed)), //nolint:gosec // Fault injection does not need a secure random source
+		reads:  map[string]any{},
+		sleep:  time.Sleep,
+	}
+}
+
+// Fired returns the number of times each rule has fired.
+func (i *Injector) Fired() []int {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return append([]int{}, i.fired...)
+}
+
+// match returns the rule to apply to a call, if any.
+func (i *Injector) match(service, method string) *Rule {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for n := range i.rules {
+		rule := &i.rules[n]
+		if rule.Service != "" && rule.Service != service {
+			continue
+		}
+		if rule.Method != "" {
+			if ok, _ := path.Match(rule.Method, method); !ok {
+				continue
+			}
+		}
+		if rule.Limit > 0 && i.fired[n] >= rule.Limit {
+			continue
+		}
+		if rule.Probability > 0 && i.random.Float64() >= rule.Probability {
+			continue
+		}
+		i.fired[n]++
+		return rule
+	}
+	return nil
+}
+
+// recordsReads returns whether a StaleRead rule may still fire on a method,
+// so that its reads must be recorded.
+func (i *Injector) recordsReads(service, method string) bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for n := range i.rules {
+		rule := &i.rules[n]
+		if !rule.StaleRead || rule.StatusCode != 0 {
+			continue
+		}
+		if rule.Service != "" && rule.Service != service {
+			continue
+		}
+		if rule.Method != "" {
+			if ok, _ := path.Match(rule.Method, method); !ok {
+				continue
+			}
+		}
+		if rule.Limit > 0 && i.fired[n] >= rule.Limit {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// swapRead records the result of a read and returns the result of the
+// previous identical read, if there was one.
+func (i *Injector) swapRead(key string, result any) (any, bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	previous, ok := i.reads[key]
+	i.reads[key] = result
+	return previous, ok
+}
+
+// Error returns the error injected for an API call failing with statusCode.
+// It satisfies the same checks as an error returned by gophercloud, for
+// example capoerrors.IsConflict.
+func Error(service, method string, statusCode int) error {
+	return gophercloud.ErrUnexpectedResponseCode{
+		Method:   method,
+		URL:      "fault-injection://" + service,
+		Expected: []int{http.StatusOK},
+		Actual:   statusCode,
+		Body:     []byte(fmt.Sprintf("fault injected into %s %s", service, method)),
+	}
+}
+
+// call makes a client call which modifies state, applying any matching rule.
+func call[T any](i *Injector, service, method string, fn func() (T, error)) (T, error) {
+	return apply(i, i.match(service, method), service, method, fn)
+}
+
+// callErr is call for methods which only return an error.
+func callErr(i *Injector, service, method string, fn func() error) error {
+	_, err := call(i, service, method, func() (struct{}, error) {
+		return struct{}{}, fn()
+	})
+	return err
+}
+
+// read makes a client call which does not modify state, applying any
+// matching rule. args identify identical calls for stale reads.
+func read[T any](i *Injector, service, method string, fn func() (T, error), args ...any) (T, error) {
+	rule := i.match(service, method)
+	if rule != nil && rule.StaleRead && rule.StatusCode == 0 {
+		i.sleep(rule.Latency.Duration)
+		result, err := fn()
+		if err != nil {
+			return result, err
+		}
+		if previous, ok := i.swapRead(readKey(service, method, args), result); ok {
+			return previous.(T), nil
+		}
+		return result, nil
+	}
+
+	result, err := apply(i, rule, service, method, fn)
+	if err == nil && i.recordsReads(service, method) {
+		i.swapRead(readKey(service, method, args), result)
+	}
+	return result, err
+}
+
+// apply makes a client call, injecting the faults described by rule.
+func apply[T any](i *Injector, rule *Rule, service, method string, fn func() (T, error)) (T, error) {
+	var zero T
+	if rule == nil {
+		return fn()
+	}
+	i.sleep(rule.Latency.Duration)
+	if rule.StatusCode == 0 {
+		return fn()
+	}
+	if rule.AfterCall {
+		if _, err := fn(); err != nil {
+			return zero, err
+		}
+	}
+	return zero, Error(service, method, rule.StatusCode)
+}
+
+// readKey identifies identical reads. Arguments are compared by their JSON
+// representation so that list options containing pointers compare equal.
+func readKey(service, method string, args []any) string {
+	key, err := json.Marshal(args)
+	if err != nil {
+		return fmt.Sprintf("%s/%s/%v", service, method, args)
+	}
+	return fmt.Sprintf("%s/%s/%s", service, method, key)
+}
@@ -0,0 +1,251 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package faults
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+// newNetworkClient returns a fault injecting network client for a new fake
+// cloud, and the unwrapped client to observe the state of the cloud.
+func newNetworkClient(config Config) (clients.NetworkClient, clients.NetworkClient, *Injector) {
+	cloud := fake.NewNetworkClient(fake.NewCloud())
+	injector := NewInjector(config)
+	injector.sleep = func(time.Duration) {}
+	return NewNetworkClient(cloud, injector), cloud, injector
+}
+
+func Test_StatusCode(t *testing.T) {
+	tests := []struct {
+		name       string
+		rule       Rule
+		wantErr    func(error) bool
+		wantExists bool
+	}{
+		{
+			name:    "Conflict",
+			rule:    Rule{Method: "CreateNetwork", StatusCode: 409},
+			wantErr: capoerrors.IsConflict,
+		},
+		{
+			name:    "Service unavailable",
+			rule:    Rule{Service: ServiceNetwork, StatusCode: 503},
+			wantErr: capoerrors.IsRetryable,
+		},
+		{
+			name:       "Lost response",
+			rule:       Rule{Method: "Create*", StatusCode: 500, AfterCall: true},
+			wantErr:    func(err error) bool { return err != nil },
+			wantExists: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			c, cloud, injector := newNetworkClient(Config{Rules: []Rule{tt.rule}})
+
+			_, err := c.CreateNetwork(networks.CreateOpts{Name: "test"})
+			g.Expect(tt.wantErr(err)).To(BeTrue(), "unexpected error: %v", err)
+			g.Expect(injector.Fired()).To(Equal([]int{1}))
+
+			existing, err := cloud.ListNetwork(networks.ListOpts{Name: "test"})
+			g.Expect(err).NotTo(HaveOccurred())
+			if tt.wantExists {
+				g.Expect(existing).To(HaveLen(1))
+			} else {
+				g.Expect(existing).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func Test_RuleMatching(t *testing.T) {
+	g := NewWithT(t)
+	c, _, injector := newNetworkClient(Config{
+		Seed: 1,
+		Rules: []Rule{
+			{Service: ServiceCompute, StatusCode: 500},
+			{Method: "ListNetwork", StatusCode: 500, Limit: 2},
+			{Method: "List*", StatusCode: 503, Probability: 0.5},
+		},
+	})
+
+	var failed int
+	for range 100 {
+		if _, err := c.ListNetwork(networks.ListOpts{}); err != nil {
+			failed++
+		}
+	}
+	fired := injector.Fired()
+	g.Expect(fired[0]).To(BeZero(), "rule for another service should not fire")
+	g.Expect(fired[1]).To(Equal(2), "rule should fire until its limit")
+	g.Expect(fired[2]).To(BeNumerically("~", 49, 15), "rule should fire with its probability")
+	g.Expect(failed).To(Equal(fired[1] + fired[2]))
+}
+
+func Test_Latency(t *testing.T) {
+	g := NewWithT(t)
+	c, _, injector := newNetworkClient(Config{Rules: []Rule{
+		{Method: "GetNetwork", Latency: metav1.Duration{Duration: time.Second}},
+	}})
+	var slept time.Duration
+	injector.sleep = func(d time.Duration) { slept += d }
+
+	network, err := c.CreateNetwork(networks.CreateOpts{Name: "test"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(slept).To(BeZero())
+
+	_, err = c.GetNetwork(network.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(slept).To(Equal(time.Second))
+}
+
+func Test_StaleRead(t *testing.T) {
+	g := NewWithT(t)
+	c, _, _ := newNetworkClient(Config{Rules: []Rule{
+		{Method: "GetPort", StaleRead: true, Limit: 2},
+	}})
+
+	network, err := c.CreateNetwork(networks.CreateOpts{Name: "test"})
+	g.Expect(err).NotTo(HaveOccurred())
+	port, err := c.CreatePort(ports.CreateOpts{Name: "before", NetworkID: network.ID})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	got, err := c.GetPort(port.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got.Name).To(Equal("before"), "first read has no previous result to return")
+
+	_, err = c.UpdatePort(port.ID, ports.UpdateOpts{Name: ptr.To("after")})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	got, err = c.GetPort(port.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got.Name).To(Equal("before"), "stale read should return the previous result")
+
+	got, err = c.GetPort(port.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got.Name).To(Equal("after"), "read after the rule's limit should be current")
+}
+
+func Test_StaleReadRecordsOnlyMatchingReads(t *testing.T) {
+	g := NewWithT(t)
+	c, _, injector := newNetworkClient(Config{Rules: []Rule{
+		{Method: "GetPort", StaleRead: true, Limit: 1},
+		{Method: "GetNetwork", Latency: metav1.Duration{Duration: time.Second}},
+	}})
+
+	network, err := c.CreateNetwork(networks.CreateOpts{Name: "test"})
+	g.Expect(err).NotTo(HaveOccurred())
+	port, err := c.CreatePort(ports.CreateOpts{Name: "test", NetworkID: network.ID})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = c.GetNetwork(network.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(injector.reads).To(BeEmpty(), "reads without a StaleRead rule should not be recorded")
+
+	_, err = c.GetPort(port.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(injector.reads).To(HaveLen(1))
+
+	// The rule has reached its limit, so there is nothing more to record
+	injector.reads = map[string]any{}
+	_, err = c.GetPort(port.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(injector.reads).To(BeEmpty(), "reads after the rule's limit should not be recorded")
+}
+
+func Test_LoadConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		want    *Config
+		wantErr bool
+	}{
+		{
+			name: "Valid",
+			config: `
+seed: 42
+rules:
+- service: network
+  method: Create*
+  statusCode: 500
+  afterCall: true
+  probability: 0.1
+- method: Get*
+  latency: 2s
+  staleRead: true
+`,
+			want: &Config{Seed: 42, Rules: []Rule{
+				{Service: ServiceNetwork, Method: "Create*", StatusCode: 500, AfterCall: true, Probability: 0.1},
+				{Method: "Get*", Latency: metav1.Duration{Duration: 2 * time.Second}, StaleRead: true},
+			}},
+		},
+		{
+			name:    "Unknown field",
+			config:  "rules:\n- status: 500\n",
+			wantErr: true,
+		},
+		{
+			name:    "Unknown service",
+			config:  "rules:\n- service: dns\n",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid method pattern",
+			config:  "rules:\n- method: '['\n",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid probability",
+			config:  "rules:\n- probability: 2\n",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid status code",
+			config:  "rules:\n- statusCode: 200\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			file := filepath.Join(t.TempDir(), "faults.yaml")
+			g.Expect(os.WriteFile(file, []byte(tt.config), 0o600)).To(Succeed())
+
+			got, err := LoadConfig(file)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package faults
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/applicationcredentials"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+)
+
+type identityClient struct {
+	client   clients.IdentityClient
+	injector *Injector
+}
+
+var _ clients.IdentityClient = identityClient{}
+
+// NewIdentityClient returns an IdentityClient which injects faults into calls to client.
+func NewIdentityClient(client clients.IdentityClient, injector *Injector) clients.IdentityClient {
+	return identityClient{client: client, injector: injector}
+}
+
+func (c identityClient) ListApplicationCredentials(userID string, opts applicationcredentials.ListOptsBuilder) ([]applicationcredentials.ApplicationCredential, error) {
+	return read(c.injector, ServiceIdentity, "ListApplicationCredentials", func() ([]applicationcredentials.ApplicationCredential, error) {
+		return c.client.ListApplicationCredentials(userID, opts)
+	}, userID, opts)
+}
+
+func (c identityClient) GetApplicationCredential(userID, id string) (*applicationcredentials.ApplicationCredential, error) {
+	return read(c.injector, ServiceIdentity, "GetApplicationCredential", func() (*applicationcredentials.ApplicationCredential, error) {
+		return c.client.GetApplicationCredential(userID, id)
+	}, userID, id)
+}
+
+func (c identityClient) CreateApplicationCredential(userID string, opts applicationcredentials.CreateOptsBuilder) (*applicationcredentials.ApplicationCredential, error) {
+	return call(c.injector, ServiceIdentity, "CreateApplicationCredential", func() (*applicationcredentials.ApplicationCredential, error) {
+		return c.client.CreateApplicationCredential(userID, opts)
+	})
+}
+
+func (c identityClient) DeleteApplicationCredential(userID, id string) error {
+	return callErr(c.injector, ServiceIdentity, "DeleteApplicationCredential", func() error {
+		return c.client.DeleteApplicationCredential(userID, id)
+	})
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package faults
+
+import (
+	"context"
+	"io"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/imageimport"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+)
+
+type imageClient struct {
+	client   clients.ImageClient
+	injector *Injector
+}
+
+var _ clients.ImageClient = imageClient{}
+
+// NewImageClient returns an ImageClient which injects faults into calls to client.
+func NewImageClient(client clients.ImageClient, injector *Injector) clients.ImageClient {
+	return imageClient{client: client, injector: injector}
+}
+
+func (c imageClient) ListImages(listOpts images.ListOptsBuilder) ([]images.Image, error) {
+	return read(c.injector, ServiceImage, "ListImages", func() ([]images.Image, error) {
+		return c.client.ListImages(listOpts)
+	}, listOpts)
+}
+
+func (c imageClient) GetImage(id string) (*images.Image, error) {
+	return read(c.injector, ServiceImage, "GetImage", func() (*images.Image, error) {
+		return c.client.GetImage(id)
+	}, id)
+}
+
+func (c imageClient) CreateImage(ctx context.Context, createOpts images.CreateOptsBuilder) (*images.Image, error) {
+	return call(c.injector, ServiceImage, "CreateImage", func() (*images.Image, error) {
+		return c.client.CreateImage(ctx, createOpts)
+	})
+}
+
+func (c imageClient) DeleteImage(ctx context.Context, id string) error {
+	return callErr(c.injector, ServiceImage, "DeleteImage", func() error {
+		return c.client.DeleteImage(ctx, id)
+	})
+}
+
+func (c imageClient) UploadData(ctx context.Context, id string, data io.Reader) error {
+	return callErr(c.injector, ServiceImage, "UploadData", func() error {
+		return c.client.UploadData(ctx, id, data)
+	})
+}
+
+func (c imageClient) GetImportInfo(ctx context.Context) (*imageimport.ImportInfo, error) {
+	return read(c.injector, ServiceImage, "GetImportInfo", func() (*imageimport.ImportInfo, error) {
+		return c.client.GetImportInfo(ctx)
+	})
+}
+
+func (c imageClient) CreateImport(ctx context.Context, id string, createOpts imageimport.CreateOptsBuilder) error {
+	return callErr(c.injector, ServiceImage, "CreateImport", func() error {
+		return c.client.CreateImport(ctx, id, createOpts)
+	})
+}
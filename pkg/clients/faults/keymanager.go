@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package faults
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/secrets"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+)
+
+type keyManagerClient struct {
+	client   clients.KeyManagerClient
+	injector *Injector
+}
+
+var _ clients.KeyManagerClient = keyManagerClient{}
+
+// NewKeyManagerClient returns a KeyManagerClient which injects faults into calls to client.
+func NewKeyManagerClient(client clients.KeyManagerClient, injector *Injector) clients.KeyManagerClient {
+	return keyManagerClient{client: client, injector: injector}
+}
+
+func (c keyManagerClient) CreateSecret(opts secrets.CreateOptsBuilder) (*secrets.Secret, error) {
+	return call(c.injector, ServiceKeyManager, "CreateSecret", func() (*secrets.Secret, error) {
+		return c.client.CreateSecret(opts)
+	})
+}
+
+func (c keyManagerClient) DeleteSecret(id string) error {
+	return callErr(c.injector, ServiceKeyManager, "DeleteSecret", func() error {
+		return c.client.DeleteSecret(id)
+	})
+}
+
+func (c keyManagerClient) CreateContainer(opts containers.CreateOptsBuilder) (*containers.Container, error) {
+	return call(c.injector, ServiceKeyManager, "CreateContainer", func() (*containers.Container, error) {
+		return c.client.CreateContainer(opts)
+	})
+}
+
+func (c keyManagerClient) ListContainers(opts containers.ListOptsBuilder) ([]containers.Container, error) {
+	return read(c.injector, ServiceKeyManager, "ListContainers", func() ([]containers.Container, error) {
+		return c.client.ListContainers(opts)
+	}, opts)
+}
+
+func (c keyManagerClient) DeleteContainer(id string) error {
+	return callErr(c.injector, ServiceKeyManager, "DeleteContainer", func() error {
+		return c.client.DeleteContainer(id)
+	})
+}
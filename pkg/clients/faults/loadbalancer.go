@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package faults
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/apiversions"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/flavors"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/providers"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+)
+
+type lbClient struct {
+	client   clients.LbClient
+	injector *Injector
+}
+
+var _ clients.LbClient = lbClient{}
+
+// NewLbClient returns a LbClient which injects faults into calls to client.
+func NewLbClient(client clients.LbClient, injector *Injector) clients.LbClient {
+	return lbClient{client: client, injector: injector}
+}
+
+func (c lbClient) CreateLoadBalancer(opts loadbalancers.CreateOptsBuilder) (*loadbalancers.LoadBalancer, error) {
+	return call(c.injector, ServiceLoadBalancer, "CreateLoadBalancer", func() (*loadbalancers.LoadBalancer, error) {
+		return c.client.CreateLoadBalancer(opts)
+	})
+}
+
+func (c lbClient) ListLoadBalancers(opts loadbalancers.ListOptsBuilder) ([]loadbalancers.LoadBalancer, error) {
+	return read(c.injector, ServiceLoadBalancer, "ListLoadBalancers", func() ([]loadbalancers.LoadBalancer, error) {
+		return c.client.ListLoadBalancers(opts)
+	}, opts)
+}
+
+func (c lbClient) GetLoadBalancer(id string) (*loadbalancers.LoadBalancer, error) {
+	return read(c.injector, ServiceLoadBalancer, "GetLoadBalancer", func() (*loadbalancers.LoadBalancer, error) {
+		return c.client.GetLoadBalancer(id)
+	}, id)
+}
+
+func (c lbClient) UpdateLoadBalancer(id string, opts loadbalancers.UpdateOptsBuilder) (*loadbalancers.LoadBalancer, error) {
+	return call(c.injector, ServiceLoadBalancer, "UpdateLoadBalancer", func() (*loadbalancers.LoadBalancer, error) {
+		return c.client.UpdateLoadBalancer(id, opts)
+	})
+}
+
+func (c lbClient) DeleteLoadBalancer(id string, opts loadbalancers.DeleteOptsBuilder) error {
+	return callErr(c.injector, ServiceLoadBalancer, "DeleteLoadBalancer", func() error {
+		return c.client.DeleteLoadBalancer(id, opts)
+	})
+}
+
+func (c lbClient) GetLoadBalancerStatuses(id string) (*loadbalancers.StatusTree, error) {
+	return read(c.injector, ServiceLoadBalancer, "GetLoadBalancerStatuses", func() (*loadbalancers.StatusTree, error) {
+		return c.client.GetLoadBalancerStatuses(id)
+	}, id)
+}
+
+func (c lbClient) CreateListener(opts listeners.CreateOptsBuilder) (*listeners.Listener, error) {
+	return call(c.injector, ServiceLoadBalancer, "CreateListener", func() (*listeners.Listener, error) {
+		return c.client.CreateListener(opts)
+	})
+}
+
+func (c lbClient) ListListeners(opts listeners.ListOptsBuilder) ([]listeners.Listener, error) {
+	return read(c.injector, ServiceLoadBalancer, "ListListeners", func() ([]listeners.Listener, error) {
+		return c.client.ListListeners(opts)
+	}, opts)
+}
+
+func (c lbClient) UpdateListener(id string, opts listeners.UpdateOpts) (*listeners.Listener, error) {
+	return call(c.injector, ServiceLoadBalancer, "UpdateListener", func() (*listeners.Listener, error) {
+		return c.client.UpdateListener(id, opts)
+	})
+}
+
+func (c lbClient) GetListener(id string) (*listeners.Listener, error) {
+	return read(c.injector, ServiceLoadBalancer, "GetListener", func() (*listeners.Listener, error) {
+		return c.client.GetListener(id)
+	}, id)
+}
+
+func (c lbClient) DeleteListener(id string) error {
+	return callErr(c.injector, ServiceLoadBalancer, "DeleteListener", func() error {
+		return c.client.DeleteListener(id)
+	})
+}
+
+func (c lbClient) CreatePool(opts pools.CreateOptsBuilder) (*pools.Pool, error) {
+	return call(c.injector, ServiceLoadBalancer, "CreatePool", func() (*pools.Pool, error) {
+		return c.client.CreatePool(opts)
+	})
+}
+
+func (c lbClient) ListPools(opts pools.ListOptsBuilder) ([]pools.Pool, error) {
+	return read(c.injector, ServiceLoadBalancer, "ListPools", func() ([]pools.Pool, error) {
+		return c.client.ListPools(opts)
+	}, opts)
+}
+
+func (c lbClient) GetPool(id string) (*pools.Pool, error) {
+	return read(c.injector, ServiceLoadBalancer, "GetPool", func() (*pools.Pool, error) {
+		return c.client.GetPool(id)
+	}, id)
+}
+
+func (c lbClient) UpdatePool(id string, opts pools.UpdateOptsBuilder) (*pools.Pool, error) {
+	return call(c.injector, ServiceLoadBalancer, "UpdatePool", func() (*pools.Pool, error) {
+		return c.client.UpdatePool(id, opts)
+	})
+}
+
+func (c lbClient) DeletePool(id string) error {
+	return callErr(c.injector, ServiceLoadBalancer, "DeletePool", func() error {
+		return c.client.DeletePool(id)
+	})
+}
+
+func (c lbClient) CreatePoolMember(poolID string, opts pools.CreateMemberOptsBuilder) (*pools.Member, error) {
+	return call(c.injector, ServiceLoadBalancer, "CreatePoolMember", func() (*pools.Member, error) {
+		return c.client.CreatePoolMember(poolID, opts)
+	})
+}
+
+func (c lbClient) ListPoolMember(poolID string, opts pools.ListMembersOptsBuilder) ([]pools.Member, error) {
+	return read(c.injector, ServiceLoadBalancer, "ListPoolMember", func() ([]pools.Member, error) {
+		return c.client.ListPoolMember(poolID, opts)
+	}, poolID, opts)
+}
+
+func (c lbClient) GetPoolMember(poolID string, lbMemberID string) (*pools.Member, error) {
+	return read(c.injector, ServiceLoadBalancer, "GetPoolMember", func() (*pools.Member, error) {
+		return c.client.GetPoolMember(poolID, lbMemberID)
+	}, poolID, lbMemberID)
+}
+
+func (c lbClient) UpdatePoolMember(poolID string, lbMemberID string, opts pools.UpdateMemberOptsBuilder) (*pools.Member, error) {
+	return call(c.injector, ServiceLoadBalancer, "UpdatePoolMember", func() (*pools.Member, error) {
+		return c.client.UpdatePoolMember(poolID, lbMemberID, opts)
+	})
+}
+
+func (c lbClient) DeletePoolMember(poolID string, lbMemberID string) error {
+	return callErr(c.injector, ServiceLoadBalancer, "DeletePoolMember", func() error {
+		return c.client.DeletePoolMember(poolID, lbMemberID)
+	})
+}
+
+func (c lbClient) CreateMonitor(opts monitors.CreateOptsBuilder) (*monitors.Monitor, error) {
+	return call(c.injector, ServiceLoadBalancer, "CreateMonitor", func() (*monitors.Monitor, error) {
+		return c.client.CreateMonitor(opts)
+	})
+}
+
+func (c lbClient) ListMonitors(opts monitors.ListOptsBuilder) ([]monitors.Monitor, error) {
+	return read(c.injector, ServiceLoadBalancer, "ListMonitors", func() ([]monitors.Monitor, error) {
+		return c.client.ListMonitors(opts)
+	}, opts)
+}
+
+func (c lbClient) UpdateMonitor(id string, opts monitors.UpdateOptsBuilder) (*monitors.Monitor, error) {
+	return call(c.injector, ServiceLoadBalancer, "UpdateMonitor", func() (*monitors.Monitor, error) {
+		return c.client.UpdateMonitor(id, opts)
+	})
+}
+
+func (c lbClient) DeleteMonitor(id string) error {
+	return callErr(c.injector, ServiceLoadBalancer, "DeleteMonitor", func() error {
+		return c.client.DeleteMonitor(id)
+	})
+}
+
+func (c lbClient) ListLoadBalancerProviders() ([]providers.Provider, error) {
+	return read(c.injector, ServiceLoadBalancer, "ListLoadBalancerProviders", func() ([]providers.Provider, error) {
+		return c.client.ListLoadBalancerProviders()
+	})
+}
+
+func (c lbClient) ListOctaviaVersions() ([]apiversions.APIVersion, error) {
+	return read(c.injector, ServiceLoadBalancer, "ListOctaviaVersions", func() ([]apiversions.APIVersion, error) {
+		return c.client.ListOctaviaVersions()
+	})
+}
+
+func (c lbClient) ListLoadBalancerFlavors() ([]flavors.Flavor, error) {
+	return read(c.injector, ServiceLoadBalancer, "ListLoadBalancerFlavors", func() ([]flavors.Flavor, error) {
+		return c.client.ListLoadBalancerFlavors()
+	})
+}
@@ -0,0 +1,335 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package faults
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/quotas"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/subnetpools"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/trunks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+)
+
+type networkClient struct {
+	client   clients.NetworkClient
+	injector *Injector
+}
+
+var _ clients.NetworkClient = networkClient{}
+
+// NewNetworkClient returns a NetworkClient which injects faults into calls to client.
+func NewNetworkClient(client clients.NetworkClient, injector *Injector) clients.NetworkClient {
+	return networkClient{client: client, injector: injector}
+}
+
+func (c networkClient) ListFloatingIP(opts floatingips.ListOptsBuilder) ([]floatingips.FloatingIP, error) {
+	return read(c.injector, ServiceNetwork, "ListFloatingIP", func() ([]floatingips.FloatingIP, error) {
+		return c.client.ListFloatingIP(opts)
+	}, opts)
+}
+
+func (c networkClient) CreateFloatingIP(opts floatingips.CreateOptsBuilder) (*floatingips.FloatingIP, error) {
+	return call(c.injector, ServiceNetwork, "CreateFloatingIP", func() (*floatingips.FloatingIP, error) {
+		return c.client.CreateFloatingIP(opts)
+	})
+}
+
+func (c networkClient) DeleteFloatingIP(id string) error {
+	return callErr(c.injector, ServiceNetwork, "DeleteFloatingIP", func() error {
+		return c.client.DeleteFloatingIP(id)
+	})
+}
+
+func (c networkClient) GetFloatingIP(id string) (*floatingips.FloatingIP, error) {
+	return read(c.injector, ServiceNetwork, "GetFloatingIP", func() (*floatingips.FloatingIP, error) {
+		return c.client.GetFloatingIP(id)
+	}, id)
+}
+
+func (c networkClient) UpdateFloatingIP(id string, opts floatingips.UpdateOptsBuilder) (*floatingips.FloatingIP, error) {
+	return call(c.injector, ServiceNetwork, "UpdateFloatingIP", func() (*floatingips.FloatingIP, error) {
+		return c.client.UpdateFloatingIP(id, opts)
+	})
+}
+
+func (c networkClient) ListPort(opts ports.ListOptsBuilder) ([]ports.Port, error) {
+	return read(c.injector, ServiceNetwork, "ListPort", func() ([]ports.Port, error) {
+		return c.client.ListPort(opts)
+	}, opts)
+}
+
+func (c networkClient) CreatePort(opts ports.CreateOptsBuilder) (*ports.Port, error) {
+	return call(c.injector, ServiceNetwork, "CreatePort", func() (*ports.Port, error) {
+		return c.client.CreatePort(opts)
+	})
+}
+
+func (c networkClient) DeletePort(id string) error {
+	return callErr(c.injector, ServiceNetwork, "DeletePort", func() error {
+		return c.client.DeletePort(id)
+	})
+}
+
+func (c networkClient) GetPort(id string) (*ports.Port, error) {
+	return read(c.injector, ServiceNetwork, "GetPort", func() (*ports.Port, error) {
+		return c.client.GetPort(id)
+	}, id)
+}
+
+func (c networkClient) UpdatePort(id string, opts ports.UpdateOptsBuilder) (*ports.Port, error) {
+	return call(c.injector, ServiceNetwork, "UpdatePort", func() (*ports.Port, error) {
+		return c.client.UpdatePort(id, opts)
+	})
+}
+
+func (c networkClient) ListTrunk(opts trunks.ListOptsBuilder) ([]trunks.Trunk, error) {
+	return read(c.injector, ServiceNetwork, "ListTrunk", func() ([]trunks.Trunk, error) {
+		return c.client.ListTrunk(opts)
+	}, opts)
+}
+
+func (c networkClient) CreateTrunk(opts trunks.CreateOptsBuilder) (*trunks.Trunk, error) {
+	return call(c.injector, ServiceNetwork, "CreateTrunk", func() (*trunks.Trunk, error) {
+		return c.client.CreateTrunk(opts)
+	})
+}
+
+func (c networkClient) DeleteTrunk(id string) error {
+	return callErr(c.injector, ServiceNetwork, "DeleteTrunk", func() error {
+		return c.client.DeleteTrunk(id)
+	})
+}
+
+func (c networkClient) ListTrunkSubports(trunkID string) ([]trunks.Subport, error) {
+	return read(c.injector, ServiceNetwork, "ListTrunkSubports", func() ([]trunks.Subport, error) {
+		return c.client.ListTrunkSubports(trunkID)
+	}, trunkID)
+}
+
+func (c networkClient) RemoveSubports(id string, opts trunks.RemoveSubportsOpts) error {
+	return callErr(c.injector, ServiceNetwork, "RemoveSubports", func() error {
+		return c.client.RemoveSubports(id, opts)
+	})
+}
+
+func (c networkClient) ListRouter(opts routers.ListOpts) ([]routers.Router, error) {
+	return read(c.injector, ServiceNetwork, "ListRouter", func() ([]routers.Router, error) {
+		return c.client.ListRouter(opts)
+	}, opts)
+}
+
+func (c networkClient) CreateRouter(opts routers.CreateOptsBuilder) (*routers.Router, error) {
+	return call(c.injector, ServiceNetwork, "CreateRouter", func() (*routers.Router, error) {
+		return c.client.CreateRouter(opts)
+	})
+}
+
+func (c networkClient) DeleteRouter(id string) error {
+	return callErr(c.injector, ServiceNetwork, "DeleteRouter", func() error {
+		return c.client.DeleteRouter(id)
+	})
+}
+
+func (c networkClient) GetRouter(id string) (*routers.Router, error) {
+	return read(c.injector, ServiceNetwork, "GetRouter", func() (*routers.Router, error) {
+		return c.client.GetRouter(id)
+	}, id)
+}
+
+func (c networkClient) UpdateRouter(id string, opts routers.UpdateOptsBuilder) (*routers.Router, error) {
+	return call(c.injector, ServiceNetwork, "UpdateRouter", func() (*routers.Router, error) {
+		return c.client.UpdateRouter(id, opts)
+	})
+}
+
+func (c networkClient) AddRouterInterface(id string, opts routers.AddInterfaceOptsBuilder) (*routers.InterfaceInfo, error) {
+	return call(c.injector, ServiceNetwork, "AddRouterInterface", func() (*routers.InterfaceInfo, error) {
+		return c.client.AddRouterInterface(id, opts)
+	})
+}
+
+func (c networkClient) RemoveRouterInterface(id string, opts routers.RemoveInterfaceOptsBuilder) (*routers.InterfaceInfo, error) {
+	return call(c.injector, ServiceNetwork, "RemoveRouterInterface", func() (*routers.InterfaceInfo, error) {
+		return c.client.RemoveRouterInterface(id, opts)
+	})
+}
+
+func (c networkClient) ListSecGroup(opts groups.ListOpts) ([]groups.SecGroup, error) {
+	return read(c.injector, ServiceNetwork, "ListSecGroup", func() ([]groups.SecGroup, error) {
+		return c.client.ListSecGroup(opts)
+	}, opts)
+}
+
+func (c networkClient) CreateSecGroup(opts groups.CreateOptsBuilder) (*groups.SecGroup, error) {
+	return call(c.injector, ServiceNetwork, "CreateSecGroup", func() (*groups.SecGroup, error) {
+		return c.client.CreateSecGroup(opts)
+	})
+}
+
+func (c networkClient) DeleteSecGroup(id string) error {
+	return callErr(c.injector, ServiceNetwork, "DeleteSecGroup", func() error {
+		return c.client.DeleteSecGroup(id)
+	})
+}
+
+func (c networkClient) GetSecGroup(id string) (*groups.SecGroup, error) {
+	return read(c.injector, ServiceNetwork, "GetSecGroup", func() (*groups.SecGroup, error) {
+		return c.client.GetSecGroup(id)
+	}, id)
+}
+
+func (c networkClient) UpdateSecGroup(id string, opts groups.UpdateOptsBuilder) (*groups.SecGroup, error) {
+	return call(c.injector, ServiceNetwork, "UpdateSecGroup", func() (*groups.SecGroup, error) {
+		return c.client.UpdateSecGroup(id, opts)
+	})
+}
+
+func (c networkClient) ListSecGroupRule(opts rules.ListOpts) ([]rules.SecGroupRule, error) {
+	return read(c.injector, ServiceNetwork, "ListSecGroupRule", func() ([]rules.SecGroupRule, error) {
+		return c.client.ListSecGroupRule(opts)
+	}, opts)
+}
+
+func (c networkClient) CreateSecGroupRule(opts rules.CreateOptsBuilder) (*rules.SecGroupRule, error) {
+	return call(c.injector, ServiceNetwork, "CreateSecGroupRule", func() (*rules.SecGroupRule, error) {
+		return c.client.CreateSecGroupRule(opts)
+	})
+}
+
+func (c networkClient) DeleteSecGroupRule(id string) error {
+	return callErr(c.injector, ServiceNetwork, "DeleteSecGroupRule", func() error {
+		return c.client.DeleteSecGroupRule(id)
+	})
+}
+
+func (c networkClient) GetSecGroupRule(id string) (*rules.SecGroupRule, error) {
+	return read(c.injector, ServiceNetwork, "GetSecGroupRule", func() (*rules.SecGroupRule, error) {
+		return c.client.GetSecGroupRule(id)
+	}, id)
+}
+
+func (c networkClient) ListNetwork(opts networks.ListOptsBuilder) ([]networks.Network, error) {
+	return read(c.injector, ServiceNetwork, "ListNetwork", func() ([]networks.Network, error) {
+		return c.client.ListNetwork(opts)
+	}, opts)
+}
+
+func (c networkClient) CreateNetwork(opts networks.CreateOptsBuilder) (*networks.Network, error) {
+	return call(c.injector, ServiceNetwork, "CreateNetwork", func() (*networks.Network, error) {
+		return c.client.CreateNetwork(opts)
+	})
+}
+
+func (c networkClient) DeleteNetwork(id string) error {
+	return callErr(c.injector, ServiceNetwork, "DeleteNetwork", func() error {
+		return c.client.DeleteNetwork(id)
+	})
+}
+
+func (c networkClient) GetNetwork(id string) (*networks.Network, error) {
+	return read(c.injector, ServiceNetwork, "GetNetwork", func() (*networks.Network, error) {
+		return c.client.GetNetwork(id)
+	}, id)
+}
+
+func (c networkClient) UpdateNetwork(id string, opts networks.UpdateOptsBuilder) (*networks.Network, error) {
+	return call(c.injector, ServiceNetwork, "UpdateNetwork", func() (*networks.Network, error) {
+		return c.client.UpdateNetwork(id, opts)
+	})
+}
+
+func (c networkClient) ListSubnet(opts subnets.ListOptsBuilder) ([]subnets.Subnet, error) {
+	return read(c.injector, ServiceNetwork, "ListSubnet", func() ([]subnets.Subnet, error) {
+		return c.client.ListSubnet(opts)
+	}, opts)
+}
+
+func (c networkClient) CreateSubnet(opts subnets.CreateOptsBuilder) (*subnets.Subnet, error) {
+	return call(c.injector, ServiceNetwork, "CreateSubnet", func() (*subnets.Subnet, error) {
+		return c.client.CreateSubnet(opts)
+	})
+}
+
+func (c networkClient) DeleteSubnet(id string) error {
+	return callErr(c.injector, ServiceNetwork, "DeleteSubnet", func() error {
+		return c.client.DeleteSubnet(id)
+	})
+}
+
+func (c networkClient) GetSubnet(id string) (*subnets.Subnet, error) {
+	return read(c.injector, ServiceNetwork, "GetSubnet", func() (*subnets.Subnet, error) {
+		return c.client.GetSubnet(id)
+	}, id)
+}
+
+func (c networkClient) UpdateSubnet(id string, opts subnets.UpdateOptsBuilder) (*subnets.Subnet, error) {
+	return call(c.injector, ServiceNetwork, "UpdateSubnet", func() (*subnets.Subnet, error) {
+		return c.client.UpdateSubnet(id, opts)
+	})
+}
+
+func (c networkClient) ListSubnetPool(opts subnetpools.ListOptsBuilder) ([]subnetpools.SubnetPool, error) {
+	return read(c.injector, ServiceNetwork, "ListSubnetPool", func() ([]subnetpools.SubnetPool, error) {
+		return c.client.ListSubnetPool(opts)
+	}, opts)
+}
+
+func (c networkClient) GetSubnetPool(id string) (*subnetpools.SubnetPool, error) {
+	return read(c.injector, ServiceNetwork, "GetSubnetPool", func() (*subnetpools.SubnetPool, error) {
+		return c.client.GetSubnetPool(id)
+	}, id)
+}
+
+func (c networkClient) ListQoSPolicy(opts policies.PolicyListOptsBuilder) ([]policies.Policy, error) {
+	return read(c.injector, ServiceNetwork, "ListQoSPolicy", func() ([]policies.Policy, error) {
+		return c.client.ListQoSPolicy(opts)
+	}, opts)
+}
+
+func (c networkClient) GetQoSPolicy(id string) (*policies.Policy, error) {
+	return read(c.injector, ServiceNetwork, "GetQoSPolicy", func() (*policies.Policy, error) {
+		return c.client.GetQoSPolicy(id)
+	}, id)
+}
+
+func (c networkClient) ListExtensions() ([]extensions.Extension, error) {
+	return read(c.injector, ServiceNetwork, "ListExtensions", func() ([]extensions.Extension, error) {
+		return c.client.ListExtensions()
+	})
+}
+
+func (c networkClient) GetQuotaDetail(projectID string) (*quotas.QuotaDetailSet, error) {
+	return read(c.injector, ServiceNetwork, "GetQuotaDetail", func() (*quotas.QuotaDetailSet, error) {
+		return c.client.GetQuotaDetail(projectID)
+	}, projectID)
+}
+
+func (c networkClient) ReplaceAllAttributesTags(resourceType string, resourceID string, opts attributestags.ReplaceAllOptsBuilder) ([]string, error) {
+	return call(c.injector, ServiceNetwork, "ReplaceAllAttributesTags", func() ([]string, error) {
+		return c.client.ReplaceAllAttributesTags(resourceType, resourceID, opts)
+	})
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package faults
+
+import (
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/quotasets"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+)
+
+type volumeClient struct {
+	client   clients.VolumeClient
+	injector *Injector
+}
+
+var _ clients.VolumeClient = volumeClient{}
+
+// NewVolumeClient returns a VolumeClient which injects faults into calls to client.
+func NewVolumeClient(client clients.VolumeClient, injector *Injector) clients.VolumeClient {
+	return volumeClient{client: client, injector: injector}
+}
+
+func (c volumeClient) ListVolumes(opts volumes.ListOptsBuilder) ([]volumes.Volume, error) {
+	return read(c.injector, ServiceVolume, "ListVolumes", func() ([]volumes.Volume, error) {
+		return c.client.ListVolumes(opts)
+	}, opts)
+}
+
+func (c volumeClient) CreateVolume(opts volumes.CreateOptsBuilder) (*volumes.Volume, error) {
+	return call(c.injector, ServiceVolume, "CreateVolume", func() (*volumes.Volume, error) {
+		return c.client.CreateVolume(opts)
+	})
+}
+
+func (c volumeClient) DeleteVolume(volumeID string, opts volumes.DeleteOptsBuilder) error {
+	return callErr(c.injector, ServiceVolume, "DeleteVolume", func() error {
+		return c.client.DeleteVolume(volumeID, opts)
+	})
+}
+
+func (c volumeClient) GetVolume(volumeID string) (*volumes.Volume, error) {
+	return read(c.injector, ServiceVolume, "GetVolume", func() (*volumes.Volume, error) {
+		return c.client.GetVolume(volumeID)
+	}, volumeID)
+}
+
+func (c volumeClient) GetQuotaSetUsage(projectID string) (*quotasets.QuotaUsageSet, error) {
+	return read(c.injector, ServiceVolume, "GetQuotaSetUsage", func() (*quotasets.QuotaUsageSet, error) {
+		return c.client.GetQuotaSetUsage(projectID)
+	}, projectID)
+}
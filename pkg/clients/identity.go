@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/applicationcredentials"
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/metrics"
+)
+
+type IdentityClient interface {
+	ListApplicationCredentials(userID string, opts applicationcredentials.ListOptsBuilder) ([]applicationcredentials.ApplicationCredential, error)
+	GetApplicationCredential(userID, id string) (*applicationcredentials.ApplicationCredential, error)
+	CreateApplicationCredential(userID string, opts applicationcredentials.CreateOptsBuilder) (*applicationcredentials.ApplicationCredential, error)
+	DeleteApplicationCredential(userID, id string) error
+}
+
+type identityClient struct{ client *gophercloud.ServiceClient }
+
+// NewIdentityClient returns a new keystone client.
+func NewIdentityClient(providerClient *gophercloud.ProviderClient, providerClientOpts *clientconfig.ClientOpts) (IdentityClient, error) {
+	identity, err := openstack.NewIdentityV3(providerClient, gophercloud.EndpointOpts{
+		Region:       providerClientOpts.RegionName,
+		Availability: clientconfig.GetEndpointType(providerClientOpts.EndpointType),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create identity service client: %v", err)
+	}
+
+	return &identityClient{identity}, nil
+}
+
+func (c identityClient) ListApplicationCredentials(userID string, opts applicationcredentials.ListOptsBuilder) ([]applicationcredentials.ApplicationCredential, error) {
+	mc := metrics.NewMetricPrometheusContext("application_credential", "list")
+	pages, err := applicationcredentials.List(c.client, userID, opts).AllPages(context.TODO())
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return applicationcredentials.ExtractApplicationCredentials(pages)
+}
+
+func (c identityClient) GetApplicationCredential(userID, id string) (*applicationcredentials.ApplicationCredential, error) {
+	mc := metrics.NewMetricPrometheusContext("application_credential", "get")
+	credential, err := applicationcredentials.Get(context.TODO(), c.client, userID, id).Extract()
+	return credential, mc.ObserveRequestIgnoreNotFound(err)
+}
+
+func (c identityClient) CreateApplicationCredential(userID string, opts applicationcredentials.CreateOptsBuilder) (*applicationcredentials.ApplicationCredential, error) {
+	mc := metrics.NewMetricPrometheusContext("application_credential", "create")
+	credential, err := applicationcredentials.Create(context.TODO(), c.client, userID, opts).Extract()
+	return credential, mc.ObserveRequest(err)
+}
+
+func (c identityClient) DeleteApplicationCredential(userID, id string) error {
+	mc := metrics.NewMetricPrometheusContext("application_credential", "delete")
+	err := applicationcredentials.Delete(context.TODO(), c.client, userID, id).ExtractErr()
+	return mc.ObserveRequestIgnoreNotFound(err)
+}
+
+type identityErrorClient struct{ error }
+
+// NewIdentityErrorClient returns an IdentityClient in which every method returns the given error.
+func NewIdentityErrorClient(e error) IdentityClient {
+	return identityErrorClient{e}
+}
+
+func (e identityErrorClient) ListApplicationCredentials(_ string, _ applicationcredentials.ListOptsBuilder) ([]applicationcredentials.ApplicationCredential, error) {
+	return nil, e.error
+}
+
+func (e identityErrorClient) GetApplicationCredential(_, _ string) (*applicationcredentials.ApplicationCredential, error) {
+	return nil, e.error
+}
+
+func (e identityErrorClient) CreateApplicationCredential(_ string, _ applicationcredentials.CreateOptsBuilder) (*applicationcredentials.ApplicationCredential, error) {
+	return nil, e.error
+}
+
+func (e identityErrorClient) DeleteApplicationCredential(_, _ string) error {
+	return e.error
+}
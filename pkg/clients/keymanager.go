@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/secrets"
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/metrics"
+)
+
+type KeyManagerClient interface {
+	CreateSecret(opts secrets.CreateOptsBuilder) (*secrets.Secret, error)
+	DeleteSecret(id string) error
+	CreateContainer(opts containers.CreateOptsBuilder) (*containers.Container, error)
+	ListContainers(opts containers.ListOptsBuilder) ([]containers.Container, error)
+	DeleteContainer(id string) error
+}
+
+type keyManagerClient struct{ client *gophercloud.ServiceClient }
+
+// NewKeyManagerClient returns a new barbican client.
+func NewKeyManagerClient(providerClient *gophercloud.ProviderClient, providerClientOpts *clientconfig.ClientOpts) (KeyManagerClient, error) {
+	keyManager, err := openstack.NewKeyManagerV1(providerClient, gophercloud.EndpointOpts{
+		Region:       providerClientOpts.RegionName,
+		Availability: clientconfig.GetEndpointType(providerClientOpts.EndpointType),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create key manager service client: %v", err)
+	}
+
+	return &keyManagerClient{keyManager}, nil
+}
+
+func (c keyManagerClient) CreateSecret(opts secrets.CreateOptsBuilder) (*secrets.Secret, error) {
+	mc := metrics.NewMetricPrometheusContext("secret", "create")
+	secret, err := secrets.Create(context.TODO(), c.client, opts).Extract()
+	return secret, mc.ObserveRequest(err)
+}
+
+func (c keyManagerClient) DeleteSecret(id string) error {
+	mc := metrics.NewMetricPrometheusContext("secret", "delete")
+	err := secrets.Delete(context.TODO(), c.client, id).ExtractErr()
+	return mc.ObserveRequestIgnoreNotFound(err)
+}
+
+func (c keyManagerClient) CreateContainer(opts containers.CreateOptsBuilder) (*containers.Container, error) {
+	mc := metrics.NewMetricPrometheusContext("secret_container", "create")
+	container, err := containers.Create(context.TODO(), c.client, opts).Extract()
+	return container, mc.ObserveRequest(err)
+}
+
+func (c keyManagerClient) ListContainers(opts containers.ListOptsBuilder) ([]containers.Container, error) {
+	mc := metrics.NewMetricPrometheusContext("secret_container", "list")
+	pages, err := containers.List(c.client, opts).AllPages(context.TODO())
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return containers.ExtractContainers(pages)
+}
+
+func (c keyManagerClient) DeleteContainer(id string) error {
+	mc := metrics.NewMetricPrometheusContext("secret_container", "delete")
+	err := containers.Delete(context.TODO(), c.client, id).ExtractErr()
+	return mc.ObserveRequestIgnoreNotFound(err)
+}
+
+type keyManagerErrorClient struct{ error }
+
+// NewKeyManagerErrorClient returns a KeyManagerClient in which every method returns the given error.
+func NewKeyManagerErrorClient(e error) KeyManagerClient {
+	return keyManagerErrorClient{e}
+}
+
+func (e keyManagerErrorClient) CreateSecret(_ secrets.CreateOptsBuilder) (*secrets.Secret, error) {
+	return nil, e.error
+}
+
+func (e keyManagerErrorClient) DeleteSecret(_ string) error {
+	return e.error
+}
+
+func (e keyManagerErrorClient) CreateContainer(_ containers.CreateOptsBuilder) (*containers.Container, error) {
+	return nil, e.error
+}
+
+func (e keyManagerErrorClient) ListContainers(_ containers.ListOptsBuilder) ([]containers.Container, error) {
+	return nil, e.error
+}
+
+func (e keyManagerErrorClient) DeleteContainer(_ string) error {
+	return e.error
+}
@@ -39,7 +39,9 @@ type LbClient interface {
 	CreateLoadBalancer(opts loadbalancers.CreateOptsBuilder) (*loadbalancers.LoadBalancer, error)
 	ListLoadBalancers(opts loadbalancers.ListOptsBuilder) ([]loadbalancers.LoadBalancer, error)
 	GetLoadBalancer(id string) (*loadbalancers.LoadBalancer, error)
+	UpdateLoadBalancer(id string, opts loadbalancers.UpdateOptsBuilder) (*loadbalancers.LoadBalancer, error)
 	DeleteLoadBalancer(id string, opts loadbalancers.DeleteOptsBuilder) error
+	GetLoadBalancerStatuses(id string) (*loadbalancers.StatusTree, error)
 	CreateListener(opts listeners.CreateOptsBuilder) (*listeners.Listener, error)
 	ListListeners(opts listeners.ListOptsBuilder) ([]listeners.Listener, error)
 	UpdateListener(id string, opts listeners.UpdateOpts) (*listeners.Listener, error)
@@ -48,10 +50,12 @@ type LbClient interface {
 	CreatePool(opts pools.CreateOptsBuilder) (*pools.Pool, error)
 	ListPools(opts pools.ListOptsBuilder) ([]pools.Pool, error)
 	GetPool(id string) (*pools.Pool, error)
+	UpdatePool(id string, opts pools.UpdateOptsBuilder) (*pools.Pool, error)
 	DeletePool(id string) error
 	CreatePoolMember(poolID string, opts pools.CreateMemberOptsBuilder) (*pools.Member, error)
 	ListPoolMember(poolID string, opts pools.ListMembersOptsBuilder) ([]pools.Member, error)
 	GetPoolMember(poolID string, lbMemberID string) (*pools.Member, error)
+	UpdatePoolMember(poolID string, lbMemberID string, opts pools.UpdateMemberOptsBuilder) (*pools.Member, error)
 	DeletePoolMember(poolID string, lbMemberID string) error
 	CreateMonitor(opts monitors.CreateOptsBuilder) (*monitors.Monitor, error)
 	ListMonitors(opts monitors.ListOptsBuilder) ([]monitors.Monitor, error)
@@ -106,6 +110,15 @@ func (l lbClient) GetLoadBalancer(id string) (*loadbalancers.LoadBalancer, error
 	return lb, nil
 }
 
+func (l lbClient) UpdateLoadBalancer(id string, opts loadbalancers.UpdateOptsBuilder) (*loadbalancers.LoadBalancer, error) {
+	mc := metrics.NewMetricPrometheusContext("loadbalancer", "update")
+	lb, err := loadbalancers.Update(context.TODO(), l.serviceClient, id, opts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return lb, nil
+}
+
 func (l lbClient) DeleteLoadBalancer(id string, opts loadbalancers.DeleteOptsBuilder) error {
 	mc := metrics.NewMetricPrometheusContext("loadbalancer", "delete")
 	err := loadbalancers.Delete(context.TODO(), l.serviceClient, id, opts).ExtractErr()
@@ -115,6 +128,15 @@ func (l lbClient) DeleteLoadBalancer(id string, opts loadbalancers.DeleteOptsBui
 	return nil
 }
 
+func (l lbClient) GetLoadBalancerStatuses(id string) (*loadbalancers.StatusTree, error) {
+	mc := metrics.NewMetricPrometheusContext("loadbalancer", "statuses")
+	statuses, err := loadbalancers.GetStatuses(context.TODO(), l.serviceClient, id).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
 func (l lbClient) CreateListener(opts listeners.CreateOptsBuilder) (*listeners.Listener, error) {
 	mc := metrics.NewMetricPrometheusContext("loadbalancer_listener", "create")
 	listener, err := listeners.Create(context.TODO(), l.serviceClient, opts).Extract()
@@ -187,6 +209,15 @@ func (l lbClient) GetPool(id string) (*pools.Pool, error) {
 	return pool, nil
 }
 
+func (l lbClient) UpdatePool(id string, opts pools.UpdateOptsBuilder) (*pools.Pool, error) {
+	mc := metrics.NewMetricPrometheusContext("loadbalancer_pool", "update")
+	pool, err := pools.Update(context.TODO(), l.serviceClient, id, opts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
 func (l lbClient) DeletePool(id string) error {
 	mc := metrics.NewMetricPrometheusContext("loadbalancer_pool", "delete")
 	err := pools.Delete(context.TODO(), l.serviceClient, id).ExtractErr()
@@ -223,6 +254,15 @@ func (l lbClient) GetPoolMember(poolID string, lbMemberID string) (*pools.Member
 	return member, nil
 }
 
+func (l lbClient) UpdatePoolMember(poolID string, lbMemberID string, opts pools.UpdateMemberOptsBuilder) (*pools.Member, error) {
+	mc := metrics.NewMetricPrometheusContext("loadbalancer_member", "update")
+	member, err := pools.UpdateMember(context.TODO(), l.serviceClient, poolID, lbMemberID, opts).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, fmt.Errorf("error updating lbmember: %s", err)
+	}
+	return member, nil
+}
+
 func (l lbClient) DeletePoolMember(poolID string, lbMemberID string) error {
 	mc := metrics.NewMetricPrometheusContext("loadbalancer_member", "delete")
 	err := pools.DeleteMember(context.TODO(), l.serviceClient, poolID, lbMemberID).ExtractErr()
@@ -30,6 +30,7 @@ import (
 	attachinterfaces "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/attachinterfaces"
 	availabilityzones "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/availabilityzones"
 	flavors "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
+	quotasets "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/quotasets"
 	servergroups "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servergroups"
 	servers "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
 	gomock "go.uber.org/mock/gomock"
@@ -133,6 +134,21 @@ func (mr *MockComputeClientMockRecorder) GetFlavor(flavorID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlavor", reflect.TypeOf((*MockComputeClient)(nil).GetFlavor), flavorID)
 }
 
+// GetQuotaSetDetail mocks base method.
+func (m *MockComputeClient) GetQuotaSetDetail(projectID string) (*quotasets.QuotaDetailSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuotaSetDetail", projectID)
+	ret0, _ := ret[0].(*quotasets.QuotaDetailSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuotaSetDetail indicates an expected call of GetQuotaSetDetail.
+func (mr *MockComputeClientMockRecorder) GetQuotaSetDetail(projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuotaSetDetail", reflect.TypeOf((*MockComputeClient)(nil).GetQuotaSetDetail), projectID)
+}
+
 // GetServer mocks base method.
 func (m *MockComputeClient) GetServer(serverID string) (*servers.Server, error) {
 	m.ctrl.T.Helper()
@@ -25,6 +25,12 @@ import (
 //go:generate mockgen -package mock -destination=compute.go sigs.k8s.io/cluster-api-provider-openstack/pkg/clients ComputeClient
 //go:generate /usr/bin/env bash -c "cat ../../../hack/boilerplate/boilerplate.generatego.txt compute.go > _compute.go && mv _compute.go compute.go"
 
+//go:generate mockgen -package mock -destination=identity.go sigs.k8s.io/cluster-api-provider-openstack/pkg/clients IdentityClient
+//go:generate /usr/bin/env bash -c "cat ../../../hack/boilerplate/boilerplate.generatego.txt identity.go > _identity.go && mv _identity.go identity.go"
+
+//go:generate mockgen -package mock -destination=keymanager.go sigs.k8s.io/cluster-api-provider-openstack/pkg/clients KeyManagerClient
+//go:generate /usr/bin/env bash -c "cat ../../../hack/boilerplate/boilerplate.generatego.txt keymanager.go > _keymanager.go && mv _keymanager.go keymanager.go"
+
 //go:generate mockgen -package mock -destination=image.go sigs.k8s.io/cluster-api-provider-openstack/pkg/clients ImageClient
 //go:generate /usr/bin/env bash -c "cat ../../../hack/boilerplate/boilerplate.generatego.txt image.go > _image.go && mv _image.go image.go"
 
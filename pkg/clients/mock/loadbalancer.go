@@ -236,6 +236,21 @@ func (mr *MockLbClientMockRecorder) GetLoadBalancer(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancer", reflect.TypeOf((*MockLbClient)(nil).GetLoadBalancer), id)
 }
 
+// GetLoadBalancerStatuses mocks base method.
+func (m *MockLbClient) GetLoadBalancerStatuses(id string) (*loadbalancers.StatusTree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoadBalancerStatuses", id)
+	ret0, _ := ret[0].(*loadbalancers.StatusTree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoadBalancerStatuses indicates an expected call of GetLoadBalancerStatuses.
+func (mr *MockLbClientMockRecorder) GetLoadBalancerStatuses(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerStatuses", reflect.TypeOf((*MockLbClient)(nil).GetLoadBalancerStatuses), id)
+}
+
 // GetPool mocks base method.
 func (m *MockLbClient) GetPool(id string) (*pools.Pool, error) {
 	m.ctrl.T.Helper()
@@ -401,6 +416,21 @@ func (mr *MockLbClientMockRecorder) UpdateListener(id, opts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateListener", reflect.TypeOf((*MockLbClient)(nil).UpdateListener), id, opts)
 }
 
+// UpdateLoadBalancer mocks base method.
+func (m *MockLbClient) UpdateLoadBalancer(id string, opts loadbalancers.UpdateOptsBuilder) (*loadbalancers.LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLoadBalancer", id, opts)
+	ret0, _ := ret[0].(*loadbalancers.LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLoadBalancer indicates an expected call of UpdateLoadBalancer.
+func (mr *MockLbClientMockRecorder) UpdateLoadBalancer(id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoadBalancer", reflect.TypeOf((*MockLbClient)(nil).UpdateLoadBalancer), id, opts)
+}
+
 // UpdateMonitor mocks base method.
 func (m *MockLbClient) UpdateMonitor(id string, opts monitors.UpdateOptsBuilder) (*monitors.Monitor, error) {
 	m.ctrl.T.Helper()
@@ -415,3 +445,33 @@ func (mr *MockLbClientMockRecorder) UpdateMonitor(id, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMonitor", reflect.TypeOf((*MockLbClient)(nil).UpdateMonitor), id, opts)
 }
+
+// UpdatePool mocks base method.
+func (m *MockLbClient) UpdatePool(id string, opts pools.UpdateOptsBuilder) (*pools.Pool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePool", id, opts)
+	ret0, _ := ret[0].(*pools.Pool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePool indicates an expected call of UpdatePool.
+func (mr *MockLbClientMockRecorder) UpdatePool(id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePool", reflect.TypeOf((*MockLbClient)(nil).UpdatePool), id, opts)
+}
+
+// UpdatePoolMember mocks base method.
+func (m *MockLbClient) UpdatePoolMember(poolID, lbMemberID string, opts pools.UpdateMemberOptsBuilder) (*pools.Member, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePoolMember", poolID, lbMemberID, opts)
+	ret0, _ := ret[0].(*pools.Member)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePoolMember indicates an expected call of UpdatePoolMember.
+func (mr *MockLbClientMockRecorder) UpdatePoolMember(poolID, lbMemberID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePoolMember", reflect.TypeOf((*MockLbClient)(nil).UpdatePoolMember), poolID, lbMemberID, opts)
+}
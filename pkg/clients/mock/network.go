@@ -31,8 +31,11 @@ import (
 	attributestags "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
 	floatingips "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
 	routers "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	policies "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
+	quotas "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/quotas"
 	groups "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	rules "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	subnetpools "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/subnetpools"
 	trunks "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/trunks"
 	networks "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	ports "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
@@ -356,6 +359,36 @@ func (mr *MockNetworkClientMockRecorder) GetPort(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPort", reflect.TypeOf((*MockNetworkClient)(nil).GetPort), id)
 }
 
+// GetQoSPolicy mocks base method.
+func (m *MockNetworkClient) GetQoSPolicy(id string) (*policies.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQoSPolicy", id)
+	ret0, _ := ret[0].(*policies.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQoSPolicy indicates an expected call of GetQoSPolicy.
+func (mr *MockNetworkClientMockRecorder) GetQoSPolicy(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQoSPolicy", reflect.TypeOf((*MockNetworkClient)(nil).GetQoSPolicy), id)
+}
+
+// GetQuotaDetail mocks base method.
+func (m *MockNetworkClient) GetQuotaDetail(projectID string) (*quotas.QuotaDetailSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuotaDetail", projectID)
+	ret0, _ := ret[0].(*quotas.QuotaDetailSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuotaDetail indicates an expected call of GetQuotaDetail.
+func (mr *MockNetworkClientMockRecorder) GetQuotaDetail(projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuotaDetail", reflect.TypeOf((*MockNetworkClient)(nil).GetQuotaDetail), projectID)
+}
+
 // GetRouter mocks base method.
 func (m *MockNetworkClient) GetRouter(id string) (*routers.Router, error) {
 	m.ctrl.T.Helper()
@@ -416,6 +449,21 @@ func (mr *MockNetworkClientMockRecorder) GetSubnet(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnet", reflect.TypeOf((*MockNetworkClient)(nil).GetSubnet), id)
 }
 
+// GetSubnetPool mocks base method.
+func (m *MockNetworkClient) GetSubnetPool(id string) (*subnetpools.SubnetPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetPool", id)
+	ret0, _ := ret[0].(*subnetpools.SubnetPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetPool indicates an expected call of GetSubnetPool.
+func (mr *MockNetworkClientMockRecorder) GetSubnetPool(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetPool", reflect.TypeOf((*MockNetworkClient)(nil).GetSubnetPool), id)
+}
+
 // ListExtensions mocks base method.
 func (m *MockNetworkClient) ListExtensions() ([]extensions.Extension, error) {
 	m.ctrl.T.Helper()
@@ -476,6 +524,21 @@ func (mr *MockNetworkClientMockRecorder) ListPort(opts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPort", reflect.TypeOf((*MockNetworkClient)(nil).ListPort), opts)
 }
 
+// ListQoSPolicy mocks base method.
+func (m *MockNetworkClient) ListQoSPolicy(opts policies.PolicyListOptsBuilder) ([]policies.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQoSPolicy", opts)
+	ret0, _ := ret[0].([]policies.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQoSPolicy indicates an expected call of ListQoSPolicy.
+func (mr *MockNetworkClientMockRecorder) ListQoSPolicy(opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQoSPolicy", reflect.TypeOf((*MockNetworkClient)(nil).ListQoSPolicy), opts)
+}
+
 // ListRouter mocks base method.
 func (m *MockNetworkClient) ListRouter(opts routers.ListOpts) ([]routers.Router, error) {
 	m.ctrl.T.Helper()
@@ -536,6 +599,21 @@ func (mr *MockNetworkClientMockRecorder) ListSubnet(opts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubnet", reflect.TypeOf((*MockNetworkClient)(nil).ListSubnet), opts)
 }
 
+// ListSubnetPool mocks base method.
+func (m *MockNetworkClient) ListSubnetPool(opts subnetpools.ListOptsBuilder) ([]subnetpools.SubnetPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubnetPool", opts)
+	ret0, _ := ret[0].([]subnetpools.SubnetPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubnetPool indicates an expected call of ListSubnetPool.
+func (mr *MockNetworkClientMockRecorder) ListSubnetPool(opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubnetPool", reflect.TypeOf((*MockNetworkClient)(nil).ListSubnetPool), opts)
+}
+
 // ListTrunk mocks base method.
 func (m *MockNetworkClient) ListTrunk(opts trunks.ListOptsBuilder) ([]trunks.Trunk, error) {
 	m.ctrl.T.Helper()
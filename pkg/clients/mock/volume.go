@@ -27,6 +27,7 @@ package mock
 import (
 	reflect "reflect"
 
+	quotasets "github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/quotasets"
 	volumes "github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -84,6 +85,21 @@ func (mr *MockVolumeClientMockRecorder) DeleteVolume(volumeID, opts any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockVolumeClient)(nil).DeleteVolume), volumeID, opts)
 }
 
+// GetQuotaSetUsage mocks base method.
+func (m *MockVolumeClient) GetQuotaSetUsage(projectID string) (*quotasets.QuotaUsageSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuotaSetUsage", projectID)
+	ret0, _ := ret[0].(*quotasets.QuotaUsageSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuotaSetUsage indicates an expected call of GetQuotaSetUsage.
+func (mr *MockVolumeClientMockRecorder) GetQuotaSetUsage(projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuotaSetUsage", reflect.TypeOf((*MockVolumeClient)(nil).GetQuotaSetUsage), projectID)
+}
+
 // GetVolume mocks base method.
 func (m *MockVolumeClient) GetVolume(volumeID string) (*volumes.Volume, error) {
 	m.ctrl.T.Helper()
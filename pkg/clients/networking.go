@@ -26,8 +26,11 @@ import (
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/quotas"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/subnetpools"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/trunks"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
@@ -88,8 +91,16 @@ type NetworkClient interface {
 	GetSubnet(id string) (*subnets.Subnet, error)
 	UpdateSubnet(id string, opts subnets.UpdateOptsBuilder) (*subnets.Subnet, error)
 
+	ListSubnetPool(opts subnetpools.ListOptsBuilder) ([]subnetpools.SubnetPool, error)
+	GetSubnetPool(id string) (*subnetpools.SubnetPool, error)
+
+	ListQoSPolicy(opts policies.PolicyListOptsBuilder) ([]policies.Policy, error)
+	GetQoSPolicy(id string) (*policies.Policy, error)
+
 	ListExtensions() ([]extensions.Extension, error)
 
+	GetQuotaDetail(projectID string) (*quotas.QuotaDetailSet, error)
+
 	ReplaceAllAttributesTags(resourceType string, resourceID string, opts attributestags.ReplaceAllOptsBuilder) ([]string, error)
 }
 
@@ -456,6 +467,42 @@ func (c networkClient) UpdateSubnet(id string, opts subnets.UpdateOptsBuilder) (
 	return subnet, nil
 }
 
+func (c networkClient) ListSubnetPool(opts subnetpools.ListOptsBuilder) ([]subnetpools.SubnetPool, error) {
+	mc := metrics.NewMetricPrometheusContext("subnetpool", "list")
+	allPages, err := subnetpools.List(c.serviceClient, opts).AllPages(context.TODO())
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return subnetpools.ExtractSubnetPools(allPages)
+}
+
+func (c networkClient) GetSubnetPool(id string) (*subnetpools.SubnetPool, error) {
+	mc := metrics.NewMetricPrometheusContext("subnetpool", "get")
+	subnetPool, err := subnetpools.Get(context.TODO(), c.serviceClient, id).Extract()
+	if mc.ObserveRequestIgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	return subnetPool, nil
+}
+
+func (c networkClient) ListQoSPolicy(opts policies.PolicyListOptsBuilder) ([]policies.Policy, error) {
+	mc := metrics.NewMetricPrometheusContext("qos_policy", "list")
+	allPages, err := policies.List(c.serviceClient, opts).AllPages(context.TODO())
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return policies.ExtractPolicies(allPages)
+}
+
+func (c networkClient) GetQoSPolicy(id string) (*policies.Policy, error) {
+	mc := metrics.NewMetricPrometheusContext("qos_policy", "get")
+	policy, err := policies.Get(context.TODO(), c.serviceClient, id).Extract()
+	if mc.ObserveRequestIgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
 func (c networkClient) ListExtensions() ([]extensions.Extension, error) {
 	mc := metrics.NewMetricPrometheusContext("network_extension", "list")
 	allPages, err := extensions.List(c.serviceClient).AllPages(context.TODO())
@@ -464,3 +511,12 @@ func (c networkClient) ListExtensions() ([]extensions.Extension, error) {
 	}
 	return extensions.ExtractExtensions(allPages)
 }
+
+func (c networkClient) GetQuotaDetail(projectID string) (*quotas.QuotaDetailSet, error) {
+	mc := metrics.NewMetricPrometheusContext("network_quota", "get")
+	quotaDetail, err := quotas.GetDetail(context.TODO(), c.serviceClient, projectID).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return quotaDetail, nil
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+// DefaultRetryPolicy is the retry policy of the provider clients used by the
+// controllers.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	BaseDelay:  time.Second,
+	MaxDelay:   5 * time.Minute,
+	MaxWait:    10 * time.Second,
+}
+
+// RetryPolicy decides whether and when an OpenStack API request which failed
+// with a transient error is retried. It is shared by all service clients
+// created from a provider client.
+//
+// A request is retried after the delay requested by the Retry-After header of
+// the response or, if there is none, after an exponential backoff. Short
+// delays are waited for in the client. If the delay is longer than MaxWait or
+// the request has already been retried MaxRetries times, the request fails
+// with a capoerrors.RequeueAfterError so that the controller requeues the
+// object after the delay rather than blocking a worker.
+//
+// Requests which may have been processed although they failed, for example
+// with a 502 response, are only retried in the client if they are idempotent.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a request is retried in the
+	// client.
+	MaxRetries uint
+	// BaseDelay is the backoff after the first failure of a request. It
+	// doubles with every subsequent failure.
+	BaseDelay time.Duration
+	// MaxDelay is the maximum backoff.
+	MaxDelay time.Duration
+	// MaxWait is the longest delay which is waited for in the client.
+	MaxWait time.Duration
+}
+
+// Apply makes providerClient retry requests according to the policy.
+func (p RetryPolicy) Apply(providerClient *gophercloud.ProviderClient) {
+	providerClient.RetryFunc = p.retry
+	// Requests rejected with 429 are handled by RetryFunc
+	providerClient.RetryBackoffFunc = nil
+}
+
+// Backoff returns the delay before retrying a request which has failed
+// failCount times without a Retry-After header.
+func (p RetryPolicy) Backoff(failCount uint) time.Duration {
+	delay := p.BaseDelay
+	for i := uint(1); i < failCount && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	return min(wait.Jitter(delay, 0.1), p.MaxDelay)
+}
+
+// Delay returns the delay before retrying a request which has failed
+// failCount times with err.
+func (p RetryPolicy) Delay(err error, failCount uint) time.Duration {
+	if delay, ok := capoerrors.RetryAfterHeader(err, time.Now()); ok {
+		return min(delay, p.MaxDelay)
+	}
+	return p.Backoff(failCount)
+}
+
+// retry implements gophercloud.RetryFunc.
+func (p RetryPolicy) retry(ctx context.Context, method, _ string, options *gophercloud.RequestOpts, err error, failCount uint) error {
+	if !capoerrors.IsTransient(err) {
+		return err
+	}
+
+	delay := p.Delay(err, failCount)
+	if failCount > p.MaxRetries || delay > p.MaxWait || !canRetry(method, options, err) {
+		return &capoerrors.RequeueAfterError{Err: err, RequeueAfter: delay}
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return err
+	case <-timer.C:
+		return nil
+	}
+}
+
+// canRetry returns true if a request which failed with a transient error can
+// be sent again.
+func canRetry(method string, options *gophercloud.RequestOpts, err error) bool {
+	if options != nil && options.RawBody != nil {
+		seeker, ok := options.RawBody.(io.Seeker)
+		if !ok {
+			return false
+		}
+		if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+			return false
+		}
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return capoerrors.IsRejected(err)
+	}
+}
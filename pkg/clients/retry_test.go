@@ -0,0 +1,248 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	. "github.com/onsi/gomega"
+
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+// testPolicy retries quickly so that tests which wait in the client are fast.
+var testPolicy = RetryPolicy{
+	MaxRetries: 2,
+	BaseDelay:  time.Millisecond,
+	MaxDelay:   5 * time.Minute,
+	MaxWait:    time.Second,
+}
+
+// response is a canned response of the test server.
+type response struct {
+	status     int
+	retryAfter string
+}
+
+// newTestClient returns a service client for a server which returns responses
+// in order, and then 200. It also returns the number of requests received.
+func newTestClient(t *testing.T, policy RetryPolicy, responses ...response) (*gophercloud.ServiceClient, *atomic.Int32) {
+	t.Helper()
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := int(requests.Add(1))
+		if n > len(responses) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte("{}"))
+			return
+		}
+		if responses[n-1].retryAfter != "" {
+			w.Header().Set("Retry-After", responses[n-1].retryAfter)
+		}
+		w.WriteHeader(responses[n-1].status)
+	}))
+	t.Cleanup(server.Close)
+
+	providerClient := &gophercloud.ProviderClient{}
+	policy.Apply(providerClient)
+	return &gophercloud.ServiceClient{ProviderClient: providerClient, Endpoint: server.URL + "/"}, &requests
+}
+
+func TestRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		responses    []response
+		wantRequests int32
+		wantErr      func(g *WithT, err error)
+	}{
+		{
+			name:         "Success",
+			method:       http.MethodGet,
+			wantRequests: 1,
+		},
+		{
+			name:         "Retry after short Retry-After",
+			method:       http.MethodGet,
+			responses:    []response{{status: http.StatusTooManyRequests, retryAfter: "0"}},
+			wantRequests: 2,
+		},
+		{
+			name:         "Retry rejected create",
+			method:       http.MethodPost,
+			responses:    []response{{status: http.StatusServiceUnavailable}, {status: http.StatusTooManyRequests}},
+			wantRequests: 3,
+		},
+		{
+			name:         "Requeue after long Retry-After",
+			method:       http.MethodGet,
+			responses:    []response{{status: http.StatusTooManyRequests, retryAfter: "120"}},
+			wantRequests: 1,
+			wantErr: func(g *WithT, err error) {
+				requeueAfter, ok := capoerrors.RequeueAfter(err)
+				g.Expect(ok).To(BeTrue())
+				g.Expect(requeueAfter).To(Equal(2 * time.Minute))
+				g.Expect(capoerrors.IsTransient(err)).To(BeTrue())
+			},
+		},
+		{
+			name:   "Requeue after too many retries",
+			method: http.MethodGet,
+			responses: []response{
+				{status: http.StatusBadGateway}, {status: http.StatusBadGateway}, {status: http.StatusBadGateway},
+			},
+			wantRequests: 3,
+			wantErr: func(g *WithT, err error) {
+				_, ok := capoerrors.RequeueAfter(err)
+				g.Expect(ok).To(BeTrue())
+				g.Expect(gophercloud.ResponseCodeIs(err, http.StatusBadGateway)).To(BeTrue())
+			},
+		},
+		{
+			name:         "Do not retry a create which may have been processed",
+			method:       http.MethodPost,
+			responses:    []response{{status: http.StatusGatewayTimeout}},
+			wantRequests: 1,
+			wantErr: func(g *WithT, err error) {
+				_, ok := capoerrors.RequeueAfter(err)
+				g.Expect(ok).To(BeTrue())
+			},
+		},
+		{
+			name:         "Do not retry a permanent error",
+			method:       http.MethodGet,
+			responses:    []response{{status: http.StatusForbidden}},
+			wantRequests: 1,
+			wantErr: func(g *WithT, err error) {
+				_, ok := capoerrors.RequeueAfter(err)
+				g.Expect(ok).To(BeFalse())
+				g.Expect(capoerrors.IsTransient(err)).To(BeFalse())
+				g.Expect(gophercloud.ResponseCodeIs(err, http.StatusForbidden)).To(BeTrue())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			client, requests := newTestClient(t, testPolicy, tt.responses...)
+
+			var body map[string]any
+			_, err := client.Request(context.TODO(), tt.method, client.Endpoint+"resource", &gophercloud.RequestOpts{
+				JSONBody:     map[string]any{},
+				JSONResponse: &body,
+				OkCodes:      []int{http.StatusOK},
+			})
+			if tt.wantErr == nil {
+				g.Expect(err).NotTo(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+				tt.wantErr(g, err)
+			}
+			g.Expect(requests.Load()).To(Equal(tt.wantRequests))
+		})
+	}
+}
+
+func TestRetryPolicy_Delay(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Second, MaxDelay: time.Minute}
+	withRetryAfter := func(value string) error {
+		return gophercloud.ErrUnexpectedResponseCode{
+			Actual:         http.StatusTooManyRequests,
+			ResponseHeader: http.Header{"Retry-After": []string{value}},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		err       error
+		failCount uint
+		wantMin   time.Duration
+		wantMax   time.Duration
+	}{
+		{
+			name:      "Retry-After seconds",
+			err:       withRetryAfter("30"),
+			failCount: 1,
+			wantMin:   30 * time.Second,
+			wantMax:   30 * time.Second,
+		},
+		{
+			name:      "Retry-After date",
+			err:       withRetryAfter(time.Now().Add(20 * time.Second).UTC().Format(http.TimeFormat)),
+			failCount: 1,
+			wantMin:   18 * time.Second,
+			wantMax:   20 * time.Second,
+		},
+		{
+			name:      "Retry-After is capped",
+			err:       withRetryAfter("3600"),
+			failCount: 1,
+			wantMin:   time.Minute,
+			wantMax:   time.Minute,
+		},
+		{
+			name:      "Invalid Retry-After falls back to backoff",
+			err:       withRetryAfter("soon"),
+			failCount: 1,
+			wantMin:   time.Second,
+			wantMax:   1100 * time.Millisecond,
+		},
+		{
+			name:      "Backoff doubles",
+			err:       errors.New("connection reset"),
+			failCount: 4,
+			wantMin:   8 * time.Second,
+			wantMax:   8800 * time.Millisecond,
+		},
+		{
+			name:      "Backoff is capped",
+			err:       errors.New("connection reset"),
+			failCount: 20,
+			wantMin:   time.Minute,
+			wantMax:   time.Minute,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			delay := policy.Delay(tt.err, tt.failCount)
+			g.Expect(delay).To(BeNumerically(">=", tt.wantMin))
+			g.Expect(delay).To(BeNumerically("<=", tt.wantMax))
+		})
+	}
+}
+
+func TestRetryPolicy_RawBody(t *testing.T) {
+	g := NewWithT(t)
+	client, requests := newTestClient(t, testPolicy, response{status: http.StatusServiceUnavailable})
+
+	// A body which can be rewound is sent again
+	_, err := client.Request(context.TODO(), http.MethodPut, client.Endpoint+"file", &gophercloud.RequestOpts{
+		RawBody: strings.NewReader("data"),
+		OkCodes: []int{http.StatusOK},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(requests.Load()).To(Equal(int32(2)))
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/quotasets"
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
 	"github.com/gophercloud/utils/v2/openstack/clientconfig"
 
@@ -33,6 +34,8 @@ type VolumeClient interface {
 	CreateVolume(opts volumes.CreateOptsBuilder) (*volumes.Volume, error)
 	DeleteVolume(volumeID string, opts volumes.DeleteOptsBuilder) error
 	GetVolume(volumeID string) (*volumes.Volume, error)
+
+	GetQuotaSetUsage(projectID string) (*quotasets.QuotaUsageSet, error)
 }
 
 type volumeClient struct{ client *gophercloud.ServiceClient }
@@ -77,6 +80,15 @@ func (c volumeClient) GetVolume(volumeID string) (*volumes.Volume, error) {
 	return volume, mc.ObserveRequestIgnoreNotFound(err)
 }
 
+func (c volumeClient) GetQuotaSetUsage(projectID string) (*quotasets.QuotaUsageSet, error) {
+	mc := metrics.NewMetricPrometheusContext("volume_quota_set", "get")
+	quotaSet, err := quotasets.GetUsage(context.TODO(), c.client, projectID).Extract()
+	if mc.ObserveRequest(err) != nil {
+		return nil, err
+	}
+	return &quotaSet, nil
+}
+
 type volumeErrorClient struct{ error }
 
 // NewVolumeErrorClient returns a VolumeClient in which every method returns the given error.
@@ -99,3 +111,7 @@ func (e volumeErrorClient) DeleteVolume(_ string, _ volumes.DeleteOptsBuilder) e
 func (e volumeErrorClient) GetVolume(_ string) (*volumes.Volume, error) {
 	return nil, e.error
 }
+
+func (e volumeErrorClient) GetQuotaSetUsage(_ string) (*quotasets.QuotaUsageSet, error) {
+	return nil, e.error
+}
@@ -140,7 +140,7 @@ func (s *Service) createInstanceImpl(eventObject runtime.Object, instanceSpec *I
 	)
 	if err != nil {
 		record.Warnf(eventObject, "FailedCreateServer", "Failed to create server %s: %v", instanceSpec.Name, err)
-		return nil, fmt.Errorf("error creating Openstack instance: %v", err)
+		return nil, fmt.Errorf("error creating Openstack instance: %w", err)
 	}
 
 	record.Eventf(eventObject, "SuccessfulCreateServer", "Created server %s with id %s", server.Name, server.ID)
@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"errors"
+	"fmt"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+// CheckInstanceQuota returns a QuotaExceededError if creating the instance,
+// and the volumes which do not exist yet, would exceed a compute or volume
+// quota of the project. Quotas which cannot be read are not checked, as the
+// policy of some clouds does not allow users to read them.
+func (s *Service) CheckInstanceQuota(instanceSpec *InstanceSpec) error {
+	projectID := s.scope.ProjectID()
+	if projectID == "" {
+		s.scope.Logger().V(4).Info("Not checking quota: project ID is unknown")
+		return nil
+	}
+
+	var errs []error
+
+	computeQuota, err := s.getComputeClient().GetQuotaSetDetail(projectID)
+	if err != nil {
+		s.scope.Logger().V(3).Info("Unable to read compute quota", "error", err)
+	} else {
+		flavor, err := s.GetFlavor(instanceSpec.FlavorID)
+		if err != nil {
+			return fmt.Errorf("getting flavor %s: %w", instanceSpec.FlavorID, err)
+		}
+		errs = append(errs,
+			capoerrors.CheckQuota("instances", 1, computeQuota.Instances.InUse+computeQuota.Instances.Reserved, computeQuota.Instances.Limit),
+			capoerrors.CheckQuota("cores", flavor.VCPUs, computeQuota.Cores.InUse+computeQuota.Cores.Reserved, computeQuota.Cores.Limit),
+			capoerrors.CheckQuota("ram", flavor.RAM, computeQuota.RAM.InUse+computeQuota.RAM.Reserved, computeQuota.RAM.Limit),
+		)
+	}
+
+	volumeCount, volumeGiB, err := s.volumesToCreate(instanceSpec)
+	if err != nil {
+		return err
+	}
+	if volumeCount > 0 {
+		volumeQuota, err := s.getVolumeClient().GetQuotaSetUsage(projectID)
+		if err != nil {
+			s.scope.Logger().V(3).Info("Unable to read volume quota", "error", err)
+		} else {
+			errs = append(errs,
+				capoerrors.CheckQuota("volumes", volumeCount, volumeQuota.Volumes.InUse+volumeQuota.Volumes.Reserved+volumeQuota.Volumes.Allocated, volumeQuota.Volumes.Limit),
+				capoerrors.CheckQuota("gigabytes", volumeGiB, volumeQuota.Gigabytes.InUse+volumeQuota.Gigabytes.Reserved+volumeQuota.Gigabytes.Allocated, volumeQuota.Gigabytes.Limit),
+			)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// volumesToCreate returns the number and total size of the volumes of the
+// instance which do not exist yet.
+func (s *Service) volumesToCreate(instanceSpec *InstanceSpec) (int, int, error) {
+	var count, sizeGiB int
+	addVolume := func(nameSuffix string, volumeSizeGiB int32) error {
+		volume, err := s.getVolumeByName(volumeName(instanceSpec.Name, nameSuffix))
+		if err != nil {
+			return err
+		}
+		if volume == nil {
+			count++
+			sizeGiB += int(volumeSizeGiB)
+		}
+		return nil
+	}
+
+	if hasRootVolume(instanceSpec) {
+		if err := addVolume("root", instanceSpec.RootVolume.SizeGiB); err != nil {
+			return 0, 0, err
+		}
+	}
+	for i := range instanceSpec.AdditionalBlockDevices {
+		blockDevice := &instanceSpec.AdditionalBlockDevices[i]
+		if blockDevice.Storage.Type != infrav1.VolumeBlockDevice {
+			continue
+		}
+		if err := addVolume(blockDevice.Name, blockDevice.SizeGiB); err != nil {
+			return 0, 0, err
+		}
+	}
+	return count, sizeGiB, nil
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compute
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/quotasets"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
+	computequotasets "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/quotasets"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+func TestService_CheckInstanceQuota(t *testing.T) {
+	const projectID = "project-id"
+
+	computeQuota := func(instances, cores, ram int) *computequotasets.QuotaDetailSet {
+		return &computequotasets.QuotaDetailSet{
+			Instances: computequotasets.QuotaDetail{InUse: instances, Limit: 10},
+			Cores:     computequotasets.QuotaDetail{InUse: cores, Limit: 20},
+			RAM:       computequotasets.QuotaDetail{InUse: ram, Limit: 40960},
+		}
+	}
+	volumeQuota := func(volumes, gigabytes int) *quotasets.QuotaUsageSet {
+		return &quotasets.QuotaUsageSet{
+			Volumes:   quotasets.QuotaUsage{InUse: volumes, Limit: 10},
+			Gigabytes: quotasets.QuotaUsage{InUse: gigabytes, Limit: 1000},
+		}
+	}
+	expectFlavor := func(r *mock.MockComputeClientMockRecorder) {
+		r.GetFlavor(flavorUUID).Return(&flavors.Flavor{ID: flavorUUID, VCPUs: 4, RAM: 8192}, nil)
+	}
+	withRootVolume := func() *InstanceSpec {
+		s := getDefaultInstanceSpec()
+		s.RootVolume = &infrav1.RootVolume{SizeGiB: 50}
+		s.AdditionalBlockDevices = []infrav1.AdditionalBlockDevice{
+			{Name: "etcd", SizeGiB: 10, Storage: infrav1.BlockDeviceStorage{Type: infrav1.VolumeBlockDevice}},
+			{Name: "scratch", SizeGiB: 100, Storage: infrav1.BlockDeviceStorage{Type: infrav1.LocalBlockDevice}},
+		}
+		return s
+	}
+	expectVolumes := func(r *mock.MockVolumeClientMockRecorder, existing ...string) {
+		for _, suffix := range []string{"root", "etcd"} {
+			var found []volumes.Volume
+			for _, e := range existing {
+				if e == suffix {
+					found = append(found, volumes.Volume{ID: suffix, Size: 10})
+				}
+			}
+			r.ListVolumes(volumes.ListOpts{Name: volumeName(openStackMachineName, suffix), TenantID: projectID}).Return(found, nil)
+		}
+	}
+
+	tests := []struct {
+		name          string
+		projectID     string
+		instanceSpec  *InstanceSpec
+		expect        func(compute *mock.MockComputeClientMockRecorder, volume *mock.MockVolumeClientMockRecorder)
+		wantResources []string
+		wantErr       bool
+	}{
+		{
+			name:         "within quota",
+			projectID:    projectID,
+			instanceSpec: getDefaultInstanceSpec(),
+			expect: func(compute *mock.MockComputeClientMockRecorder, _ *mock.MockVolumeClientMockRecorder) {
+				compute.GetQuotaSetDetail(projectID).Return(computeQuota(9, 16, 32768), nil)
+				expectFlavor(compute)
+			},
+		},
+		{
+			name:         "cores and ram exceeded",
+			projectID:    projectID,
+			instanceSpec: getDefaultInstanceSpec(),
+			expect: func(compute *mock.MockComputeClientMockRecorder, _ *mock.MockVolumeClientMockRecorder) {
+				compute.GetQuotaSetDetail(projectID).Return(computeQuota(2, 17, 32769), nil)
+				expectFlavor(compute)
+			},
+			wantResources: []string{"cores", "ram"},
+		},
+		{
+			name:         "only volumes which do not exist count against the volume quota",
+			projectID:    projectID,
+			instanceSpec: withRootVolume(),
+			expect: func(compute *mock.MockComputeClientMockRecorder, volume *mock.MockVolumeClientMockRecorder) {
+				compute.GetQuotaSetDetail(projectID).Return(computeQuota(0, 0, 0), nil)
+				expectFlavor(compute)
+				expectVolumes(volume, "root")
+				volume.GetQuotaSetUsage(projectID).Return(volumeQuota(9, 990), nil)
+			},
+		},
+		{
+			name:         "gigabytes exceeded",
+			projectID:    projectID,
+			instanceSpec: withRootVolume(),
+			expect: func(compute *mock.MockComputeClientMockRecorder, volume *mock.MockVolumeClientMockRecorder) {
+				compute.GetQuotaSetDetail(projectID).Return(computeQuota(0, 0, 0), nil)
+				expectFlavor(compute)
+				expectVolumes(volume)
+				volume.GetQuotaSetUsage(projectID).Return(volumeQuota(0, 950), nil)
+			},
+			wantResources: []string{"gigabytes"},
+		},
+		{
+			name:         "quota which cannot be read is not checked",
+			projectID:    projectID,
+			instanceSpec: withRootVolume(),
+			expect: func(compute *mock.MockComputeClientMockRecorder, volume *mock.MockVolumeClientMockRecorder) {
+				compute.GetQuotaSetDetail(projectID).Return(nil, gophercloud.ErrUnexpectedResponseCode{Actual: 403})
+				expectVolumes(volume)
+				volume.GetQuotaSetUsage(projectID).Return(nil, gophercloud.ErrUnexpectedResponseCode{Actual: 403})
+			},
+		},
+		{
+			name:         "error listing volumes",
+			projectID:    projectID,
+			instanceSpec: withRootVolume(),
+			expect: func(compute *mock.MockComputeClientMockRecorder, volume *mock.MockVolumeClientMockRecorder) {
+				compute.GetQuotaSetDetail(projectID).Return(computeQuota(0, 0, 0), nil)
+				expectFlavor(compute)
+				volume.ListVolumes(gomock.Any()).Return(nil, fmt.Errorf("test error"))
+			},
+			wantErr: true,
+		},
+		{
+			name:         "unknown project",
+			instanceSpec: withRootVolume(),
+			expect:       func(*mock.MockComputeClientMockRecorder, *mock.MockVolumeClientMockRecorder) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, tt.projectID)
+			tt.expect(mockScopeFactory.ComputeClient.EXPECT(), mockScopeFactory.VolumeClient.EXPECT())
+
+			s, err := NewService(scope.NewWithLogger(mockScopeFactory, testr.New(t)))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			err = s.CheckInstanceQuota(tt.instanceSpec)
+			switch {
+			case tt.wantErr:
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(capoerrors.IsQuotaExceeded(err)).To(BeFalse())
+			case len(tt.wantResources) > 0:
+				g.Expect(capoerrors.IsQuotaExceeded(err)).To(BeTrue())
+				for _, resource := range tt.wantResources {
+					g.Expect(err.Error()).To(ContainSubstring("quota exceeded for " + resource))
+				}
+			default:
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identity
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/applicationcredentials"
+)
+
+// GetApplicationCredential returns the application credential of the authenticated user with the given ID, or with
+// the given name if id is empty.
+func (s *Service) GetApplicationCredential(id, name string) (*applicationcredentials.ApplicationCredential, error) {
+	userID := s.scope.UserID()
+	if id != "" {
+		return s.client.GetApplicationCredential(userID, id)
+	}
+	if name == "" {
+		return nil, fmt.Errorf("application credential ID or name is required")
+	}
+
+	credentials, err := s.client.ListApplicationCredentials(userID, applicationcredentials.ListOpts{Name: name})
+	if err != nil {
+		return nil, err
+	}
+	switch len(credentials) {
+	case 0:
+		return nil, fmt.Errorf("application credential %s not found", name)
+	case 1:
+		return &credentials[0], nil
+	default:
+		return nil, fmt.Errorf("found %d application credentials with name %s", len(credentials), name)
+	}
+}
+
+// ListApplicationCredentials returns all application credentials of the authenticated user.
+func (s *Service) ListApplicationCredentials() ([]applicationcredentials.ApplicationCredential, error) {
+	return s.client.ListApplicationCredentials(s.scope.UserID(), applicationcredentials.ListOpts{})
+}
+
+// CreateApplicationCredential creates an application credential for the authenticated user. The secret of the new
+// credential is only available from the returned object.
+func (s *Service) CreateApplicationCredential(opts applicationcredentials.CreateOpts) (*applicationcredentials.ApplicationCredential, error) {
+	s.scope.Logger().Info("Creating application credential", "name", opts.Name, "expiresAt", opts.ExpiresAt)
+	credential, err := s.client.CreateApplicationCredential(s.scope.UserID(), opts)
+	if err != nil {
+		return nil, fmt.Errorf("create application credential %s: %w", opts.Name, err)
+	}
+	return credential, nil
+}
+
+// DeleteApplicationCredential deletes an application credential of the authenticated user.
+func (s *Service) DeleteApplicationCredential(id string) error {
+	s.scope.Logger().Info("Deleting application credential", "id", id)
+	return s.client.DeleteApplicationCredential(s.scope.UserID(), id)
+}
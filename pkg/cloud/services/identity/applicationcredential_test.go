@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identity
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/applicationcredentials"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+const (
+	userID                  = "7a9bd1b4-5b12-4bd1-8b53-6b0b0e1a0a6d"
+	applicationCredentialID = "d2a0bfac-5b4b-4c7b-9d2f-7d2c8e0d9f61"
+)
+
+func TestService_GetApplicationCredential(t *testing.T) {
+	tests := []struct {
+		testName string
+		id       string
+		name     string
+		expect   func(m *mock.MockIdentityClientMockRecorder)
+		wantErr  bool
+	}{
+		{
+			testName: "Get by ID",
+			id:       applicationCredentialID,
+			expect: func(m *mock.MockIdentityClientMockRecorder) {
+				m.GetApplicationCredential(userID, applicationCredentialID).Return(
+					&applicationcredentials.ApplicationCredential{ID: applicationCredentialID}, nil)
+			},
+		},
+		{
+			testName: "Get by name",
+			name:     "capo",
+			expect: func(m *mock.MockIdentityClientMockRecorder) {
+				m.ListApplicationCredentials(userID, applicationcredentials.ListOpts{Name: "capo"}).Return(
+					[]applicationcredentials.ApplicationCredential{{ID: applicationCredentialID, Name: "capo"}}, nil)
+			},
+		},
+		{
+			testName: "Name not found",
+			name:     "capo",
+			expect: func(m *mock.MockIdentityClientMockRecorder) {
+				m.ListApplicationCredentials(userID, applicationcredentials.ListOpts{Name: "capo"}).Return(nil, nil)
+			},
+			wantErr: true,
+		},
+		{
+			testName: "Name not unique",
+			name:     "capo",
+			expect: func(m *mock.MockIdentityClientMockRecorder) {
+				m.ListApplicationCredentials(userID, applicationcredentials.ListOpts{Name: "capo"}).Return(
+					[]applicationcredentials.ApplicationCredential{{ID: applicationCredentialID}, {ID: "other"}}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			testName: "OpenStack returns error",
+			id:       applicationCredentialID,
+			expect: func(m *mock.MockIdentityClientMockRecorder) {
+				m.GetApplicationCredential(userID, applicationCredentialID).Return(nil, fmt.Errorf("test error"))
+			},
+			wantErr: true,
+		},
+		{
+			testName: "Neither ID nor name",
+			expect:   func(*mock.MockIdentityClientMockRecorder) {},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			mockScopeFactory.SetUserID(userID)
+
+			s, err := NewService(scope.NewWithLogger(mockScopeFactory, testr.New(t)))
+			g.Expect(err).NotTo(HaveOccurred())
+			tt.expect(mockScopeFactory.IdentityClient.EXPECT())
+
+			got, err := s.GetApplicationCredential(tt.id, tt.name)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got.ID).To(Equal(applicationCredentialID))
+		})
+	}
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package identity
+
+import (
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+// Service interfaces with the OpenStack Identity API.
+// It manages the application credentials of the user the scope is authenticated as.
+type Service struct {
+	scope  *scope.WithLogger
+	client clients.IdentityClient
+}
+
+// NewService returns an instance of the identity service.
+func NewService(scope *scope.WithLogger) (*Service, error) {
+	identityClient, err := scope.NewIdentityClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Service{
+		scope:  scope,
+		client: identityClient,
+	}, nil
+}
@@ -0,0 +1,565 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
+	capostrings "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/strings"
+)
+
+// monitorUDPConnect is the type of the health monitor of UDP pools.
+const monitorUDPConnect infrav1.APIServerLoadBalancerMonitorType = "UDP-CONNECT"
+
+func getAdditionalLoadBalancerName(clusterResourceName, name string) string {
+	return fmt.Sprintf("%s-cluster-%s-%s", networkPrefix, clusterResourceName, name)
+}
+
+// getAdditionalListenerSpec returns the configuration of a listener of an
+// additional load balancer.
+func getAdditionalListenerSpec(listener *infrav1.AdditionalLoadBalancerListener) listenerSpec {
+	spec := getListenerSpec(&infrav1.APIServerLoadBalancer{Monitor: listener.Monitor}, true, "")
+	if listener.Protocol == infrav1.AdditionalLoadBalancerProtocolUDP {
+		spec.listenerProtocol = listeners.ProtocolUDP
+		spec.poolProtocol = pools.ProtocolUDP
+		spec.monitor.Type = monitorUDPConnect
+		spec.monitor.URLPath = ""
+		spec.monitor.ExpectedCodes = ""
+	}
+	return spec
+}
+
+// getAdditionalMemberPort returns the port members of a listener receive
+// connections on.
+func getAdditionalMemberPort(listener *infrav1.AdditionalLoadBalancerListener) int {
+	if listener.MemberPort != 0 {
+		return int(listener.MemberPort)
+	}
+	return int(listener.Port)
+}
+
+// isAdditionalLoadBalancerMember returns true if a machine with the given
+// labels is selected as a member of an additional load balancer.
+func isAdditionalLoadBalancerMember(members *infrav1.AdditionalLoadBalancerMembers, machineLabels map[string]string) (bool, error) {
+	if md, ok := machineLabels[clusterv1.MachineDeploymentNameLabel]; ok && slices.Contains(members.MachineDeployments, md) {
+		return true, nil
+	}
+	if members.MachineSelector == nil {
+		return false, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(members.MachineSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid machine selector: %w", err)
+	}
+	return selector.Matches(labels.Set(machineLabels)), nil
+}
+
+func getAdditionalLoadBalancerStatus(openStackCluster *infrav1.OpenStackCluster, name string) *infrav1.AdditionalLoadBalancerStatus {
+	for i := range openStackCluster.Status.AdditionalLoadBalancers {
+		if openStackCluster.Status.AdditionalLoadBalancers[i].Name == name {
+			return &openStackCluster.Status.AdditionalLoadBalancers[i]
+		}
+	}
+	return nil
+}
+
+// ReconcileAdditionalLoadBalancers reconciles the additional load balancers
+// of the cluster, and deletes those which were removed from the spec.
+func (s *Service) ReconcileAdditionalLoadBalancers(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string) error {
+	var errs []error
+	lbStatuses := make([]infrav1.AdditionalLoadBalancerStatus, 0, len(openStackCluster.Spec.AdditionalLoadBalancers))
+
+	for i := range openStackCluster.Spec.AdditionalLoadBalancers {
+		lbSpec := &openStackCluster.Spec.AdditionalLoadBalancers[i]
+		lbStatus := infrav1.AdditionalLoadBalancerStatus{Name: lbSpec.Name}
+		if previous := getAdditionalLoadBalancerStatus(openStackCluster, lbSpec.Name); previous != nil {
+			lbStatus = *previous
+		}
+
+		if err := s.reconcileAdditionalLoadBalancer(openStackCluster, clusterResourceName, lbSpec, &lbStatus); err != nil {
+			errs = append(errs, fmt.Errorf("load balancer %s: %w", lbSpec.Name, err))
+		}
+		lbStatuses = append(lbStatuses, lbStatus)
+	}
+
+	for i := range openStackCluster.Status.AdditionalLoadBalancers {
+		lbStatus := &openStackCluster.Status.AdditionalLoadBalancers[i]
+		if slices.ContainsFunc(openStackCluster.Spec.AdditionalLoadBalancers, func(lb infrav1.AdditionalLoadBalancer) bool { return lb.Name == lbStatus.Name }) {
+			continue
+		}
+
+		if _, err := s.deleteAdditionalLoadBalancer(openStackCluster, clusterResourceName, lbStatus.Name); err != nil {
+			// Keep the status until the load balancer is deleted
+			errs = append(errs, fmt.Errorf("load balancer %s: %w", lbStatus.Name, err))
+			lbStatuses = append(lbStatuses, *lbStatus)
+		}
+	}
+
+	openStackCluster.Status.AdditionalLoadBalancers = lbStatuses
+	return errors.Join(errs...)
+}
+
+func (s *Service) reconcileAdditionalLoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, lbSpec *infrav1.AdditionalLoadBalancer, lbStatus *infrav1.AdditionalLoadBalancerStatus) error {
+	loadBalancerName := getAdditionalLoadBalancerName(clusterResourceName, lbSpec.Name)
+	s.scope.Logger().V(3).Info("Reconciling additional load balancer", "name", loadBalancerName)
+
+	lb, err := s.getOrCreateAdditionalLoadBalancer(openStackCluster, clusterResourceName, lbSpec)
+	if err != nil {
+		return err
+	}
+
+	lbStatus.ID = lb.ID
+	lbStatus.InternalIP = lb.VipAddress
+
+	if lb.ProvisioningStatus != loadBalancerProvisioningStatusActive {
+		lbID := lb.ID
+		lb, err = s.waitForLoadBalancerActive(lbID)
+		if err != nil {
+			return fmt.Errorf("load balancer %q with id %s is not active after timeout: %v", loadBalancerName, lbID, err)
+		}
+	}
+
+	if ptr.Deref(lbSpec.EnableFloatingIP, false) {
+		fp, err := s.networkingService.GetFloatingIPByPortID(lb.VipPortID)
+		if err != nil {
+			return err
+		}
+		if fp == nil {
+			var floatingIPAddress *string
+			if lbStatus.IP != "" {
+				floatingIPAddress = &lbStatus.IP
+			}
+			fp, err = s.networkingService.GetOrCreateFloatingIP(openStackCluster, openStackCluster, clusterResourceName, floatingIPAddress, nil)
+			if err != nil {
+				return err
+			}
+
+			// Write the floating IP to the status immediately so we won't
+			// create a new floating IP on the next reconcile if something
+			// fails below.
+			lbStatus.IP = fp.FloatingIP
+
+			if err := s.networkingService.AssociateFloatingIP(openStackCluster, fp, lb.VipPortID); err != nil {
+				return err
+			}
+		}
+		lbStatus.IP = fp.FloatingIP
+	}
+
+	caps, err := s.getProviderCapabilities(lb.Provider)
+	if err != nil {
+		return err
+	}
+
+	for i := range lbSpec.Listeners {
+		if err := s.reconcileAdditionalLoadBalancerListener(openStackCluster, lb, loadBalancerName, &lbSpec.Listeners[i], caps); err != nil {
+			return err
+		}
+	}
+
+	return s.deleteStaleAdditionalListeners(openStackCluster, lb, loadBalancerName, lbSpec.Listeners)
+}
+
+// getOrCreateAdditionalLoadBalancer returns an additional load balancer if
+// it already exists, or creates it if it does not.
+func (s *Service) getOrCreateAdditionalLoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, lbSpec *infrav1.AdditionalLoadBalancer) (*loadbalancers.LoadBalancer, error) {
+	loadBalancerName := getAdditionalLoadBalancerName(clusterResourceName, lbSpec.Name)
+	lb, err := s.checkIfLbExists(loadBalancerName)
+	if err != nil {
+		return nil, err
+	}
+	if lb != nil {
+		return lb, nil
+	}
+
+	if openStackCluster.Status.Network == nil {
+		return nil, fmt.Errorf("network is not yet available in OpenStackCluster.Status")
+	}
+
+	var vipNetworkID, vipSubnetID string
+	if lbSpec.Network != nil {
+		vipNetworkID, err = s.networkingService.GetNetworkIDByParam(lbSpec.Network)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve network: %w", err)
+		}
+	}
+	if lbSpec.Subnet != nil {
+		subnet, err := s.networkingService.GetNetworkSubnetByParam(vipNetworkID, lbSpec.Subnet)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve subnet: %w", err)
+		}
+		vipSubnetID = subnet.ID
+	}
+	if vipNetworkID == "" && vipSubnetID == "" {
+		vipSubnetID, err = s.getPrimarySubnetID(openStackCluster)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	lbProvider, lbFlavorID, lbAvailabilityZone, err := s.getProviderAndFlavor(openStackCluster, lbSpec.Provider, lbSpec.Flavor, lbSpec.AvailabilityZone)
+	if err != nil {
+		return nil, err
+	}
+
+	s.scope.Logger().V(2).Info("Creating additional load balancer", "name", loadBalancerName, "networkID", vipNetworkID, "subnetID", vipSubnetID)
+
+	lbCreateOpts := loadbalancers.CreateOpts{
+		Name:             loadBalancerName,
+		VipSubnetID:      vipSubnetID,
+		VipNetworkID:     vipNetworkID,
+		Description:      names.GetDescription(clusterResourceName),
+		Provider:         lbProvider,
+		FlavorID:         lbFlavorID,
+		AvailabilityZone: lbAvailabilityZone,
+		Tags:             openStackCluster.Spec.Tags,
+	}
+	lb, err = s.loadbalancerClient.CreateLoadBalancer(lbCreateOpts)
+	if err != nil {
+		record.Warnf(openStackCluster, "FailedCreateLoadBalancer", "Failed to create load balancer %s: %v", loadBalancerName, err)
+		return nil, err
+	}
+
+	record.Eventf(openStackCluster, "SuccessfulCreateLoadBalancer", "Created load balancer %s with id %s", loadBalancerName, lb.ID)
+	return lb, nil
+}
+
+// reconcileAdditionalLoadBalancerListener ensures that the listener, pool and
+// health monitor of a listener of an additional load balancer exist and are
+// configured correctly.
+func (s *Service) reconcileAdditionalLoadBalancerListener(openStackCluster *infrav1.OpenStackCluster, lb *loadbalancers.LoadBalancer, loadBalancerName string, listenerSpec *infrav1.AdditionalLoadBalancerListener, caps *providerCapabilities) error {
+	lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, listenerSpec.Port)
+	spec := caps.adjustListenerSpec(getAdditionalListenerSpec(listenerSpec))
+
+	// The protocol of a listener and its pool cannot be updated, so they
+	// must be replaced
+	listener, err := s.checkIfListenerExists(lbPortObjectsName)
+	if err != nil {
+		return err
+	}
+	if listener != nil && listener.Protocol != string(spec.listenerProtocol) {
+		s.scope.Logger().V(2).Info("Deleting load balancer listener to change its protocol", "name", lbPortObjectsName, "current", listener.Protocol, "desired", spec.listenerProtocol)
+		if err := s.deleteListenerAndPool(openStackCluster, lb.ID, listener); err != nil {
+			return err
+		}
+	}
+
+	// allowedCIDRs is nil if allowedCIDRs is not supported by the Octavia provider
+	var allowedCIDRs []string
+	if caps.allowedCIDRs {
+		allowedCIDRs = capostrings.Canonicalize(append([]string{}, listenerSpec.AllowedCIDRs...))
+	}
+
+	listener, err = s.getOrCreateListener(openStackCluster, lbPortObjectsName, lb.ID, allowedCIDRs, int(listenerSpec.Port), spec)
+	if err != nil {
+		return err
+	}
+
+	pool, err := s.getOrCreatePool(openStackCluster, lbPortObjectsName, listener.ID, lb.ID, spec)
+	if err != nil {
+		return err
+	}
+	if err := s.ensureMonitor(openStackCluster, lbPortObjectsName, pool.ID, lb.ID, spec.monitor); err != nil {
+		return err
+	}
+
+	if allowedCIDRs != nil {
+		if err := s.getOrUpdateAllowedCIDRs(openStackCluster, listener, allowedCIDRs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteStaleAdditionalListeners deletes the listeners of an additional load
+// balancer whose port is no longer in the spec.
+func (s *Service) deleteStaleAdditionalListeners(openStackCluster *infrav1.OpenStackCluster, lb *loadbalancers.LoadBalancer, loadBalancerName string, listenerSpecs []infrav1.AdditionalLoadBalancerListener) error {
+	listenerList, err := s.loadbalancerClient.ListListeners(listeners.ListOpts{LoadbalancerID: lb.ID})
+	if err != nil {
+		return err
+	}
+
+	for i := range listenerList {
+		listener := &listenerList[i]
+		if !strings.HasPrefix(listener.Name, loadBalancerName+"-") {
+			continue
+		}
+		if slices.ContainsFunc(listenerSpecs, func(l infrav1.AdditionalLoadBalancerListener) bool { return int(l.Port) == listener.ProtocolPort }) {
+			continue
+		}
+
+		s.scope.Logger().V(2).Info("Deleting load balancer listener which was removed from the spec", "name", listener.Name)
+		if err := s.deleteListenerAndPool(openStackCluster, lb.ID, listener); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteListenerAndPool deletes a listener and the pool with the same name,
+// which also deletes the members and health monitor of the pool.
+func (s *Service) deleteListenerAndPool(openStackCluster *infrav1.OpenStackCluster, lbID string, listener *listeners.Listener) error {
+	pool, err := s.checkIfPoolExists(listener.Name)
+	if err != nil {
+		return err
+	}
+	if pool != nil {
+		if err := s.loadbalancerClient.DeletePool(pool.ID); err != nil && !capoerrors.IsNotFound(err) {
+			record.Warnf(openStackCluster, "FailedDeletePool", "Failed to delete pool %s with id %s: %v", pool.Name, pool.ID, err)
+			return err
+		}
+		if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+			return err
+		}
+		record.Eventf(openStackCluster, "SuccessfulDeletePool", "Deleted pool %s with id %s", pool.Name, pool.ID)
+	}
+
+	if err := s.loadbalancerClient.DeleteListener(listener.ID); err != nil && !capoerrors.IsNotFound(err) {
+		record.Warnf(openStackCluster, "FailedDeleteListener", "Failed to delete listener %s with id %s: %v", listener.Name, listener.ID, err)
+		return err
+	}
+	if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+		return err
+	}
+	record.Eventf(openStackCluster, "SuccessfulDeleteListener", "Deleted listener %s with id %s", listener.Name, listener.ID)
+	return nil
+}
+
+// deleteAdditionalLoadBalancer deletes an additional load balancer. It
+// returns true if the load balancer no longer exists.
+func (s *Service) deleteAdditionalLoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName, name string) (bool, error) {
+	loadBalancerName := getAdditionalLoadBalancerName(clusterResourceName, name)
+	lb, err := s.checkIfLbExists(loadBalancerName)
+	if err != nil {
+		return false, err
+	}
+	if lb == nil {
+		return true, nil
+	}
+
+	if lb.ProvisioningStatus == loadBalancerProvisioningStatusPendingDelete {
+		s.scope.Logger().V(3).Info("Load balancer is already in PENDING_DELETE state", "name", loadBalancerName)
+		return false, nil
+	}
+
+	return false, s.deleteLoadBalancer(openStackCluster, lb, nil)
+}
+
+// DeleteAdditionalLoadBalancers deletes the additional load balancers of the
+// cluster. It requests a requeue until they no longer exist.
+func (s *Service) DeleteAdditionalLoadBalancers(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string) (*ctrl.Result, error) {
+	lbNames := make([]string, 0, len(openStackCluster.Spec.AdditionalLoadBalancers)+len(openStackCluster.Status.AdditionalLoadBalancers))
+	for i := range openStackCluster.Spec.AdditionalLoadBalancers {
+		lbNames = append(lbNames, openStackCluster.Spec.AdditionalLoadBalancers[i].Name)
+	}
+	for i := range openStackCluster.Status.AdditionalLoadBalancers {
+		lbNames = append(lbNames, openStackCluster.Status.AdditionalLoadBalancers[i].Name)
+	}
+
+	var result *ctrl.Result
+	for _, name := range capostrings.Canonicalize(lbNames) {
+		deleted, err := s.deleteAdditionalLoadBalancer(openStackCluster, clusterResourceName, name)
+		if err != nil {
+			return nil, err
+		}
+		if !deleted {
+			result = &ctrl.Result{RequeueAfter: waitForOctaviaLBCleanup}
+		}
+	}
+
+	if result == nil {
+		openStackCluster.Status.AdditionalLoadBalancers = nil
+	}
+	return result, nil
+}
+
+// ReconcileAdditionalLoadBalancerMembers ensures that a machine is a member
+// of the pools of the additional load balancers which select it, and is not
+// a member of the others.
+func (s *Service) ReconcileAdditionalLoadBalancerMembers(openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, machineLabels map[string]string, clusterResourceName, ip string) error {
+	if len(openStackCluster.Spec.AdditionalLoadBalancers) == 0 {
+		return nil
+	}
+
+	subnetID, err := s.getPrimarySubnetID(openStackCluster)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i := range openStackCluster.Spec.AdditionalLoadBalancers {
+		lbSpec := &openStackCluster.Spec.AdditionalLoadBalancers[i]
+		lbStatus := getAdditionalLoadBalancerStatus(openStackCluster, lbSpec.Name)
+		if lbStatus == nil || lbStatus.ID == "" {
+			// The load balancer has not been created yet
+			continue
+		}
+
+		isMember, err := isAdditionalLoadBalancerMember(&lbSpec.Members, machineLabels)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("load balancer %s: %w", lbSpec.Name, err))
+			continue
+		}
+
+		loadBalancerName := getAdditionalLoadBalancerName(clusterResourceName, lbSpec.Name)
+		for j := range lbSpec.Listeners {
+			listenerSpec := &lbSpec.Listeners[j]
+			lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, listenerSpec.Port)
+			memberName := lbPortObjectsName + "-" + openStackMachine.Name
+
+			pool, err := s.checkIfPoolExists(lbPortObjectsName)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if pool == nil {
+				s.scope.Logger().V(4).Info("Load balancer pool does not exist yet", "name", lbPortObjectsName)
+				continue
+			}
+
+			if isMember {
+				err = s.ensurePoolMember(openStackCluster, lbStatus.ID, pool.ID, memberName, ip, getAdditionalMemberPort(listenerSpec), subnetID)
+			} else {
+				err = s.deletePoolMember(lbStatus.ID, pool.ID, memberName)
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("load balancer %s: %w", lbSpec.Name, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// DeleteAdditionalLoadBalancerMembers removes a machine from the pools of the
+// additional load balancers.
+func (s *Service) DeleteAdditionalLoadBalancerMembers(openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, clusterResourceName string) error {
+	if openStackMachine == nil {
+		return errors.New("openStackMachine is nil")
+	}
+
+	for i := range openStackCluster.Spec.AdditionalLoadBalancers {
+		lbSpec := &openStackCluster.Spec.AdditionalLoadBalancers[i]
+		lbStatus := getAdditionalLoadBalancerStatus(openStackCluster, lbSpec.Name)
+		if lbStatus == nil || lbStatus.ID == "" {
+			continue
+		}
+
+		loadBalancerName := getAdditionalLoadBalancerName(clusterResourceName, lbSpec.Name)
+		for j := range lbSpec.Listeners {
+			lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, lbSpec.Listeners[j].Port)
+
+			pool, err := s.checkIfPoolExists(lbPortObjectsName)
+			if err != nil {
+				return err
+			}
+			if pool == nil {
+				continue
+			}
+
+			if err := s.deletePoolMember(lbStatus.ID, pool.ID, lbPortObjectsName+"-"+openStackMachine.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ensurePoolMember ensures that a pool has a member with the given address
+// and port, replacing the member if either changed.
+func (s *Service) ensurePoolMember(openStackCluster *infrav1.OpenStackCluster, lbID, poolID, name, ip string, port int, subnetID string) error {
+	lbMember, err := s.checkIfLbMemberExists(poolID, name)
+	if err != nil {
+		return err
+	}
+
+	if lbMember != nil {
+		if lbMember.Address == ip && lbMember.ProtocolPort == port {
+			return nil
+		}
+
+		s.scope.Logger().V(2).Info("Deleting load balancer member because its address changed", "name", name)
+		if err := s.deletePoolMember(lbID, poolID, name); err != nil {
+			return err
+		}
+	}
+
+	s.scope.Logger().V(2).Info("Creating load balancer member", "name", name)
+
+	lbMemberOpts := pools.CreateMemberOpts{
+		Name:         name,
+		ProtocolPort: port,
+		Address:      ip,
+		SubnetID:     subnetID,
+		Tags:         openStackCluster.Spec.Tags,
+	}
+
+	if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+		return err
+	}
+
+	member, err := s.loadbalancerClient.CreatePoolMember(poolID, lbMemberOpts)
+	if err != nil {
+		return err
+	}
+
+	if _, err := s.waitForPoolMemberActive(poolID, member.ID); err != nil {
+		return err
+	}
+
+	_, err = s.waitForLoadBalancerActive(lbID)
+	return err
+}
+
+// deletePoolMember deletes the member of a pool with the given name, if it
+// exists.
+func (s *Service) deletePoolMember(lbID, poolID, name string) error {
+	lbMember, err := s.checkIfLbMemberExists(poolID, name)
+	if err != nil {
+		return err
+	}
+	if lbMember == nil {
+		return nil
+	}
+
+	s.scope.Logger().V(2).Info("Deleting load balancer member", "name", name)
+
+	if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+		return err
+	}
+	if err := s.loadbalancerClient.DeletePoolMember(poolID, lbMember.ID); err != nil && !capoerrors.IsNotFound(err) {
+		return err
+	}
+	_, err = s.waitForLoadBalancerActive(lbID)
+	return err
+}
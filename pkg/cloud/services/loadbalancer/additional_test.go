@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func Test_isAdditionalLoadBalancerMember(t *testing.T) {
+	members := &infrav1.AdditionalLoadBalancerMembers{
+		MachineSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: clusterv1.MachineControlPlaneLabel, Operator: metav1.LabelSelectorOpExists},
+			},
+		},
+		MachineDeployments: []string{"md-ingress"},
+	}
+
+	tests := []struct {
+		name          string
+		members       *infrav1.AdditionalLoadBalancerMembers
+		machineLabels map[string]string
+		want          bool
+		wantErr       bool
+	}{
+		{
+			name:          "machine of a selected MachineDeployment",
+			members:       members,
+			machineLabels: map[string]string{clusterv1.MachineDeploymentNameLabel: "md-ingress"},
+			want:          true,
+		},
+		{
+			name:          "machine of another MachineDeployment",
+			members:       members,
+			machineLabels: map[string]string{clusterv1.MachineDeploymentNameLabel: "md-0"},
+			want:          false,
+		},
+		{
+			name:          "machine matching the selector",
+			members:       members,
+			machineLabels: map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+			want:          true,
+		},
+		{
+			name:          "no selector",
+			members:       &infrav1.AdditionalLoadBalancerMembers{MachineDeployments: []string{"md-ingress"}},
+			machineLabels: map[string]string{clusterv1.MachineControlPlaneLabel: ""},
+			want:          false,
+		},
+		{
+			name: "invalid selector",
+			members: &infrav1.AdditionalLoadBalancerMembers{
+				MachineSelector: &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "role", Operator: "Invalid"}},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			got, err := isAdditionalLoadBalancerMember(tt.members, tt.machineLabels)
+			if tt.wantErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
+
+func Test_AdditionalLoadBalancers(t *testing.T) {
+	g := NewWithT(t)
+
+	cloud := fake.NewCloud(fake.WithTransitionDelay(0))
+	factory := scope.NewFakeScopeFactory(cloud)
+	s, err := NewService(scope.NewWithLogger(factory, testr.New(t)))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	externalNetworkID, err := cloud.AddExternalNetwork("public", "203.0.113.0/24")
+	g.Expect(err).NotTo(HaveOccurred())
+	networkClient, err := factory.NewNetworkClient()
+	g.Expect(err).NotTo(HaveOccurred())
+	network, err := networkClient.CreateNetwork(networks.CreateOpts{Name: "cluster"})
+	g.Expect(err).NotTo(HaveOccurred())
+	subnet, err := networkClient.CreateSubnet(subnets.CreateOpts{NetworkID: network.ID, CIDR: "10.0.0.0/24", IPVersion: 4})
+	g.Expect(err).NotTo(HaveOccurred())
+	router, err := networkClient.CreateRouter(routers.CreateOpts{GatewayInfo: &routers.GatewayInfo{NetworkID: externalNetworkID}})
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = networkClient.AddRouterInterface(router.ID, routers.AddInterfaceOpts{SubnetID: subnet.ID})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	const clusterResourceName = "AAAAA"
+	openStackCluster := &infrav1.OpenStackCluster{
+		Spec: infrav1.OpenStackClusterSpec{
+			AdditionalLoadBalancers: []infrav1.AdditionalLoadBalancer{
+				{
+					Name:             "ingress",
+					EnableFloatingIP: ptr.To(true),
+					Listeners: []infrav1.AdditionalLoadBalancerListener{
+						{Port: 80, Protocol: infrav1.AdditionalLoadBalancerProtocolTCP},
+						{Port: 443, Protocol: infrav1.AdditionalLoadBalancerProtocolTCP, MemberPort: 30443},
+						{Port: 53, Protocol: infrav1.AdditionalLoadBalancerProtocolUDP},
+					},
+					Members: infrav1.AdditionalLoadBalancerMembers{MachineDeployments: []string{"md-ingress"}},
+				},
+				{
+					Name:      "konnectivity",
+					Listeners: []infrav1.AdditionalLoadBalancerListener{{Port: 8132}},
+					Members: infrav1.AdditionalLoadBalancerMembers{
+						MachineSelector: &metav1.LabelSelector{MatchLabels: map[string]string{clusterv1.MachineControlPlaneLabel: ""}},
+					},
+				},
+			},
+		},
+		Status: infrav1.OpenStackClusterStatus{
+			Network: &infrav1.NetworkStatusWithSubnets{
+				NetworkStatus: infrav1.NetworkStatus{ID: network.ID, Name: network.Name},
+				Subnets:       []infrav1.Subnet{{ID: subnet.ID, CIDR: subnet.CIDR}},
+			},
+			ExternalNetwork: &infrav1.NetworkStatus{ID: externalNetworkID},
+		},
+	}
+
+	g.Expect(s.ReconcileAdditionalLoadBalancers(openStackCluster, clusterResourceName)).To(Succeed())
+	g.Expect(cloud.Count("loadbalancer")).To(Equal(2))
+	g.Expect(cloud.Count("listener")).To(Equal(4))
+	g.Expect(cloud.Count("pool")).To(Equal(4))
+	g.Expect(cloud.Count("healthmonitor")).To(Equal(4))
+	g.Expect(cloud.Count("floatingip")).To(Equal(1))
+	g.Expect(openStackCluster.Status.AdditionalLoadBalancers).To(HaveLen(2))
+	lbStatus := openStackCluster.Status.AdditionalLoadBalancers[0]
+	g.Expect(lbStatus.Name).To(Equal("ingress"))
+	g.Expect(lbStatus.ID).NotTo(BeEmpty())
+	g.Expect(lbStatus.InternalIP).NotTo(BeEmpty())
+	g.Expect(lbStatus.IP).NotTo(BeEmpty())
+
+	monitor, err := s.checkIfMonitorExists("k8s-clusterapi-cluster-AAAAA-ingress-53")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(monitor.Type).To(Equal("UDP-CONNECT"))
+
+	// Reconciling again does not change anything
+	g.Expect(s.ReconcileAdditionalLoadBalancers(openStackCluster, clusterResourceName)).To(Succeed())
+	g.Expect(cloud.Count("loadbalancer")).To(Equal(2))
+	g.Expect(cloud.Count("listener")).To(Equal(4))
+	g.Expect(cloud.Count("floatingip")).To(Equal(1))
+	g.Expect(openStackCluster.Status.AdditionalLoadBalancers[0]).To(Equal(lbStatus))
+
+	// Machines of the selected MachineDeployment are members of every pool
+	openStackMachine := &infrav1.OpenStackMachine{ObjectMeta: metav1.ObjectMeta{Name: "md-ingress-abcde"}}
+	machineLabels := map[string]string{clusterv1.MachineDeploymentNameLabel: "md-ingress"}
+	g.Expect(s.ReconcileAdditionalLoadBalancerMembers(openStackCluster, openStackMachine, machineLabels, clusterResourceName, "10.0.0.10")).To(Succeed())
+	g.Expect(cloud.Count("member")).To(Equal(3))
+	pool, err := s.checkIfPoolExists("k8s-clusterapi-cluster-AAAAA-ingress-443")
+	g.Expect(err).NotTo(HaveOccurred())
+	member, err := s.checkIfLbMemberExists(pool.ID, "k8s-clusterapi-cluster-AAAAA-ingress-443-md-ingress-abcde")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(member.Address).To(Equal("10.0.0.10"))
+	g.Expect(member.ProtocolPort).To(Equal(30443))
+
+	// A machine which is no longer selected is removed
+	machineLabels[clusterv1.MachineDeploymentNameLabel] = "md-0"
+	g.Expect(s.ReconcileAdditionalLoadBalancerMembers(openStackCluster, openStackMachine, machineLabels, clusterResourceName, "10.0.0.10")).To(Succeed())
+	g.Expect(cloud.Count("member")).To(Equal(0))
+
+	// Listeners removed from the spec are deleted
+	openStackCluster.Spec.AdditionalLoadBalancers[0].Listeners = openStackCluster.Spec.AdditionalLoadBalancers[0].Listeners[1:]
+	g.Expect(s.ReconcileAdditionalLoadBalancers(openStackCluster, clusterResourceName)).To(Succeed())
+	g.Expect(cloud.Count("listener")).To(Equal(3))
+	g.Expect(cloud.Count("pool")).To(Equal(3))
+
+	// Load balancers removed from the spec are deleted
+	openStackCluster.Spec.AdditionalLoadBalancers = openStackCluster.Spec.AdditionalLoadBalancers[:1]
+	g.Expect(s.ReconcileAdditionalLoadBalancers(openStackCluster, clusterResourceName)).To(Succeed())
+	g.Expect(openStackCluster.Status.AdditionalLoadBalancers).To(ConsistOf(lbStatus))
+	g.Expect(cloud.Count("loadbalancer")).To(Equal(1))
+	g.Expect(cloud.Count("listener")).To(Equal(2))
+}
@@ -0,0 +1,256 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/providers"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	openstackutil "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/openstack"
+)
+
+const providerOVN = "ovn"
+
+// ovnBackoff is used to wait for load balancers of the OVN provider. OVN
+// load balancers are flows programmed in the OVN northbound database rather
+// than virtual machines, so they become active much faster than amphorae.
+var ovnBackoff = wait.Backoff{
+	Steps:    10,
+	Duration: 200 * time.Millisecond,
+	Factor:   1.5,
+	Jitter:   0.1,
+}
+
+// providerCapabilities are the features of an Octavia provider which
+// determine how the listeners, pools and health monitors of its load
+// balancers can be configured.
+type providerCapabilities struct {
+	// provider is the name of the provider.
+	provider string
+	// allowedCIDRs is true if listeners can restrict the source addresses of
+	// connections.
+	allowedCIDRs bool
+	// tls is true if the provider supports HTTPS and TERMINATED_HTTPS
+	// listeners.
+	tls bool
+	// httpMonitors is true if the provider supports HTTP and HTTPS health
+	// monitors.
+	httpMonitors bool
+	// flavors is true if load balancers can be created with a flavor.
+	flavors bool
+	// availabilityZones is true if load balancers can be created in an
+	// availability zone.
+	availabilityZones bool
+	// lbMethod is the load balancing algorithm of pools.
+	lbMethod pools.LBMethod
+	// memberWeights is true if the provider sends no new connections to
+	// members with a weight of 0.
+	memberWeights bool
+}
+
+// isOVNProvider returns true if the named provider is the OVN provider. Some
+// clouds register the OVN driver under a different name, so the description
+// returned by Octavia is checked as well.
+func isOVNProvider(provider string, providerList []providers.Provider) bool {
+	if provider == providerOVN {
+		return true
+	}
+	for i := range providerList {
+		if providerList[i].Name == provider {
+			return strings.Contains(strings.ToUpper(providerList[i].Description), "OVN")
+		}
+	}
+	return false
+}
+
+// getOctaviaVersion returns the current version of the Octavia API.
+func (s *Service) getOctaviaVersion() (string, error) {
+	if s.octaviaVersion != "" {
+		return s.octaviaVersion, nil
+	}
+	octaviaVersions, err := s.loadbalancerClient.ListOctaviaVersions()
+	if err != nil {
+		return "", err
+	}
+	if len(octaviaVersions) == 0 {
+		return "", fmt.Errorf("octavia did not report any API versions")
+	}
+	// The current version is always the last one in the list.
+	s.octaviaVersion = octaviaVersions[len(octaviaVersions)-1].ID
+	return s.octaviaVersion, nil
+}
+
+// getLoadBalancerProviders returns the providers enabled in Octavia.
+func (s *Service) getLoadBalancerProviders() ([]providers.Provider, error) {
+	if s.loadBalancerProviders != nil {
+		return s.loadBalancerProviders, nil
+	}
+	providerList, err := s.loadbalancerClient.ListLoadBalancerProviders()
+	if err != nil {
+		return nil, err
+	}
+	if providerList == nil {
+		providerList = []providers.Provider{}
+	}
+	s.loadBalancerProviders = providerList
+	return providerList, nil
+}
+
+// getProviderCapabilities returns the capabilities of the named Octavia
+// provider, based on the providers enabled in Octavia and the version of
+// the Octavia API. The capabilities of each provider are only looked up once
+// per service.
+func (s *Service) getProviderCapabilities(provider string) (*providerCapabilities, error) {
+	if caps, ok := s.capabilities[provider]; ok {
+		return caps, nil
+	}
+	caps, err := s.lookupProviderCapabilities(provider)
+	if err != nil {
+		return nil, err
+	}
+	if s.capabilities == nil {
+		s.capabilities = make(map[string]*providerCapabilities)
+	}
+	s.capabilities[provider] = caps
+	return caps, nil
+}
+
+func (s *Service) lookupProviderCapabilities(provider string) (*providerCapabilities, error) {
+	octaviaVersion, err := s.getOctaviaVersion()
+	if err != nil {
+		return nil, err
+	}
+	providerList, err := s.getLoadBalancerProviders()
+	if err != nil {
+		return nil, err
+	}
+
+	if isOVNProvider(provider, providerList) {
+		return &providerCapabilities{
+			provider:          provider,
+			allowedCIDRs:      openstackutil.IsOctaviaFeatureSupported(octaviaVersion, openstackutil.OctaviaFeatureVIPACL, providerOVN),
+			flavors:           openstackutil.IsOctaviaFeatureSupported(octaviaVersion, openstackutil.OctaviaFeatureFlavors, providerOVN),
+			availabilityZones: openstackutil.IsOctaviaFeatureSupported(octaviaVersion, openstackutil.OctaviaFeatureAvailabilityZones, providerOVN),
+			lbMethod:          pools.LBMethodSourceIpPort,
+		}, nil
+	}
+
+	return &providerCapabilities{
+		provider:          provider,
+		allowedCIDRs:      openstackutil.IsOctaviaFeatureSupported(octaviaVersion, openstackutil.OctaviaFeatureVIPACL, provider),
+		tls:               true,
+		httpMonitors:      true,
+		flavors:           openstackutil.IsOctaviaFeatureSupported(octaviaVersion, openstackutil.OctaviaFeatureFlavors, provider),
+		availabilityZones: openstackutil.IsOctaviaFeatureSupported(octaviaVersion, openstackutil.OctaviaFeatureAvailabilityZones, provider),
+		lbMethod:          pools.LBMethodRoundRobin,
+		memberWeights:     true,
+	}, nil
+}
+
+// adjustListenerSpec returns the listener configuration closest to spec which
+// the provider supports. Listeners which pass TLS through to the members are
+// replaced by TCP listeners, which behave the same, and HTTPS health monitors
+// by TCP health monitors.
+func (c *providerCapabilities) adjustListenerSpec(spec listenerSpec) listenerSpec {
+	spec.lbMethod = c.lbMethod
+	if !c.tls {
+		spec.listenerProtocol = listeners.ProtocolTCP
+		spec.poolProtocol = pools.ProtocolTCP
+		spec.poolTLSEnabled = false
+		spec.tlsContainerRef = ""
+	}
+	if !c.httpMonitors && spec.monitor.Type == infrav1.APIServerLoadBalancerMonitorHTTPS {
+		spec.monitor.Type = infrav1.APIServerLoadBalancerMonitorTCP
+		spec.monitor.URLPath = ""
+		spec.monitor.ExpectedCodes = ""
+	}
+	return spec
+}
+
+// unsupportedFeatures returns the features requested in lbSpec which the
+// provider does not support.
+func (c *providerCapabilities) unsupportedFeatures(lbSpec *infrav1.APIServerLoadBalancer) []string {
+	if lbSpec == nil {
+		return nil
+	}
+
+	var unsupported []string
+	if len(lbSpec.AllowedCIDRs) > 0 && !c.allowedCIDRs {
+		unsupported = append(unsupported, "allowed CIDRs")
+	}
+	if isTLSTerminated(lbSpec) && !c.tls {
+		unsupported = append(unsupported, "TLS termination")
+	}
+	if lbSpec.Monitor != nil && lbSpec.Monitor.Type == infrav1.APIServerLoadBalancerMonitorHTTPS && !c.httpMonitors {
+		unsupported = append(unsupported, "HTTPS health monitors")
+	}
+	if lbSpec.Flavor != nil && !c.flavors {
+		unsupported = append(unsupported, "flavors")
+	}
+	if lbSpec.AvailabilityZone != nil && !c.availabilityZones {
+		unsupported = append(unsupported, "availability zones")
+	}
+	return unsupported
+}
+
+// GetUnsupportedFeatures returns the features requested for the API server
+// load balancer which its Octavia provider does not support. The load
+// balancer is configured without these features. It returns nil if the
+// provider is not known yet because the load balancer does not exist and no
+// provider is set in the spec.
+func (s *Service) GetUnsupportedFeatures(openStackCluster *infrav1.OpenStackCluster) ([]string, error) {
+	lbSpec := openStackCluster.Spec.APIServer.GetManagedLoadBalancer()
+	if !lbSpec.IsEnabled() {
+		return nil, nil
+	}
+
+	var provider string
+	if lbStatus := openStackCluster.Status.APIServerManagedLoadBalancer; lbStatus != nil && lbStatus.ID != "" {
+		lb, err := s.loadbalancerClient.GetLoadBalancer(lbStatus.ID)
+		if err != nil {
+			return nil, err
+		}
+		provider = lb.Provider
+	} else if lbSpec.Provider != nil {
+		provider = *lbSpec.Provider
+	}
+	if provider == "" {
+		return nil, nil
+	}
+
+	caps, err := s.getProviderCapabilities(provider)
+	if err != nil {
+		return nil, err
+	}
+	return caps.unsupportedFeatures(lbSpec), nil
+}
+
+// getBackoff returns the backoff used to wait for a load balancer of the
+// given provider.
+func getBackoff(provider string) wait.Backoff {
+	if provider == providerOVN {
+		return ovnBackoff
+	}
+	return backoff
+}
@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/apiversions"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/providers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func Test_isOVNProvider(t *testing.T) {
+	providerList := []providers.Provider{
+		{Name: "amphora", Description: "The Octavia Amphora driver."},
+		{Name: "ovn-tenant", Description: "Octavia OVN driver."},
+	}
+
+	tests := []struct {
+		provider string
+		want     bool
+	}{
+		{provider: "amphora", want: false},
+		{provider: "ovn", want: true},
+		{provider: "ovn-tenant", want: true},
+		{provider: "unknown", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.provider, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(isOVNProvider(tt.provider, providerList)).To(Equal(tt.want))
+		})
+	}
+}
+
+func Test_providerCapabilities_unsupportedFeatures(t *testing.T) {
+	lbSpec := &infrav1.APIServerLoadBalancer{
+		Enabled:          ptr.To(true),
+		AllowedCIDRs:     []string{"192.0.2.0/24"},
+		Flavor:           ptr.To("small"),
+		AvailabilityZone: ptr.To("az1"),
+		Monitor:          &infrav1.APIServerLoadBalancerMonitor{Type: infrav1.APIServerLoadBalancerMonitorHTTPS},
+		TLS: &infrav1.APIServerLoadBalancerTLS{
+			Mode:                  infrav1.APIServerLoadBalancerTLSTerminated,
+			CertificateSecretName: ptr.To("tls"),
+		},
+	}
+
+	tests := []struct {
+		name   string
+		caps   providerCapabilities
+		lbSpec *infrav1.APIServerLoadBalancer
+		want   []string
+	}{
+		{
+			name:   "all features supported",
+			caps:   providerCapabilities{allowedCIDRs: true, tls: true, httpMonitors: true, flavors: true, availabilityZones: true},
+			lbSpec: lbSpec,
+		},
+		{
+			name:   "no features supported",
+			caps:   providerCapabilities{},
+			lbSpec: lbSpec,
+			want:   []string{"allowed CIDRs", "TLS termination", "HTTPS health monitors", "flavors", "availability zones"},
+		},
+		{
+			name:   "no features requested",
+			caps:   providerCapabilities{},
+			lbSpec: &infrav1.APIServerLoadBalancer{Enabled: ptr.To(true)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(tt.caps.unsupportedFeatures(tt.lbSpec)).To(Equal(tt.want))
+		})
+	}
+}
+
+func Test_getProviderCapabilities_cached(t *testing.T) {
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+
+	mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+	s, err := NewService(scope.NewWithLogger(mockScopeFactory, testr.New(t)))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// Octavia is only asked about itself once, however many times the
+	// capabilities of its providers are needed
+	mockScopeFactory.LbClient.EXPECT().ListOctaviaVersions().Return([]apiversions.APIVersion{{ID: "2.24"}, {ID: "2.25"}}, nil).Times(1)
+	mockScopeFactory.LbClient.EXPECT().ListLoadBalancerProviders().Return([]providers.Provider{
+		{Name: "amphora", Description: "Amphora driver"},
+		{Name: "ovn", Description: "OVN driver"},
+	}, nil).Times(1)
+
+	for range 2 {
+		caps, err := s.getProviderCapabilities("amphora")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(caps.tls).To(BeTrue())
+
+		caps, err = s.getProviderCapabilities("ovn")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(caps.tls).To(BeFalse())
+
+		providerList, err := s.getLoadBalancerProviders()
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(providerList).To(HaveLen(2))
+	}
+}
+
+func Test_ReconcileLoadBalancer_OVN(t *testing.T) {
+	g := NewWithT(t)
+
+	cloud := fake.NewCloud(fake.WithTransitionDelay(0))
+	factory := scope.NewFakeScopeFactory(cloud)
+	s, err := NewService(scope.NewWithLogger(factory, testr.New(t)))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	networkClient, err := factory.NewNetworkClient()
+	g.Expect(err).NotTo(HaveOccurred())
+	network, err := networkClient.CreateNetwork(networks.CreateOpts{Name: "cluster"})
+	g.Expect(err).NotTo(HaveOccurred())
+	subnet, err := networkClient.CreateSubnet(subnets.CreateOpts{NetworkID: network.ID, CIDR: "10.0.0.0/24", IPVersion: 4})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	openStackCluster := &infrav1.OpenStackCluster{
+		Spec: infrav1.OpenStackClusterSpec{
+			APIServer: &infrav1.APIServer{
+				EnableFloatingIP: ptr.To(false),
+				ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+					Enabled:      ptr.To(true),
+					Provider:     ptr.To("ovn"),
+					Flavor:       ptr.To("small"),
+					AllowedCIDRs: []string{"192.0.2.0/24"},
+					Monitor:      &infrav1.APIServerLoadBalancerMonitor{Type: infrav1.APIServerLoadBalancerMonitorHTTPS},
+					TLS:          &infrav1.APIServerLoadBalancerTLS{Mode: infrav1.APIServerLoadBalancerTLSPassthrough},
+				},
+			},
+		},
+		Status: infrav1.OpenStackClusterStatus{
+			Network: &infrav1.NetworkStatusWithSubnets{
+				NetworkStatus: infrav1.NetworkStatus{ID: network.ID, Name: network.Name},
+				Subnets:       []infrav1.Subnet{{ID: subnet.ID, CIDR: subnet.CIDR}},
+			},
+		},
+	}
+
+	// Options the OVN provider does not support are rejected by the fake
+	// cloud, so this only succeeds if they are replaced or omitted
+	_, err = s.ReconcileLoadBalancer(openStackCluster, "AAAAA", 6443, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cloud.Count("loadbalancer")).To(Equal(1))
+	g.Expect(cloud.Count("healthmonitor")).To(Equal(1))
+	g.Expect(openStackCluster.Status.APIServerManagedLoadBalancer.AllowedCIDRs).To(BeNil())
+
+	listener, err := s.checkIfListenerExists("k8s-clusterapi-cluster-AAAAA-kubeapi-6443")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(listener.Protocol).To(Equal("TCP"))
+	pool, err := s.checkIfPoolExists("k8s-clusterapi-cluster-AAAAA-kubeapi-6443")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(pool.LBMethod).To(Equal(string(pools.LBMethodSourceIpPort)))
+	monitor, err := s.checkIfMonitorExists("k8s-clusterapi-cluster-AAAAA-kubeapi-6443")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(monitor.Type).To(Equal("TCP"))
+
+	g.Expect(s.GetUnsupportedFeatures(openStackCluster)).To(Equal([]string{"allowed CIDRs", "HTTPS health monitors", "flavors"}))
+
+	// Reconciling again does not change anything
+	_, err = s.ReconcileLoadBalancer(openStackCluster, "AAAAA", 6443, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cloud.Count("listener")).To(Equal(1))
+	g.Expect(cloud.Count("healthmonitor")).To(Equal(1))
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+)
+
+// MemberDrainStatus describes the draining of the load balancer members of
+// a control plane machine.
+type MemberDrainStatus struct {
+	// Members is the number of load balancer members of the machine.
+	Members int
+}
+
+// DrainLoadBalancerMember stops the API server load balancer and its zonal
+// load balancers from sending new connections to a control plane machine.
+// Providers which support member weights keep sending packets of existing
+// connections to members with a weight of 0. Other providers only support
+// disabling the members.
+func (s *Service) DrainLoadBalancerMember(openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, clusterResourceName string) (*MemberDrainStatus, error) {
+	if openStackMachine == nil {
+		return nil, errors.New("openStackMachine is nil")
+	}
+
+	lbSpec := openStackCluster.Spec.APIServer.GetManagedLoadBalancer()
+	if lbSpec == nil || openStackCluster.Spec.ControlPlaneEndpoint == nil {
+		return &MemberDrainStatus{}, nil
+	}
+	portList := getAPILoadBalancerPorts(lbSpec, int(openStackCluster.Spec.ControlPlaneEndpoint.Port))
+
+	loadBalancerNames := []string{getLoadBalancerName(clusterResourceName)}
+	for _, zone := range getZonalLoadBalancerZones(openStackCluster) {
+		loadBalancerNames = append(loadBalancerNames, getZonalLoadBalancerName(clusterResourceName, zone))
+	}
+
+	status := &MemberDrainStatus{}
+	for i, loadBalancerName := range loadBalancerNames {
+		// The API server load balancer may be an existing load balancer
+		var lb *loadbalancers.LoadBalancer
+		var err error
+		if i == 0 {
+			lb, err = s.getAPILoadBalancer(openStackCluster, clusterResourceName)
+		} else {
+			lb, err = s.checkIfLbExists(loadBalancerName)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if lb == nil {
+			continue
+		}
+
+		caps, err := s.getProviderCapabilities(lb.Provider)
+		if err != nil {
+			return nil, err
+		}
+
+		members := 0
+		for _, port := range portList {
+			lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, port)
+
+			var pool *pools.Pool
+			if i == 0 {
+				pool, err = s.getAPILoadBalancerPool(lbSpec, lbPortObjectsName)
+			} else {
+				pool, err = s.checkIfPoolExists(lbPortObjectsName)
+			}
+			if err != nil {
+				return nil, err
+			}
+			if pool == nil {
+				continue
+			}
+
+			drained, err := s.drainPoolMember(lb.ID, pool.ID, lbPortObjectsName+"-"+openStackMachine.Name, caps.memberWeights)
+			if err != nil {
+				return nil, err
+			}
+			if drained {
+				members++
+			}
+		}
+		status.Members += members
+	}
+	return status, nil
+}
+
+// drainPoolMember stops sending new connections to the member of a pool with
+// the given name. It returns false if the member does not exist.
+func (s *Service) drainPoolMember(lbID, poolID, name string, memberWeights bool) (bool, error) {
+	lbMember, err := s.checkIfLbMemberExists(poolID, name)
+	if err != nil {
+		return false, err
+	}
+	if lbMember == nil {
+		return false, nil
+	}
+
+	var updateOpts pools.UpdateMemberOpts
+	if memberWeights {
+		if lbMember.Weight == 0 {
+			return true, nil
+		}
+		updateOpts.Weight = ptr.To(0)
+	} else {
+		if !lbMember.AdminStateUp {
+			return true, nil
+		}
+		updateOpts.AdminStateUp = ptr.To(false)
+	}
+
+	s.scope.Logger().V(2).Info("Draining load balancer member", "name", name)
+
+	if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+		return false, err
+	}
+	if _, err := s.loadbalancerClient.UpdatePoolMember(poolID, lbMember.ID, updateOpts); err != nil {
+		return false, err
+	}
+	_, err = s.waitForLoadBalancerActive(lbID)
+	return true, err
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func Test_DrainLoadBalancerMember(t *testing.T) {
+	const clusterResourceName = "AAAAA"
+
+	tests := []struct {
+		name             string
+		provider         string
+		wantWeight       int
+		wantAdminStateUp bool
+	}{
+		{
+			name:             "amphora members get a weight of 0",
+			provider:         "amphora",
+			wantWeight:       0,
+			wantAdminStateUp: true,
+		},
+		{
+			name:             "ovn members are disabled",
+			provider:         "ovn",
+			wantWeight:       1,
+			wantAdminStateUp: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cloud := fake.NewCloud(fake.WithTransitionDelay(0))
+			factory := scope.NewFakeScopeFactory(cloud)
+			s, err := NewService(scope.NewWithLogger(factory, testr.New(t)))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			networkClient, err := factory.NewNetworkClient()
+			g.Expect(err).NotTo(HaveOccurred())
+			network, err := networkClient.CreateNetwork(networks.CreateOpts{Name: "cluster"})
+			g.Expect(err).NotTo(HaveOccurred())
+			subnet, err := networkClient.CreateSubnet(subnets.CreateOpts{NetworkID: network.ID, CIDR: "10.0.0.0/24", IPVersion: 4})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			networkStatus := &infrav1.NetworkStatusWithSubnets{
+				NetworkStatus: infrav1.NetworkStatus{ID: network.ID, Name: network.Name},
+				Subnets:       []infrav1.Subnet{{ID: subnet.ID, CIDR: subnet.CIDR}},
+			}
+			openStackCluster := &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServer: &infrav1.APIServer{
+						EnableFloatingIP: ptr.To(false),
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled:     ptr.To(true),
+							Provider:    ptr.To(tt.provider),
+							MemberDrain: &infrav1.APIServerLoadBalancerMemberDrain{PeriodSeconds: 30},
+						},
+					},
+					ControlPlaneEndpoint: &clusterv1.APIEndpoint{Host: "10.0.0.5", Port: 6443},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: networkStatus,
+					APIServerManagedLoadBalancer: &infrav1.LoadBalancer{
+						LoadBalancerNetwork: networkStatus,
+					},
+				},
+			}
+
+			_, err = s.ReconcileLoadBalancer(openStackCluster, clusterResourceName, 6443, nil)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			// A machine which is not a member has nothing to drain
+			openStackMachine := &infrav1.OpenStackMachine{ObjectMeta: metav1.ObjectMeta{Name: "control-plane-abcde"}}
+			drainStatus, err := s.DrainLoadBalancerMember(openStackCluster, openStackMachine, clusterResourceName)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(drainStatus.Members).To(Equal(0))
+
+			g.Expect(s.ReconcileLoadBalancerMember(openStackCluster, openStackMachine, clusterResourceName, "", "10.0.0.10")).To(Succeed())
+
+			for range 2 {
+				drainStatus, err = s.DrainLoadBalancerMember(openStackCluster, openStackMachine, clusterResourceName)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(drainStatus.Members).To(Equal(1))
+			}
+
+			pool, err := s.checkIfPoolExists("k8s-clusterapi-cluster-AAAAA-kubeapi-6443")
+			g.Expect(err).NotTo(HaveOccurred())
+			member, err := s.checkIfLbMemberExists(pool.ID, "k8s-clusterapi-cluster-AAAAA-kubeapi-6443-control-plane-abcde")
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(member.Weight).To(Equal(tt.wantWeight))
+			g.Expect(member.AdminStateUp).To(Equal(tt.wantAdminStateUp))
+
+			// The drained member is deleted as usual
+			g.Expect(s.DeleteLoadBalancerMember(openStackCluster, openStackMachine, clusterResourceName)).To(Succeed())
+			g.Expect(cloud.Count("member")).To(Equal(0))
+		})
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/filterconvert"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
+)
+
+// isExistingLoadBalancer returns true if an existing load balancer is used
+// as the API server load balancer instead of creating one.
+func isExistingLoadBalancer(lbSpec *infrav1.APIServerLoadBalancer) bool {
+	return lbSpec != nil && lbSpec.LoadBalancer != nil
+}
+
+// GetLoadBalancerByParam returns the load balancer specified by param.
+func (s *Service) GetLoadBalancerByParam(param *infrav1.LoadBalancerParam) (*loadbalancers.LoadBalancer, error) {
+	if param.ID != nil {
+		return s.loadbalancerClient.GetLoadBalancer(*param.ID)
+	}
+
+	if param.Filter == nil {
+		// Should have been caught by validation
+		return nil, errors.New("invalid load balancer param, either ID or Filter must be set")
+	}
+
+	lbList, err := s.loadbalancerClient.ListLoadBalancers(filterconvert.LoadBalancerFilterToListOpts(param.Filter))
+	if err != nil {
+		return nil, err
+	}
+	switch len(lbList) {
+	case 0:
+		return nil, capoerrors.ErrNoMatches
+	case 1:
+		return &lbList[0], nil
+	}
+	return nil, capoerrors.ErrMultipleMatches
+}
+
+// getAPILoadBalancer returns the API server load balancer, or nil if it does
+// not exist. An existing load balancer is only returned once it has been
+// resolved by ReconcileLoadBalancer.
+func (s *Service) getAPILoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string) (*loadbalancers.LoadBalancer, error) {
+	if !isExistingLoadBalancer(openStackCluster.Spec.APIServer.GetManagedLoadBalancer()) {
+		return s.checkIfLbExists(getLoadBalancerName(clusterResourceName))
+	}
+
+	lbStatus := openStackCluster.Status.APIServerManagedLoadBalancer
+	if lbStatus == nil || lbStatus.ID == "" {
+		return nil, nil
+	}
+	lb, err := s.loadbalancerClient.GetLoadBalancer(lbStatus.ID)
+	if err != nil {
+		if capoerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return lb, nil
+}
+
+// getExistingAPILoadBalancer returns the existing load balancer used as the
+// API server load balancer. It is fetched by ID once it has been resolved.
+func (s *Service) getExistingAPILoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string) (*loadbalancers.LoadBalancer, error) {
+	lb, err := s.getAPILoadBalancer(openStackCluster, clusterResourceName)
+	if err != nil || lb != nil {
+		return lb, err
+	}
+
+	lb, err = s.GetLoadBalancerByParam(openStackCluster.Spec.APIServer.GetManagedLoadBalancer().LoadBalancer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find existing load balancer: %w", err)
+	}
+	s.scope.Logger().V(2).Info("Using existing load balancer", "name", lb.Name, "id", lb.ID)
+	return lb, nil
+}
+
+// getAPILoadBalancerPool returns the pool of the API server load balancer
+// with the given name, or the existing pool if lbSpec has one. It returns
+// nil if the pool does not exist.
+func (s *Service) getAPILoadBalancerPool(lbSpec *infrav1.APIServerLoadBalancer, name string) (*pools.Pool, error) {
+	if lbSpec == nil || lbSpec.PoolID == nil {
+		return s.checkIfPoolExists(name)
+	}
+
+	pool, err := s.loadbalancerClient.GetPool(*lbSpec.PoolID)
+	if err != nil {
+		if capoerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return pool, nil
+}
+
+// checkExistingPool checks that the existing pool of lbSpec belongs to lb.
+func (s *Service) checkExistingPool(lb *loadbalancers.LoadBalancer, lbSpec *infrav1.APIServerLoadBalancer) error {
+	pool, err := s.getAPILoadBalancerPool(lbSpec, "")
+	if err != nil {
+		return err
+	}
+	if pool == nil {
+		return fmt.Errorf("existing pool %s does not exist", *lbSpec.PoolID)
+	}
+	if !slices.ContainsFunc(pool.Loadbalancers, func(ref pools.LoadBalancerID) bool { return ref.ID == lb.ID }) {
+		return fmt.Errorf("existing pool %s does not belong to load balancer %s", pool.ID, lb.ID)
+	}
+	return nil
+}
+
+// deleteExistingAPILoadBalancerObjects deletes what was created on the
+// existing load balancer used as the API server load balancer: its
+// listeners and pools, and its floating IP if it was created for the
+// cluster. The load balancer itself is not deleted.
+func (s *Service) deleteExistingAPILoadBalancerObjects(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string) error {
+	loadBalancerName := getLoadBalancerName(clusterResourceName)
+	lbSpec := openStackCluster.Spec.APIServer.GetManagedLoadBalancer()
+
+	lb, err := s.getAPILoadBalancer(openStackCluster, clusterResourceName)
+	if err != nil {
+		return err
+	}
+	if lb != nil {
+		if err := s.deleteExistingAPILoadBalancerFloatingIP(openStackCluster, clusterResourceName, lb); err != nil {
+			return err
+		}
+
+		// Nothing but the members of the control plane machines is
+		// created in an existing pool, and these are deleted with the
+		// machines
+		if lbSpec.PoolID == nil && openStackCluster.Spec.ControlPlaneEndpoint != nil {
+			for _, port := range getAPILoadBalancerPorts(lbSpec, int(openStackCluster.Spec.ControlPlaneEndpoint.Port)) {
+				listener, err := s.checkIfListenerExists(fmt.Sprintf("%s-%d", loadBalancerName, port))
+				if err != nil {
+					return err
+				}
+				if listener == nil {
+					continue
+				}
+
+				s.scope.Logger().V(2).Info("Deleting listener of existing load balancer", "name", listener.Name, "loadBalancerID", lb.ID)
+				if _, err := s.waitForLoadBalancerActive(lb.ID); err != nil {
+					return err
+				}
+				if err := s.deleteListenerAndPool(openStackCluster, lb.ID, listener); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	// Certificates can only be deleted once the listener using them is gone
+	if isTLSTerminated(lbSpec) {
+		return s.deleteTLSContainers(openStackCluster, loadBalancerName, "")
+	}
+	return nil
+}
+
+// deleteExistingAPILoadBalancerFloatingIP deletes the floating IP of an
+// existing load balancer if it was created for the cluster.
+func (s *Service) deleteExistingAPILoadBalancerFloatingIP(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, lb *loadbalancers.LoadBalancer) error {
+	if lb.VipPortID == "" {
+		return nil
+	}
+
+	fip, err := s.networkingService.GetFloatingIPByPortID(lb.VipPortID)
+	if err != nil {
+		return err
+	}
+	if fip == nil || fip.Description != names.GetDescription(clusterResourceName) {
+		return nil
+	}
+	if userFloatingIP := openStackCluster.Spec.APIServer.GetFloatingIP(); userFloatingIP != nil && *userFloatingIP == fip.FloatingIP {
+		s.scope.Logger().V(3).Info("Skipping load balancer floating IP deletion as it's a user-provided resource", "name", lb.Name, "floatingIP", fip.FloatingIP)
+		return nil
+	}
+
+	if err := s.networkingService.DisassociateFloatingIP(openStackCluster, fip.FloatingIP); err != nil {
+		return err
+	}
+	return s.networkingService.DeleteFloatingIP(openStackCluster, fip.FloatingIP)
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+func Test_ExistingLoadBalancer(t *testing.T) {
+	const clusterResourceName = "AAAAA"
+
+	tests := []struct {
+		name string
+		// existingPool creates a listener and pool on the existing load
+		// balancer and adds the members to it
+		existingPool bool
+	}{
+		{
+			name: "listeners are created on the existing load balancer",
+		},
+		{
+			name:         "members are added to the existing pool",
+			existingPool: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cloud := fake.NewCloud(fake.WithTransitionDelay(0))
+			factory := scope.NewFakeScopeFactory(cloud)
+			s, err := NewService(scope.NewWithLogger(factory, testr.New(t)))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			networkClient, err := factory.NewNetworkClient()
+			g.Expect(err).NotTo(HaveOccurred())
+			network, err := networkClient.CreateNetwork(networks.CreateOpts{Name: "cluster"})
+			g.Expect(err).NotTo(HaveOccurred())
+			subnet, err := networkClient.CreateSubnet(subnets.CreateOpts{NetworkID: network.ID, CIDR: "10.0.0.0/24", IPVersion: 4})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			lbClient, err := factory.NewLbClient()
+			g.Expect(err).NotTo(HaveOccurred())
+			sharedLB, err := lbClient.CreateLoadBalancer(loadbalancers.CreateOpts{Name: "shared", VipSubnetID: subnet.ID, Tags: []string{"shared"}})
+			g.Expect(err).NotTo(HaveOccurred())
+			_, err = s.waitForLoadBalancerActive(sharedLB.ID)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			lbSpec := &infrav1.APIServerLoadBalancer{
+				Enabled: ptr.To(true),
+				LoadBalancer: &infrav1.LoadBalancerParam{
+					Filter: &infrav1.LoadBalancerFilter{
+						FilterByNeutronTags: infrav1.FilterByNeutronTags{Tags: []infrav1.NeutronTag{"shared"}},
+					},
+				},
+			}
+			if tt.existingPool {
+				listener, err := lbClient.CreateListener(listeners.CreateOpts{Name: "shared-6443", Protocol: listeners.ProtocolTCP, ProtocolPort: 6443, LoadbalancerID: sharedLB.ID})
+				g.Expect(err).NotTo(HaveOccurred())
+				_, err = s.waitForLoadBalancerActive(sharedLB.ID)
+				g.Expect(err).NotTo(HaveOccurred())
+				pool, err := lbClient.CreatePool(pools.CreateOpts{Name: "shared-6443", Protocol: pools.ProtocolTCP, LBMethod: pools.LBMethodRoundRobin, ListenerID: listener.ID})
+				g.Expect(err).NotTo(HaveOccurred())
+				_, err = s.waitForLoadBalancerActive(sharedLB.ID)
+				g.Expect(err).NotTo(HaveOccurred())
+				lbSpec.PoolID = ptr.To(pool.ID)
+			}
+
+			openStackCluster := &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServer: &infrav1.APIServer{
+						EnableFloatingIP:    ptr.To(false),
+						ManagedLoadBalancer: lbSpec,
+					},
+					ControlPlaneEndpoint: &clusterv1.APIEndpoint{Host: sharedLB.VipAddress, Port: 6443},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						NetworkStatus: infrav1.NetworkStatus{ID: network.ID, Name: network.Name},
+						Subnets:       []infrav1.Subnet{{ID: subnet.ID, CIDR: subnet.CIDR}},
+					},
+				},
+			}
+
+			for range 2 {
+				_, err = s.ReconcileLoadBalancer(openStackCluster, clusterResourceName, 6443, nil)
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			lbStatus := openStackCluster.Status.APIServerManagedLoadBalancer
+			g.Expect(lbStatus.ID).To(Equal(sharedLB.ID))
+			g.Expect(lbStatus.InternalIP).To(Equal(sharedLB.VipAddress))
+			g.Expect(lbStatus.LoadBalancerNetwork.ID).To(Equal(network.ID))
+			g.Expect(cloud.Count("loadbalancer")).To(Equal(1))
+			g.Expect(cloud.Count("listener")).To(Equal(1))
+			g.Expect(cloud.Count("pool")).To(Equal(1))
+
+			openStackMachine := &infrav1.OpenStackMachine{ObjectMeta: metav1.ObjectMeta{Name: "control-plane-abcde"}}
+			g.Expect(s.ReconcileLoadBalancerMember(openStackCluster, openStackMachine, clusterResourceName, "", "10.0.0.10")).To(Succeed())
+			g.Expect(cloud.Count("member")).To(Equal(1))
+			if tt.existingPool {
+				members, err := lbClient.ListPoolMember(*lbSpec.PoolID, pools.ListMembersOpts{})
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(members).To(ConsistOf(HaveField("Address", "10.0.0.10")))
+			}
+
+			g.Expect(s.DeleteLoadBalancerMember(openStackCluster, openStackMachine, clusterResourceName)).To(Succeed())
+			g.Expect(cloud.Count("member")).To(Equal(0))
+
+			// The existing load balancer is not deleted, only the listener
+			// and pool created on it
+			result, err := s.DeleteLoadBalancer(openStackCluster, clusterResourceName)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(result).To(BeNil())
+			g.Expect(cloud.Count("loadbalancer")).To(Equal(1))
+			if tt.existingPool {
+				g.Expect(cloud.Count("listener")).To(Equal(1))
+				g.Expect(cloud.Count("pool")).To(Equal(1))
+			} else {
+				g.Expect(cloud.Count("listener")).To(Equal(0))
+				g.Expect(cloud.Count("pool")).To(Equal(0))
+			}
+		})
+	}
+}
+
+func Test_ExistingLoadBalancerNotFound(t *testing.T) {
+	g := NewWithT(t)
+
+	cloud := fake.NewCloud(fake.WithTransitionDelay(0))
+	s, err := NewService(scope.NewWithLogger(scope.NewFakeScopeFactory(cloud), testr.New(t)))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	openStackCluster := &infrav1.OpenStackCluster{
+		Spec: infrav1.OpenStackClusterSpec{
+			APIServer: &infrav1.APIServer{
+				ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+					Enabled: ptr.To(true),
+					LoadBalancer: &infrav1.LoadBalancerParam{
+						Filter: &infrav1.LoadBalancerFilter{Name: "shared"},
+					},
+				},
+			},
+		},
+	}
+
+	terminal, err := s.ReconcileLoadBalancer(openStackCluster, "AAAAA", 6443, nil)
+	g.Expect(err).To(MatchError(capoerrors.ErrFilterMatch))
+	g.Expect(terminal).To(BeTrue())
+	g.Expect(cloud.Count("loadbalancer")).To(Equal(0))
+}
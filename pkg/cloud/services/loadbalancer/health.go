@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+)
+
+const (
+	provisioningStatusError = "ERROR"
+
+	operatingStatusOffline = "OFFLINE"
+	operatingStatusError   = "ERROR"
+)
+
+// ReconcileLoadBalancerHealth records the status tree of the API server load
+// balancer in the cluster status: the provisioning and operating status of
+// the load balancer, and of the listeners, pools and members used by the API
+// server. An event is emitted for every member whose operating status changed
+// since the previous reconcile.
+func (s *Service) ReconcileLoadBalancerHealth(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string) error {
+	lbStatus := openStackCluster.Status.APIServerManagedLoadBalancer
+	if lbStatus == nil || lbStatus.ID == "" {
+		return nil
+	}
+
+	tree, err := s.loadbalancerClient.GetLoadBalancerStatuses(lbStatus.ID)
+	if err != nil {
+		return err
+	}
+	if tree == nil || tree.Loadbalancer == nil {
+		return fmt.Errorf("load balancer %s has no status tree", lbStatus.ID)
+	}
+
+	previous := map[string]string{}
+	for _, listener := range lbStatus.Listeners {
+		for _, pool := range listener.Pools {
+			for _, member := range pool.Members {
+				previous[member.ID] = member.OperatingStatus
+			}
+		}
+	}
+
+	lbSpec := openStackCluster.Spec.APIServer.GetManagedLoadBalancer()
+	loadBalancerName := getLoadBalancerName(clusterResourceName)
+	var listenerStatuses []infrav1.LoadBalancerListenerStatus
+	for i := range tree.Loadbalancer.Listeners {
+		listener := &tree.Loadbalancer.Listeners[i]
+		// An existing load balancer may have listeners and pools which are
+		// not used by the API server
+		if isExistingLoadBalancer(lbSpec) && lbSpec.PoolID == nil && !strings.HasPrefix(listener.Name, loadBalancerName+"-") {
+			continue
+		}
+
+		var poolStatuses []infrav1.LoadBalancerPoolStatus
+		for j := range listener.Pools {
+			pool := &listener.Pools[j]
+			if lbSpec.PoolID != nil && pool.ID != *lbSpec.PoolID {
+				continue
+			}
+
+			var memberStatuses []infrav1.LoadBalancerMemberStatus
+			for _, member := range pool.Members {
+				if previousStatus, ok := previous[member.ID]; ok && previousStatus != member.OperatingStatus {
+					s.recordMemberOperatingStatusChange(openStackCluster, &member, previousStatus)
+				}
+				memberStatuses = append(memberStatuses, infrav1.LoadBalancerMemberStatus{
+					ID:                 member.ID,
+					Name:               member.Name,
+					Address:            member.Address,
+					ProvisioningStatus: member.ProvisioningStatus,
+					OperatingStatus:    member.OperatingStatus,
+				})
+			}
+			poolStatuses = append(poolStatuses, getPoolStatus(pool, memberStatuses))
+		}
+		if lbSpec.PoolID != nil && len(poolStatuses) == 0 {
+			continue
+		}
+		listenerStatuses = append(listenerStatuses, getListenerStatus(listener, poolStatuses))
+	}
+
+	lbStatus.ProvisioningStatus = tree.Loadbalancer.ProvisioningStatus
+	lbStatus.OperatingStatus = tree.Loadbalancer.OperatingStatus
+	lbStatus.Listeners = listenerStatuses
+	return nil
+}
+
+func getListenerStatus(listener *listeners.Listener, poolStatuses []infrav1.LoadBalancerPoolStatus) infrav1.LoadBalancerListenerStatus {
+	return infrav1.LoadBalancerListenerStatus{
+		ID:                 listener.ID,
+		Name:               listener.Name,
+		ProvisioningStatus: listener.ProvisioningStatus,
+		OperatingStatus:    listener.OperatingStatus,
+		Pools:              poolStatuses,
+	}
+}
+
+func getPoolStatus(pool *pools.Pool, memberStatuses []infrav1.LoadBalancerMemberStatus) infrav1.LoadBalancerPoolStatus {
+	return infrav1.LoadBalancerPoolStatus{
+		ID:                 pool.ID,
+		Name:               pool.Name,
+		ProvisioningStatus: pool.ProvisioningStatus,
+		OperatingStatus:    pool.OperatingStatus,
+		Members:            memberStatuses,
+	}
+}
+
+func (s *Service) recordMemberOperatingStatusChange(openStackCluster *infrav1.OpenStackCluster, member *pools.Member, previousStatus string) {
+	s.scope.Logger().V(2).Info("Load balancer member changed operating status", "name", member.Name, "id", member.ID, "previous", previousStatus, "current", member.OperatingStatus)
+	if isMemberUnhealthy(member.OperatingStatus) {
+		record.Warnf(openStackCluster, "LoadBalancerMemberUnhealthy", "Load balancer member %s with address %s changed operating status from %s to %s", member.Name, member.Address, previousStatus, member.OperatingStatus)
+		return
+	}
+	record.Eventf(openStackCluster, "LoadBalancerMemberStatusChanged", "Load balancer member %s with address %s changed operating status from %s to %s", member.Name, member.Address, previousStatus, member.OperatingStatus)
+}
+
+func isMemberUnhealthy(operatingStatus string) bool {
+	return operatingStatus == operatingStatusOffline || operatingStatus == operatingStatusError
+}
+
+// GetLoadBalancerHealthIssues returns the names of the listeners and pools of
+// the API server load balancer which are in ERROR, and of its members which
+// are OFFLINE or ERROR, according to the status recorded by
+// ReconcileLoadBalancerHealth.
+func GetLoadBalancerHealthIssues(lbStatus *infrav1.LoadBalancer) (provisioningErrors, unhealthyMembers []string) {
+	if lbStatus == nil {
+		return nil, nil
+	}
+
+	nameOrID := func(name, id string) string {
+		if name != "" {
+			return name
+		}
+		return id
+	}
+	for _, listener := range lbStatus.Listeners {
+		if listener.ProvisioningStatus == provisioningStatusError {
+			provisioningErrors = append(provisioningErrors, "listener "+nameOrID(listener.Name, listener.ID))
+		}
+		for _, pool := range listener.Pools {
+			if pool.ProvisioningStatus == provisioningStatusError {
+				provisioningErrors = append(provisioningErrors, "pool "+nameOrID(pool.Name, pool.ID))
+			}
+			for _, member := range pool.Members {
+				if isMemberUnhealthy(member.OperatingStatus) {
+					unhealthyMembers = append(unhealthyMembers, fmt.Sprintf("%s (%s)", nameOrID(member.Name, member.ID), member.OperatingStatus))
+				}
+			}
+		}
+	}
+	return provisioningErrors, unhealthyMembers
+}
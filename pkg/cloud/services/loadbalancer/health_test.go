@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func Test_ReconcileLoadBalancerHealth(t *testing.T) {
+	const clusterResourceName = "AAAAA"
+	g := NewWithT(t)
+
+	cloud := fake.NewCloud(fake.WithTransitionDelay(0))
+	factory := scope.NewFakeScopeFactory(cloud)
+	s, err := NewService(scope.NewWithLogger(factory, testr.New(t)))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	networkClient, err := factory.NewNetworkClient()
+	g.Expect(err).NotTo(HaveOccurred())
+	network, err := networkClient.CreateNetwork(networks.CreateOpts{Name: "cluster"})
+	g.Expect(err).NotTo(HaveOccurred())
+	subnet, err := networkClient.CreateSubnet(subnets.CreateOpts{NetworkID: network.ID, CIDR: "10.0.0.0/24", IPVersion: 4})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	networkStatus := &infrav1.NetworkStatusWithSubnets{
+		NetworkStatus: infrav1.NetworkStatus{ID: network.ID, Name: network.Name},
+		Subnets:       []infrav1.Subnet{{ID: subnet.ID, CIDR: subnet.CIDR}},
+	}
+	openStackCluster := &infrav1.OpenStackCluster{
+		Spec: infrav1.OpenStackClusterSpec{
+			APIServer: &infrav1.APIServer{
+				EnableFloatingIP:    ptr.To(false),
+				ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{Enabled: ptr.To(true)},
+			},
+			ControlPlaneEndpoint: &clusterv1.APIEndpoint{Host: "10.0.0.5", Port: 6443},
+		},
+		Status: infrav1.OpenStackClusterStatus{
+			Network: networkStatus,
+			APIServerManagedLoadBalancer: &infrav1.LoadBalancer{
+				LoadBalancerNetwork: networkStatus,
+			},
+		},
+	}
+
+	_, err = s.ReconcileLoadBalancer(openStackCluster, clusterResourceName, 6443, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	for i, name := range []string{"control-plane-a", "control-plane-b"} {
+		openStackMachine := &infrav1.OpenStackMachine{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		g.Expect(s.ReconcileLoadBalancerMember(openStackCluster, openStackMachine, clusterResourceName, "", []string{"10.0.0.10", "10.0.0.11"}[i])).To(Succeed())
+	}
+
+	g.Expect(s.ReconcileLoadBalancerHealth(openStackCluster, clusterResourceName)).To(Succeed())
+	lbStatus := openStackCluster.Status.APIServerManagedLoadBalancer
+	g.Expect(lbStatus.ProvisioningStatus).To(Equal("ACTIVE"))
+	g.Expect(lbStatus.Listeners).To(ConsistOf(And(
+		HaveField("Name", "k8s-clusterapi-cluster-AAAAA-kubeapi-6443"),
+		HaveField("ProvisioningStatus", "ACTIVE"),
+		HaveField("Pools", ConsistOf(And(
+			HaveField("ProvisioningStatus", "ACTIVE"),
+			HaveField("Members", ConsistOf(
+				And(HaveField("Address", "10.0.0.10"), HaveField("OperatingStatus", "ONLINE")),
+				And(HaveField("Address", "10.0.0.11"), HaveField("OperatingStatus", "ONLINE")),
+			)),
+		))),
+	)))
+	provisioningErrors, unhealthyMembers := GetLoadBalancerHealthIssues(lbStatus)
+	g.Expect(provisioningErrors).To(BeEmpty())
+	g.Expect(unhealthyMembers).To(BeEmpty())
+
+	// A member reported OFFLINE by the health monitor is unhealthy
+	members := lbStatus.Listeners[0].Pools[0].Members
+	offlineMember := members[0]
+	if offlineMember.Address != "10.0.0.11" {
+		offlineMember = members[1]
+	}
+	cloud.SetPoolMemberOperatingStatus(offlineMember.ID, "OFFLINE")
+
+	g.Expect(s.ReconcileLoadBalancerHealth(openStackCluster, clusterResourceName)).To(Succeed())
+	provisioningErrors, unhealthyMembers = GetLoadBalancerHealthIssues(lbStatus)
+	g.Expect(provisioningErrors).To(BeEmpty())
+	g.Expect(unhealthyMembers).To(ConsistOf("k8s-clusterapi-cluster-AAAAA-kubeapi-6443-control-plane-b (OFFLINE)"))
+}
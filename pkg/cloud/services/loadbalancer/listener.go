@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"maps"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+)
+
+// insertHeaders returns the headers inserted by the listener. Octavia only
+// inserts headers into HTTP requests, so it returns nil for listeners which
+// do not terminate TLS.
+func (spec *listenerSpec) insertHeaders() map[string]string {
+	if spec.options == nil || spec.listenerProtocol != listeners.ProtocolTerminatedHTTPS {
+		return nil
+	}
+	return spec.options.InsertHeaders
+}
+
+// setListenerCreateOptions sets the options of spec which are specified in
+// the create options of a listener.
+func setListenerCreateOptions(createOpts *listeners.CreateOpts, spec listenerSpec) {
+	if spec.options == nil {
+		return
+	}
+	createOpts.TimeoutClientData = toIntPtr(spec.options.TimeoutClientData)
+	createOpts.TimeoutMemberConnect = toIntPtr(spec.options.TimeoutMemberConnect)
+	createOpts.TimeoutMemberData = toIntPtr(spec.options.TimeoutMemberData)
+	createOpts.TimeoutTCPInspect = toIntPtr(spec.options.TimeoutTCPInspect)
+	createOpts.ConnLimit = toIntPtr(spec.options.ConnectionLimit)
+	createOpts.InsertHeaders = spec.insertHeaders()
+}
+
+func toIntPtr(i *int32) *int {
+	if i == nil {
+		return nil
+	}
+	return ptr.To(int(*i))
+}
+
+// getListenerUpdateOptions returns the options to update on a listener so that
+// its timeouts, connection limit and inserted headers correspond to spec, and
+// the names of the changed options. Options which are not specified are not
+// changed.
+func getListenerUpdateOptions(listener *listeners.Listener, spec listenerSpec) (listeners.UpdateOpts, []string) {
+	var updateOpts listeners.UpdateOpts
+	var changes []string
+	if spec.options == nil {
+		return updateOpts, nil
+	}
+
+	update := func(name string, desired *int32, current int, opt **int) {
+		if desired != nil && int(*desired) != current {
+			*opt = ptr.To(int(*desired))
+			changes = append(changes, name)
+		}
+	}
+	update("timeout_client_data", spec.options.TimeoutClientData, listener.TimeoutClientData, &updateOpts.TimeoutClientData)
+	update("timeout_member_connect", spec.options.TimeoutMemberConnect, listener.TimeoutMemberConnect, &updateOpts.TimeoutMemberConnect)
+	update("timeout_member_data", spec.options.TimeoutMemberData, listener.TimeoutMemberData, &updateOpts.TimeoutMemberData)
+	update("timeout_tcp_inspect", spec.options.TimeoutTCPInspect, listener.TimeoutTCPInspect, &updateOpts.TimeoutTCPInspect)
+	update("connection_limit", spec.options.ConnectionLimit, listener.ConnLimit, &updateOpts.ConnLimit)
+
+	if insertHeaders := spec.insertHeaders(); insertHeaders != nil && !maps.Equal(insertHeaders, listener.InsertHeaders) {
+		updateOpts.InsertHeaders = &insertHeaders
+		changes = append(changes, "insert_headers")
+	}
+	return updateOpts, changes
+}
+
+// getOrUpdateListenerOptions ensures that the timeouts, connection limit and
+// inserted headers of a listener correspond to spec.
+func (s *Service) getOrUpdateListenerOptions(openStackCluster *infrav1.OpenStackCluster, listener *listeners.Listener, spec listenerSpec) error {
+	listenerUpdateOpts, changes := getListenerUpdateOptions(listener, spec)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	s.scope.Logger().V(3).Info("Listener options do not match, updating listener", "name", listener.Name, "changes", changes)
+
+	listenerID := listener.ID
+	listener, err := s.loadbalancerClient.UpdateListener(listener.ID, listenerUpdateOpts)
+	if err != nil {
+		record.Warnf(openStackCluster, "FailedUpdateListener", "Failed to update listener %s: %v", listenerID, err)
+		return err
+	}
+
+	if err := s.waitForListener(listener.ID, "ACTIVE"); err != nil {
+		record.Warnf(openStackCluster, "FailedUpdateListener", "Failed to update listener %s with id %s: wait for listener active: %v", listener.Name, listener.ID, err)
+		return err
+	}
+
+	record.Eventf(openStackCluster, "SuccessfulUpdateListener", "Updated %s for listener %s with id %s", strings.Join(changes, ", "), listener.Name, listener.ID)
+	return nil
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func Test_getListenerUpdateOptions(t *testing.T) {
+	listener := &listeners.Listener{
+		ConnLimit:            -1,
+		TimeoutClientData:    50000,
+		TimeoutMemberConnect: 5000,
+		TimeoutMemberData:    50000,
+		InsertHeaders:        map[string]string{},
+	}
+
+	tests := []struct {
+		name        string
+		spec        listenerSpec
+		wantOpts    listeners.UpdateOpts
+		wantChanges []string
+	}{
+		{
+			name: "no options",
+			spec: listenerSpec{listenerProtocol: listeners.ProtocolTCP},
+		},
+		{
+			name: "unchanged options",
+			spec: listenerSpec{
+				listenerProtocol: listeners.ProtocolTCP,
+				options:          &infrav1.APIServerLoadBalancerListener{TimeoutClientData: ptr.To[int32](50000), ConnectionLimit: ptr.To[int32](-1)},
+			},
+		},
+		{
+			name: "changed timeouts",
+			spec: listenerSpec{
+				listenerProtocol: listeners.ProtocolTCP,
+				options: &infrav1.APIServerLoadBalancerListener{
+					TimeoutClientData: ptr.To[int32](3600000),
+					TimeoutMemberData: ptr.To[int32](3600000),
+					ConnectionLimit:   ptr.To[int32](1000),
+				},
+			},
+			wantOpts: listeners.UpdateOpts{
+				TimeoutClientData: ptr.To(3600000),
+				TimeoutMemberData: ptr.To(3600000),
+				ConnLimit:         ptr.To(1000),
+			},
+			wantChanges: []string{"timeout_client_data", "timeout_member_data", "connection_limit"},
+		},
+		{
+			name: "insert headers are ignored by TCP listeners",
+			spec: listenerSpec{
+				listenerProtocol: listeners.ProtocolTCP,
+				options:          &infrav1.APIServerLoadBalancerListener{InsertHeaders: map[string]string{"X-Forwarded-For": "true"}},
+			},
+		},
+		{
+			name: "insert headers of a TLS terminated listener",
+			spec: listenerSpec{
+				listenerProtocol: listeners.ProtocolTerminatedHTTPS,
+				options:          &infrav1.APIServerLoadBalancerListener{InsertHeaders: map[string]string{"X-Forwarded-For": "true"}},
+			},
+			wantOpts: listeners.UpdateOpts{
+				InsertHeaders: &map[string]string{"X-Forwarded-For": "true"},
+			},
+			wantChanges: []string{"insert_headers"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			updateOpts, changes := getListenerUpdateOptions(listener, tt.spec)
+			g.Expect(updateOpts).To(Equal(tt.wantOpts))
+			g.Expect(changes).To(Equal(tt.wantChanges))
+		})
+	}
+}
+
+func Test_ReconcileLoadBalancerListenerOptions(t *testing.T) {
+	const clusterResourceName = "AAAAA"
+	g := NewWithT(t)
+
+	cloud := fake.NewCloud(fake.WithTransitionDelay(0))
+	factory := scope.NewFakeScopeFactory(cloud)
+	s, err := NewService(scope.NewWithLogger(factory, testr.New(t)))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	networkClient, err := factory.NewNetworkClient()
+	g.Expect(err).NotTo(HaveOccurred())
+	network, err := networkClient.CreateNetwork(networks.CreateOpts{Name: "cluster"})
+	g.Expect(err).NotTo(HaveOccurred())
+	subnet, err := networkClient.CreateSubnet(subnets.CreateOpts{NetworkID: network.ID, CIDR: "10.0.0.0/24", IPVersion: 4})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	networkStatus := &infrav1.NetworkStatusWithSubnets{
+		NetworkStatus: infrav1.NetworkStatus{ID: network.ID, Name: network.Name},
+		Subnets:       []infrav1.Subnet{{ID: subnet.ID, CIDR: subnet.CIDR}},
+	}
+	lbSpec := &infrav1.APIServerLoadBalancer{
+		Enabled:         ptr.To(true),
+		AdditionalPorts: []int32{8443},
+		Listener: &infrav1.APIServerLoadBalancerListener{
+			TimeoutClientData: ptr.To[int32](600000),
+			TimeoutMemberData: ptr.To[int32](600000),
+		},
+	}
+	openStackCluster := &infrav1.OpenStackCluster{
+		Spec: infrav1.OpenStackClusterSpec{
+			APIServer: &infrav1.APIServer{
+				EnableFloatingIP:    ptr.To(false),
+				ManagedLoadBalancer: lbSpec,
+			},
+			ControlPlaneEndpoint: &clusterv1.APIEndpoint{Host: "10.0.0.5", Port: 6443},
+		},
+		Status: infrav1.OpenStackClusterStatus{
+			Network: networkStatus,
+			APIServerManagedLoadBalancer: &infrav1.LoadBalancer{
+				LoadBalancerNetwork: networkStatus,
+			},
+		},
+	}
+
+	lbClient, err := factory.NewLbClient()
+	g.Expect(err).NotTo(HaveOccurred())
+	listAPIListeners := func() []listeners.Listener {
+		listenerList, err := lbClient.ListListeners(listeners.ListOpts{LoadbalancerID: openStackCluster.Status.APIServerManagedLoadBalancer.ID})
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(listenerList).To(HaveLen(2))
+		return listenerList
+	}
+
+	// The options are set when the listeners are created
+	_, err = s.ReconcileLoadBalancer(openStackCluster, clusterResourceName, 6443, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	for _, listener := range listAPIListeners() {
+		g.Expect(listener.TimeoutClientData).To(Equal(600000))
+		g.Expect(listener.TimeoutMemberData).To(Equal(600000))
+		g.Expect(listener.TimeoutMemberConnect).To(Equal(5000))
+		g.Expect(listener.ConnLimit).To(Equal(-1))
+	}
+
+	// Changed options are updated on the existing listeners
+	lbSpec.Listener.TimeoutClientData = ptr.To[int32](3600000)
+	lbSpec.Listener.ConnectionLimit = ptr.To[int32](1000)
+	_, err = s.ReconcileLoadBalancer(openStackCluster, clusterResourceName, 6443, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	for _, listener := range listAPIListeners() {
+		g.Expect(listener.TimeoutClientData).To(Equal(3600000))
+		g.Expect(listener.TimeoutMemberData).To(Equal(600000))
+		g.Expect(listener.ConnLimit).To(Equal(1000))
+	}
+}
@@ -38,7 +38,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
 	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
-	openstackutil "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/openstack"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/optional"
 	capostrings "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/strings"
 )
 
@@ -46,7 +46,6 @@ const (
 	networkPrefix           string = "k8s-clusterapi"
 	kubeapiLBSuffix         string = "kubeapi"
 	waitForOctaviaLBCleanup        = 15 * time.Second
-	loadBalancerProtocolTCP        = "TCP"
 )
 
 const (
@@ -84,8 +83,68 @@ const (
 	defaultMonitorTimeout        = 5
 	defaultMonitorMaxRetries     = 5
 	defaultMonitorMaxRetriesDown = 3
+	defaultMonitorURLPath        = "/readyz"
+	defaultMonitorExpectedCodes  = "200"
 )
 
+// listenerSpec is the desired configuration of the listener, pool and health
+// monitor of a port of the API server load balancer.
+type listenerSpec struct {
+	listenerProtocol listeners.Protocol
+	tlsContainerRef  string
+	poolProtocol     pools.Protocol
+	poolTLSEnabled   bool
+	lbMethod         pools.LBMethod
+	monitor          infrav1.APIServerLoadBalancerMonitor
+	options          *infrav1.APIServerLoadBalancerListener
+}
+
+// getListenerSpec returns the configuration of the listener for a port of
+// the API server load balancer. TLS and HTTPS health monitors are only
+// configured for the API server port: additional ports always use TCP.
+func getListenerSpec(lbSpec *infrav1.APIServerLoadBalancer, isAPIServerPort bool, tlsContainerRef string) listenerSpec {
+	spec := listenerSpec{
+		listenerProtocol: listeners.ProtocolTCP,
+		poolProtocol:     pools.ProtocolTCP,
+	}
+	if lbSpec != nil && lbSpec.Monitor != nil {
+		spec.monitor = *lbSpec.Monitor
+	}
+	if lbSpec != nil {
+		spec.options = lbSpec.Listener
+	}
+
+	cfg := &spec.monitor
+	cfg.Delay = cmp.Or(cfg.Delay, defaultMonitorDelay)
+	cfg.Timeout = cmp.Or(cfg.Timeout, defaultMonitorTimeout)
+	cfg.MaxRetries = cmp.Or(cfg.MaxRetries, defaultMonitorMaxRetries)
+	cfg.MaxRetriesDown = cmp.Or(cfg.MaxRetriesDown, defaultMonitorMaxRetriesDown)
+	if !isAPIServerPort || cfg.Type != infrav1.APIServerLoadBalancerMonitorHTTPS {
+		cfg.Type = infrav1.APIServerLoadBalancerMonitorTCP
+		cfg.URLPath = ""
+		cfg.ExpectedCodes = ""
+	} else {
+		cfg.URLPath = cmp.Or(cfg.URLPath, defaultMonitorURLPath)
+		cfg.ExpectedCodes = cmp.Or(cfg.ExpectedCodes, defaultMonitorExpectedCodes)
+	}
+
+	if !isAPIServerPort || lbSpec == nil || lbSpec.TLS == nil {
+		return spec
+	}
+	switch lbSpec.TLS.Mode {
+	case infrav1.APIServerLoadBalancerTLSPassthrough:
+		spec.listenerProtocol = listeners.ProtocolHTTPS
+		spec.poolProtocol = pools.ProtocolHTTPS
+	case infrav1.APIServerLoadBalancerTLSTerminated:
+		// Connections to the API server are encrypted again
+		spec.listenerProtocol = listeners.ProtocolTerminatedHTTPS
+		spec.tlsContainerRef = tlsContainerRef
+		spec.poolProtocol = pools.ProtocolHTTP
+		spec.poolTLSEnabled = true
+	}
+	return spec
+}
+
 // We wrap the LookupHost function in a variable to allow overriding it in unit tests.
 //
 //nolint:gocritic
@@ -103,7 +162,11 @@ var lookupHost = func(host string) (*string, error) {
 }
 
 // ReconcileLoadBalancer reconciles the load balancer for the given cluster.
-func (s *Service) ReconcileLoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, apiServerPort int) (bool, error) {
+// certificate is the certificate served by the load balancer if it
+// terminates TLS, and is otherwise ignored.
+func (s *Service) ReconcileLoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, apiServerPort int, certificate *Certificate) (bool, error) {
+	s.blockedChanges = nil
+
 	lbSpec := openStackCluster.Spec.APIServer.GetManagedLoadBalancer()
 	if !lbSpec.IsEnabled() {
 		return false, nil
@@ -118,6 +181,19 @@ func (s *Service) ReconcileLoadBalancer(openStackCluster *infrav1.OpenStackClust
 		openStackCluster.Status.APIServerManagedLoadBalancer = lbStatus
 	}
 
+	portList := getAPILoadBalancerPorts(lbSpec, apiServerPort)
+
+	// Once the floating IP has been moved to the replacement of the load
+	// balancer, the previous load balancer is deleted before anything else
+	// is reconciled because it still has the name of the load balancer
+	replacement := openStackCluster.Status.APIServerLoadBalancerReplacement
+	if replacement != nil && replacement.Phase == infrav1.APIServerLoadBalancerReplacementDeletingPrevious {
+		done, err := s.completeLoadBalancerReplacement(openStackCluster, clusterResourceName, portList)
+		if err != nil || !done {
+			return false, err
+		}
+	}
+
 	lb, err := s.getOrCreateAPILoadBalancer(openStackCluster, clusterResourceName)
 	if err != nil {
 		if errors.Is(err, capoerrors.ErrFilterMatch) {
@@ -130,6 +206,13 @@ func (s *Service) ReconcileLoadBalancer(openStackCluster *infrav1.OpenStackClust
 	lbStatus.ID = lb.ID
 	lbStatus.InternalIP = lb.VipAddress
 	lbStatus.Tags = lb.Tags
+	if isExistingLoadBalancer(lbSpec) {
+		// Members are reached from the network of the existing load balancer
+		lbStatus.LoadBalancerNetwork = &infrav1.NetworkStatusWithSubnets{
+			NetworkStatus: infrav1.NetworkStatus{ID: lb.VipNetworkID},
+			Subnets:       []infrav1.Subnet{{ID: lb.VipSubnetID}},
+		}
+	}
 
 	if lb.ProvisioningStatus != loadBalancerProvisioningStatusActive {
 		var err error
@@ -146,7 +229,18 @@ func (s *Service) ReconcileLoadBalancer(openStackCluster *infrav1.OpenStackClust
 			return false, err
 		}
 
-		fp, err := s.networkingService.GetOrCreateFloatingIP(openStackCluster, openStackCluster, clusterResourceName, floatingIPAddress)
+		// An existing load balancer may already have a floating IP
+		if floatingIPAddress == nil && isExistingLoadBalancer(lbSpec) {
+			fp, err := s.networkingService.GetFloatingIPByPortID(lb.VipPortID)
+			if err != nil {
+				return false, err
+			}
+			if fp != nil {
+				floatingIPAddress = &fp.FloatingIP
+			}
+		}
+
+		fp, err := s.networkingService.GetOrCreateFloatingIP(openStackCluster, openStackCluster, clusterResourceName, floatingIPAddress, openStackCluster.Spec.APIServer.GetFloatingIPDNS())
 		if err != nil {
 			if errors.Is(err, capoerrors.ErrFilterMatch) {
 				return true, err
@@ -159,30 +253,73 @@ func (s *Service) ReconcileLoadBalancer(openStackCluster *infrav1.OpenStackClust
 		// fails below.
 		lbStatus.IP = fp.FloatingIP
 
-		if err = s.networkingService.AssociateFloatingIP(openStackCluster, fp, lb.VipPortID); err != nil {
-			return false, err
+		// The floating IP may already have been moved to the replacement of
+		// the load balancer
+		replacement := openStackCluster.Status.APIServerLoadBalancerReplacement
+		if replacement == nil || replacement.Phase == infrav1.APIServerLoadBalancerReplacementProvisioning {
+			if err = s.networkingService.AssociateFloatingIP(openStackCluster, fp, lb.VipPortID); err != nil {
+				return false, err
+			}
 		}
 	}
 
-	allowedCIDRsSupported, err := s.isAllowsCIDRSSupported(lb)
+	caps, err := s.getProviderCapabilities(lb.Provider)
 	if err != nil {
 		return false, err
 	}
+	if unsupported := caps.unsupportedFeatures(lbSpec); len(unsupported) > 0 {
+		s.scope.Logger().V(2).Info("Octavia provider does not support all requested features", "provider", lb.Provider, "unsupported", unsupported)
+	}
 
-	// AllowedCIDRs will be nil if allowed CIDRs is not supported by the Octavia provider
-	if allowedCIDRsSupported {
+	// AllowedCIDRs will be nil if allowed CIDRs is not supported by the
+	// Octavia provider, or if the listener is not managed by CAPO
+	if caps.allowedCIDRs && lbSpec.PoolID == nil {
 		lbStatus.AllowedCIDRs = getCanonicalAllowedCIDRs(openStackCluster)
 	} else {
 		lbStatus.AllowedCIDRs = nil
 	}
 
-	portList := make([]int, 0, 1+len(lbSpec.AdditionalPorts))
-	portList = append(portList, apiServerPort)
-	for _, p := range lbSpec.AdditionalPorts {
-		portList = append(portList, int(p))
+	var tlsContainerRef string
+	if isTLSTerminated(lbSpec) && caps.tls && lbSpec.PoolID == nil {
+		tlsContainerRef, err = s.getOrCreateTLSContainer(openStackCluster, loadBalancerName, certificate)
+		if err != nil {
+			return false, err
+		}
 	}
-	for _, port := range portList {
-		if err := s.reconcileAPILoadBalancerListener(lb, openStackCluster, clusterResourceName, port); err != nil {
+
+	if lbSpec.PoolID != nil {
+		// Only the members of an existing pool are managed
+		if err := s.checkExistingPool(lb, lbSpec); err != nil {
+			return false, err
+		}
+	} else {
+		for i, port := range portList {
+			spec := caps.adjustListenerSpec(getListenerSpec(lbSpec, i == 0, tlsContainerRef))
+			if err := s.reconcileAPILoadBalancerListener(lb, openStackCluster, loadBalancerName, port, spec, lbStatus.AllowedCIDRs); err != nil {
+				return false, err
+			}
+		}
+	}
+
+	// Zonal load balancers use the same certificate, so they are reconciled
+	// before replaced certificates are deleted
+	err = s.reconcileZonalLoadBalancers(openStackCluster, clusterResourceName, portList, certificate)
+	lbStatus.Addresses = getAPILoadBalancerAddresses(lbStatus)
+	if err != nil {
+		return false, err
+	}
+
+	// Delete certificates which were replaced once the listener no longer uses them
+	if tlsContainerRef != "" {
+		if err := s.deleteTLSContainers(openStackCluster, loadBalancerName, tlsContainerRef); err != nil {
+			return false, err
+		}
+	}
+
+	// An existing load balancer is not managed by CAPO, so it is never
+	// replaced
+	if !isExistingLoadBalancer(lbSpec) {
+		if err := s.reconcileLoadBalancerReplacement(openStackCluster, clusterResourceName, lb, caps, portList, certificate); err != nil {
 			return false, err
 		}
 	}
@@ -190,6 +327,17 @@ func (s *Service) ReconcileLoadBalancer(openStackCluster *infrav1.OpenStackClust
 	return false, nil
 }
 
+// getAPILoadBalancerPorts returns the ports of the listeners of the API
+// server load balancer. The API server port is always first.
+func getAPILoadBalancerPorts(lbSpec *infrav1.APIServerLoadBalancer, apiServerPort int) []int {
+	portList := make([]int, 0, 1+len(lbSpec.AdditionalPorts))
+	portList = append(portList, apiServerPort)
+	for _, p := range lbSpec.AdditionalPorts {
+		portList = append(portList, int(p))
+	}
+	return portList
+}
+
 // getAPIServerVIPAddress gets the VIP address for the API server from wherever it is specified.
 // Returns an empty string if the VIP address is not specified and it should be allocated automatically.
 func getAPIServerVIPAddress(openStackCluster *infrav1.OpenStackCluster) (*string, error) {
@@ -289,20 +437,12 @@ func getCanonicalAllowedCIDRs(openStackCluster *infrav1.OpenStackCluster) []stri
 	return capostrings.Canonicalize(validCIDRs)
 }
 
-// isAllowsCIDRSSupported returns true if Octavia supports allowed CIDRs for the loadbalancer provider in use.
-func (s *Service) isAllowsCIDRSSupported(lb *loadbalancers.LoadBalancer) (bool, error) {
-	octaviaVersions, err := s.loadbalancerClient.ListOctaviaVersions()
-	if err != nil {
-		return false, err
-	}
-	// The current version is always the last one in the list.
-	octaviaVersion := octaviaVersions[len(octaviaVersions)-1].ID
-
-	return openstackutil.IsOctaviaFeatureSupported(octaviaVersion, openstackutil.OctaviaFeatureVIPACL, lb.Provider), nil
-}
-
 // getOrCreateAPILoadBalancer returns an existing API loadbalancer if it already exists, or creates a new one if it does not.
 func (s *Service) getOrCreateAPILoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string) (*loadbalancers.LoadBalancer, error) {
+	if isExistingLoadBalancer(openStackCluster.Spec.APIServer.GetManagedLoadBalancer()) {
+		return s.getExistingAPILoadBalancer(openStackCluster, clusterResourceName)
+	}
+
 	loadBalancerName := getLoadBalancerName(clusterResourceName)
 	lb, err := s.checkIfLbExists(loadBalancerName)
 	if err != nil {
@@ -312,6 +452,22 @@ func (s *Service) getOrCreateAPILoadBalancer(openStackCluster *infrav1.OpenStack
 		return lb, nil
 	}
 
+	vipAddress, err := getAPIServerVIPAddress(openStackCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	var availabilityZone optional.String
+	if lbSpec := openStackCluster.Spec.APIServer.GetManagedLoadBalancer(); lbSpec != nil {
+		availabilityZone = lbSpec.AvailabilityZone
+	}
+	return s.createAPILoadBalancer(openStackCluster, clusterResourceName, loadBalancerName, vipAddress, availabilityZone)
+}
+
+// createAPILoadBalancer creates a load balancer for the API server with the
+// given name in the given availability zone, and with the given VIP address
+// if it is not nil.
+func (s *Service) createAPILoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName, loadBalancerName string, vipAddress *string, availabilityZone optional.String) (*loadbalancers.LoadBalancer, error) {
 	if openStackCluster.Status.Network == nil {
 		return nil, fmt.Errorf("network is not yet available in OpenStackCluster.Status")
 	}
@@ -350,73 +506,32 @@ func (s *Service) getOrCreateAPILoadBalancer(openStackCluster *infrav1.OpenStack
 		s.scope.Logger().V(2).Info("Creating load balancer in subnet", "subnetID", vipSubnetID, "name", loadBalancerName)
 	}
 
-	providers, err := s.loadbalancerClient.ListLoadBalancerProviders()
-	if err != nil {
-		return nil, err
-	}
-
 	// Choose the selected provider and flavor if set in cluster spec, if not, omit these fields and Octavia will use the default values.
-	lbProvider := ""
-	lbFlavorID := ""
-	var availabilityZone *string
+	var provider, flavor optional.String
 	if lbSpec := openStackCluster.Spec.APIServer.GetManagedLoadBalancer(); lbSpec != nil {
-		if lbSpec.Provider != nil {
-			for _, v := range providers {
-				if v.Name == *lbSpec.Provider {
-					lbProvider = v.Name
-					break
-				}
-			}
-			if lbProvider == "" {
-				record.Warnf(openStackCluster, "OctaviaProviderNotFound", "Provider specified for Octavia not found.")
-				record.Eventf(openStackCluster, "OctaviaProviderNotFound", "Provider %s specified for Octavia not found, using the default provider.", lbSpec.Provider)
-			}
-		}
-		if lbSpec.Flavor != nil {
-			// Gophercloud does not support filtering loadbalancer flavors by name and status (enabled) so we have to get all available flavors
-			// and filter them localy. There is a feature request in Gophercloud to implement this functionality:
-			// https://github.com/gophercloud/gophercloud/v2/issues/3049
-			flavors, err := s.loadbalancerClient.ListLoadBalancerFlavors()
-			if err != nil {
-				return nil, err
-			}
-
-			for _, v := range flavors {
-				if v.Enabled && v.Name == *lbSpec.Flavor {
-					lbFlavorID = v.ID
-					break
-				}
-			}
-			if lbFlavorID == "" {
-				record.Warnf(openStackCluster, "OctaviaFlavorNotFound", "Flavor %s specified for Octavia not found, using the default flavor.", *lbSpec.Flavor)
-			}
-		}
-
-		availabilityZone = lbSpec.AvailabilityZone
+		provider = lbSpec.Provider
+		flavor = lbSpec.Flavor
 	}
-
-	vipAddress, err := getAPIServerVIPAddress(openStackCluster)
+	lbProvider, lbFlavorID, lbAvailabilityZone, err := s.getProviderAndFlavor(openStackCluster, provider, flavor, availabilityZone)
 	if err != nil {
 		return nil, err
 	}
 
 	lbCreateOpts := loadbalancers.CreateOpts{
-		Name:         loadBalancerName,
-		VipSubnetID:  vipSubnetID,
-		VipNetworkID: vipNetworkID,
-		Description:  names.GetDescription(clusterResourceName),
-		Provider:     lbProvider,
-		FlavorID:     lbFlavorID,
-		Tags:         openStackCluster.Spec.Tags,
-	}
-	if availabilityZone != nil {
-		lbCreateOpts.AvailabilityZone = *availabilityZone
+		Name:             loadBalancerName,
+		VipSubnetID:      vipSubnetID,
+		VipNetworkID:     vipNetworkID,
+		Description:      names.GetDescription(clusterResourceName),
+		Provider:         lbProvider,
+		FlavorID:         lbFlavorID,
+		AvailabilityZone: lbAvailabilityZone,
+		Tags:             openStackCluster.Spec.Tags,
 	}
 	if vipAddress != nil {
 		lbCreateOpts.VipAddress = *vipAddress
 	}
 
-	lb, err = s.loadbalancerClient.CreateLoadBalancer(lbCreateOpts)
+	lb, err := s.loadbalancerClient.CreateLoadBalancer(lbCreateOpts)
 	if err != nil {
 		record.Warnf(openStackCluster, "FailedCreateLoadBalancer", "Failed to create load balancer %s: %v", loadBalancerName, err)
 		return nil, err
@@ -426,27 +541,96 @@ func (s *Service) getOrCreateAPILoadBalancer(openStackCluster *infrav1.OpenStack
 	return lb, nil
 }
 
-// reconcileAPILoadBalancerListener ensures that the listener on the given port exists and is configured correctly.
-func (s *Service) reconcileAPILoadBalancerListener(lb *loadbalancers.LoadBalancer, openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, port int) error {
-	loadBalancerName := getLoadBalancerName(clusterResourceName)
-	lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, port)
+// getProviderAndFlavor returns the name of the Octavia provider, the ID of
+// the Octavia flavor and the availability zone to create a load balancer
+// with. Each is empty if it is not specified, not found or not supported by
+// the provider, in which case Octavia uses its default.
+func (s *Service) getProviderAndFlavor(openStackCluster *infrav1.OpenStackCluster, provider, flavor, availabilityZone optional.String) (string, string, string, error) {
+	providers, err := s.getLoadBalancerProviders()
+	if err != nil {
+		return "", "", "", err
+	}
 
-	if openStackCluster.Status.APIServerManagedLoadBalancer == nil {
-		return fmt.Errorf("APIServerLoadBalancer is not yet available in OpenStackCluster.Status")
+	lbProvider := ""
+	if provider != nil {
+		for _, v := range providers {
+			if v.Name == *provider {
+				lbProvider = v.Name
+				break
+			}
+		}
+		if lbProvider == "" {
+			record.Warnf(openStackCluster, "OctaviaProviderNotFound", "Provider specified for Octavia not found.")
+			record.Eventf(openStackCluster, "OctaviaProviderNotFound", "Provider %s specified for Octavia not found, using the default provider.", *provider)
+		}
+	}
+
+	// The capabilities of the default provider are not known until the load
+	// balancer has been created
+	if lbProvider != "" && (flavor != nil || availabilityZone != nil) {
+		caps, err := s.getProviderCapabilities(lbProvider)
+		if err != nil {
+			return "", "", "", err
+		}
+		if flavor != nil && !caps.flavors {
+			record.Warnf(openStackCluster, "OctaviaFlavorNotSupported", "Provider %s does not support flavors, ignoring flavor %s.", lbProvider, *flavor)
+			flavor = nil
+		}
+		if availabilityZone != nil && !caps.availabilityZones {
+			record.Warnf(openStackCluster, "OctaviaAvailabilityZoneNotSupported", "Provider %s does not support availability zones, ignoring availability zone %s.", lbProvider, *availabilityZone)
+			availabilityZone = nil
+		}
+	}
+
+	lbFlavorID := ""
+	if flavor != nil {
+		// Gophercloud does not support filtering loadbalancer flavors by name and status (enabled) so we have to get all available flavors
+		// and filter them localy. There is a feature request in Gophercloud to implement this functionality:
+		// https://github.com/gophercloud/gophercloud/v2/issues/3049
+		flavors, err := s.loadbalancerClient.ListLoadBalancerFlavors()
+		if err != nil {
+			return "", "", "", err
+		}
+
+		for _, v := range flavors {
+			if v.Enabled && v.Name == *flavor {
+				lbFlavorID = v.ID
+				break
+			}
+		}
+		if lbFlavorID == "" {
+			record.Warnf(openStackCluster, "OctaviaFlavorNotFound", "Flavor %s specified for Octavia not found, using the default flavor.", *flavor)
+		}
 	}
 
-	allowedCIDRs := openStackCluster.Status.APIServerManagedLoadBalancer.AllowedCIDRs
+	return lbProvider, lbFlavorID, ptr.Deref(availabilityZone, ""), nil
+}
+
+// reconcileAPILoadBalancerListener ensures that the listener on the given port exists and is configured correctly.
+// allowedCIDRs is nil if the Octavia provider does not support allowed CIDRs.
+func (s *Service) reconcileAPILoadBalancerListener(lb *loadbalancers.LoadBalancer, openStackCluster *infrav1.OpenStackCluster, loadBalancerName string, port int, spec listenerSpec, allowedCIDRs []string) error {
+	lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, port)
 
-	listener, err := s.getOrCreateListener(openStackCluster, lbPortObjectsName, lb.ID, allowedCIDRs, port)
+	listener, err := s.getOrCreateListener(openStackCluster, lbPortObjectsName, lb.ID, allowedCIDRs, port, spec)
 	if err != nil {
 		return err
 	}
 
-	pool, err := s.getOrCreatePool(openStackCluster, lbPortObjectsName, listener.ID, lb.ID, lb.Provider)
+	pool, err := s.getOrCreatePool(openStackCluster, lbPortObjectsName, listener.ID, lb.ID, spec)
 	if err != nil {
 		return err
 	}
-	if err := s.ensureMonitor(openStackCluster, lbPortObjectsName, pool.ID, lb.ID); err != nil {
+	if err := s.ensureMonitor(openStackCluster, lbPortObjectsName, pool.ID, lb.ID, spec.monitor); err != nil {
+		return err
+	}
+
+	if spec.tlsContainerRef != "" {
+		if err := s.getOrUpdateTLSContainerRef(openStackCluster, listener, spec.tlsContainerRef); err != nil {
+			return err
+		}
+	}
+
+	if err := s.getOrUpdateListenerOptions(openStackCluster, listener, spec); err != nil {
 		return err
 	}
 
@@ -463,7 +647,7 @@ func (s *Service) reconcileAPILoadBalancerListener(lb *loadbalancers.LoadBalance
 
 // getOrCreateListener returns an existing listener for the given loadbalancer
 // and port if it already exists, or creates a new one if it does not.
-func (s *Service) getOrCreateListener(openStackCluster *infrav1.OpenStackCluster, listenerName, lbID string, allowedCIDRs []string, port int) (*listeners.Listener, error) {
+func (s *Service) getOrCreateListener(openStackCluster *infrav1.OpenStackCluster, listenerName, lbID string, allowedCIDRs []string, port int, spec listenerSpec) (*listeners.Listener, error) {
 	listener, err := s.checkIfListenerExists(listenerName)
 	if err != nil {
 		return nil, err
@@ -476,13 +660,15 @@ func (s *Service) getOrCreateListener(openStackCluster *infrav1.OpenStackCluster
 	s.scope.Logger().V(2).Info("Creating load balancer listener", "name", listenerName, "loadBalancerID", lbID)
 
 	listenerCreateOpts := listeners.CreateOpts{
-		Name:           listenerName,
-		Protocol:       loadBalancerProtocolTCP,
-		ProtocolPort:   port,
-		LoadbalancerID: lbID,
-		Tags:           openStackCluster.Spec.Tags,
-		AllowedCIDRs:   allowedCIDRs,
-	}
+		Name:                   listenerName,
+		Protocol:               spec.listenerProtocol,
+		ProtocolPort:           port,
+		LoadbalancerID:         lbID,
+		Tags:                   openStackCluster.Spec.Tags,
+		AllowedCIDRs:           allowedCIDRs,
+		DefaultTlsContainerRef: spec.tlsContainerRef,
+	}
+	setListenerCreateOptions(&listenerCreateOpts, spec)
 	listener, err = s.loadbalancerClient.CreateListener(listenerCreateOpts)
 	if err != nil {
 		record.Warnf(openStackCluster, "FailedCreateListener", "Failed to create listener %s: %v", listenerName, err)
@@ -531,7 +717,7 @@ func (s *Service) getOrUpdateAllowedCIDRs(openStackCluster *infrav1.OpenStackClu
 	return nil
 }
 
-func (s *Service) getOrCreatePool(openStackCluster *infrav1.OpenStackCluster, poolName, listenerID, lbID string, lbProvider string) (*pools.Pool, error) {
+func (s *Service) getOrCreatePool(openStackCluster *infrav1.OpenStackCluster, poolName, listenerID, lbID string, spec listenerSpec) (*pools.Pool, error) {
 	pool, err := s.checkIfPoolExists(poolName)
 	if err != nil {
 		return nil, err
@@ -543,18 +729,13 @@ func (s *Service) getOrCreatePool(openStackCluster *infrav1.OpenStackCluster, po
 
 	s.scope.Logger().V(2).Info("Creating load balancer pool for listener", "loadBalancerID", lbID, "listenerID", listenerID, "name", poolName)
 
-	method := pools.LBMethodRoundRobin
-
-	if lbProvider == "ovn" {
-		method = pools.LBMethodSourceIpPort
-	}
-
 	poolCreateOpts := pools.CreateOpts{
 		Name:       poolName,
-		Protocol:   loadBalancerProtocolTCP,
-		LBMethod:   method,
+		Protocol:   spec.poolProtocol,
+		LBMethod:   cmp.Or(spec.lbMethod, pools.LBMethodRoundRobin),
 		ListenerID: listenerID,
 		Tags:       openStackCluster.Spec.Tags,
+		TLSEnabled: spec.poolTLSEnabled,
 	}
 	pool, err = s.loadbalancerClient.CreatePool(poolCreateOpts)
 	if err != nil {
@@ -571,23 +752,30 @@ func (s *Service) getOrCreatePool(openStackCluster *infrav1.OpenStackCluster, po
 	return pool, nil
 }
 
-func (s *Service) ensureMonitor(openStackCluster *infrav1.OpenStackCluster, monitorName, poolID, lbID string) error {
-	var cfg infrav1.APIServerLoadBalancerMonitor
-
-	if lbSpec := openStackCluster.Spec.APIServer.GetManagedLoadBalancer(); lbSpec != nil && lbSpec.Monitor != nil {
-		cfg = *lbSpec.Monitor
-	}
-
-	cfg.Delay = cmp.Or(cfg.Delay, defaultMonitorDelay)
-	cfg.Timeout = cmp.Or(cfg.Timeout, defaultMonitorTimeout)
-	cfg.MaxRetries = cmp.Or(cfg.MaxRetries, defaultMonitorMaxRetries)
-	cfg.MaxRetriesDown = cmp.Or(cfg.MaxRetriesDown, defaultMonitorMaxRetriesDown)
-
+func (s *Service) ensureMonitor(openStackCluster *infrav1.OpenStackCluster, monitorName, poolID, lbID string, cfg infrav1.APIServerLoadBalancerMonitor) error {
 	monitor, err := s.checkIfMonitorExists(monitorName)
 	if err != nil {
 		return err
 	}
 
+	// The type of a monitor cannot be updated, so it must be replaced
+	if monitor != nil && monitor.Type != string(cfg.Type) {
+		s.scope.Logger().V(2).Info("Deleting load balancer monitor to change its type", "loadBalancerID", lbID, "name", monitorName, "monitorID", monitor.ID, "current", monitor.Type, "desired", cfg.Type)
+
+		if err := s.loadbalancerClient.DeleteMonitor(monitor.ID); err != nil && !capoerrors.IsNotFound(err) {
+			record.Warnf(openStackCluster, "FailedDeleteMonitor", "Failed to delete monitor %s with id %s: %v", monitorName, monitor.ID, err)
+			return err
+		}
+
+		if _, err = s.waitForLoadBalancerActive(lbID); err != nil {
+			record.Warnf(openStackCluster, "FailedDeleteMonitor", "Failed to delete monitor %s with id %s: wait for load balancer active %s: %v", monitorName, monitor.ID, lbID, err)
+			return err
+		}
+
+		record.Eventf(openStackCluster, "SuccessfulDeleteMonitor", "Deleted %s monitor %s with id %s to replace it with a %s monitor", monitor.Type, monitorName, monitor.ID, cfg.Type)
+		monitor = nil
+	}
+
 	if monitor != nil {
 		needsUpdate := false
 		monitorUpdateOpts := monitors.UpdateOpts{}
@@ -616,6 +804,18 @@ func (s *Service) ensureMonitor(openStackCluster *infrav1.OpenStackCluster, moni
 			needsUpdate = true
 		}
 
+		if monitor.URLPath != cfg.URLPath && cfg.URLPath != "" {
+			s.scope.Logger().V(3).Info("Monitor urlPath needs update", "current", monitor.URLPath, "desired", cfg.URLPath)
+			monitorUpdateOpts.URLPath = cfg.URLPath
+			needsUpdate = true
+		}
+
+		if monitor.ExpectedCodes != cfg.ExpectedCodes && cfg.ExpectedCodes != "" {
+			s.scope.Logger().V(3).Info("Monitor expectedCodes needs update", "current", monitor.ExpectedCodes, "desired", cfg.ExpectedCodes)
+			monitorUpdateOpts.ExpectedCodes = cfg.ExpectedCodes
+			needsUpdate = true
+		}
+
 		if needsUpdate {
 			s.scope.Logger().V(2).Info("Updating load balancer monitor", "loadBalancerID", lbID, "name", monitorName, "monitorID", monitor.ID)
 
@@ -638,15 +838,21 @@ func (s *Service) ensureMonitor(openStackCluster *infrav1.OpenStackCluster, moni
 
 	s.scope.Logger().V(2).Info("Creating load balancer monitor for pool", "loadBalancerID", lbID, "name", monitorName, "poolID", poolID)
 
-	monitor, err = s.loadbalancerClient.CreateMonitor(monitors.CreateOpts{
+	monitorCreateOpts := monitors.CreateOpts{
 		Name:           monitorName,
 		PoolID:         poolID,
-		Type:           loadBalancerProtocolTCP,
+		Type:           string(cfg.Type),
 		Delay:          int(cfg.Delay),
 		Timeout:        int(cfg.Timeout),
 		MaxRetries:     int(cfg.MaxRetries),
 		MaxRetriesDown: int(cfg.MaxRetriesDown),
-	})
+	}
+	if cfg.Type == infrav1.APIServerLoadBalancerMonitorHTTPS {
+		monitorCreateOpts.HTTPMethod = "GET"
+		monitorCreateOpts.URLPath = cfg.URLPath
+		monitorCreateOpts.ExpectedCodes = cfg.ExpectedCodes
+	}
+	monitor, err = s.loadbalancerClient.CreateMonitor(monitorCreateOpts)
 	if err != nil {
 		if capoerrors.IsNotImplementedError(err) {
 			record.Warnf(openStackCluster, "SkippedCreateMonitor", "Health Monitor is not created as it's not implemented with the current Octavia provider.")
@@ -666,7 +872,10 @@ func (s *Service) ensureMonitor(openStackCluster *infrav1.OpenStackCluster, moni
 	return nil
 }
 
-func (s *Service) ReconcileLoadBalancerMember(openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, clusterResourceName, ip string) error {
+// ReconcileLoadBalancerMember adds a control plane machine to the pools of
+// the API server load balancer, and to those of the zonal load balancer of
+// availabilityZone, the availability zone of the machine.
+func (s *Service) ReconcileLoadBalancerMember(openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, clusterResourceName, availabilityZone, ip string) error {
 	if openStackCluster.Status.Network == nil {
 		return errors.New("network is not yet available in openStackCluster.Status")
 	}
@@ -700,7 +909,7 @@ func (s *Service) ReconcileLoadBalancerMember(openStackCluster *infrav1.OpenStac
 		lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, port)
 		name := lbPortObjectsName + "-" + openStackMachine.Name
 
-		pool, err := s.checkIfPoolExists(lbPortObjectsName)
+		pool, err := s.getAPILoadBalancerPool(openStackCluster.Spec.APIServer.GetManagedLoadBalancer(), lbPortObjectsName)
 		if err != nil {
 			return err
 		}
@@ -771,10 +980,44 @@ func (s *Service) ReconcileLoadBalancerMember(openStackCluster *infrav1.OpenStac
 			return err
 		}
 	}
-	return nil
+
+	return s.reconcileZonalLoadBalancerMember(openStackCluster, openStackMachine, clusterResourceName, availabilityZone, ip, portList)
 }
 
 func (s *Service) DeleteLoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string) (result *ctrl.Result, reterr error) {
+	// Delete the replacement of the load balancer if the cluster is deleted
+	// while the load balancer is being replaced
+	replacementLB, err := s.checkIfLbExists(getReplacementLoadBalancerName(clusterResourceName))
+	if err != nil {
+		return nil, err
+	}
+	if replacementLB != nil {
+		if replacementLB.ProvisioningStatus != loadBalancerProvisioningStatusPendingDelete {
+			if err := s.deleteLoadBalancer(openStackCluster, replacementLB, openStackCluster.Spec.APIServer.GetFloatingIP()); err != nil {
+				return nil, err
+			}
+		}
+		result = &ctrl.Result{RequeueAfter: waitForOctaviaLBCleanup}
+	}
+
+	for _, zone := range getZonalLoadBalancerZones(openStackCluster) {
+		deleted, err := s.deleteZonalLoadBalancer(openStackCluster, clusterResourceName, zone)
+		if err != nil {
+			return nil, err
+		}
+		if !deleted {
+			result = &ctrl.Result{RequeueAfter: waitForOctaviaLBCleanup}
+		}
+	}
+
+	// Only what was created on an existing load balancer is deleted
+	if isExistingLoadBalancer(openStackCluster.Spec.APIServer.GetManagedLoadBalancer()) {
+		if err := s.deleteExistingAPILoadBalancerObjects(openStackCluster, clusterResourceName); err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
 	loadBalancerName := getLoadBalancerName(clusterResourceName)
 	lb, err := s.checkIfLbExists(loadBalancerName)
 	if err != nil {
@@ -782,6 +1025,16 @@ func (s *Service) DeleteLoadBalancer(openStackCluster *infrav1.OpenStackCluster,
 	}
 
 	if lb == nil {
+		if result != nil {
+			return result, nil
+		}
+
+		// Certificates can only be deleted once the listener using them is gone
+		if isTLSTerminated(openStackCluster.Spec.APIServer.GetManagedLoadBalancer()) {
+			if err := s.deleteTLSContainers(openStackCluster, loadBalancerName, ""); err != nil {
+				return nil, err
+			}
+		}
 		return nil, nil
 	}
 
@@ -793,24 +1046,36 @@ func (s *Service) DeleteLoadBalancer(openStackCluster *infrav1.OpenStackCluster,
 		return &ctrl.Result{RequeueAfter: waitForOctaviaLBCleanup}, nil
 	}
 
+	if err := s.deleteLoadBalancer(openStackCluster, lb, openStackCluster.Spec.APIServer.GetFloatingIP()); err != nil {
+		return nil, err
+	}
+
+	// If we have reached this point, that means that the load balancer wasn't initially deleted but the request to delete it didn't return an error.
+	// So we want to requeue until the load balancer and its associated ports are actually deleted.
+	return &ctrl.Result{RequeueAfter: waitForOctaviaLBCleanup}, nil
+}
+
+// deleteLoadBalancer deletes a load balancer with everything it contains,
+// and the floating IP associated with its VIP unless it is userFloatingIP.
+func (s *Service) deleteLoadBalancer(openStackCluster *infrav1.OpenStackCluster, lb *loadbalancers.LoadBalancer, userFloatingIP *string) error {
 	if lb.VipPortID != "" {
 		fip, err := s.networkingService.GetFloatingIPByPortID(lb.VipPortID)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		if fip != nil && fip.FloatingIP != "" {
 			if err = s.networkingService.DisassociateFloatingIP(openStackCluster, fip.FloatingIP); err != nil {
-				return nil, err
+				return err
 			}
 
 			// If the floating is user-provider (BYO floating IP), don't delete it.
-			if floatingIP := openStackCluster.Spec.APIServer.GetFloatingIP(); floatingIP == nil || *floatingIP != fip.FloatingIP {
+			if userFloatingIP == nil || *userFloatingIP != fip.FloatingIP {
 				if err = s.networkingService.DeleteFloatingIP(openStackCluster, fip.FloatingIP); err != nil {
-					return nil, err
+					return err
 				}
 			} else {
-				s.scope.Logger().V(3).Info("Skipping load balancer floating IP deletion as it's a user-provided resource", "name", loadBalancerName, "floatingIP", fip.FloatingIP)
+				s.scope.Logger().V(3).Info("Skipping load balancer floating IP deletion as it's a user-provided resource", "name", lb.Name, "floatingIP", fip.FloatingIP)
 			}
 		}
 	}
@@ -818,18 +1083,15 @@ func (s *Service) DeleteLoadBalancer(openStackCluster *infrav1.OpenStackCluster,
 	deleteOpts := loadbalancers.DeleteOpts{
 		Cascade: true,
 	}
-	s.scope.Logger().V(2).Info("Deleting load balancer", "name", loadBalancerName, "cascade", deleteOpts.Cascade)
-	err = s.loadbalancerClient.DeleteLoadBalancer(lb.ID, deleteOpts)
+	s.scope.Logger().V(2).Info("Deleting load balancer", "name", lb.Name, "cascade", deleteOpts.Cascade)
+	err := s.loadbalancerClient.DeleteLoadBalancer(lb.ID, deleteOpts)
 	if err != nil && !capoerrors.IsNotFound(err) {
 		record.Warnf(openStackCluster, "FailedDeleteLoadBalancer", "Failed to delete load balancer %s with id %s: %v", lb.Name, lb.ID, err)
-		return nil, err
+		return err
 	}
 
 	record.Eventf(openStackCluster, "SuccessfulDeleteLoadBalancer", "Deleted load balancer %s with id %s", lb.Name, lb.ID)
-
-	// If we have reached this point, that means that the load balancer wasn't initially deleted but the request to delete it didn't return an error.
-	// So we want to requeue until the load balancer and its associated ports are actually deleted.
-	return &ctrl.Result{RequeueAfter: waitForOctaviaLBCleanup}, nil
+	return nil
 }
 
 func (s *Service) DeleteLoadBalancerMember(openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, clusterResourceName string) error {
@@ -837,8 +1099,22 @@ func (s *Service) DeleteLoadBalancerMember(openStackCluster *infrav1.OpenStackCl
 		return errors.New("openStackMachine is nil")
 	}
 
+	var portList []int
+	if openStackCluster.Spec.ControlPlaneEndpoint != nil {
+		portList = append(portList, int(openStackCluster.Spec.ControlPlaneEndpoint.Port))
+	}
+	if lbSpec := openStackCluster.Spec.APIServer.GetManagedLoadBalancer(); lbSpec != nil {
+		for _, p := range lbSpec.AdditionalPorts {
+			portList = append(portList, int(p))
+		}
+	}
+
+	if err := s.deleteZonalLoadBalancerMembers(openStackMachine, clusterResourceName, getZonalLoadBalancerZones(openStackCluster), portList); err != nil {
+		return err
+	}
+
 	loadBalancerName := getLoadBalancerName(clusterResourceName)
-	lb, err := s.checkIfLbExists(loadBalancerName)
+	lb, err := s.getAPILoadBalancer(openStackCluster, clusterResourceName)
 	if err != nil {
 		return err
 	}
@@ -849,20 +1125,11 @@ func (s *Service) DeleteLoadBalancerMember(openStackCluster *infrav1.OpenStackCl
 
 	lbID := lb.ID
 
-	var portList []int
-	if openStackCluster.Spec.ControlPlaneEndpoint != nil {
-		portList = append(portList, int(openStackCluster.Spec.ControlPlaneEndpoint.Port))
-	}
-	if lbSpec := openStackCluster.Spec.APIServer.GetManagedLoadBalancer(); lbSpec != nil {
-		for _, p := range lbSpec.AdditionalPorts {
-			portList = append(portList, int(p))
-		}
-	}
 	for _, port := range portList {
 		lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, port)
 		name := lbPortObjectsName + "-" + openStackMachine.Name
 
-		pool, err := s.checkIfPoolExists(lbPortObjectsName)
+		pool, err := s.getAPILoadBalancerPool(openStackCluster.Spec.APIServer.GetManagedLoadBalancer(), lbPortObjectsName)
 		if err != nil {
 			return err
 		}
@@ -962,10 +1229,17 @@ var backoff = wait.Backoff{
 
 // Possible LoadBalancer states are documented here: https://docs.openstack.org/api-ref/load-balancer/v2/index.html#prov-status
 func (s *Service) waitForLoadBalancerActive(id string) (*loadbalancers.LoadBalancer, error) {
-	var lb *loadbalancers.LoadBalancer
-
 	s.scope.Logger().V(3).Info("Waiting for load balancer", "id", id, "targetStatus", "ACTIVE")
-	err := wait.ExponentialBackoff(backoff, func() (bool, error) {
+	lb, err := s.loadbalancerClient.GetLoadBalancer(id)
+	if err != nil {
+		return nil, err
+	}
+	if lb.ProvisioningStatus == loadBalancerProvisioningStatusActive {
+		return lb, nil
+	}
+
+	// How long a change takes depends on the provider of the load balancer
+	err = wait.ExponentialBackoff(getBackoff(lb.Provider), func() (bool, error) {
 		var err error
 		lb, err = s.loadbalancerClient.GetLoadBalancer(id)
 		if err != nil {
@@ -126,6 +126,7 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 					{ID: "2.22"},
 				}
 				m.ListOctaviaVersions().Return(versions, nil)
+				m.ListLoadBalancerProviders().Return([]providers.Provider{{Name: "amphora"}}, nil)
 
 				listenerList := []listeners.Listener{
 					{
@@ -148,6 +149,7 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 					{
 						ID:             "aaaaaaaa-bbbb-cccc-dddd-666666666666",
 						Name:           "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
+						Type:           "TCP",
 						Delay:          10,
 						Timeout:        5,
 						MaxRetries:     5,
@@ -233,6 +235,7 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 					{ID: "2.22"},
 				}
 				m.ListOctaviaVersions().Return(versions, nil)
+				m.ListLoadBalancerProviders().Return([]providers.Provider{{Name: "amphora"}}, nil)
 
 				listenerList := []listeners.Listener{
 					{
@@ -254,6 +257,7 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 				existingMonitor := monitors.Monitor{
 					ID:             "aaaaaaaa-bbbb-cccc-dddd-666666666666",
 					Name:           "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
+					Type:           "TCP",
 					Delay:          10,
 					Timeout:        5,
 					MaxRetries:     5,
@@ -336,6 +340,7 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 					{ID: "2.22"},
 				}
 				m.ListOctaviaVersions().Return(versions, nil)
+				m.ListLoadBalancerProviders().Return([]providers.Provider{{Name: "amphora"}}, nil)
 
 				listenerList := []listeners.Listener{
 					{
@@ -357,6 +362,7 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 				existingMonitor := monitors.Monitor{
 					ID:             "aaaaaaaa-bbbb-cccc-dddd-666666666666",
 					Name:           "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
+					Type:           "TCP",
 					Delay:          10,
 					Timeout:        5,
 					MaxRetries:     5,
@@ -431,6 +437,7 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 					{ID: "2.22"},
 				}
 				m.ListOctaviaVersions().Return(versions, nil)
+				m.ListLoadBalancerProviders().Return([]providers.Provider{{Name: "amphora"}}, nil)
 
 				listenerList := []listeners.Listener{
 					{
@@ -479,6 +486,113 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 			},
 			wantError: nil,
 		},
+		{
+			name: "should replace monitor when its type changes",
+			clusterSpec: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServer: &infrav1.APIServer{
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled: ptr.To(true),
+							Monitor: &infrav1.APIServerLoadBalancerMonitor{
+								Type:    infrav1.APIServerLoadBalancerMonitorHTTPS,
+								URLPath: "/livez",
+							},
+						},
+						EnableFloatingIP: ptr.To(false),
+					},
+					ControlPlaneEndpoint: &clusterv1.APIEndpoint{
+						Host: apiHostname,
+						Port: 6443,
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					ExternalNetwork: &infrav1.NetworkStatus{
+						ID: "aaaaaaaa-bbbb-cccc-dddd-111111111111",
+					},
+					Network: &infrav1.NetworkStatusWithSubnets{
+						Subnets: []infrav1.Subnet{
+							{ID: "aaaaaaaa-bbbb-cccc-dddd-222222222222"},
+						},
+					},
+				},
+			},
+			expectNetwork: func(*mock.MockNetworkClientMockRecorder) {
+				// add network api call results here
+			},
+			expectLoadBalancer: func(m *mock.MockLbClientMockRecorder) {
+				activeLB := loadbalancers.LoadBalancer{
+					ID:                 "aaaaaaaa-bbbb-cccc-dddd-333333333333",
+					Name:               "k8s-clusterapi-cluster-AAAAA-kubeapi",
+					ProvisioningStatus: "ACTIVE",
+				}
+
+				// return existing loadbalancer in active state
+				lbList := []loadbalancers.LoadBalancer{activeLB}
+				m.ListLoadBalancers(loadbalancers.ListOpts{Name: activeLB.Name}).Return(lbList, nil)
+
+				// return octavia versions
+				versions := []apiversions.APIVersion{
+					{ID: "2.24"},
+					{ID: "2.23"},
+					{ID: "2.22"},
+				}
+				m.ListOctaviaVersions().Return(versions, nil)
+				m.ListLoadBalancerProviders().Return([]providers.Provider{{Name: "amphora"}}, nil)
+
+				listenerList := []listeners.Listener{
+					{
+						ID:   "aaaaaaaa-bbbb-cccc-dddd-444444444444",
+						Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
+					},
+				}
+				m.ListListeners(listeners.ListOpts{Name: listenerList[0].Name}).Return(listenerList, nil)
+
+				poolList := []pools.Pool{
+					{
+						ID:   "aaaaaaaa-bbbb-cccc-dddd-555555555555",
+						Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
+					},
+				}
+				m.ListPools(pools.ListOpts{Name: poolList[0].Name}).Return(poolList, nil)
+
+				// existing monitor is a TCP monitor
+				existingMonitor := monitors.Monitor{
+					ID:             "aaaaaaaa-bbbb-cccc-dddd-666666666666",
+					Name:           "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
+					Type:           "TCP",
+					Delay:          10,
+					Timeout:        5,
+					MaxRetries:     5,
+					MaxRetriesDown: 3,
+				}
+				m.ListMonitors(monitors.ListOpts{Name: existingMonitor.Name}).Return([]monitors.Monitor{existingMonitor}, nil)
+
+				// The type cannot be updated, so the monitor is deleted and recreated
+				m.DeleteMonitor(existingMonitor.ID).Return(nil)
+				m.GetLoadBalancer(activeLB.ID).Return(&activeLB, nil)
+
+				createOpts := monitors.CreateOpts{
+					Name:           "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
+					PoolID:         "aaaaaaaa-bbbb-cccc-dddd-555555555555",
+					Type:           "HTTPS",
+					Delay:          10,
+					Timeout:        5,
+					MaxRetries:     5,
+					MaxRetriesDown: 3,
+					HTTPMethod:     "GET",
+					URLPath:        "/livez",
+					ExpectedCodes:  "200",
+				}
+				createdMonitor := monitors.Monitor{
+					ID:   "aaaaaaaa-bbbb-cccc-dddd-777777777777",
+					Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-0",
+					Type: "HTTPS",
+				}
+				m.CreateMonitor(createOpts).Return(&createdMonitor, nil)
+				m.GetLoadBalancer(activeLB.ID).Return(&activeLB, nil)
+			},
+			wantError: nil,
+		},
 	}
 	for _, tt := range lbtests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -491,7 +605,7 @@ func Test_ReconcileLoadBalancer(t *testing.T) {
 
 			tt.expectNetwork(mockScopeFactory.NetworkClient.EXPECT())
 			tt.expectLoadBalancer(mockScopeFactory.LbClient.EXPECT())
-			_, err = lbs.ReconcileLoadBalancer(tt.clusterSpec, "AAAAA", 0)
+			_, err = lbs.ReconcileLoadBalancer(tt.clusterSpec, "AAAAA", 0, nil)
 			if tt.wantError != nil {
 				g.Expect(err).To(MatchError(tt.wantError))
 			} else {
@@ -1287,7 +1401,7 @@ func Test_ReconcileLoadBalancerMember(t *testing.T) {
 			tt.expectNetwork(mockScopeFactory.NetworkClient.EXPECT())
 			tt.expectLoadBalancer(mockScopeFactory.LbClient.EXPECT())
 
-			err = lbs.ReconcileLoadBalancerMember(tt.clusterSpec, openStackMachine, clusterName, memberIP)
+			err = lbs.ReconcileLoadBalancerMember(tt.clusterSpec, openStackMachine, clusterName, "", memberIP)
 			if tt.wantError != nil {
 				g.Expect(err).To(MatchError(tt.wantError))
 			} else {
@@ -0,0 +1,424 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/monitors"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+const (
+	memberOperatingStatusOnline    = "ONLINE"
+	memberOperatingStatusNoMonitor = "NO_MONITOR"
+)
+
+// getReplacementLoadBalancerName returns the name of the load balancer which
+// replaces the API server load balancer while it is being replaced. The
+// replacement is renamed to the name of the API server load balancer once
+// the previous load balancer has been deleted.
+func getReplacementLoadBalancerName(clusterResourceName string) string {
+	return getLoadBalancerName(clusterResourceName) + "-replacement"
+}
+
+// GetBlockedChanges returns the properties of the API server load balancer
+// which the last call to ReconcileLoadBalancer found must be changed by
+// replacing it, but which cannot be because the API server does not use a
+// floating IP.
+func (s *Service) GetBlockedChanges() []string {
+	return s.blockedChanges
+}
+
+// getAPILoadBalancerChanges returns the properties of the API server load
+// balancer in the spec which differ from the existing load balancer and
+// which Octavia cannot change without recreating it.
+func (s *Service) getAPILoadBalancerChanges(openStackCluster *infrav1.OpenStackCluster, lb *loadbalancers.LoadBalancer, caps *providerCapabilities) ([]string, error) {
+	lbSpec := openStackCluster.Spec.APIServer.GetManagedLoadBalancer()
+
+	var changes []string
+	if lbSpec.Provider != nil && *lbSpec.Provider != lb.Provider {
+		providerList, err := s.getLoadBalancerProviders()
+		if err != nil {
+			return nil, err
+		}
+		// A provider which does not exist is ignored when the load balancer
+		// is created, so it is not a change either
+		for i := range providerList {
+			if providerList[i].Name == *lbSpec.Provider {
+				changes = append(changes, "provider")
+				break
+			}
+		}
+	}
+
+	if lbSpec.Flavor != nil && caps.flavors {
+		flavors, err := s.loadbalancerClient.ListLoadBalancerFlavors()
+		if err != nil {
+			return nil, err
+		}
+		for i := range flavors {
+			if flavors[i].Enabled && flavors[i].Name == *lbSpec.Flavor {
+				if flavors[i].ID != lb.FlavorID {
+					changes = append(changes, "flavor")
+				}
+				break
+			}
+		}
+	}
+
+	if lbSpec.AvailabilityZone != nil && caps.availabilityZones && *lbSpec.AvailabilityZone != lb.AvailabilityZone {
+		changes = append(changes, "availability zone")
+	}
+
+	if lbNetwork := openStackCluster.Status.APIServerManagedLoadBalancer.LoadBalancerNetwork; lbNetwork != nil {
+		switch {
+		case lbNetwork.ID != "" && lb.VipNetworkID != "" && lbNetwork.ID != lb.VipNetworkID:
+			changes = append(changes, "network")
+		case lbSpec.Network != nil && len(lbSpec.Subnets) > 0 && len(lbNetwork.Subnets) > 0 && lbNetwork.Subnets[0].ID != lb.VipSubnetID:
+			changes = append(changes, "subnet")
+		}
+	}
+
+	return changes, nil
+}
+
+// reconcileLoadBalancerReplacement replaces the API server load balancer lb
+// if properties which Octavia cannot change have changed in the spec. The
+// replacement is created next to lb with the same listeners, pools, monitors
+// and members. Once its members are healthy the floating IP of the API server
+// is moved to it, so clients never see the API server become unavailable.
+// The previous load balancer is deleted by completeLoadBalancerReplacement.
+func (s *Service) reconcileLoadBalancerReplacement(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, lb *loadbalancers.LoadBalancer, caps *providerCapabilities, portList []int, certificate *Certificate) error {
+	lbStatus := openStackCluster.Status.APIServerManagedLoadBalancer
+
+	replacement := openStackCluster.Status.APIServerLoadBalancerReplacement
+	if replacement == nil {
+		changes, err := s.getAPILoadBalancerChanges(openStackCluster, lb, caps)
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			return nil
+		}
+
+		// Without a floating IP the address of the API server is the VIP of
+		// the load balancer, which cannot be moved to another load balancer
+		if lbStatus.IP == "" {
+			s.blockedChanges = changes
+			return nil
+		}
+
+		replacement = &infrav1.APIServerLoadBalancerReplacement{
+			Phase:      infrav1.APIServerLoadBalancerReplacementProvisioning,
+			Changes:    changes,
+			PreviousID: lb.ID,
+			Name:       getReplacementLoadBalancerName(clusterResourceName),
+		}
+		openStackCluster.Status.APIServerLoadBalancerReplacement = replacement
+		record.Eventf(openStackCluster, "StartedLoadBalancerReplacement", "Replacing load balancer %s with id %s to change its %s", lb.Name, lb.ID, strings.Join(changes, ", "))
+	}
+
+	switch replacement.Phase {
+	case infrav1.APIServerLoadBalancerReplacementProvisioning:
+		newLB, err := s.provisionReplacementLoadBalancer(openStackCluster, clusterResourceName, replacement, portList, certificate)
+		if err != nil {
+			return err
+		}
+		replacement.ID = newLB.ID
+		replacement.InternalIP = newLB.VipAddress
+		replacement.Phase = infrav1.APIServerLoadBalancerReplacementSwitchingOver
+		fallthrough
+
+	case infrav1.APIServerLoadBalancerReplacementSwitchingOver:
+		newLB, err := s.loadbalancerClient.GetLoadBalancer(replacement.ID)
+		if err != nil {
+			return err
+		}
+
+		ready, err := s.syncReplacementMembers(openStackCluster, clusterResourceName, newLB, portList)
+		if err != nil {
+			return err
+		}
+		if !ready {
+			s.scope.Logger().V(3).Info("Waiting for the members of the replacement load balancer to become healthy", "name", newLB.Name)
+			return nil
+		}
+
+		fp, err := s.networkingService.GetFloatingIP(lbStatus.IP)
+		if err != nil {
+			return err
+		}
+		if fp == nil {
+			return fmt.Errorf("floating IP %s of the API server does not exist", lbStatus.IP)
+		}
+		if err := s.networkingService.AssociateFloatingIP(openStackCluster, fp, newLB.VipPortID); err != nil {
+			return err
+		}
+
+		replacement.Phase = infrav1.APIServerLoadBalancerReplacementDeletingPrevious
+		record.Eventf(openStackCluster, "SwitchedLoadBalancer", "Moved floating IP %s to replacement load balancer %s with id %s", fp.FloatingIP, newLB.Name, newLB.ID)
+		fallthrough
+
+	case infrav1.APIServerLoadBalancerReplacementDeletingPrevious:
+		_, err := s.completeLoadBalancerReplacement(openStackCluster, clusterResourceName, portList)
+		return err
+	}
+
+	return nil
+}
+
+// provisionReplacementLoadBalancer creates the replacement load balancer with
+// the same listeners, pools and monitors as the API server load balancer,
+// configured for the provider of the replacement.
+func (s *Service) provisionReplacementLoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, replacement *infrav1.APIServerLoadBalancerReplacement, portList []int, certificate *Certificate) (*loadbalancers.LoadBalancer, error) {
+	lbSpec := openStackCluster.Spec.APIServer.GetManagedLoadBalancer()
+
+	newLB, err := s.checkIfLbExists(replacement.Name)
+	if err != nil {
+		return nil, err
+	}
+	if newLB == nil {
+		// The VIP address of the replacement is allocated by Neutron because
+		// the address of the previous load balancer is still in use
+		newLB, err = s.createAPILoadBalancer(openStackCluster, clusterResourceName, replacement.Name, nil, lbSpec.AvailabilityZone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newLB, err = s.waitForLoadBalancerActive(newLB.ID)
+	if err != nil {
+		return nil, fmt.Errorf("replacement load balancer %q is not active after timeout: %v", replacement.Name, err)
+	}
+
+	caps, err := s.getProviderCapabilities(newLB.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	var allowedCIDRs []string
+	if caps.allowedCIDRs {
+		allowedCIDRs = getCanonicalAllowedCIDRs(openStackCluster)
+	}
+
+	// The certificate is shared with the previous load balancer, but it may
+	// not have used one if its provider does not support TLS termination
+	var tlsContainerRef string
+	if isTLSTerminated(lbSpec) && caps.tls {
+		tlsContainerRef, err = s.getOrCreateTLSContainer(openStackCluster, getLoadBalancerName(clusterResourceName), certificate)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i, port := range portList {
+		spec := caps.adjustListenerSpec(getListenerSpec(lbSpec, i == 0, tlsContainerRef))
+		if err := s.reconcileAPILoadBalancerListener(newLB, openStackCluster, replacement.Name, port, spec, allowedCIDRs); err != nil {
+			return nil, err
+		}
+	}
+
+	return newLB, nil
+}
+
+// syncReplacementMembers makes the members of the pools of the replacement
+// load balancer the same as those of the API server load balancer. Members
+// keep the names of the API server load balancer so that they are managed as
+// usual once the replacement is complete. It returns true if every member
+// which is online in the API server load balancer is online in the
+// replacement.
+func (s *Service) syncReplacementMembers(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, newLB *loadbalancers.LoadBalancer, portList []int) (bool, error) {
+	loadBalancerName := getLoadBalancerName(clusterResourceName)
+	replacementName := getReplacementLoadBalancerName(clusterResourceName)
+
+	var subnetID string
+	if openStackCluster.Status.Network != nil && openStackCluster.Status.APIServerManagedLoadBalancer.LoadBalancerNetwork != nil &&
+		openStackCluster.Status.Network.ID != openStackCluster.Status.APIServerManagedLoadBalancer.LoadBalancerNetwork.ID {
+		var err error
+		subnetID, err = s.getPrimarySubnetID(openStackCluster)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	ready := true
+	for _, port := range portList {
+		pool, err := s.checkIfPoolExists(fmt.Sprintf("%s-%d", loadBalancerName, port))
+		if err != nil {
+			return false, err
+		}
+		newPool, err := s.checkIfPoolExists(fmt.Sprintf("%s-%d", replacementName, port))
+		if err != nil {
+			return false, err
+		}
+		if pool == nil || newPool == nil {
+			return false, errors.New("load balancer pool does not exist yet")
+		}
+
+		members, err := s.loadbalancerClient.ListPoolMember(pool.ID, pools.ListMembersOpts{})
+		if err != nil {
+			return false, err
+		}
+		newMembers, err := s.loadbalancerClient.ListPoolMember(newPool.ID, pools.ListMembersOpts{})
+		if err != nil {
+			return false, err
+		}
+
+		names := make(map[string]bool, len(members))
+		for i := range members {
+			member := &members[i]
+			names[member.Name] = true
+			if err := s.ensurePoolMember(openStackCluster, newLB.ID, newPool.ID, member.Name, member.Address, member.ProtocolPort, subnetID); err != nil {
+				return false, err
+			}
+
+			if member.OperatingStatus != memberOperatingStatusOnline {
+				continue
+			}
+			newMember, err := s.checkIfLbMemberExists(newPool.ID, member.Name)
+			if err != nil {
+				return false, err
+			}
+			if newMember == nil || (newMember.OperatingStatus != memberOperatingStatusOnline && newMember.OperatingStatus != memberOperatingStatusNoMonitor) {
+				ready = false
+			}
+		}
+
+		for i := range newMembers {
+			if !names[newMembers[i].Name] {
+				if err := s.deletePoolMember(newLB.ID, newPool.ID, newMembers[i].Name); err != nil {
+					return false, err
+				}
+			}
+		}
+	}
+
+	return ready, nil
+}
+
+// completeLoadBalancerReplacement deletes the previous API server load
+// balancer once the floating IP has been moved to the replacement, and then
+// renames the replacement to take its place. It returns true once the
+// replacement is complete.
+func (s *Service) completeLoadBalancerReplacement(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, portList []int) (bool, error) {
+	replacement := openStackCluster.Status.APIServerLoadBalancerReplacement
+
+	previous, err := s.loadbalancerClient.GetLoadBalancer(replacement.PreviousID)
+	if err != nil && !capoerrors.IsNotFound(err) {
+		return false, err
+	}
+	if err == nil {
+		if previous.ProvisioningStatus != loadBalancerProvisioningStatusPendingDelete {
+			// The floating IP is no longer associated with the previous load
+			// balancer, so only the load balancer itself is deleted
+			if err := s.deleteLoadBalancer(openStackCluster, previous, nil); err != nil {
+				return false, err
+			}
+		}
+		s.scope.Logger().V(3).Info("Waiting for the previous load balancer to be deleted", "id", replacement.PreviousID)
+		return false, nil
+	}
+
+	if err := s.renameReplacementLoadBalancer(clusterResourceName, replacement.ID, portList); err != nil {
+		return false, err
+	}
+
+	lbStatus := openStackCluster.Status.APIServerManagedLoadBalancer
+	lbStatus.ID = replacement.ID
+	lbStatus.Name = getLoadBalancerName(clusterResourceName)
+	lbStatus.InternalIP = replacement.InternalIP
+	openStackCluster.Status.APIServerLoadBalancerReplacement = nil
+
+	record.Eventf(openStackCluster, "SuccessfulReplaceLoadBalancer", "Replaced load balancer %s with id %s by load balancer with id %s", lbStatus.Name, replacement.PreviousID, replacement.ID)
+	return true, nil
+}
+
+// renameReplacementLoadBalancer gives the replacement load balancer and its
+// listeners, pools and monitors the names of the API server load balancer.
+// The load balancer is renamed last, so an interrupted rename is resumed on
+// the next reconcile.
+func (s *Service) renameReplacementLoadBalancer(clusterResourceName, lbID string, portList []int) error {
+	loadBalancerName := getLoadBalancerName(clusterResourceName)
+	replacementName := getReplacementLoadBalancerName(clusterResourceName)
+
+	for _, port := range portList {
+		name := fmt.Sprintf("%s-%d", loadBalancerName, port)
+		replacementObjectsName := fmt.Sprintf("%s-%d", replacementName, port)
+
+		listener, err := s.checkIfListenerExists(replacementObjectsName)
+		if err != nil {
+			return err
+		}
+		if listener != nil {
+			if _, err := s.loadbalancerClient.UpdateListener(listener.ID, listeners.UpdateOpts{Name: &name}); err != nil {
+				return err
+			}
+			if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+				return err
+			}
+		}
+
+		pool, err := s.checkIfPoolExists(replacementObjectsName)
+		if err != nil {
+			return err
+		}
+		if pool != nil {
+			if _, err := s.loadbalancerClient.UpdatePool(pool.ID, pools.UpdateOpts{Name: &name}); err != nil {
+				return err
+			}
+			if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+				return err
+			}
+		}
+
+		monitor, err := s.checkIfMonitorExists(replacementObjectsName)
+		if err != nil {
+			return err
+		}
+		if monitor != nil {
+			if _, err := s.loadbalancerClient.UpdateMonitor(monitor.ID, monitors.UpdateOpts{Name: &name}); err != nil {
+				return err
+			}
+			if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+				return err
+			}
+		}
+	}
+
+	lb, err := s.loadbalancerClient.GetLoadBalancer(lbID)
+	if err != nil {
+		return err
+	}
+	if lb.Name != loadBalancerName {
+		if _, err := s.loadbalancerClient.UpdateLoadBalancer(lbID, loadbalancers.UpdateOpts{Name: &loadBalancerName}); err != nil {
+			return err
+		}
+		if _, err := s.waitForLoadBalancerActive(lbID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func Test_ReconcileLoadBalancer_Replacement(t *testing.T) {
+	const clusterResourceName = "AAAAA"
+
+	tests := []struct {
+		name             string
+		enableFloatingIP bool
+		host             string
+		wantReplaced     bool
+	}{
+		{
+			name:             "load balancer with floating IP is replaced",
+			enableFloatingIP: true,
+			host:             "203.0.113.10",
+			wantReplaced:     true,
+		},
+		{
+			name:             "load balancer without floating IP is not replaced",
+			enableFloatingIP: false,
+			host:             "10.0.0.5",
+			wantReplaced:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cloud := fake.NewCloud(fake.WithTransitionDelay(0))
+			factory := scope.NewFakeScopeFactory(cloud)
+			s, err := NewService(scope.NewWithLogger(factory, testr.New(t)))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			externalNetworkID, err := cloud.AddExternalNetwork("public", "203.0.113.0/24")
+			g.Expect(err).NotTo(HaveOccurred())
+			networkClient, err := factory.NewNetworkClient()
+			g.Expect(err).NotTo(HaveOccurred())
+			network, err := networkClient.CreateNetwork(networks.CreateOpts{Name: "cluster"})
+			g.Expect(err).NotTo(HaveOccurred())
+			subnet, err := networkClient.CreateSubnet(subnets.CreateOpts{NetworkID: network.ID, CIDR: "10.0.0.0/24", IPVersion: 4})
+			g.Expect(err).NotTo(HaveOccurred())
+			router, err := networkClient.CreateRouter(routers.CreateOpts{GatewayInfo: &routers.GatewayInfo{NetworkID: externalNetworkID}})
+			g.Expect(err).NotTo(HaveOccurred())
+			_, err = networkClient.AddRouterInterface(router.ID, routers.AddInterfaceOpts{SubnetID: subnet.ID})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			networkStatus := &infrav1.NetworkStatusWithSubnets{
+				NetworkStatus: infrav1.NetworkStatus{ID: network.ID, Name: network.Name},
+				Subnets:       []infrav1.Subnet{{ID: subnet.ID, CIDR: subnet.CIDR}},
+			}
+			openStackCluster := &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServer: &infrav1.APIServer{
+						EnableFloatingIP: ptr.To(tt.enableFloatingIP),
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled:         ptr.To(true),
+							Provider:        ptr.To("amphora"),
+							AdditionalPorts: []int32{8132},
+						},
+					},
+					ControlPlaneEndpoint: &clusterv1.APIEndpoint{Host: tt.host, Port: 6443},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network:         networkStatus,
+					ExternalNetwork: &infrav1.NetworkStatus{ID: externalNetworkID},
+					APIServerManagedLoadBalancer: &infrav1.LoadBalancer{
+						LoadBalancerNetwork: networkStatus,
+					},
+				},
+			}
+
+			_, err = s.ReconcileLoadBalancer(openStackCluster, clusterResourceName, 6443, nil)
+			g.Expect(err).NotTo(HaveOccurred())
+			lbStatus := openStackCluster.Status.APIServerManagedLoadBalancer
+			previousID := lbStatus.ID
+			g.Expect(lbStatus.IP != "").To(Equal(tt.enableFloatingIP))
+
+			openStackMachine := &infrav1.OpenStackMachine{ObjectMeta: metav1.ObjectMeta{Name: "control-plane-abcde"}}
+			g.Expect(s.ReconcileLoadBalancerMember(openStackCluster, openStackMachine, clusterResourceName, "", "10.0.0.10")).To(Succeed())
+			g.Expect(cloud.Count("member")).To(Equal(2))
+
+			// The provider of an existing load balancer cannot be changed
+			openStackCluster.Spec.APIServer.ManagedLoadBalancer.Provider = ptr.To("ovn")
+			for range 5 {
+				_, err = s.ReconcileLoadBalancer(openStackCluster, clusterResourceName, 6443, nil)
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(openStackCluster.Status.APIServerLoadBalancerReplacement).To(BeNil())
+			g.Expect(cloud.Count("loadbalancer")).To(Equal(1))
+
+			lb, err := s.checkIfLbExists(getLoadBalancerName(clusterResourceName))
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(lb.ID).To(Equal(lbStatus.ID))
+			if !tt.wantReplaced {
+				g.Expect(lb.ID).To(Equal(previousID))
+				g.Expect(lb.Provider).To(Equal("amphora"))
+				g.Expect(s.GetBlockedChanges()).To(Equal([]string{"provider"}))
+				return
+			}
+			g.Expect(s.GetBlockedChanges()).To(BeEmpty())
+			g.Expect(lb.ID).NotTo(Equal(previousID))
+			g.Expect(lb.Provider).To(Equal("ovn"))
+			g.Expect(lbStatus.InternalIP).To(Equal(lb.VipAddress))
+
+			// The floating IP of the API server moved to the replacement
+			fip, err := s.networkingService.GetFloatingIP(lbStatus.IP)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(fip.PortID).To(Equal(lb.VipPortID))
+			g.Expect(cloud.Count("floatingip")).To(Equal(1))
+
+			// The replacement has the names and members of the previous load balancer
+			g.Expect(cloud.Count("listener")).To(Equal(2))
+			g.Expect(cloud.Count("member")).To(Equal(2))
+			for _, name := range []string{"k8s-clusterapi-cluster-AAAAA-kubeapi-6443", "k8s-clusterapi-cluster-AAAAA-kubeapi-8132"} {
+				pool, err := s.checkIfPoolExists(name)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(pool.Loadbalancers).To(ConsistOf(HaveField("ID", lb.ID)))
+				member, err := s.checkIfLbMemberExists(pool.ID, name+"-control-plane-abcde")
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(member.Address).To(Equal("10.0.0.10"))
+				monitor, err := s.checkIfMonitorExists(name)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(monitor).NotTo(BeNil())
+			}
+
+			// Members are managed as usual once the replacement is complete
+			g.Expect(s.DeleteLoadBalancerMember(openStackCluster, openStackMachine, clusterResourceName)).To(Succeed())
+			g.Expect(cloud.Count("member")).To(Equal(0))
+		})
+	}
+}
@@ -19,6 +19,8 @@ package loadbalancer
 import (
 	"fmt"
 
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/providers"
+
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/cloud/services/networking"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
@@ -29,6 +31,19 @@ type Service struct {
 	scope              *scope.WithLogger
 	loadbalancerClient clients.LbClient
 	networkingService  *networking.Service
+	_keyManagerClient  clients.KeyManagerClient
+
+	// octaviaVersion, loadBalancerProviders and capabilities cache what
+	// Octavia reports about itself, which does not change while the service
+	// is in use.
+	octaviaVersion        string
+	loadBalancerProviders []providers.Provider
+	capabilities          map[string]*providerCapabilities
+
+	// blockedChanges are the properties of the API server load balancer
+	// which ReconcileLoadBalancer found must be changed by replacing it, but
+	// could not be.
+	blockedChanges []string
 }
 
 // NewService returns an instance of the loadbalancer service.
@@ -49,3 +64,19 @@ func NewService(scope *scope.WithLogger) (*Service, error) {
 		networkingService:  networkingService,
 	}, nil
 }
+
+// getKeyManagerClient returns a Barbican client. It is only created when it
+// is needed so that clouds without Barbican can be used if the API server
+// load balancer does not terminate TLS.
+func (s *Service) getKeyManagerClient() clients.KeyManagerClient {
+	if s._keyManagerClient == nil {
+		keyManagerClient, err := s.scope.NewKeyManagerClient()
+		if err != nil {
+			return clients.NewKeyManagerErrorClient(err)
+		}
+
+		s._keyManagerClient = keyManagerClient
+	}
+
+	return s._keyManagerClient
+}
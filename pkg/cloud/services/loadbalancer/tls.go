@@ -0,0 +1,230 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/secrets"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+// Certificate is a certificate and private key in PEM format, served by the
+// API server load balancer when it terminates TLS.
+type Certificate struct {
+	// Certificate is the certificate of the load balancer, optionally
+	// followed by intermediate certificates.
+	Certificate []byte
+	// PrivateKey is the private key of the certificate.
+	PrivateKey []byte
+}
+
+// NewCertificate returns the Certificate stored in the tls.crt and tls.key
+// keys of a Secret of type kubernetes.io/tls.
+func NewCertificate(certificate, privateKey []byte) (*Certificate, error) {
+	if _, err := tls.X509KeyPair(certificate, privateKey); err != nil {
+		return nil, fmt.Errorf("invalid certificate: %w", err)
+	}
+	return &Certificate{Certificate: certificate, PrivateKey: privateKey}, nil
+}
+
+// split returns the certificate of the load balancer and its intermediate
+// certificates.
+func (c *Certificate) split() (leaf, intermediates []byte) {
+	rest := c.Certificate
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return leaf, intermediates
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		if leaf == nil {
+			leaf = pem.EncodeToMemory(block)
+		} else {
+			intermediates = append(intermediates, pem.EncodeToMemory(block)...)
+		}
+	}
+}
+
+// containerName returns the name of the Barbican container storing the
+// certificate. The name contains a hash of the certificate so that a rotated
+// certificate is stored in a new container.
+func (c *Certificate) containerName(loadBalancerName string) string {
+	hash := sha256.New()
+	hash.Write(c.Certificate)
+	hash.Write(c.PrivateKey)
+	return getTLSContainerPrefix(loadBalancerName) + hex.EncodeToString(hash.Sum(nil))[:8]
+}
+
+func getTLSContainerPrefix(loadBalancerName string) string {
+	return loadBalancerName + "-tls-"
+}
+
+// isTLSTerminated returns true if the API server load balancer terminates TLS.
+func isTLSTerminated(lbSpec *infrav1.APIServerLoadBalancer) bool {
+	return lbSpec != nil && lbSpec.TLS != nil && lbSpec.TLS.Mode == infrav1.APIServerLoadBalancerTLSTerminated
+}
+
+// refID returns the ID of the Barbican secret or container a reference refers to.
+func refID(ref string) string {
+	return path.Base(ref)
+}
+
+// getOrCreateTLSContainer returns the reference to the Barbican certificate
+// container storing the given certificate, storing it if it does not exist.
+func (s *Service) getOrCreateTLSContainer(openStackCluster *infrav1.OpenStackCluster, loadBalancerName string, certificate *Certificate) (string, error) {
+	if certificate == nil {
+		return "", errors.New("load balancer terminates TLS, but no certificate was given")
+	}
+
+	name := certificate.containerName(loadBalancerName)
+	containerList, err := s.getKeyManagerClient().ListContainers(containers.ListOpts{Name: name})
+	if err != nil {
+		return "", err
+	}
+	if len(containerList) > 0 {
+		return containerList[0].ContainerRef, nil
+	}
+
+	s.scope.Logger().V(2).Info("Storing load balancer certificate in Barbican", "name", name)
+
+	leaf, intermediates := certificate.split()
+	var secretRefs []containers.SecretRef
+	for _, secret := range []struct {
+		name       string
+		secretType secrets.SecretType
+		payload    []byte
+	}{
+		{"certificate", secrets.CertificateSecret, leaf},
+		{"private_key", secrets.PrivateSecret, certificate.PrivateKey},
+		{"intermediates", secrets.CertificateSecret, intermediates},
+	} {
+		if len(secret.payload) == 0 {
+			continue
+		}
+		created, err := s.getKeyManagerClient().CreateSecret(secrets.CreateOpts{
+			Name:               name + "-" + secret.name,
+			SecretType:         secret.secretType,
+			Payload:            string(secret.payload),
+			PayloadContentType: "text/plain",
+		})
+		if err != nil {
+			record.Warnf(openStackCluster, "FailedCreateTLSContainer", "Failed to store certificate %s: %v", name, err)
+			return "", errors.Join(err, s.deleteSecrets(secretRefs))
+		}
+		secretRefs = append(secretRefs, containers.SecretRef{Name: secret.name, SecretRef: created.SecretRef})
+	}
+
+	container, err := s.getKeyManagerClient().CreateContainer(containers.CreateOpts{
+		Type:       containers.CertificateContainer,
+		Name:       name,
+		SecretRefs: secretRefs,
+	})
+	if err != nil {
+		record.Warnf(openStackCluster, "FailedCreateTLSContainer", "Failed to create certificate container %s: %v", name, err)
+		return "", errors.Join(err, s.deleteSecrets(secretRefs))
+	}
+
+	record.Eventf(openStackCluster, "SuccessfulCreateTLSContainer", "Created certificate container %s", name)
+	return container.ContainerRef, nil
+}
+
+// deleteTLSContainers deletes the Barbican certificate containers of a load
+// balancer, and their secrets, except for the container referred to by keep.
+func (s *Service) deleteTLSContainers(openStackCluster *infrav1.OpenStackCluster, loadBalancerName, keep string) error {
+	// Barbican only filters containers by their exact name
+	containerList, err := s.getKeyManagerClient().ListContainers(containers.ListOpts{})
+	if err != nil {
+		return err
+	}
+
+	prefix := getTLSContainerPrefix(loadBalancerName)
+	for _, container := range containerList {
+		if !strings.HasPrefix(container.Name, prefix) || container.ContainerRef == keep {
+			continue
+		}
+
+		s.scope.Logger().V(2).Info("Deleting load balancer certificate container", "name", container.Name)
+
+		// Delete the secrets first, so that they are not leaked if their
+		// deletion fails
+		if err := s.deleteSecrets(container.SecretRefs); err != nil {
+			record.Warnf(openStackCluster, "FailedDeleteTLSContainer", "Failed to delete certificate container %s: %v", container.Name, err)
+			return err
+		}
+		if err := s.getKeyManagerClient().DeleteContainer(refID(container.ContainerRef)); err != nil && !capoerrors.IsNotFound(err) {
+			record.Warnf(openStackCluster, "FailedDeleteTLSContainer", "Failed to delete certificate container %s: %v", container.Name, err)
+			return err
+		}
+
+		record.Eventf(openStackCluster, "SuccessfulDeleteTLSContainer", "Deleted certificate container %s", container.Name)
+	}
+	return nil
+}
+
+func (s *Service) deleteSecrets(secretRefs []containers.SecretRef) error {
+	var errs []error
+	for _, secretRef := range secretRefs {
+		if err := s.getKeyManagerClient().DeleteSecret(refID(secretRef.SecretRef)); err != nil && !capoerrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("delete secret %s: %w", secretRef.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// getOrUpdateTLSContainerRef ensures that a listener terminating TLS serves
+// the certificate in the given container.
+func (s *Service) getOrUpdateTLSContainerRef(openStackCluster *infrav1.OpenStackCluster, listener *listeners.Listener, tlsContainerRef string) error {
+	if listener.DefaultTlsContainerRef == tlsContainerRef {
+		return nil
+	}
+
+	s.scope.Logger().V(3).Info("Certificate does not match, updating listener", "expectedContainer", tlsContainerRef, "currentContainer", listener.DefaultTlsContainerRef)
+	listenerUpdateOpts := listeners.UpdateOpts{
+		DefaultTlsContainerRef: &tlsContainerRef,
+	}
+
+	listenerID := listener.ID
+	listener, err := s.loadbalancerClient.UpdateListener(listener.ID, listenerUpdateOpts)
+	if err != nil {
+		record.Warnf(openStackCluster, "FailedUpdateListener", "Failed to update listener %s: %v", listenerID, err)
+		return err
+	}
+
+	if err := s.waitForListener(listener.ID, "ACTIVE"); err != nil {
+		record.Warnf(openStackCluster, "FailedUpdateListener", "Failed to update listener %s with id %s: wait for listener active: %v", listener.Name, listener.ID, err)
+		return err
+	}
+
+	record.Eventf(openStackCluster, "SuccessfulUpdateListener", "Updated certificate for listener %s with id %s", listener.Name, listener.ID)
+	return nil
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/pools"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+// newTestCertificate returns a self-signed certificate and its private key in
+// PEM format.
+func newTestCertificate(t *testing.T, commonName string) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestNewCertificate(t *testing.T) {
+	g := NewWithT(t)
+
+	cert, key := newTestCertificate(t, "api.example.com")
+	intermediate, otherKey := newTestCertificate(t, "intermediate")
+
+	certificate, err := NewCertificate(append(cert, intermediate...), key)
+	g.Expect(err).NotTo(HaveOccurred())
+	leaf, intermediates := certificate.split()
+	g.Expect(leaf).To(Equal(cert))
+	g.Expect(intermediates).To(Equal(intermediate))
+
+	_, err = NewCertificate(cert, otherKey)
+	g.Expect(err).To(HaveOccurred())
+	_, err = NewCertificate(nil, nil)
+	g.Expect(err).To(HaveOccurred())
+}
+
+func Test_getListenerSpec(t *testing.T) {
+	https := &infrav1.APIServerLoadBalancerMonitor{Type: infrav1.APIServerLoadBalancerMonitorHTTPS}
+	tests := []struct {
+		name            string
+		lbSpec          *infrav1.APIServerLoadBalancer
+		isAPIServerPort bool
+		want            listenerSpec
+	}{
+		{
+			name:            "defaults",
+			lbSpec:          &infrav1.APIServerLoadBalancer{},
+			isAPIServerPort: true,
+			want: listenerSpec{
+				listenerProtocol: listeners.ProtocolTCP,
+				poolProtocol:     pools.ProtocolTCP,
+				monitor: infrav1.APIServerLoadBalancerMonitor{
+					Type: infrav1.APIServerLoadBalancerMonitorTCP, Delay: 10, Timeout: 5, MaxRetries: 5, MaxRetriesDown: 3,
+				},
+			},
+		},
+		{
+			name: "HTTPS monitor with TLS passthrough",
+			lbSpec: &infrav1.APIServerLoadBalancer{
+				Monitor: https,
+				TLS:     &infrav1.APIServerLoadBalancerTLS{Mode: infrav1.APIServerLoadBalancerTLSPassthrough},
+			},
+			isAPIServerPort: true,
+			want: listenerSpec{
+				listenerProtocol: listeners.ProtocolHTTPS,
+				poolProtocol:     pools.ProtocolHTTPS,
+				monitor: infrav1.APIServerLoadBalancerMonitor{
+					Type: infrav1.APIServerLoadBalancerMonitorHTTPS, Delay: 10, Timeout: 5, MaxRetries: 5, MaxRetriesDown: 3,
+					URLPath: "/readyz", ExpectedCodes: "200",
+				},
+			},
+		},
+		{
+			name: "TLS termination",
+			lbSpec: &infrav1.APIServerLoadBalancer{
+				TLS: &infrav1.APIServerLoadBalancerTLS{Mode: infrav1.APIServerLoadBalancerTLSTerminated, CertificateSecretName: ptr.To("api-tls")},
+			},
+			isAPIServerPort: true,
+			want: listenerSpec{
+				listenerProtocol: listeners.ProtocolTerminatedHTTPS,
+				tlsContainerRef:  "https://key-manager.fake/v1/containers/1",
+				poolProtocol:     pools.ProtocolHTTP,
+				poolTLSEnabled:   true,
+				monitor: infrav1.APIServerLoadBalancerMonitor{
+					Type: infrav1.APIServerLoadBalancerMonitorTCP, Delay: 10, Timeout: 5, MaxRetries: 5, MaxRetriesDown: 3,
+				},
+			},
+		},
+		{
+			name: "additional ports always use TCP",
+			lbSpec: &infrav1.APIServerLoadBalancer{
+				Monitor: https,
+				TLS:     &infrav1.APIServerLoadBalancerTLS{Mode: infrav1.APIServerLoadBalancerTLSTerminated, CertificateSecretName: ptr.To("api-tls")},
+			},
+			isAPIServerPort: false,
+			want: listenerSpec{
+				listenerProtocol: listeners.ProtocolTCP,
+				poolProtocol:     pools.ProtocolTCP,
+				monitor: infrav1.APIServerLoadBalancerMonitor{
+					Type: infrav1.APIServerLoadBalancerMonitorTCP, Delay: 10, Timeout: 5, MaxRetries: 5, MaxRetriesDown: 3,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(getListenerSpec(tt.lbSpec, tt.isAPIServerPort, "https://key-manager.fake/v1/containers/1")).To(Equal(tt.want))
+		})
+	}
+}
+
+func Test_TLSContainers(t *testing.T) {
+	g := NewWithT(t)
+
+	cloud := fake.NewCloud()
+	s, err := NewService(scope.NewWithLogger(scope.NewFakeScopeFactory(cloud), testr.New(t)))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	openStackCluster := &infrav1.OpenStackCluster{}
+	const lbName = "k8s-clusterapi-cluster-AAAAA-kubeapi"
+
+	_, err = s.getOrCreateTLSContainer(openStackCluster, lbName, nil)
+	g.Expect(err).To(HaveOccurred())
+
+	cert, key := newTestCertificate(t, "api.example.com")
+	certificate, err := NewCertificate(cert, key)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ref, err := s.getOrCreateTLSContainer(openStackCluster, lbName, certificate)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ref).NotTo(BeEmpty())
+	g.Expect(cloud.Count("container")).To(Equal(1))
+	g.Expect(cloud.Count("secret")).To(Equal(2))
+
+	// The existing container is reused
+	g.Expect(s.getOrCreateTLSContainer(openStackCluster, lbName, certificate)).To(Equal(ref))
+	g.Expect(cloud.Count("container")).To(Equal(1))
+
+	// A rotated certificate is stored in a new container
+	cert, key = newTestCertificate(t, "api.example.com")
+	rotated, err := NewCertificate(cert, key)
+	g.Expect(err).NotTo(HaveOccurred())
+	rotatedRef, err := s.getOrCreateTLSContainer(openStackCluster, lbName, rotated)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rotatedRef).NotTo(Equal(ref))
+	g.Expect(cloud.Count("container")).To(Equal(2))
+
+	// Only the replaced container is deleted
+	g.Expect(s.deleteTLSContainers(openStackCluster, lbName, rotatedRef)).To(Succeed())
+	g.Expect(cloud.Count("container")).To(Equal(1))
+	g.Expect(cloud.Count("secret")).To(Equal(2))
+	g.Expect(s.getOrCreateTLSContainer(openStackCluster, lbName, rotated)).To(Equal(rotatedRef))
+
+	// Containers of other load balancers are not deleted
+	_, err = s.getOrCreateTLSContainer(openStackCluster, "other", certificate)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(s.deleteTLSContainers(openStackCluster, lbName, "")).To(Succeed())
+	g.Expect(cloud.Count("container")).To(Equal(1))
+	g.Expect(cloud.Count("secret")).To(Equal(2))
+}
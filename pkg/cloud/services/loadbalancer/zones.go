@@ -0,0 +1,305 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+)
+
+// getZonalLoadBalancerName returns the name of the zonal load balancer of the
+// API server in the given availability zone.
+func getZonalLoadBalancerName(clusterResourceName, zone string) string {
+	return fmt.Sprintf("%s-%s", getLoadBalancerName(clusterResourceName), zone)
+}
+
+// getZonalLoadBalancerStatus returns the status of the zonal load balancer
+// in the given availability zone, or nil if it does not have one.
+func getZonalLoadBalancerStatus(openStackCluster *infrav1.OpenStackCluster, zone string) *infrav1.LoadBalancerZone {
+	lbStatus := openStackCluster.Status.APIServerManagedLoadBalancer
+	if lbStatus == nil {
+		return nil
+	}
+	for i := range lbStatus.Zones {
+		if lbStatus.Zones[i].Name == zone {
+			return &lbStatus.Zones[i]
+		}
+	}
+	return nil
+}
+
+// getAPILoadBalancerAddresses returns the addresses at which the API server
+// can be reached through the load balancer and its zonal load balancers.
+func getAPILoadBalancerAddresses(lbStatus *infrav1.LoadBalancer) []string {
+	addresses := make([]string, 0, 1+len(lbStatus.Zones))
+	if address := cmp.Or(lbStatus.IP, lbStatus.InternalIP); address != "" {
+		addresses = append(addresses, address)
+	}
+	for i := range lbStatus.Zones {
+		if address := cmp.Or(lbStatus.Zones[i].IP, lbStatus.Zones[i].InternalIP); address != "" {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
+}
+
+// reconcileZonalLoadBalancers reconciles a zonal load balancer of the API
+// server for each availability zone in the spec, and deletes those of zones
+// which were removed from the spec. Zonal load balancers have the same
+// listeners as the API server load balancer.
+func (s *Service) reconcileZonalLoadBalancers(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, portList []int, certificate *Certificate) error {
+	lbSpec := openStackCluster.Spec.APIServer.GetManagedLoadBalancer()
+	lbStatus := openStackCluster.Status.APIServerManagedLoadBalancer
+
+	var errs []error
+	zoneStatuses := make([]infrav1.LoadBalancerZone, 0, len(lbSpec.Zones))
+
+	for i := range lbSpec.Zones {
+		zone := &lbSpec.Zones[i]
+		zoneStatus := infrav1.LoadBalancerZone{Name: zone.Name}
+		if previous := getZonalLoadBalancerStatus(openStackCluster, zone.Name); previous != nil {
+			zoneStatus = *previous
+		}
+
+		if err := s.reconcileZonalLoadBalancer(openStackCluster, clusterResourceName, zone, &zoneStatus, portList, certificate); err != nil {
+			errs = append(errs, fmt.Errorf("zonal load balancer %s: %w", zone.Name, err))
+		}
+		zoneStatuses = append(zoneStatuses, zoneStatus)
+	}
+
+	for i := range lbStatus.Zones {
+		zoneStatus := &lbStatus.Zones[i]
+		if slices.ContainsFunc(lbSpec.Zones, func(zone infrav1.APIServerLoadBalancerZone) bool { return zone.Name == zoneStatus.Name }) {
+			continue
+		}
+
+		// Keep the status until the load balancer is deleted
+		deleted, err := s.deleteZonalLoadBalancer(openStackCluster, clusterResourceName, zoneStatus.Name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("zonal load balancer %s: %w", zoneStatus.Name, err))
+		}
+		if !deleted {
+			zoneStatuses = append(zoneStatuses, *zoneStatus)
+		}
+	}
+
+	if len(zoneStatuses) == 0 {
+		zoneStatuses = nil
+	}
+	lbStatus.Zones = zoneStatuses
+	return errors.Join(errs...)
+}
+
+func (s *Service) reconcileZonalLoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, zone *infrav1.APIServerLoadBalancerZone, zoneStatus *infrav1.LoadBalancerZone, portList []int, certificate *Certificate) error {
+	lbSpec := openStackCluster.Spec.APIServer.GetManagedLoadBalancer()
+	loadBalancerName := getZonalLoadBalancerName(clusterResourceName, zone.Name)
+	s.scope.Logger().V(3).Info("Reconciling zonal load balancer", "name", loadBalancerName)
+
+	lb, err := s.checkIfLbExists(loadBalancerName)
+	if err != nil {
+		return err
+	}
+	if lb == nil {
+		lb, err = s.createAPILoadBalancer(openStackCluster, clusterResourceName, loadBalancerName, nil, cmp.Or(zone.AvailabilityZone, &zone.Name))
+		if err != nil {
+			return err
+		}
+	}
+
+	zoneStatus.ID = lb.ID
+	zoneStatus.InternalIP = lb.VipAddress
+
+	if lb.ProvisioningStatus != loadBalancerProvisioningStatusActive {
+		lbID := lb.ID
+		lb, err = s.waitForLoadBalancerActive(lbID)
+		if err != nil {
+			return fmt.Errorf("load balancer %q with id %s is not active after timeout: %v", loadBalancerName, lbID, err)
+		}
+	}
+
+	if ptr.Deref(openStackCluster.Spec.APIServer.GetEnableFloatingIP(), true) {
+		fp, err := s.networkingService.GetFloatingIPByPortID(lb.VipPortID)
+		if err != nil {
+			return err
+		}
+		if fp == nil {
+			var floatingIPAddress *string
+			if zoneStatus.IP != "" {
+				floatingIPAddress = &zoneStatus.IP
+			}
+			fp, err = s.networkingService.GetOrCreateFloatingIP(openStackCluster, openStackCluster, clusterResourceName, floatingIPAddress, nil)
+			if err != nil {
+				return err
+			}
+
+			// Write the floating IP to the status immediately so we won't
+			// create a new floating IP on the next reconcile if something
+			// fails below.
+			zoneStatus.IP = fp.FloatingIP
+
+			if err := s.networkingService.AssociateFloatingIP(openStackCluster, fp, lb.VipPortID); err != nil {
+				return err
+			}
+		}
+		zoneStatus.IP = fp.FloatingIP
+	}
+
+	caps, err := s.getProviderCapabilities(lb.Provider)
+	if err != nil {
+		return err
+	}
+
+	var allowedCIDRs []string
+	if caps.allowedCIDRs {
+		allowedCIDRs = getCanonicalAllowedCIDRs(openStackCluster)
+	}
+
+	// Zonal load balancers serve the certificate of the API server load
+	// balancer
+	var tlsContainerRef string
+	if isTLSTerminated(lbSpec) && caps.tls {
+		tlsContainerRef, err = s.getOrCreateTLSContainer(openStackCluster, getLoadBalancerName(clusterResourceName), certificate)
+		if err != nil {
+			return err
+		}
+	}
+
+	for i, port := range portList {
+		spec := caps.adjustListenerSpec(getListenerSpec(lbSpec, i == 0, tlsContainerRef))
+		if err := s.reconcileAPILoadBalancerListener(lb, openStackCluster, loadBalancerName, port, spec, allowedCIDRs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteZonalLoadBalancer deletes the zonal load balancer of the API server
+// in the given availability zone with its floating IP. It returns true once
+// the load balancer no longer exists.
+func (s *Service) deleteZonalLoadBalancer(openStackCluster *infrav1.OpenStackCluster, clusterResourceName, zone string) (bool, error) {
+	lb, err := s.checkIfLbExists(getZonalLoadBalancerName(clusterResourceName, zone))
+	if err != nil {
+		return false, err
+	}
+	if lb == nil {
+		return true, nil
+	}
+	if lb.ProvisioningStatus == loadBalancerProvisioningStatusPendingDelete {
+		return false, nil
+	}
+	return false, s.deleteLoadBalancer(openStackCluster, lb, nil)
+}
+
+// getZonalLoadBalancerZones returns the availability zones of the zonal load
+// balancers of the API server in the spec and the status.
+func getZonalLoadBalancerZones(openStackCluster *infrav1.OpenStackCluster) []string {
+	var zones []string
+	if lbSpec := openStackCluster.Spec.APIServer.GetManagedLoadBalancer(); lbSpec != nil {
+		for i := range lbSpec.Zones {
+			zones = append(zones, lbSpec.Zones[i].Name)
+		}
+	}
+	if lbStatus := openStackCluster.Status.APIServerManagedLoadBalancer; lbStatus != nil {
+		for i := range lbStatus.Zones {
+			if !slices.Contains(zones, lbStatus.Zones[i].Name) {
+				zones = append(zones, lbStatus.Zones[i].Name)
+			}
+		}
+	}
+	return zones
+}
+
+// reconcileZonalLoadBalancerMember adds a control plane machine in the given
+// availability zone to the pools of the zonal load balancer of that zone.
+func (s *Service) reconcileZonalLoadBalancerMember(openStackCluster *infrav1.OpenStackCluster, openStackMachine *infrav1.OpenStackMachine, clusterResourceName, availabilityZone, ip string, portList []int) error {
+	zoneStatus := getZonalLoadBalancerStatus(openStackCluster, availabilityZone)
+	if zoneStatus == nil || !slices.ContainsFunc(openStackCluster.Spec.APIServer.GetManagedLoadBalancer().Zones, func(zone infrav1.APIServerLoadBalancerZone) bool { return zone.Name == availabilityZone }) {
+		return nil
+	}
+	if zoneStatus.ID == "" {
+		return fmt.Errorf("zonal load balancer %s does not exist yet", availabilityZone)
+	}
+
+	var subnetID string
+	if openStackCluster.Status.Network.ID != openStackCluster.Status.APIServerManagedLoadBalancer.LoadBalancerNetwork.ID {
+		var err error
+		subnetID, err = s.getPrimarySubnetID(openStackCluster)
+		if err != nil {
+			return err
+		}
+	}
+
+	loadBalancerName := getZonalLoadBalancerName(clusterResourceName, availabilityZone)
+	for _, port := range portList {
+		lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, port)
+
+		pool, err := s.checkIfPoolExists(lbPortObjectsName)
+		if err != nil {
+			return err
+		}
+		if pool == nil {
+			return errors.New("zonal load balancer pool does not exist yet")
+		}
+
+		if err := s.ensurePoolMember(openStackCluster, zoneStatus.ID, pool.ID, lbPortObjectsName+"-"+openStackMachine.Name, ip, port, subnetID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteZonalLoadBalancerMembers removes a machine from the pools of the
+// zonal load balancers of the API server.
+func (s *Service) deleteZonalLoadBalancerMembers(openStackMachine *infrav1.OpenStackMachine, clusterResourceName string, zones []string, portList []int) error {
+	for _, zone := range zones {
+		loadBalancerName := getZonalLoadBalancerName(clusterResourceName, zone)
+		var lb *loadbalancers.LoadBalancer
+		for _, port := range portList {
+			lbPortObjectsName := fmt.Sprintf("%s-%d", loadBalancerName, port)
+
+			pool, err := s.checkIfPoolExists(lbPortObjectsName)
+			if err != nil {
+				return err
+			}
+			if pool == nil {
+				continue
+			}
+			if lb == nil {
+				lb, err = s.checkIfLbExists(loadBalancerName)
+				if err != nil {
+					return err
+				}
+				if lb == nil {
+					break
+				}
+			}
+
+			if err := s.deletePoolMember(lb.ID, pool.ID, lbPortObjectsName+"-"+openStackMachine.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
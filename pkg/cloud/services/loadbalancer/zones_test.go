@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func Test_getAPILoadBalancerAddresses(t *testing.T) {
+	g := NewWithT(t)
+
+	lbStatus := &infrav1.LoadBalancer{
+		IP:         "203.0.113.10",
+		InternalIP: "10.0.0.5",
+		Zones: []infrav1.LoadBalancerZone{
+			{Name: "az1", InternalIP: "10.0.0.6", IP: "203.0.113.11"},
+			{Name: "az2", InternalIP: "10.0.0.7"},
+			{Name: "az3"},
+		},
+	}
+	g.Expect(getAPILoadBalancerAddresses(lbStatus)).To(Equal([]string{"203.0.113.10", "203.0.113.11", "10.0.0.7"}))
+}
+
+func Test_ZonalLoadBalancers(t *testing.T) {
+	g := NewWithT(t)
+
+	cloud := fake.NewCloud(fake.WithTransitionDelay(0))
+	factory := scope.NewFakeScopeFactory(cloud)
+	s, err := NewService(scope.NewWithLogger(factory, testr.New(t)))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	externalNetworkID, err := cloud.AddExternalNetwork("public", "203.0.113.0/24")
+	g.Expect(err).NotTo(HaveOccurred())
+	networkClient, err := factory.NewNetworkClient()
+	g.Expect(err).NotTo(HaveOccurred())
+	network, err := networkClient.CreateNetwork(networks.CreateOpts{Name: "cluster"})
+	g.Expect(err).NotTo(HaveOccurred())
+	subnet, err := networkClient.CreateSubnet(subnets.CreateOpts{NetworkID: network.ID, CIDR: "10.0.0.0/24", IPVersion: 4})
+	g.Expect(err).NotTo(HaveOccurred())
+	router, err := networkClient.CreateRouter(routers.CreateOpts{GatewayInfo: &routers.GatewayInfo{NetworkID: externalNetworkID}})
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = networkClient.AddRouterInterface(router.ID, routers.AddInterfaceOpts{SubnetID: subnet.ID})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	const clusterResourceName = "AAAAA"
+	networkStatus := &infrav1.NetworkStatusWithSubnets{
+		NetworkStatus: infrav1.NetworkStatus{ID: network.ID, Name: network.Name},
+		Subnets:       []infrav1.Subnet{{ID: subnet.ID, CIDR: subnet.CIDR}},
+	}
+	openStackCluster := &infrav1.OpenStackCluster{
+		Spec: infrav1.OpenStackClusterSpec{
+			APIServer: &infrav1.APIServer{
+				EnableFloatingIP: ptr.To(false),
+				ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+					Enabled: ptr.To(true),
+					Zones: []infrav1.APIServerLoadBalancerZone{
+						{Name: "az1"},
+						{Name: "az2", AvailabilityZone: ptr.To("octavia-az2")},
+					},
+				},
+			},
+			ControlPlaneEndpoint: &clusterv1.APIEndpoint{Host: "10.0.0.5", Port: 6443},
+		},
+		Status: infrav1.OpenStackClusterStatus{
+			Network:         networkStatus,
+			ExternalNetwork: &infrav1.NetworkStatus{ID: externalNetworkID},
+			APIServerManagedLoadBalancer: &infrav1.LoadBalancer{
+				LoadBalancerNetwork: networkStatus,
+			},
+		},
+	}
+
+	_, err = s.ReconcileLoadBalancer(openStackCluster, clusterResourceName, 6443, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cloud.Count("loadbalancer")).To(Equal(3))
+	g.Expect(cloud.Count("listener")).To(Equal(3))
+	g.Expect(cloud.Count("floatingip")).To(Equal(0))
+
+	lbStatus := openStackCluster.Status.APIServerManagedLoadBalancer
+	g.Expect(lbStatus.Zones).To(HaveLen(2))
+	for _, zoneStatus := range lbStatus.Zones {
+		g.Expect(zoneStatus.ID).NotTo(BeEmpty())
+		g.Expect(zoneStatus.InternalIP).NotTo(BeEmpty())
+	}
+	g.Expect(lbStatus.Addresses).To(Equal([]string{"10.0.0.5", lbStatus.Zones[0].InternalIP, lbStatus.Zones[1].InternalIP}))
+
+	lb, err := s.checkIfLbExists("k8s-clusterapi-cluster-AAAAA-kubeapi-az2")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(lb.AvailabilityZone).To(Equal("octavia-az2"))
+
+	// Reconciling again does not change anything
+	_, err = s.ReconcileLoadBalancer(openStackCluster, clusterResourceName, 6443, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cloud.Count("loadbalancer")).To(Equal(3))
+	g.Expect(cloud.Count("listener")).To(Equal(3))
+
+	// A machine is a member of the API server load balancer and of the zonal
+	// load balancer of its zone
+	openStackMachine := &infrav1.OpenStackMachine{ObjectMeta: metav1.ObjectMeta{Name: "control-plane-abcde"}}
+	g.Expect(s.ReconcileLoadBalancerMember(openStackCluster, openStackMachine, clusterResourceName, "az1", "10.0.0.10")).To(Succeed())
+	g.Expect(cloud.Count("member")).To(Equal(2))
+	pool, err := s.checkIfPoolExists("k8s-clusterapi-cluster-AAAAA-kubeapi-az1-6443")
+	g.Expect(err).NotTo(HaveOccurred())
+	member, err := s.checkIfLbMemberExists(pool.ID, "k8s-clusterapi-cluster-AAAAA-kubeapi-az1-6443-control-plane-abcde")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(member.Address).To(Equal("10.0.0.10"))
+
+	g.Expect(s.DeleteLoadBalancerMember(openStackCluster, openStackMachine, clusterResourceName)).To(Succeed())
+	g.Expect(cloud.Count("member")).To(Equal(0))
+
+	// Zones removed from the spec are deleted
+	openStackCluster.Spec.APIServer.ManagedLoadBalancer.Zones = openStackCluster.Spec.APIServer.ManagedLoadBalancer.Zones[:1]
+	for range 2 {
+		_, err = s.ReconcileLoadBalancer(openStackCluster, clusterResourceName, 6443, nil)
+		g.Expect(err).NotTo(HaveOccurred())
+	}
+	g.Expect(cloud.Count("loadbalancer")).To(Equal(2))
+	g.Expect(lbStatus.Zones).To(HaveLen(1))
+	g.Expect(lbStatus.Addresses).To(HaveLen(2))
+}
@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/dns"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -33,7 +34,11 @@ import (
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
 )
 
-func (s *Service) GetOrCreateFloatingIP(eventObject runtime.Object, openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, ip *string) (*floatingips.FloatingIP, error) {
+// GetOrCreateFloatingIP returns the floating IP with the given address,
+// creating it if it does not exist. If dnsOpts is set and the Neutron
+// dns-integration extension is available, a newly created floating IP is given
+// the requested DNS name and domain.
+func (s *Service) GetOrCreateFloatingIP(eventObject runtime.Object, openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, ip *string, dnsOpts *infrav1.FloatingIPDNS) (*floatingips.FloatingIP, error) {
 	var fp *floatingips.FloatingIP
 	var err error
 	var fpCreateOpts floatingips.CreateOpts
@@ -69,9 +74,17 @@ func (s *Service) GetOrCreateFloatingIP(eventObject runtime.Object, openStackClu
 		}
 	}
 
+	var builder floatingips.CreateOptsBuilder = fpCreateOpts
+	if dnsOpts != nil {
+		builder, err = s.withFloatingIPDNS(builder, dnsOpts.DNSName, dnsOpts.DNSDomain)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	s.scope.Logger().Info("Creating floating IP", "ip", fpCreateOpts.FloatingIP, "floatingNetworkID", openStackCluster.Status.ExternalNetwork.ID, "description", fpCreateOpts.Description)
 
-	fp, err = s.client.CreateFloatingIP(fpCreateOpts)
+	fp, err = s.client.CreateFloatingIP(builder)
 	if err != nil {
 		record.Warnf(eventObject, "FailedCreateFloatingIP", "Failed to create floating IP %s: %v", fpCreateOpts.FloatingIP, err)
 		return nil, err
@@ -101,7 +114,19 @@ func (s *Service) CreateFloatingIPForPool(pool *infrav1alpha1.OpenStackFloatingI
 	fpCreateOpts.FloatingNetworkID = pool.Status.FloatingIPNetwork.ID
 	fpCreateOpts.Description = fmt.Sprintf("Created by cluster-api-provider-openstack OpenStackFloatingIPPool %s", pool.Name)
 
-	fp, err := s.client.CreateFloatingIP(fpCreateOpts)
+	var builder floatingips.CreateOptsBuilder = fpCreateOpts
+	if dnsDomain := ptr.Deref(pool.Spec.DNSDomain, ""); dnsDomain != "" {
+		// Every floating IP of the pool needs its own DNS name, otherwise
+		// the external DNS service would reject the duplicate record.
+		dnsName := names.GetFloatingIPPoolDNSName(pool.Name)
+		var err error
+		builder, err = s.withFloatingIPDNS(builder, dnsName, dnsDomain)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	fp, err := s.client.CreateFloatingIP(builder)
 	if err != nil {
 		record.Warnf(pool, "FailedCreateFloatingIP", "%s failed to create floating IP: %v", pool.Name, err)
 		return nil, err
@@ -111,6 +136,25 @@ func (s *Service) CreateFloatingIPForPool(pool *infrav1alpha1.OpenStackFloatingI
 	return fp, nil
 }
 
+// withFloatingIPDNS adds the DNS name and domain to a floating IP create
+// request. As Neutron only accepts these attributes when the dns-integration
+// extension is available, the request is returned unchanged otherwise.
+func (s *Service) withFloatingIPDNS(builder floatingips.CreateOptsBuilder, dnsName, dnsDomain string) (floatingips.CreateOptsBuilder, error) {
+	dnsSupported, err := s.HasDNSIntegrationExtension()
+	if err != nil {
+		return nil, err
+	}
+	if !dnsSupported {
+		s.scope.Logger().V(4).Info("dns-integration extension not available, skipping floating IP DNS attributes", "dnsName", dnsName, "dnsDomain", dnsDomain)
+		return builder, nil
+	}
+	return dns.FloatingIPCreateOptsExt{
+		CreateOptsBuilder: builder,
+		DNSName:           dnsName,
+		DNSDomain:         dnsDomain,
+	}, nil
+}
+
 func (s *Service) TagFloatingIP(ip string, tag string) error {
 	fip, err := s.GetFloatingIP(ip)
 	if err != nil {
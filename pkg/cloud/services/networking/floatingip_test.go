@@ -23,6 +23,7 @@ import (
 	"github.com/go-logr/logr/testr"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/dns"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
@@ -41,6 +42,7 @@ func Test_GetOrCreateFloatingIP(t *testing.T) {
 		name    string
 		ip      string
 		tags    []string
+		dns     *infrav1.FloatingIPDNS
 		expect  func(m *mock.MockNetworkClientMockRecorder)
 		want    *floatingips.FloatingIP
 		wantErr bool
@@ -118,6 +120,50 @@ func Test_GetOrCreateFloatingIP(t *testing.T) {
 			},
 			want: &floatingips.FloatingIP{ID: "fip-1", FloatingIP: "192.168.111.0"},
 		},
+		{
+			name: "creates floating IP with DNS name when dns-integration is supported",
+			ip:   "192.168.111.0",
+			dns:  &infrav1.FloatingIPDNS{DNSName: "api", DNSDomain: "example.com."},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				dnsIntegrationExt := extensions.Extension{}
+				dnsIntegrationExt.Alias = "dns-integration"
+				m.ListExtensions().Return([]extensions.Extension{dnsIntegrationExt}, nil)
+
+				m.
+					ListFloatingIP(floatingips.ListOpts{FloatingIP: "192.168.111.0"}).
+					Return([]floatingips.FloatingIP{}, nil)
+				m.
+					CreateFloatingIP(dns.FloatingIPCreateOptsExt{
+						CreateOptsBuilder: floatingips.CreateOpts{
+							FloatingIP:  "192.168.111.0",
+							Description: "Created by cluster-api-provider-openstack cluster test-cluster",
+						},
+						DNSName:   "api",
+						DNSDomain: "example.com.",
+					}).
+					Return(&floatingips.FloatingIP{FloatingIP: "192.168.111.0"}, nil)
+			},
+			want: &floatingips.FloatingIP{FloatingIP: "192.168.111.0"},
+		},
+		{
+			name: "creates floating IP without DNS name when dns-integration is not supported",
+			ip:   "192.168.111.0",
+			dns:  &infrav1.FloatingIPDNS{DNSName: "api", DNSDomain: "example.com."},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListExtensions().Return([]extensions.Extension{}, nil)
+
+				m.
+					ListFloatingIP(floatingips.ListOpts{FloatingIP: "192.168.111.0"}).
+					Return([]floatingips.FloatingIP{}, nil)
+				m.
+					CreateFloatingIP(floatingips.CreateOpts{
+						FloatingIP:  "192.168.111.0",
+						Description: "Created by cluster-api-provider-openstack cluster test-cluster",
+					}).
+					Return(&floatingips.FloatingIP{FloatingIP: "192.168.111.0"}, nil)
+			},
+			want: &floatingips.FloatingIP{FloatingIP: "192.168.111.0"},
+		},
 		{
 			name: "tags requested, extension discovery fails before floating IP is allocated",
 			ip:   "192.168.111.0",
@@ -158,7 +204,7 @@ func Test_GetOrCreateFloatingIP(t *testing.T) {
 				client: mockClient,
 			}
 			eventObject := infrav1.OpenStackMachine{}
-			got, err := s.GetOrCreateFloatingIP(&eventObject, openStackCluster, "test-cluster", ptr.To(tt.ip))
+			got, err := s.GetOrCreateFloatingIP(&eventObject, openStackCluster, "test-cluster", ptr.To(tt.ip), tt.dns)
 			if tt.wantErr {
 				g.Expect(err).To(HaveOccurred())
 				return
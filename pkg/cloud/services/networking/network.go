@@ -24,6 +24,7 @@ import (
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/external"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/subnetpools"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -42,6 +43,7 @@ type createOpts struct {
 	Name                string `json:"name,omitempty"`
 	PortSecurityEnabled *bool  `json:"port_security_enabled,omitempty"`
 	MTU                 *int32 `json:"mtu,omitempty"`
+	QoSPolicyID         string `json:"qos_policy_id,omitempty"`
 }
 
 func (c createOpts) ToNetworkCreateMap() (map[string]interface{}, error) {
@@ -129,6 +131,14 @@ func (s *Service) ReconcileNetwork(openStackCluster *infrav1.OpenStackCluster, c
 		if openStackCluster.Spec.ManagedNetwork.MTU != nil {
 			opts.MTU = openStackCluster.Spec.ManagedNetwork.MTU
 		}
+
+		if openStackCluster.Spec.ManagedNetwork.QoSPolicy != nil {
+			qosPolicyID, err := s.GetQoSPolicyIDByParam(openStackCluster.Spec.ManagedNetwork.QoSPolicy)
+			if err != nil {
+				return fmt.Errorf("failed to get QoS policy of the network: %w", err)
+			}
+			opts.QoSPolicyID = qosPolicyID
+		}
 	}
 
 	// Determine standard-attr-tag support before creating the network, so
@@ -200,67 +210,142 @@ func (s *Service) ReconcileSubnet(openStackCluster *infrav1.OpenStackCluster, cl
 		return nil
 	}
 
-	subnetName := getSubnetName(clusterResourceName)
+	// The IP version of a subnet allocated from a subnet pool is only known
+	// once the subnet pool is resolved
+	managedSubnets := openStackCluster.Spec.ManagedSubnets
+	subnetPools := make([]*subnetpools.SubnetPool, len(managedSubnets))
+	ipVersions := make([]gophercloud.IPVersion, len(managedSubnets))
+	for i := range managedSubnets {
+		if managedSubnets[i].SubnetPool == nil {
+			ipVersions[i] = getSubnetIPVersion(managedSubnets[i].CIDR)
+			continue
+		}
+		subnetPool, err := s.GetSubnetPoolByParam(managedSubnets[i].SubnetPool)
+		if err != nil {
+			return fmt.Errorf("failed to get subnet pool of managed subnet %d: %w", i, err)
+		}
+		subnetPools[i] = subnetPool
+		ipVersions[i] = gophercloud.IPVersion(subnetPool.IPversion)
+	}
+	if err := checkSubnetIPVersions(ipVersions); err != nil {
+		return err
+	}
+
+	var clusterSubnets []infrav1.Subnet
+	for i := range managedSubnets {
+		subnet, err := s.reconcileManagedSubnet(openStackCluster, clusterResourceName, getManagedSubnetName(clusterResourceName, i), &managedSubnets[i], subnetPools[i])
+		if err != nil {
+			return err
+		}
+
+		clusterSubnets = append(clusterSubnets, infrav1.Subnet{
+			ID:   subnet.ID,
+			Name: subnet.Name,
+			CIDR: subnet.CIDR,
+			Tags: subnet.Tags,
+		})
+	}
+
+	openStackCluster.Status.Network.Subnets = clusterSubnets
+	return nil
+}
+
+// reconcileManagedSubnet creates the subnet described by subnetSpec in the
+// cluster network, or updates it if it already exists. A subnet allocated
+// from subnetPool is looked up by name, as its CIDR is chosen by OpenStack.
+func (s *Service) reconcileManagedSubnet(openStackCluster *infrav1.OpenStackCluster, clusterResourceName, subnetName string, subnetSpec *infrav1.SubnetSpec, subnetPool *subnetpools.SubnetPool) (*subnets.Subnet, error) {
 	s.scope.Logger().Info("Reconciling subnet", "name", subnetName)
 
-	subnetList, err := s.client.ListSubnet(subnets.ListOpts{
+	listOpts := subnets.ListOpts{
 		NetworkID: openStackCluster.Status.Network.ID,
-		// Currently we only support 1 SubnetSpec.
-		CIDR: openStackCluster.Spec.ManagedSubnets[0].CIDR,
-	})
+		CIDR:      subnetSpec.CIDR,
+	}
+	if subnetPool != nil {
+		listOpts = subnets.ListOpts{
+			NetworkID:    openStackCluster.Status.Network.ID,
+			Name:         subnetName,
+			SubnetPoolID: subnetPool.ID,
+		}
+	}
+	subnetList, err := s.client.ListSubnet(listOpts)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if len(subnetList) > 1 {
-		return fmt.Errorf("found %d subnets with the CIDR %s and network %s, which should not happen",
-			len(subnetList), openStackCluster.Spec.ManagedSubnets[0], openStackCluster.Status.Network.ID)
+		if subnetPool != nil {
+			return nil, fmt.Errorf("found %d subnets with the name %s and subnet pool %s in network %s, which should not happen",
+				len(subnetList), subnetName, subnetPool.ID, openStackCluster.Status.Network.ID)
+		}
+		return nil, fmt.Errorf("found %d subnets with the CIDR %s and network %s, which should not happen",
+			len(subnetList), subnetSpec.CIDR, openStackCluster.Status.Network.ID)
 	}
 
-	var subnet *subnets.Subnet
 	if len(subnetList) == 0 {
-		var err error
-		subnet, err = s.createSubnet(openStackCluster, clusterResourceName, subnetName)
-		if err != nil {
-			return err
-		}
-	} else if len(subnetList) == 1 {
-		subnet = &subnetList[0]
-		s.scope.Logger().V(5).Info("Reusing existing subnet", "name", subnet.Name, "id", subnet.ID)
+		return s.createSubnet(openStackCluster, clusterResourceName, subnetName, subnetSpec, subnetPool)
+	}
 
-		if err := s.updateSubnetDNSNameservers(openStackCluster, subnet); err != nil {
-			return err
+	subnet := &subnetList[0]
+	s.scope.Logger().V(5).Info("Reusing existing subnet", "name", subnet.Name, "id", subnet.ID)
+
+	if err := s.updateSubnetDNSNameservers(openStackCluster, subnet, subnetSpec); err != nil {
+		return nil, err
+	}
+	return subnet, nil
+}
+
+// ValidateManagedSubnets checks that managedSubnets contains at most one
+// subnet of each IP version, so that machines get at most one address of each
+// IP version on the cluster network. Subnets allocated from a subnet pool are
+// checked by ReconcileSubnet, once their IP version is known.
+func ValidateManagedSubnets(managedSubnets []infrav1.SubnetSpec) error {
+	var ipVersions []gophercloud.IPVersion
+	for i := range managedSubnets {
+		if managedSubnets[i].SubnetPool == nil {
+			ipVersions = append(ipVersions, getSubnetIPVersion(managedSubnets[i].CIDR))
 		}
 	}
+	return checkSubnetIPVersions(ipVersions)
+}
 
-	openStackCluster.Status.Network.Subnets = []infrav1.Subnet{
-		{
-			ID:   subnet.ID,
-			Name: subnet.Name,
-			CIDR: subnet.CIDR,
-			Tags: subnet.Tags,
-		},
+func checkSubnetIPVersions(ipVersions []gophercloud.IPVersion) error {
+	seen := map[gophercloud.IPVersion]bool{}
+	for _, ipVersion := range ipVersions {
+		if seen[ipVersion] {
+			return fmt.Errorf("ManagedSubnets can contain at most one IPv%d subnet", ipVersion)
+		}
+		seen[ipVersion] = true
 	}
 	return nil
 }
 
-func (s *Service) createSubnet(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, name string) (*subnets.Subnet, error) {
-	cidr := openStackCluster.Spec.ManagedSubnets[0].CIDR
-	ipVersion := gophercloud.IPv4
+func getSubnetIPVersion(cidr string) gophercloud.IPVersion {
 	if ip, _, err := net.ParseCIDR(cidr); err == nil && ip.To4() == nil {
-		ipVersion = gophercloud.IPv6
+		return gophercloud.IPv6
 	}
+	return gophercloud.IPv4
+}
 
+func (s *Service) createSubnet(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string, name string, subnetSpec *infrav1.SubnetSpec, subnetPool *subnetpools.SubnetPool) (*subnets.Subnet, error) {
 	opts := subnets.CreateOpts{
-		NetworkID:      openStackCluster.Status.Network.ID,
-		Name:           name,
-		IPVersion:      ipVersion,
-		CIDR:           cidr,
-		DNSNameservers: openStackCluster.Spec.ManagedSubnets[0].DNSNameservers,
-		Description:    names.GetDescription(clusterResourceName),
+		NetworkID:       openStackCluster.Status.Network.ID,
+		Name:            name,
+		IPVersion:       getSubnetIPVersion(subnetSpec.CIDR),
+		CIDR:            subnetSpec.CIDR,
+		DNSNameservers:  subnetSpec.DNSNameservers,
+		Description:     names.GetDescription(clusterResourceName),
+		IPv6AddressMode: subnetSpec.IPv6AddressMode,
+		IPv6RAMode:      subnetSpec.IPv6RAMode,
+	}
+	if subnetPool != nil {
+		opts.SubnetPoolID = subnetPool.ID
+		opts.IPVersion = gophercloud.IPVersion(subnetPool.IPversion)
+		if subnetSpec.PrefixLength != nil {
+			opts.Prefixlen = int(*subnetSpec.PrefixLength)
+		}
 	}
 
-	for _, pool := range openStackCluster.Spec.ManagedSubnets[0].AllocationPools {
+	for _, pool := range subnetSpec.AllocationPools {
 		opts.AllocationPools = append(opts.AllocationPools, subnets.AllocationPool{Start: pool.Start, End: pool.End})
 	}
 
@@ -301,9 +386,8 @@ func (s *Service) createSubnet(openStackCluster *infrav1.OpenStackCluster, clust
 }
 
 // updateSubnetDNSNameservers updates the DNS nameservers for an existing subnet if they differ from the desired configuration.
-func (s *Service) updateSubnetDNSNameservers(openStackCluster *infrav1.OpenStackCluster, subnet *subnets.Subnet) error {
-	// Picking the first managed subnet since we only support one for now
-	desiredNameservers := openStackCluster.Spec.ManagedSubnets[0].DNSNameservers
+func (s *Service) updateSubnetDNSNameservers(openStackCluster *infrav1.OpenStackCluster, subnet *subnets.Subnet, subnetSpec *infrav1.SubnetSpec) error {
+	desiredNameservers := subnetSpec.DNSNameservers
 	currentNameservers := subnet.DNSNameservers
 
 	var needsUpdate bool
@@ -448,6 +532,36 @@ func (s *Service) GetSubnetByParam(param *infrav1.SubnetParam) (*subnets.Subnet,
 	return s.GetNetworkSubnetByParam("", param)
 }
 
+// GetSubnetPoolByParam gets the subnet pool specified by the given
+// SubnetPoolParam. It returns an ErrFilterMatch if no or multiple subnet pools
+// are found.
+func (s *Service) GetSubnetPoolByParam(param *infrav1.SubnetPoolParam) (*subnetpools.SubnetPool, error) {
+	if param.ID != nil {
+		subnetPool, err := s.client.GetSubnetPool(*param.ID)
+		if capoerrors.IsNotFound(err) {
+			return nil, capoerrors.ErrNoMatches
+		}
+		return subnetPool, err
+	}
+
+	if param.Filter == nil {
+		// Should have been caught by validation
+		return nil, errors.New("subnet pool filter: both id and filter are nil")
+	}
+
+	subnetPoolList, err := s.client.ListSubnetPool(filterconvert.SubnetPoolFilterToListOpts(param.Filter))
+	if err != nil {
+		return nil, err
+	}
+	switch len(subnetPoolList) {
+	case 0:
+		return nil, capoerrors.ErrNoMatches
+	case 1:
+		return &subnetPoolList[0], nil
+	}
+	return nil, capoerrors.ErrMultipleMatches
+}
+
 // GetNetworkSubnetByParam gets a single subnet of the given network, specified by the given SubnetParam.
 // It returns an ErrFilterMatch if no or multiple subnets are found.
 func (s *Service) GetNetworkSubnetByParam(networkID string, param *infrav1.SubnetParam) (*subnets.Subnet, error) {
@@ -491,6 +605,16 @@ func getSubnetName(clusterResourceName string) string {
 	return fmt.Sprintf("%s-cluster-%s", networkPrefix, clusterResourceName)
 }
 
+// getManagedSubnetName returns the name of the subnet created for the
+// ManagedSubnets entry at index. The first subnet keeps the name used before
+// more than one managed subnet was supported.
+func getManagedSubnetName(clusterResourceName string, index int) string {
+	if index == 0 {
+		return getSubnetName(clusterResourceName)
+	}
+	return fmt.Sprintf("%s-%d", getSubnetName(clusterResourceName), index)
+}
+
 func getNetworkName(clusterResourceName string) string {
 	return fmt.Sprintf("%s-cluster-%s", networkPrefix, clusterResourceName)
 }
@@ -26,6 +26,8 @@ import (
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/attributestags"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/external"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/subnetpools"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
 	. "github.com/onsi/gomega"
@@ -128,7 +130,7 @@ func Test_updateSubnetDNSNameservers(t *testing.T) {
 				scope:  scope.NewWithLogger(scopeFactory, log),
 			}
 
-			err := s.updateSubnetDNSNameservers(cluster, subnet)
+			err := s.updateSubnetDNSNameservers(cluster, subnet, &cluster.Spec.ManagedSubnets[0])
 			g.Expect(err).ShouldNot(HaveOccurred())
 			g.Expect(subnet.DNSNameservers).To(Equal(tt.desiredNameservers))
 		})
@@ -141,6 +143,7 @@ func Test_ReconcileNetwork(t *testing.T) {
 
 	expectedNetworkName := getNetworkName(clusterResourceName)
 	fakeNetworkID := "d08803fc-2fa5-4179-b9f7-8c43d0af2fe6"
+	fakeQoSPolicyID := "3e8b1f2a-7c4d-4a9e-b6f0-5d2c8a1e7b39"
 
 	tests := []struct {
 		name             string
@@ -248,6 +251,72 @@ func Test_ReconcileNetwork(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "creation with QoS policy",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedNetwork: &infrav1.ManagedNetwork{
+						QoSPolicy: &infrav1.QoSPolicyParam{
+							Filter: &infrav1.QoSPolicyFilter{Name: "cluster-qos"},
+						},
+					},
+				},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.
+					ListNetwork(networks.ListOpts{Name: expectedNetworkName}).
+					Return([]networks.Network{}, nil)
+
+				m.
+					ListQoSPolicy(policies.ListOpts{Name: "cluster-qos"}).
+					Return([]policies.Policy{{ID: fakeQoSPolicyID}}, nil)
+
+				m.
+					CreateNetwork(createOpts{
+						AdminStateUp: gophercloud.Enabled,
+						Name:         expectedNetworkName,
+						QoSPolicyID:  fakeQoSPolicyID,
+					}).
+					Return(&networks.Network{
+						ID:   fakeNetworkID,
+						Name: expectedNetworkName,
+					}, nil)
+			},
+			want: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						NetworkStatus: infrav1.NetworkStatus{
+							ID:   fakeNetworkID,
+							Name: expectedNetworkName,
+							Tags: []string{},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "creation fails when the QoS policy is not found",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedNetwork: &infrav1.ManagedNetwork{
+						QoSPolicy: &infrav1.QoSPolicyParam{
+							Filter: &infrav1.QoSPolicyFilter{Name: "cluster-qos"},
+						},
+					},
+				},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.
+					ListNetwork(networks.ListOpts{Name: expectedNetworkName}).
+					Return([]networks.Network{}, nil)
+
+				m.
+					ListQoSPolicy(policies.ListOpts{Name: "cluster-qos"}).
+					Return([]policies.Policy{}, nil)
+			},
+			wantErr: true,
+		},
 		{
 			name: "creation with tags when standard-attr-tag is not supported",
 			openStackCluster: &infrav1.OpenStackCluster{
@@ -680,6 +749,7 @@ func Test_ReconcileSubnet(t *testing.T) {
 	fakeNetworkID := "d08803fc-2fa5-4279-b9f7-8c45d0ff2fe6"
 	fakeDNS1 := "10.0.10.200"
 	fakeDNS2 := "10.0.10.201"
+	fakeSubnetPoolID := "5b1c7d2e-3f4a-4e6b-8c9d-0a1b2c3d4e5f"
 
 	tests := []struct {
 		name             string
@@ -1436,6 +1506,179 @@ func Test_ReconcileSubnet(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "creation of dual-stack subnets",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedSubnets: []infrav1.SubnetSpec{
+						{
+							CIDR: fakeCIDR,
+						},
+						{
+							CIDR:            "fd12:3456:789a::/64",
+							IPv6AddressMode: "slaac",
+							IPv6RAMode:      "slaac",
+						},
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						NetworkStatus: infrav1.NetworkStatus{
+							ID: fakeNetworkID,
+						},
+					},
+				},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.
+					ListSubnet(subnets.ListOpts{NetworkID: fakeNetworkID, CIDR: fakeCIDR}).
+					Return([]subnets.Subnet{
+						{
+							ID:   fakeSubnetID,
+							Name: expectedSubnetName,
+							CIDR: fakeCIDR,
+						},
+					}, nil)
+
+				m.
+					ListSubnet(subnets.ListOpts{NetworkID: fakeNetworkID, CIDR: "fd12:3456:789a::/64"}).
+					Return([]subnets.Subnet{}, nil)
+
+				m.
+					CreateSubnet(subnets.CreateOpts{
+						NetworkID:       fakeNetworkID,
+						Name:            expectedSubnetName + "-1",
+						IPVersion:       6,
+						CIDR:            "fd12:3456:789a::/64",
+						Description:     expectedSubnetDesc,
+						IPv6AddressMode: "slaac",
+						IPv6RAMode:      "slaac",
+					}).
+					Return(&subnets.Subnet{
+						ID:   "7c34a9f7-5a3d-4b2c-9a64-2c5a8d3f1e90",
+						Name: expectedSubnetName + "-1",
+						CIDR: "fd12:3456:789a::/64",
+					}, nil)
+			},
+			want: &infrav1.OpenStackClusterStatus{
+				Network: &infrav1.NetworkStatusWithSubnets{
+					NetworkStatus: infrav1.NetworkStatus{
+						ID: fakeNetworkID,
+					},
+					Subnets: []infrav1.Subnet{
+						{
+							Name: expectedSubnetName,
+							ID:   fakeSubnetID,
+							CIDR: fakeCIDR,
+						},
+						{
+							Name: expectedSubnetName + "-1",
+							ID:   "7c34a9f7-5a3d-4b2c-9a64-2c5a8d3f1e90",
+							CIDR: "fd12:3456:789a::/64",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "creation from a subnet pool",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedSubnets: []infrav1.SubnetSpec{
+						{
+							SubnetPool: &infrav1.SubnetPoolParam{
+								Filter: &infrav1.SubnetPoolFilter{Name: "cluster-pool"},
+							},
+							PrefixLength: ptr.To[int32](26),
+						},
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						NetworkStatus: infrav1.NetworkStatus{
+							ID: fakeNetworkID,
+						},
+					},
+				},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.
+					ListSubnetPool(subnetpools.ListOpts{Name: "cluster-pool"}).
+					Return([]subnetpools.SubnetPool{
+						{
+							ID:        fakeSubnetPoolID,
+							Name:      "cluster-pool",
+							IPversion: 4,
+						},
+					}, nil)
+
+				m.
+					ListSubnet(subnets.ListOpts{NetworkID: fakeNetworkID, Name: expectedSubnetName, SubnetPoolID: fakeSubnetPoolID}).
+					Return([]subnets.Subnet{}, nil)
+
+				m.
+					CreateSubnet(subnets.CreateOpts{
+						NetworkID:    fakeNetworkID,
+						Name:         expectedSubnetName,
+						IPVersion:    4,
+						Description:  expectedSubnetDesc,
+						SubnetPoolID: fakeSubnetPoolID,
+						Prefixlen:    26,
+					}).
+					Return(&subnets.Subnet{
+						ID:   fakeSubnetID,
+						Name: expectedSubnetName,
+						CIDR: "10.10.0.0/26",
+					}, nil)
+			},
+			want: &infrav1.OpenStackClusterStatus{
+				Network: &infrav1.NetworkStatusWithSubnets{
+					NetworkStatus: infrav1.NetworkStatus{
+						ID: fakeNetworkID,
+					},
+					Subnets: []infrav1.Subnet{
+						{
+							Name: expectedSubnetName,
+							ID:   fakeSubnetID,
+							CIDR: "10.10.0.0/26",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "fails when a subnet pool has the same IP version as another subnet",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedSubnets: []infrav1.SubnetSpec{
+						{
+							CIDR: fakeCIDR,
+						},
+						{
+							SubnetPool: &infrav1.SubnetPoolParam{
+								ID: ptr.To(fakeSubnetPoolID),
+							},
+						},
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						NetworkStatus: infrav1.NetworkStatus{
+							ID: fakeNetworkID,
+						},
+					},
+				},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.
+					GetSubnetPool(fakeSubnetPoolID).
+					Return(&subnetpools.SubnetPool{
+						ID:        fakeSubnetPoolID,
+						IPversion: 4,
+					}, nil)
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1459,3 +1702,45 @@ func Test_ReconcileSubnet(t *testing.T) {
 		})
 	}
 }
+
+func Test_ValidateManagedSubnets(t *testing.T) {
+	tests := []struct {
+		name           string
+		managedSubnets []infrav1.SubnetSpec
+		wantErr        string
+	}{
+		{
+			name:           "IPv4 subnet",
+			managedSubnets: []infrav1.SubnetSpec{{CIDR: "10.0.0.0/24"}},
+		},
+		{
+			name:           "IPv4 and IPv6 subnets",
+			managedSubnets: []infrav1.SubnetSpec{{CIDR: "10.0.0.0/24"}, {CIDR: "fd12:3456:789a::/64"}},
+		},
+		{
+			name:           "two IPv4 subnets",
+			managedSubnets: []infrav1.SubnetSpec{{CIDR: "10.0.0.0/24"}, {CIDR: "10.0.1.0/24"}},
+			wantErr:        "ManagedSubnets can contain at most one IPv4 subnet",
+		},
+		{
+			name:           "two IPv6 subnets",
+			managedSubnets: []infrav1.SubnetSpec{{CIDR: "fd12:3456:789a::/64"}, {CIDR: "fd12:3456:789b::/64"}},
+			wantErr:        "ManagedSubnets can contain at most one IPv6 subnet",
+		},
+		{
+			name:           "IPv4 subnet and subnet pool",
+			managedSubnets: []infrav1.SubnetSpec{{CIDR: "10.0.0.0/24"}, {SubnetPool: &infrav1.SubnetPoolParam{ID: ptr.To("subnetpool-id")}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := ValidateManagedSubnets(tt.managedSubnets)
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(tt.wantErr))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/portsbinding"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/portsecurity"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/portstrustedvif"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -272,6 +273,31 @@ func (s *Service) EnsurePort(eventObject runtime.Object, portSpec *infrav1.Resol
 		builder = portSecurityOpts
 	}
 
+	if portSpec.QoSPolicyID != nil {
+		builder = policies.PortCreateOptsExt{
+			CreateOptsBuilder: builder,
+			QoSPolicyID:       *portSpec.QoSPolicyID,
+		}
+	}
+
+	// DNS attributes are only accepted by Neutron when the dns-integration
+	// extension is available, so skip them rather than fail port creation.
+	if portSpec.DNSName != nil || portSpec.DNSDomain != nil {
+		dnsSupported, err := s.HasDNSIntegrationExtension()
+		if err != nil {
+			return nil, err
+		}
+		if dnsSupported {
+			builder = portDNSCreateOptsExt{
+				CreateOptsBuilder: builder,
+				DNSName:           ptr.Deref(portSpec.DNSName, ""),
+				DNSDomain:         ptr.Deref(portSpec.DNSDomain, ""),
+			}
+		} else {
+			s.scope.Logger().V(4).Info("dns-integration extension not available, skipping port DNS attributes", "port", portSpec.Name)
+		}
+	}
+
 	// Determine if port_trusted_vif extension is available when TrustedVF is requested.
 	// If available, we use the dedicated port attribute instead of binding:profile.
 	var usePortTrustedVIF bool
@@ -313,6 +339,33 @@ func (s *Service) EnsurePort(eventObject runtime.Object, portSpec *infrav1.Resol
 	return port, nil
 }
 
+// portDNSCreateOptsExt adds the dns_name and dns_domain attributes of the
+// Neutron dns-integration extension to a port create request. Gophercloud's
+// dns.PortCreateOptsExt only supports dns_name.
+type portDNSCreateOptsExt struct {
+	ports.CreateOptsBuilder
+
+	DNSName   string
+	DNSDomain string
+}
+
+func (opts portDNSCreateOptsExt) ToPortCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToPortCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	port := base["port"].(map[string]interface{})
+	if opts.DNSName != "" {
+		port["dns_name"] = opts.DNSName
+	}
+	if opts.DNSDomain != "" {
+		port["dns_domain"] = opts.DNSDomain
+	}
+
+	return base, nil
+}
+
 func getPortProfile(p *infrav1.BindingProfile, usePortTrustedVIF bool) map[string]interface{} {
 	if p == nil {
 		return nil
@@ -502,7 +555,8 @@ func (s *Service) ConstructPorts(instancePorts []infrav1.PortOpts, instanceSecur
 
 // normalizePorts ensures that a user-specified PortOpts has all required fields set. Specifically it:
 // - sets the Trunk field to the instance spec default if not specified
-// - sets the Network ID field if not specified.
+// - sets the Network ID field if not specified
+// - sets the DNSName field to the hostname of the server if not specified but DNSDomain is.
 func (s *Service) normalizePorts(ports []infrav1.PortOpts, clusterResourceName, baseName string, trunkEnabled bool, defaultSecurityGroupIDs []string, defaultNetwork *infrav1.NetworkStatusWithSubnets, baseTags []string) ([]infrav1.ResolvedPortSpec, error) {
 	normalizedPorts := make([]infrav1.ResolvedPortSpec, len(ports))
 	for i := range ports {
@@ -541,6 +595,21 @@ func (s *Service) normalizePorts(ports []infrav1.PortOpts, clusterResourceName,
 			return nil, err
 		}
 
+		// When DNS records are requested for the port, its DNS name defaults
+		// to the hostname Nova derives from the name of the server, which
+		// Nova requires it to match
+		if port.DNSName == nil && port.DNSDomain != nil && baseName != "" {
+			normalizedPort.DNSName = ptr.To(names.GetHostname(baseName))
+		}
+
+		if port.QoSPolicy != nil {
+			qosPolicyID, err := s.GetQoSPolicyIDByParam(port.QoSPolicy)
+			if err != nil {
+				return nil, fmt.Errorf("error getting QoS policy: %w", err)
+			}
+			normalizedPort.QoSPolicyID = &qosPolicyID
+		}
+
 		// Resolve security groups when port security is not disabled
 		if ptr.Deref(port.EnablePortSecurity, true) {
 			if len(port.SecurityGroups) == 0 {
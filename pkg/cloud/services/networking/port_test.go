@@ -27,6 +27,7 @@ import (
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/portsbinding"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/portsecurity"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/portstrustedvif"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/trunks"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
@@ -56,6 +57,7 @@ func Test_EnsurePort(t *testing.T) {
 		ipAddress1          = "192.0.2.1"
 		ipAddress2          = "198.51.100.1"
 		macAddress          = "de:ad:be:ef:fe:ed"
+		qosPolicyID         = "1f4a0c5e-6d2b-4b8e-9c3a-7e5d2f1b0a94"
 	)
 
 	tests := []struct {
@@ -248,6 +250,108 @@ func Test_EnsurePort(t *testing.T) {
 			},
 			want: &ports.Port{ID: portID},
 		},
+		{
+			name: "creates port with QoS policy",
+			port: infrav1.ResolvedPortSpec{
+				Name:        "test-port",
+				NetworkID:   netID,
+				QoSPolicyID: ptr.To(qosPolicyID),
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder, g Gomega) {
+				var expectedCreateOpts ports.CreateOptsBuilder
+				expectedCreateOpts = ports.CreateOpts{
+					NetworkID: netID,
+					Name:      "test-port",
+				}
+				expectedCreateOpts = policies.PortCreateOptsExt{
+					CreateOptsBuilder: expectedCreateOpts,
+					QoSPolicyID:       qosPolicyID,
+				}
+				expectedCreateOpts = portsbinding.CreateOptsExt{
+					CreateOptsBuilder: expectedCreateOpts,
+				}
+				m.ListPort(ports.ListOpts{
+					Name:      "test-port",
+					NetworkID: netID,
+				}).Return(nil, nil)
+				m.CreatePort(gomock.Any()).DoAndReturn(func(builder ports.CreateOptsBuilder) (*ports.Port, error) {
+					gotCreateOpts := builder.(portsbinding.CreateOptsExt)
+					g.Expect(gotCreateOpts).To(Equal(expectedCreateOpts), cmp.Diff(gotCreateOpts, expectedCreateOpts))
+					return &ports.Port{ID: portID}, nil
+				})
+			},
+			want: &ports.Port{ID: portID},
+		},
+		{
+			name: "creates port with DNS name and domain when dns-integration is supported",
+			port: infrav1.ResolvedPortSpec{
+				Name:      "test-port",
+				NetworkID: netID,
+				ResolvedPortSpecFields: infrav1.ResolvedPortSpecFields{
+					DNSName:   ptr.To("test-server"),
+					DNSDomain: ptr.To("example.com."),
+				},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder, g Gomega) {
+				var expectedCreateOpts ports.CreateOptsBuilder
+				expectedCreateOpts = ports.CreateOpts{
+					NetworkID: netID,
+					Name:      "test-port",
+				}
+				expectedCreateOpts = portDNSCreateOptsExt{
+					CreateOptsBuilder: expectedCreateOpts,
+					DNSName:           "test-server",
+					DNSDomain:         "example.com.",
+				}
+				expectedCreateOpts = portsbinding.CreateOptsExt{
+					CreateOptsBuilder: expectedCreateOpts,
+				}
+				m.ListPort(ports.ListOpts{
+					Name:      "test-port",
+					NetworkID: netID,
+				}).Return(nil, nil)
+				dnsIntegrationExt := extensions.Extension{}
+				dnsIntegrationExt.Alias = "dns-integration"
+				m.ListExtensions().Return([]extensions.Extension{dnsIntegrationExt}, nil)
+				m.CreatePort(gomock.Any()).DoAndReturn(func(builder ports.CreateOptsBuilder) (*ports.Port, error) {
+					gotCreateOpts := builder.(portsbinding.CreateOptsExt)
+					g.Expect(gotCreateOpts).To(Equal(expectedCreateOpts), cmp.Diff(gotCreateOpts, expectedCreateOpts))
+					return &ports.Port{ID: portID}, nil
+				})
+			},
+			want: &ports.Port{ID: portID},
+		},
+		{
+			name: "creates port without DNS name when dns-integration is not supported",
+			port: infrav1.ResolvedPortSpec{
+				Name:      "test-port",
+				NetworkID: netID,
+				ResolvedPortSpecFields: infrav1.ResolvedPortSpecFields{
+					DNSName: ptr.To("test-server"),
+				},
+			},
+			expect: func(m *mock.MockNetworkClientMockRecorder, g Gomega) {
+				var expectedCreateOpts ports.CreateOptsBuilder
+				expectedCreateOpts = ports.CreateOpts{
+					NetworkID: netID,
+					Name:      "test-port",
+				}
+				expectedCreateOpts = portsbinding.CreateOptsExt{
+					CreateOptsBuilder: expectedCreateOpts,
+				}
+				m.ListPort(ports.ListOpts{
+					Name:      "test-port",
+					NetworkID: netID,
+				}).Return(nil, nil)
+				m.ListExtensions().Return([]extensions.Extension{}, nil)
+				m.CreatePort(gomock.Any()).DoAndReturn(func(builder ports.CreateOptsBuilder) (*ports.Port, error) {
+					gotCreateOpts := builder.(portsbinding.CreateOptsExt)
+					g.Expect(gotCreateOpts).To(Equal(expectedCreateOpts), cmp.Diff(gotCreateOpts, expectedCreateOpts))
+					return &ports.Port{ID: portID}, nil
+				})
+			},
+			want: &ports.Port{ID: portID},
+		},
 		{
 			name: "disable port security with security groups produces an error",
 			port: infrav1.ResolvedPortSpec{
@@ -655,6 +759,7 @@ func TestService_ConstructPorts(t *testing.T) {
 		subnetID2        = "41ad8201-5b2f-4e0e-b29d-3d82fad6ef10"
 		securityGroupID1 = "044f6d31-3938-4f09-ad45-47b661e2ba1c"
 		securityGroupID2 = "427b77ee-40b7-4f1b-b025-72ad1a42ee51"
+		qosPolicyID      = "9b0e3d6a-2c41-4f7e-8a5b-1d6c9e2f4a73"
 
 		defaultDescription = "Created by cluster-api-provider-openstack cluster test-cluster"
 	)
@@ -817,6 +922,53 @@ func TestService_ConstructPorts(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "QoS policy defined by filter: add ID from QoS policy lookup",
+			spec: infrav1.OpenStackMachineSpec{
+				Ports: []infrav1.PortOpts{
+					{
+						QoSPolicy: &infrav1.QoSPolicyParam{
+							Filter: &infrav1.QoSPolicyFilter{Name: "test-qos-policy"},
+						},
+					},
+				},
+			},
+			expectNetwork: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListQoSPolicy(policies.ListOpts{Name: "test-qos-policy"}).Return([]policies.Policy{
+					{ID: qosPolicyID},
+				}, nil)
+			},
+			want: []infrav1.ResolvedPortSpec{
+				{
+					QoSPolicyID: ptr.To(qosPolicyID),
+
+					// Defaults
+					Name:        "test-instance-0",
+					Description: defaultDescription,
+					Tags:        []string{"test-tag"},
+					NetworkID:   defaultNetworkID,
+					FixedIPs: []infrav1.ResolvedFixedIP{
+						{SubnetID: ptr.To(defaultSubnetID)},
+					},
+				},
+			},
+		},
+		{
+			name: "QoS policy filter returns no matches: error",
+			spec: infrav1.OpenStackMachineSpec{
+				Ports: []infrav1.PortOpts{
+					{
+						QoSPolicy: &infrav1.QoSPolicyParam{
+							Filter: &infrav1.QoSPolicyFilter{Name: "test-qos-policy"},
+						},
+					},
+				},
+			},
+			expectNetwork: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListQoSPolicy(policies.ListOpts{Name: "test-qos-policy"}).Return([]policies.Policy{}, nil)
+			},
+			wantErr: true,
+		},
 		{
 			name: "No network, fixed IP has subnet by ID: add ID from subnet",
 			spec: infrav1.OpenStackMachineSpec{
@@ -980,6 +1132,81 @@ func TestService_ConstructPorts(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "No DNS fields on port: no DNS fields on the resolved port",
+			spec: infrav1.OpenStackMachineSpec{
+				Ports: []infrav1.PortOpts{
+					{
+						Network: &infrav1.NetworkParam{
+							ID: ptr.To(networkID),
+						},
+					},
+				},
+			},
+			want: []infrav1.ResolvedPortSpec{
+				{
+					Name:        "test-instance-0",
+					Description: defaultDescription,
+					NetworkID:   networkID,
+					Tags:        []string{"test-tag"},
+				},
+			},
+		},
+		{
+			name: "DNS domain set on port: DNS name defaulted to the hostname of the server",
+			spec: infrav1.OpenStackMachineSpec{
+				Ports: []infrav1.PortOpts{
+					{
+						Network: &infrav1.NetworkParam{
+							ID: ptr.To(networkID),
+						},
+						ResolvedPortSpecFields: infrav1.ResolvedPortSpecFields{
+							DNSDomain: ptr.To("example.com."),
+						},
+					},
+				},
+			},
+			want: []infrav1.ResolvedPortSpec{
+				{
+					Name:        "test-instance-0",
+					Description: defaultDescription,
+					NetworkID:   networkID,
+					Tags:        []string{"test-tag"},
+					ResolvedPortSpecFields: infrav1.ResolvedPortSpecFields{
+						DNSName:   ptr.To("test-instance"),
+						DNSDomain: ptr.To("example.com."),
+					},
+				},
+			},
+		},
+		{
+			name: "DNS name set on port: not defaulted to the server name",
+			spec: infrav1.OpenStackMachineSpec{
+				Ports: []infrav1.PortOpts{
+					{
+						Network: &infrav1.NetworkParam{
+							ID: ptr.To(networkID),
+						},
+						ResolvedPortSpecFields: infrav1.ResolvedPortSpecFields{
+							DNSName:   ptr.To("custom-dns-name"),
+							DNSDomain: ptr.To("example.com."),
+						},
+					},
+				},
+			},
+			want: []infrav1.ResolvedPortSpec{
+				{
+					Name:        "test-instance-0",
+					Description: defaultDescription,
+					NetworkID:   networkID,
+					Tags:        []string{"test-tag"},
+					ResolvedPortSpecFields: infrav1.ResolvedPortSpecFields{
+						DNSName:   ptr.To("custom-dns-name"),
+						DNSDomain: ptr.To("example.com."),
+					},
+				},
+			},
+		},
 		{
 			name: "machine spec security groups added to defaults",
 			spec: infrav1.OpenStackMachineSpec{
@@ -1155,6 +1382,38 @@ func TestService_ConstructPorts(t *testing.T) {
 	}
 }
 
+// TestService_ConstructPorts_DNSName tests that the DNS name of a port is only defaulted when the port requests DNS
+// records with a DNS domain, and then to the hostname Nova derives from the name of the server.
+func TestService_ConstructPorts_DNSName(t *testing.T) {
+	const networkID = "afa54944-1443-4132-9ef5-ce37eb4d6ab6"
+
+	g := NewWithT(t)
+	mockCtrl := gomock.NewController(t)
+	s := Service{
+		client: mock.NewMockNetworkClient(mockCtrl),
+		scope:  scope.NewWithLogger(scope.NewMockScopeFactory(mockCtrl, ""), testr.New(t)),
+	}
+
+	ports := []infrav1.PortOpts{
+		{
+			Network: &infrav1.NetworkParam{ID: ptr.To(networkID)},
+			ResolvedPortSpecFields: infrav1.ResolvedPortSpecFields{
+				DNSDomain: ptr.To("example.com."),
+			},
+		},
+		{
+			Network: &infrav1.NetworkParam{ID: ptr.To(networkID)},
+		},
+	}
+	got, err := s.ConstructPorts(ports, nil, false, "test-cluster", "Test_Instance.1", nil, nil, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got).To(HaveLen(2))
+	g.Expect(got[0].DNSName).To(HaveValue(Equal("test-instance-1")))
+	g.Expect(got[0].DNSDomain).To(HaveValue(Equal("example.com.")))
+	g.Expect(got[1].DNSName).To(BeNil())
+	g.Expect(got[1].DNSDomain).To(BeNil())
+}
+
 func Test_getPortName(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"errors"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/filterconvert"
+)
+
+// GetQoSPolicyIDByParam returns the ID of the QoS policy specified by the
+// given QoSPolicyParam. It does not make an OpenStack call if the QoS policy
+// is specified by ID. It returns an ErrFilterMatch if no or multiple QoS
+// policies are found.
+func (s *Service) GetQoSPolicyIDByParam(param *infrav1.QoSPolicyParam) (string, error) {
+	if param.ID != nil {
+		return *param.ID, nil
+	}
+
+	if param.Filter == nil {
+		// Should have been caught by validation
+		return "", errors.New("QoS policy filter: both id and filter are nil")
+	}
+
+	policyList, err := s.client.ListQoSPolicy(filterconvert.QoSPolicyFilterToListOpts(param.Filter))
+	if err != nil {
+		return "", err
+	}
+	switch len(policyList) {
+	case 0:
+		return "", capoerrors.ErrNoMatches
+	case 1:
+		return policyList[0].ID, nil
+	}
+	return "", capoerrors.ErrMultipleMatches
+}
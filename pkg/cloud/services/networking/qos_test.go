@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+func Test_GetQoSPolicyIDByParam(t *testing.T) {
+	const qosPolicyID = "6a1f3e9c-2b7d-4c5a-8e0f-9d4b2a6c1e57"
+
+	filter := &infrav1.QoSPolicyFilter{Name: "test-qos-policy"}
+
+	tests := []struct {
+		name    string
+		param   infrav1.QoSPolicyParam
+		expect  func(m *mock.MockNetworkClientMockRecorder)
+		want    string
+		wantErr error
+	}{
+		{
+			name:   "QoS policy by ID is not looked up",
+			param:  infrav1.QoSPolicyParam{ID: ptr.To(qosPolicyID)},
+			expect: func(*mock.MockNetworkClientMockRecorder) {},
+			want:   qosPolicyID,
+		},
+		{
+			name:  "QoS policy by filter",
+			param: infrav1.QoSPolicyParam{Filter: filter},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListQoSPolicy(policies.ListOpts{Name: "test-qos-policy"}).Return([]policies.Policy{{ID: qosPolicyID}}, nil)
+			},
+			want: qosPolicyID,
+		},
+		{
+			name:  "QoS policy filter matches nothing",
+			param: infrav1.QoSPolicyParam{Filter: filter},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListQoSPolicy(policies.ListOpts{Name: "test-qos-policy"}).Return(nil, nil)
+			},
+			wantErr: capoerrors.ErrNoMatches,
+		},
+		{
+			name:  "QoS policy filter matches multiple policies",
+			param: infrav1.QoSPolicyParam{Filter: filter},
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.ListQoSPolicy(policies.ListOpts{Name: "test-qos-policy"}).Return([]policies.Policy{{ID: qosPolicyID}, {ID: "other"}}, nil)
+			},
+			wantErr: capoerrors.ErrMultipleMatches,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, "")
+			tt.expect(mockScopeFactory.NetworkClient.EXPECT())
+
+			s, err := NewService(scope.NewWithLogger(mockScopeFactory, testr.New(t)))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			got, err := s.GetQoSPolicyIDByParam(&tt.param)
+			if tt.wantErr != nil {
+				g.Expect(err).To(MatchError(tt.wantErr))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(got).To(Equal(tt.want))
+		})
+	}
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"errors"
+
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+// CheckQuota returns a QuotaExceededError if creating the given number of
+// ports and floating IPs would exceed a networking quota of the project.
+// Quotas which cannot be read are not checked.
+func (s *Service) CheckQuota(ports, floatingIPs int) error {
+	projectID := s.scope.ProjectID()
+	if projectID == "" || (ports <= 0 && floatingIPs <= 0) {
+		return nil
+	}
+
+	quota, err := s.client.GetQuotaDetail(projectID)
+	if err != nil {
+		s.scope.Logger().V(3).Info("Unable to read networking quota", "error", err)
+		return nil
+	}
+
+	return errors.Join(
+		capoerrors.CheckQuota("port", ports, quota.Port.Used+quota.Port.Reserved, quota.Port.Limit),
+		capoerrors.CheckQuota("floatingip", floatingIPs, quota.FloatingIP.Used+quota.FloatingIP.Reserved, quota.FloatingIP.Limit),
+	)
+}
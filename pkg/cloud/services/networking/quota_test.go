@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/quotas"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+)
+
+func Test_CheckQuota(t *testing.T) {
+	const projectID = "project-id"
+
+	quota := &quotas.QuotaDetailSet{
+		Port:       quotas.QuotaDetail{Used: 8, Reserved: 1, Limit: 10},
+		FloatingIP: quotas.QuotaDetail{Used: 2, Limit: -1},
+	}
+
+	tests := []struct {
+		name         string
+		ports        int
+		floatingIPs  int
+		expect       func(m *mock.MockNetworkClientMockRecorder)
+		wantExceeded bool
+	}{
+		{
+			name:  "within quota",
+			ports: 1,
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.GetQuotaDetail(projectID).Return(quota, nil)
+			},
+		},
+		{
+			name:  "reserved ports count against the quota",
+			ports: 2,
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.GetQuotaDetail(projectID).Return(quota, nil)
+			},
+			wantExceeded: true,
+		},
+		{
+			name:        "unlimited floating IPs",
+			floatingIPs: 100,
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.GetQuotaDetail(projectID).Return(quota, nil)
+			},
+		},
+		{
+			name:  "quota cannot be read",
+			ports: 2,
+			expect: func(m *mock.MockNetworkClientMockRecorder) {
+				m.GetQuotaDetail(projectID).Return(nil, gophercloud.ErrUnexpectedResponseCode{Actual: 403})
+			},
+		},
+		{
+			name:   "nothing to create",
+			expect: func(*mock.MockNetworkClientMockRecorder) {},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			mockCtrl := gomock.NewController(t)
+			mockScopeFactory := scope.NewMockScopeFactory(mockCtrl, projectID)
+			tt.expect(mockScopeFactory.NetworkClient.EXPECT())
+
+			s, err := NewService(scope.NewWithLogger(mockScopeFactory, testr.New(t)))
+			g.Expect(err).ToNot(HaveOccurred())
+
+			err = s.CheckQuota(tt.ports, tt.floatingIPs)
+			if tt.wantExceeded {
+				g.Expect(capoerrors.IsQuotaExceeded(err)).To(BeTrue())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
@@ -263,23 +263,27 @@ func (s *Service) DeleteRouter(openStackCluster *infrav1.OpenStackCluster, clust
 		return nil
 	}
 
-	subnetName := getSubnetName(clusterResourceName)
-	subnet, err := s.getSubnetByName(subnetName)
-	if err != nil {
-		return err
-	}
-
-	if subnet.ID != "" {
-		_, err = s.client.RemoveRouterInterface(router.ID, routers.RemoveInterfaceOpts{
-			SubnetID: subnet.ID,
-		})
+	// Remove the interfaces of the subnets created for ManagedSubnets. The
+	// subnet named after the cluster is always looked up, which does nothing
+	// if it does not exist.
+	for i := range max(len(openStackCluster.Spec.ManagedSubnets), 1) {
+		subnet, err := s.getSubnetByName(getManagedSubnetName(clusterResourceName, i))
 		if err != nil {
-			if !capoerrors.IsNotFound(err) {
-				return fmt.Errorf("unable to remove router interface: %v", err)
+			return err
+		}
+
+		if subnet.ID != "" {
+			_, err = s.client.RemoveRouterInterface(router.ID, routers.RemoveInterfaceOpts{
+				SubnetID: subnet.ID,
+			})
+			if err != nil {
+				if !capoerrors.IsNotFound(err) {
+					return fmt.Errorf("unable to remove router interface: %v", err)
+				}
+				s.scope.Logger().V(4).Info("Router interface already removed, nothing to do", "id", router.ID, "subnetID", subnet.ID)
+			} else {
+				s.scope.Logger().V(4).Info("Removed RouterInterface of router", "id", router.ID, "subnetID", subnet.ID)
 			}
-			s.scope.Logger().V(4).Info("Router interface already removed, nothing to do", "id", router.ID)
-		} else {
-			s.scope.Logger().V(4).Info("Removed RouterInterface of router", "id", router.ID)
 		}
 	}
 
@@ -42,6 +42,8 @@ func TestService_DeleteRouter(t *testing.T) {
 
 		routerID = "38052015-5cbc-4cb4-8e45-445d53260f60"
 		subnetID = "283ee906-0072-4c81-92fb-9858e90c3c4e"
+
+		ipv6SubnetID = "5b1e3c42-8f0d-4c7e-a2b9-6d4f0e8a1c37"
 	)
 	tests := []struct {
 		name             string
@@ -73,6 +75,37 @@ func TestService_DeleteRouter(t *testing.T) {
 				m.DeleteRouter(routerID).Return(nil)
 			},
 		},
+		{
+			name: "Managed router with dual-stack subnets",
+			openStackCluster: infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedSubnets: []infrav1.SubnetSpec{
+						{CIDR: "10.0.0.0/24"},
+						{CIDR: "fd12:3456:789a::/64"},
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Router: &infrav1.Router{
+						ID: routerID,
+					},
+				},
+			},
+			expect: func(_ Gomega, m *mock.MockNetworkClientMockRecorder) {
+				// Get by ID in status
+				// Remove the interfaces of both subnets
+				// Delete router
+				m.GetRouter(routerID).Return(&routers.Router{ID: routerID}, nil)
+				m.ListSubnet(subnets.ListOpts{Name: resourceName}).Return([]subnets.Subnet{
+					{ID: subnetID, Name: resourceName},
+				}, nil)
+				m.ListSubnet(subnets.ListOpts{Name: resourceName + "-1"}).Return([]subnets.Subnet{
+					{ID: ipv6SubnetID, Name: resourceName + "-1"},
+				}, nil)
+				m.RemoveRouterInterface(routerID, routers.RemoveInterfaceOpts{SubnetID: subnetID}).Return(&routers.InterfaceInfo{}, nil)
+				m.RemoveRouterInterface(routerID, routers.RemoveInterfaceOpts{SubnetID: ipv6SubnetID}).Return(&routers.InterfaceInfo{}, nil)
+				m.DeleteRouter(routerID).Return(nil)
+			},
+		},
 		{
 			name: "Managed router in status does not exist",
 			openStackCluster: infrav1.OpenStackCluster{
@@ -218,18 +218,19 @@ func (s *Service) generateDesiredSecGroups(openStackCluster *infrav1.OpenStackCl
 		}
 	}
 
-	// Start with the default rules
-	controlPlaneRules := append([]resolvedSecurityGroupRuleSpec{}, defaultRules...)
-	workerRules := append([]resolvedSecurityGroupRuleSpec{}, defaultRules...)
-
-	controlPlaneRules = append(controlPlaneRules, getSGControlPlaneHTTPS()...)
+	controlPlaneRules := getSGControlPlaneHTTPS()
+	var workerRules []resolvedSecurityGroupRuleSpec
 
-	// Fetch subnet to use for worker node port rules
-	// In the future IPv6 support need to be added here
+	// Fetch subnets to use for worker node port rules
+	hasIPv6Subnets := false
 	if openStackCluster.Status.Network != nil {
 		for _, subnet := range openStackCluster.Status.Network.Subnets {
-			if net.IsIPv4CIDRString(subnet.CIDR) {
-				workerRules = append(workerRules, getSGWorkerNodePortCIDR(subnet.CIDR)...)
+			switch {
+			case net.IsIPv4CIDRString(subnet.CIDR):
+				workerRules = append(workerRules, getSGWorkerNodePortCIDR(subnet.CIDR, securityGroupRuleEtherTypeIPv4)...)
+			case net.IsIPv6CIDRString(subnet.CIDR):
+				workerRules = append(workerRules, getSGWorkerNodePortCIDR(subnet.CIDR, securityGroupRuleEtherTypeIPv6)...)
+				hasIPv6Subnets = true
 			}
 		}
 	}
@@ -242,6 +243,10 @@ func (s *Service) generateDesiredSecGroups(openStackCluster *infrav1.OpenStackCl
 		controlPlaneRules = append(controlPlaneRules, getSGControlPlaneAdditionalPorts(lbSpec.AdditionalPorts)...)
 	}
 
+	if vipSpec := openStackCluster.Spec.APIServer.GetVirtualIP(); vipSpec != nil && vipSpec.Provider == infrav1.APIServerVirtualIPProviderKeepalived {
+		controlPlaneRules = append(controlPlaneRules, getSGControlPlaneVRRP(remoteGroupIDSelf)...)
+	}
+
 	if openStackCluster.Spec.ManagedSecurityGroups != nil && openStackCluster.Spec.ManagedSecurityGroups.AllowAllInClusterTraffic {
 		// Permit all ingress from the cluster security groups
 		controlPlaneRules = append(controlPlaneRules, getSGControlPlaneAllowAll(remoteGroupIDSelf, secWorkerGroupID)...)
@@ -251,6 +256,25 @@ func (s *Service) generateDesiredSecGroups(openStackCluster *infrav1.OpenStackCl
 		workerRules = append(workerRules, getSGWorkerGeneral(remoteGroupIDSelf, secControlPlaneGroupID)...)
 	}
 
+	var bastionRules []resolvedSecurityGroupRuleSpec
+	if openStackCluster.Spec.Bastion.IsEnabled() {
+		controlPlaneRules = append(controlPlaneRules, getSGControlPlaneSSH(secBastionGroupID)...)
+		workerRules = append(workerRules, getSGWorkerSSH(secBastionGroupID)...)
+		bastionRules = getSGBastionSSH()
+	}
+
+	// The cluster nodes have addresses of both IP versions when the cluster
+	// network has IPv6 subnets, so every rule is needed for both ethertypes
+	if hasIPv6Subnets {
+		controlPlaneRules = withIPv6Rules(controlPlaneRules)
+		workerRules = withIPv6Rules(workerRules)
+		bastionRules = withIPv6Rules(bastionRules)
+	}
+
+	// Start with the default rules
+	controlPlaneRules = append(append([]resolvedSecurityGroupRuleSpec{}, defaultRules...), controlPlaneRules...)
+	workerRules = append(append([]resolvedSecurityGroupRuleSpec{}, defaultRules...), workerRules...)
+
 	// Append any additional rules for control plane and worker nodes
 	controlPlaneExtraRules, err := getRulesFromSpecs(remoteManagedGroups, openStackCluster.Spec.ManagedSecurityGroups.ControlPlaneNodesSecurityGroupRules)
 	if err != nil {
@@ -275,24 +299,9 @@ func (s *Service) generateDesiredSecGroups(openStackCluster *infrav1.OpenStackCl
 	desiredSecGroupsBySuffix := make(map[string]securityGroupSpec)
 
 	if openStackCluster.Spec.Bastion.IsEnabled() {
-		controlPlaneRules = append(controlPlaneRules, getSGControlPlaneSSH(secBastionGroupID)...)
-		workerRules = append(workerRules, getSGWorkerSSH(secBastionGroupID)...)
-
 		desiredSecGroupsBySuffix[bastionSuffix] = securityGroupSpec{
-			Name: suffixToNameMap[bastionSuffix],
-			Rules: append(
-				[]resolvedSecurityGroupRuleSpec{
-					{
-						Description:  securityGroupRuleDescriptionSSH,
-						Direction:    securityGroupRuleDirectionIngress,
-						EtherType:    securityGroupRuleEtherTypeIPv4,
-						PortRangeMin: 22,
-						PortRangeMax: 22,
-						Protocol:     securityGroupRuleProtocolTCP,
-					},
-				},
-				defaultRules...,
-			),
+			Name:  suffixToNameMap[bastionSuffix],
+			Rules: append(bastionRules, defaultRules...),
 		}
 	}
 
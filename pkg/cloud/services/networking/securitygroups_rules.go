@@ -23,6 +23,7 @@ const (
 	securityGroupRuleEtherTypeIPv6               = "IPv6"
 	securityGroupRuleProtocolTCP                 = "tcp"
 	securityGroupRuleProtocolUDP                 = "udp"
+	securityGroupRuleProtocolVRRP                = "vrrp"
 	securityGroupRuleDescriptionSSH              = "SSH"
 	securityGroupRuleDescriptionKubeletAPI       = "Kubelet API"
 	securityGroupRuleDescriptionNodePortServices = "Node Port Services"
@@ -140,6 +141,20 @@ func getSGWorkerSSH(secBastionGroupID string) []resolvedSecurityGroupRuleSpec {
 	}
 }
 
+// Allow all traffic, including from outside the cluster, to ssh to the bastion.
+func getSGBastionSSH() []resolvedSecurityGroupRuleSpec {
+	return []resolvedSecurityGroupRuleSpec{
+		{
+			Description:  securityGroupRuleDescriptionSSH,
+			Direction:    securityGroupRuleDirectionIngress,
+			EtherType:    securityGroupRuleEtherTypeIPv4,
+			PortRangeMin: 22,
+			PortRangeMax: 22,
+			Protocol:     securityGroupRuleProtocolTCP,
+		},
+	}
+}
+
 // Allow all traffic, including from outside the cluster, to access the API.
 func getSGControlPlaneHTTPS() []resolvedSecurityGroupRuleSpec {
 	return []resolvedSecurityGroupRuleSpec{
@@ -197,12 +212,12 @@ func getSGWorkerNodePort(secWorkerGroupID string, secControlPlaneGroupID string)
 }
 
 // Allow all traffic from a specific CIDR to access node port services.
-func getSGWorkerNodePortCIDR(cidr string) []resolvedSecurityGroupRuleSpec {
+func getSGWorkerNodePortCIDR(cidr, etherType string) []resolvedSecurityGroupRuleSpec {
 	return []resolvedSecurityGroupRuleSpec{
 		{
 			Description:    securityGroupRuleDescriptionNodePortServices,
 			Direction:      securityGroupRuleDirectionIngress,
-			EtherType:      securityGroupRuleEtherTypeIPv4,
+			EtherType:      etherType,
 			PortRangeMin:   30000,
 			PortRangeMax:   32767,
 			Protocol:       securityGroupRuleProtocolTCP,
@@ -211,7 +226,7 @@ func getSGWorkerNodePortCIDR(cidr string) []resolvedSecurityGroupRuleSpec {
 		{
 			Description:    securityGroupRuleDescriptionNodePortServices,
 			Direction:      securityGroupRuleDirectionIngress,
-			EtherType:      securityGroupRuleEtherTypeIPv4,
+			EtherType:      etherType,
 			PortRangeMin:   30000,
 			PortRangeMax:   32767,
 			Protocol:       securityGroupRuleProtocolUDP,
@@ -285,6 +300,20 @@ func getSGControlPlaneAdditionalPorts(ports []int32) []resolvedSecurityGroupRule
 	return r
 }
 
+// Permit VRRP between control plane nodes for keepalived to elect the holder
+// of the API server virtual IP.
+func getSGControlPlaneVRRP(remoteGroupIDSelf string) []resolvedSecurityGroupRuleSpec {
+	return []resolvedSecurityGroupRuleSpec{
+		{
+			Description:   "VRRP",
+			Direction:     securityGroupRuleDirectionIngress,
+			EtherType:     securityGroupRuleEtherTypeIPv4,
+			Protocol:      securityGroupRuleProtocolVRRP,
+			RemoteGroupID: remoteGroupIDSelf,
+		},
+	}
+}
+
 func getSGControlPlaneGeneral(remoteGroupIDSelf, secWorkerGroupID string) []resolvedSecurityGroupRuleSpec {
 	return getSGControlPlaneCommon(remoteGroupIDSelf, secWorkerGroupID)
 }
@@ -292,3 +321,19 @@ func getSGControlPlaneGeneral(remoteGroupIDSelf, secWorkerGroupID string) []reso
 func getSGWorkerGeneral(remoteGroupIDSelf, secControlPlaneGroupID string) []resolvedSecurityGroupRuleSpec {
 	return getSGWorkerCommon(remoteGroupIDSelf, secControlPlaneGroupID)
 }
+
+// withIPv6Rules returns rules with an IPv6 copy of every IPv4 rule. Rules with
+// a remote IP prefix only apply to the IP version of the prefix, so they are
+// not copied.
+func withIPv6Rules(rules []resolvedSecurityGroupRuleSpec) []resolvedSecurityGroupRuleSpec {
+	r := make([]resolvedSecurityGroupRuleSpec, 0, 2*len(rules))
+	r = append(r, rules...)
+	for _, rule := range rules {
+		if rule.EtherType != securityGroupRuleEtherTypeIPv4 || rule.RemoteIPPrefix != "" {
+			continue
+		}
+		rule.EtherType = securityGroupRuleEtherTypeIPv6
+		r = append(r, rule)
+	}
+	return r
+}
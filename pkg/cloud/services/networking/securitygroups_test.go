@@ -367,6 +367,26 @@ func TestGenerateDesiredSecGroups(t *testing.T) {
 			expectedNumberSecurityGroupRules: 20,
 			wantErr:                          false,
 		},
+		{
+			name: "Valid openStackCluster with default securityGroups and dual-stack subnets",
+			openStackCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						Subnets: []infrav1.Subnet{
+							{CIDR: "10.0.0.0/24"},
+							{CIDR: "fd00:10::/64"},
+						},
+					},
+				},
+			},
+			// The 10 ingress rules of the default securityGroups are also
+			// added for IPv6, and 4 node port rules for the subnets
+			expectedNumberSecurityGroupRules: 28,
+			wantErr:                          false,
+		},
 		{
 			name: "Valid openStackCluster with invalid clusterNodesSecurityGroupRules",
 			openStackCluster: &infrav1.OpenStackCluster{
@@ -416,6 +436,82 @@ func TestGenerateDesiredSecGroups(t *testing.T) {
 	}
 }
 
+func TestGenerateDesiredSecGroupsIPv6(t *testing.T) {
+	g := NewWithT(t)
+
+	secGroupNames := map[string]string{
+		"controlplane": "k8s-cluster-mycluster-secgroup-controlplane",
+		"worker":       "k8s-cluster-mycluster-secgroup-worker",
+		"bastion":      "k8s-cluster-mycluster-secgroup-bastion",
+	}
+	observedSecGroupsBySuffix := map[string]*groups.SecGroup{
+		"controlplane": {ID: "0", Name: "k8s-cluster-mycluster-secgroup-controlplane"},
+		"worker":       {ID: "1", Name: "k8s-cluster-mycluster-secgroup-worker"},
+		"bastion":      {ID: "2", Name: "k8s-cluster-mycluster-secgroup-bastion"},
+	}
+	openStackCluster := &infrav1.OpenStackCluster{
+		Spec: infrav1.OpenStackClusterSpec{
+			Bastion: &infrav1.Bastion{Enabled: ptr.To(true)},
+			ManagedSecurityGroups: &infrav1.ManagedSecurityGroups{
+				WorkerNodesSecurityGroupRules: []infrav1.SecurityGroupRuleSpec{
+					{
+						Direction:    "ingress",
+						EtherType:    ptr.To("IPv4"),
+						Protocol:     ptr.To("tcp"),
+						PortRangeMin: ptr.To[int32](8080),
+						PortRangeMax: ptr.To[int32](8080),
+					},
+				},
+			},
+		},
+		Status: infrav1.OpenStackClusterStatus{
+			Network: &infrav1.NetworkStatusWithSubnets{
+				Subnets: []infrav1.Subnet{{CIDR: "fd00:10::/64"}},
+			},
+		},
+	}
+
+	s := Service{}
+	gotSecurityGroups, err := s.generateDesiredSecGroups(openStackCluster, secGroupNames, observedSecGroupsBySuffix)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ingressRule := func(etherType string, port int, remoteGroupID, remoteIPPrefix string) resolvedSecurityGroupRuleSpec {
+		return resolvedSecurityGroupRuleSpec{
+			Direction:      "ingress",
+			EtherType:      etherType,
+			Protocol:       "tcp",
+			PortRangeMin:   port,
+			PortRangeMax:   port,
+			RemoteGroupID:  remoteGroupID,
+			RemoteIPPrefix: remoteIPPrefix,
+		}
+	}
+	matchRule := func(rule resolvedSecurityGroupRuleSpec) OmegaMatcher {
+		return ContainElement(WithTransform(func(r resolvedSecurityGroupRuleSpec) resolvedSecurityGroupRuleSpec {
+			r.Description = ""
+			return r
+		}, Equal(rule)))
+	}
+
+	controlPlaneRules := gotSecurityGroups["controlplane"].Rules
+	for _, etherType := range []string{"IPv4", "IPv6"} {
+		g.Expect(controlPlaneRules).To(matchRule(ingressRule(etherType, 6443, "", "")), "API server rule for %s", etherType)
+		g.Expect(controlPlaneRules).To(matchRule(ingressRule(etherType, 22, "2", "")), "SSH from bastion rule for %s", etherType)
+		g.Expect(gotSecurityGroups["bastion"].Rules).To(matchRule(ingressRule(etherType, 22, "", "")), "bastion SSH rule for %s", etherType)
+	}
+
+	workerRules := gotSecurityGroups["worker"].Rules
+	nodePortRule := ingressRule("IPv6", 0, "", "fd00:10::/64")
+	nodePortRule.PortRangeMin, nodePortRule.PortRangeMax = 30000, 32767
+	g.Expect(workerRules).To(matchRule(nodePortRule))
+	nodePortRule.EtherType = "IPv4"
+	g.Expect(workerRules).NotTo(matchRule(nodePortRule))
+
+	// Rules specified by the user are not copied
+	g.Expect(workerRules).To(matchRule(ingressRule("IPv4", 8080, "", "")))
+	g.Expect(workerRules).NotTo(matchRule(ingressRule("IPv6", 8080, "", "")))
+}
+
 func TestReconcileGroupRules(t *testing.T) {
 	const (
 		sgID           = "6260e813-af79-4592-8d1a-0f42dd26cc42"
@@ -96,3 +96,21 @@ func (s *Service) hasStandardAttrTagExtension() (bool, error) {
 	}
 	return false, nil
 }
+
+// HasDNSIntegrationExtension checks whether the Neutron dns-integration
+// extension is available. When this extension is present, ports and floating
+// IPs accept the dns_name and dns_domain attributes, which an external DNS
+// service such as Designate uses to publish records for them.
+func (s *Service) HasDNSIntegrationExtension() (bool, error) {
+	allExts, err := s.client.ListExtensions()
+	if err != nil {
+		return false, err
+	}
+
+	for _, ext := range allExts {
+		if ext.Alias == "dns-integration" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
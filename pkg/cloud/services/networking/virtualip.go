@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/record"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/names"
+)
+
+const virtualIPSuffix = "kubeapi-vip"
+
+func getVirtualIPPortName(clusterResourceName string) string {
+	return fmt.Sprintf("%s-cluster-%s-%s", networkPrefix, clusterResourceName, virtualIPSuffix)
+}
+
+// ReconcileAPIServerVirtualIP reserves the virtual IP of the API server with
+// a Neutron port on the cluster network, and associates the floating IP of
+// the API server with it unless the floating IP is disabled.
+func (s *Service) ReconcileAPIServerVirtualIP(openStackCluster *infrav1.OpenStackCluster, clusterResourceName string) error {
+	network := openStackCluster.Status.Network
+	if network == nil || len(network.Subnets) == 0 {
+		return errors.New("cluster network is not available yet")
+	}
+
+	portName := getVirtualIPPortName(clusterResourceName)
+	portList, err := s.client.ListPort(ports.ListOpts{Name: portName, NetworkID: network.ID})
+	if err != nil {
+		return fmt.Errorf("searching for virtual IP port: %w", err)
+	}
+	if len(portList) > 1 {
+		return fmt.Errorf("multiple ports found with name %q", portName)
+	}
+
+	var port *ports.Port
+	if len(portList) == 1 {
+		port = &portList[0]
+	} else {
+		port, err = s.createVirtualIPPort(openStackCluster, clusterResourceName, portName)
+		if err != nil {
+			return err
+		}
+	}
+	if len(port.FixedIPs) == 0 {
+		return fmt.Errorf("virtual IP port %s has no fixed IP", port.ID)
+	}
+
+	vipStatus := openStackCluster.Status.APIServerVirtualIP
+	if vipStatus == nil {
+		vipStatus = &infrav1.VirtualIPStatus{}
+		openStackCluster.Status.APIServerVirtualIP = vipStatus
+	}
+	vipStatus.PortID = port.ID
+	vipStatus.IP = port.FixedIPs[0].IPAddress
+
+	if !ptr.Deref(openStackCluster.Spec.APIServer.GetEnableFloatingIP(), true) || !ptr.Deref(openStackCluster.Spec.EnableExternalNetwork, true) {
+		return nil
+	}
+
+	fp, err := s.GetFloatingIPByPortID(port.ID)
+	if err != nil {
+		return err
+	}
+	if fp == nil {
+		floatingIPAddress := openStackCluster.Spec.APIServer.GetFloatingIP()
+		if floatingIPAddress == nil && vipStatus.FloatingIP != "" {
+			floatingIPAddress = &vipStatus.FloatingIP
+		}
+		fp, err = s.GetOrCreateFloatingIP(openStackCluster, openStackCluster, clusterResourceName, floatingIPAddress, nil)
+		if err != nil {
+			return err
+		}
+
+		// Write the floating IP to the status immediately so we won't
+		// create a new floating IP on the next reconcile if something
+		// fails below.
+		vipStatus.FloatingIP = fp.FloatingIP
+
+		if err := s.AssociateFloatingIP(openStackCluster, fp, port.ID); err != nil {
+			return err
+		}
+	}
+	vipStatus.FloatingIP = fp.FloatingIP
+
+	return nil
+}
+
+func (s *Service) createVirtualIPPort(openStackCluster *infrav1.OpenStackCluster, clusterResourceName, portName string) (*ports.Port, error) {
+	network := openStackCluster.Status.Network
+	subnetID := network.Subnets[0].ID
+	if openStackCluster.Spec.PrimarySubnet != nil {
+		subnet, err := s.GetNetworkSubnetByParam(network.ID, openStackCluster.Spec.PrimarySubnet)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve primarySubnet: %w", err)
+		}
+		subnetID = subnet.ID
+	}
+
+	createOpts := ports.CreateOpts{
+		Name:        portName,
+		NetworkID:   network.ID,
+		Description: names.GetDescription(clusterResourceName),
+		FixedIPs: []ports.IP{{
+			SubnetID:  subnetID,
+			IPAddress: ptr.Deref(openStackCluster.Spec.APIServer.GetFixedIP(), ""),
+		}},
+	}
+
+	s.scope.Logger().Info("Creating virtual IP port", "name", portName, "subnetID", subnetID)
+	port, err := s.client.CreatePort(createOpts)
+	if err != nil {
+		record.Warnf(openStackCluster, "FailedCreatePort", "Failed to create virtual IP port %s: %v", portName, err)
+		return nil, err
+	}
+
+	if len(openStackCluster.Spec.Tags) > 0 {
+		tagsSupported, err := s.hasStandardAttrTagExtension()
+		if err != nil {
+			return nil, err
+		}
+		if tagsSupported {
+			if err := s.replaceAllAttributesTags(openStackCluster, portResource, port.ID, openStackCluster.Spec.Tags); err != nil {
+				return nil, err
+			}
+		} else {
+			s.scope.Logger().V(4).Info("standard-attr-tag extension not available, skipping tag replacement", "resourceType", portResource, "resourceID", port.ID)
+		}
+	}
+
+	record.Eventf(openStackCluster, "SuccessfulCreatePort", "Created virtual IP port %s with id %s", port.Name, port.ID)
+	return port, nil
+}
+
+// DeleteAPIServerVirtualIP deletes the port reserving the virtual IP of the
+// API server, and the floating IP associated with it unless it was provided
+// by the user.
+func (s *Service) DeleteAPIServerVirtualIP(openStackCluster *infrav1.OpenStackCluster) error {
+	vipStatus := openStackCluster.Status.APIServerVirtualIP
+	if vipStatus == nil {
+		return nil
+	}
+
+	fp, err := s.GetFloatingIPByPortID(vipStatus.PortID)
+	if err != nil {
+		return err
+	}
+	if fp != nil {
+		// If the floating IP is user-provided (BYO floating IP), don't delete it.
+		if userFloatingIP := openStackCluster.Spec.APIServer.GetFloatingIP(); userFloatingIP != nil && *userFloatingIP == fp.FloatingIP {
+			s.scope.Logger().V(3).Info("Skipping virtual IP floating IP deletion as it's a user-provided resource", "floatingIP", fp.FloatingIP)
+		} else if err := s.DeleteFloatingIP(openStackCluster, fp.FloatingIP); err != nil {
+			return err
+		}
+	}
+
+	if err := s.DeletePort(openStackCluster, vipStatus.PortID); err != nil {
+		return err
+	}
+
+	openStackCluster.Status.APIServerVirtualIP = nil
+	return nil
+}
+
+// AddAllowedAddressPair adds ipAddress to the allowed address pairs of a
+// port, keeping its existing allowed address pairs.
+func (s *Service) AddAllowedAddressPair(portID, ipAddress string) error {
+	port, err := s.client.GetPort(portID)
+	if err != nil {
+		if capoerrors.IsNotFound(err) {
+			return fmt.Errorf("port %s does not exist", portID)
+		}
+		return err
+	}
+
+	if slices.ContainsFunc(port.AllowedAddressPairs, func(pair ports.AddressPair) bool { return pair.IPAddress == ipAddress }) {
+		return nil
+	}
+
+	pairs := make([]infrav1.AddressPair, 0, len(port.AllowedAddressPairs)+1)
+	for _, pair := range port.AllowedAddressPairs {
+		pairs = append(pairs, infrav1.AddressPair{IPAddress: pair.IPAddress, MACAddress: ptr.To(pair.MACAddress)})
+	}
+	pairs = append(pairs, infrav1.AddressPair{IPAddress: ipAddress})
+
+	s.scope.Logger().Info("Adding allowed address pair to port", "portID", portID, "ipAddress", ipAddress)
+	return s.UpdateAllowedAddressPairs(portID, pairs)
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"bytes"
+	"errors"
+	"text/template"
+
+	"k8s.io/utils/ptr"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+)
+
+const (
+	// VirtualIPKubeVIPManifestKey is the key of the kube-vip static pod
+	// manifest in the virtual IP configuration Secret.
+	VirtualIPKubeVIPManifestKey = "kube-vip.yaml"
+	// VirtualIPKeepalivedConfigKey is the key of the keepalived
+	// configuration in the virtual IP configuration Secret.
+	VirtualIPKeepalivedConfigKey = "keepalived.conf"
+	// VirtualIPKeepalivedCheckScriptKey is the key of the script keepalived
+	// uses to check the health of the API server in the virtual IP
+	// configuration Secret.
+	VirtualIPKeepalivedCheckScriptKey = "check-apiserver.sh"
+
+	defaultVirtualIPInterface = "eth0"
+	defaultVirtualRouterID    = 51
+	defaultKubeVIPImage       = "ghcr.io/kube-vip/kube-vip:v0.8.9"
+)
+
+var kubeVIPManifestTemplate = template.Must(template.New("kube-vip").Parse(`apiVersion: v1
+kind: Pod
+metadata:
+  name: kube-vip
+  namespace: kube-system
+spec:
+  containers:
+  - name: kube-vip
+    image: {{ .Image }}
+    imagePullPolicy: IfNotPresent
+    args:
+    - manager
+    env:
+    - name: address
+      value: "{{ .Address }}"
+    - name: port
+      value: "{{ .Port }}"
+    - name: vip_interface
+      value: "{{ .Interface }}"
+    - name: vip_arp
+      value: "true"
+    - name: cp_enable
+      value: "true"
+    - name: cp_namespace
+      value: kube-system
+    - name: vip_leaderelection
+      value: "true"
+    - name: vip_leasename
+      value: plndr-cp-lock
+    - name: vip_leaseduration
+      value: "15"
+    - name: vip_renewdeadline
+      value: "10"
+    - name: vip_retryperiod
+      value: "2"
+    securityContext:
+      capabilities:
+        add:
+        - NET_ADMIN
+        - NET_RAW
+    volumeMounts:
+    - mountPath: /etc/kubernetes/admin.conf
+      name: kubeconfig
+  hostAliases:
+  - hostnames:
+    - kubernetes
+    ip: 127.0.0.1
+  hostNetwork: true
+  volumes:
+  - hostPath:
+      path: /etc/kubernetes/admin.conf
+    name: kubeconfig
+`))
+
+var keepalivedConfigTemplate = template.Must(template.New("keepalived").Parse(`global_defs {
+  enable_script_security
+  script_user root
+}
+
+vrrp_script check_apiserver {
+  script "/etc/keepalived/check-apiserver.sh"
+  interval 3
+  fall 3
+  rise 2
+}
+
+vrrp_instance kubeapi {
+  state BACKUP
+  interface {{ .Interface }}
+  virtual_router_id {{ .VirtualRouterID }}
+  priority 100
+  advert_int 1
+  virtual_ipaddress {
+    {{ .Address }}
+  }
+  track_script {
+    check_apiserver
+  }
+}
+`))
+
+var keepalivedCheckScriptTemplate = template.Must(template.New("check-apiserver").Parse(`#!/bin/sh
+curl --silent --fail --max-time 2 --insecure --output /dev/null https://localhost:{{ .Port }}/healthz
+`))
+
+type virtualIPConfigParams struct {
+	Address         string
+	Port            int32
+	Interface       string
+	VirtualRouterID int32
+	Image           string
+}
+
+// GetAPIServerVirtualIPConfig returns the configuration of the provider of
+// the virtual IP of the API server for the control plane machines, keyed by
+// file name.
+func GetAPIServerVirtualIPConfig(openStackCluster *infrav1.OpenStackCluster) (map[string][]byte, error) {
+	vipSpec := openStackCluster.Spec.APIServer.GetVirtualIP()
+	vipStatus := openStackCluster.Status.APIServerVirtualIP
+	if vipSpec == nil || vipStatus == nil || vipStatus.IP == "" {
+		return nil, errors.New("virtual IP is not reserved yet")
+	}
+	if openStackCluster.Spec.ControlPlaneEndpoint == nil {
+		return nil, errors.New("control plane endpoint is not set yet")
+	}
+
+	params := virtualIPConfigParams{
+		Address:         vipStatus.IP,
+		Port:            openStackCluster.Spec.ControlPlaneEndpoint.Port,
+		Interface:       ptr.Deref(vipSpec.Interface, defaultVirtualIPInterface),
+		VirtualRouterID: ptr.Deref(vipSpec.VirtualRouterID, defaultVirtualRouterID),
+		Image:           ptr.Deref(vipSpec.Image, defaultKubeVIPImage),
+	}
+
+	templates := map[string]*template.Template{VirtualIPKubeVIPManifestKey: kubeVIPManifestTemplate}
+	if vipSpec.Provider == infrav1.APIServerVirtualIPProviderKeepalived {
+		templates = map[string]*template.Template{
+			VirtualIPKeepalivedConfigKey:      keepalivedConfigTemplate,
+			VirtualIPKeepalivedCheckScriptKey: keepalivedCheckScriptTemplate,
+		}
+	}
+
+	config := make(map[string][]byte, len(templates))
+	for key, tmpl := range templates {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, params); err != nil {
+			return nil, err
+		}
+		config[key] = buf.Bytes()
+	}
+	return config, nil
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networking
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr/testr"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/scope"
+)
+
+func Test_APIServerVirtualIP(t *testing.T) {
+	const clusterResourceName = "AAAAA"
+
+	tests := []struct {
+		name             string
+		enableFloatingIP bool
+	}{
+		{
+			name:             "virtual IP with floating IP",
+			enableFloatingIP: true,
+		},
+		{
+			name:             "virtual IP without floating IP",
+			enableFloatingIP: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cloud := fake.NewCloud(fake.WithTransitionDelay(0))
+			factory := scope.NewFakeScopeFactory(cloud)
+			s, err := NewService(scope.NewWithLogger(factory, testr.New(t)))
+			g.Expect(err).NotTo(HaveOccurred())
+
+			externalNetworkID, err := cloud.AddExternalNetwork("public", "203.0.113.0/24")
+			g.Expect(err).NotTo(HaveOccurred())
+			networkClient, err := factory.NewNetworkClient()
+			g.Expect(err).NotTo(HaveOccurred())
+			network, err := networkClient.CreateNetwork(networks.CreateOpts{Name: "cluster"})
+			g.Expect(err).NotTo(HaveOccurred())
+			subnet, err := networkClient.CreateSubnet(subnets.CreateOpts{NetworkID: network.ID, CIDR: "10.0.0.0/24", IPVersion: 4})
+			g.Expect(err).NotTo(HaveOccurred())
+			router, err := networkClient.CreateRouter(routers.CreateOpts{GatewayInfo: &routers.GatewayInfo{NetworkID: externalNetworkID}})
+			g.Expect(err).NotTo(HaveOccurred())
+			_, err = networkClient.AddRouterInterface(router.ID, routers.AddInterfaceOpts{SubnetID: subnet.ID})
+			g.Expect(err).NotTo(HaveOccurred())
+
+			openStackCluster := &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					APIServer: &infrav1.APIServer{
+						EnableFloatingIP: ptr.To(tt.enableFloatingIP),
+						FixedIP:          ptr.To("10.0.0.100"),
+						VirtualIP:        &infrav1.APIServerVirtualIP{Provider: infrav1.APIServerVirtualIPProviderKubeVIP},
+					},
+				},
+				Status: infrav1.OpenStackClusterStatus{
+					Network: &infrav1.NetworkStatusWithSubnets{
+						NetworkStatus: infrav1.NetworkStatus{ID: network.ID, Name: network.Name},
+						Subnets:       []infrav1.Subnet{{ID: subnet.ID, CIDR: subnet.CIDR}},
+					},
+					ExternalNetwork: &infrav1.NetworkStatus{ID: externalNetworkID},
+				},
+			}
+
+			g.Expect(s.ReconcileAPIServerVirtualIP(openStackCluster, clusterResourceName)).To(Succeed())
+			vipStatus := openStackCluster.Status.APIServerVirtualIP
+			g.Expect(vipStatus).NotTo(BeNil())
+			g.Expect(vipStatus.PortID).NotTo(BeEmpty())
+			g.Expect(vipStatus.IP).To(Equal("10.0.0.100"))
+			g.Expect(vipStatus.FloatingIP != "").To(Equal(tt.enableFloatingIP))
+
+			// Reconciling again does not change anything
+			previous := *vipStatus
+			g.Expect(s.ReconcileAPIServerVirtualIP(openStackCluster, clusterResourceName)).To(Succeed())
+			g.Expect(*openStackCluster.Status.APIServerVirtualIP).To(Equal(previous))
+			vipPorts, err := networkClient.ListPort(ports.ListOpts{Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-vip"})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(vipPorts).To(ConsistOf(HaveField("ID", vipStatus.PortID)))
+			if tt.enableFloatingIP {
+				g.Expect(cloud.Count("floatingip")).To(Equal(1))
+				fip, err := s.GetFloatingIP(vipStatus.FloatingIP)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(fip.PortID).To(Equal(vipStatus.PortID))
+			} else {
+				g.Expect(cloud.Count("floatingip")).To(Equal(0))
+			}
+
+			g.Expect(s.DeleteAPIServerVirtualIP(openStackCluster)).To(Succeed())
+			g.Expect(openStackCluster.Status.APIServerVirtualIP).To(BeNil())
+			vipPorts, err = networkClient.ListPort(ports.ListOpts{Name: "k8s-clusterapi-cluster-AAAAA-kubeapi-vip"})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(vipPorts).To(BeEmpty())
+			g.Expect(cloud.Count("floatingip")).To(Equal(0))
+		})
+	}
+}
+
+func Test_AddAllowedAddressPair(t *testing.T) {
+	g := NewWithT(t)
+
+	cloud := fake.NewCloud(fake.WithTransitionDelay(0))
+	factory := scope.NewFakeScopeFactory(cloud)
+	s, err := NewService(scope.NewWithLogger(factory, testr.New(t)))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	networkClient, err := factory.NewNetworkClient()
+	g.Expect(err).NotTo(HaveOccurred())
+	network, err := networkClient.CreateNetwork(networks.CreateOpts{Name: "cluster"})
+	g.Expect(err).NotTo(HaveOccurred())
+	_, err = networkClient.CreateSubnet(subnets.CreateOpts{NetworkID: network.ID, CIDR: "10.0.0.0/24", IPVersion: 4})
+	g.Expect(err).NotTo(HaveOccurred())
+	port, err := networkClient.CreatePort(ports.CreateOpts{
+		NetworkID:           network.ID,
+		AllowedAddressPairs: []ports.AddressPair{{IPAddress: "10.0.0.200", MACAddress: "fa:16:3e:00:00:01"}},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	for range 2 {
+		g.Expect(s.AddAllowedAddressPair(port.ID, "10.0.0.100")).To(Succeed())
+	}
+
+	port, err = networkClient.GetPort(port.ID)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(port.AllowedAddressPairs).To(ConsistOf(
+		ports.AddressPair{IPAddress: "10.0.0.200", MACAddress: "fa:16:3e:00:00:01"},
+		HaveField("IPAddress", "10.0.0.100"),
+	))
+}
+
+func Test_GetAPIServerVirtualIPConfig(t *testing.T) {
+	openStackCluster := func(vip *infrav1.APIServerVirtualIP) *infrav1.OpenStackCluster {
+		return &infrav1.OpenStackCluster{
+			Spec: infrav1.OpenStackClusterSpec{
+				APIServer:            &infrav1.APIServer{VirtualIP: vip},
+				ControlPlaneEndpoint: &clusterv1.APIEndpoint{Host: "203.0.113.10", Port: 6443},
+			},
+			Status: infrav1.OpenStackClusterStatus{
+				APIServerVirtualIP: &infrav1.VirtualIPStatus{PortID: "vip-port", IP: "10.0.0.100"},
+			},
+		}
+	}
+
+	t.Run("kube-vip", func(t *testing.T) {
+		g := NewWithT(t)
+
+		config, err := GetAPIServerVirtualIPConfig(openStackCluster(&infrav1.APIServerVirtualIP{
+			Provider:  infrav1.APIServerVirtualIPProviderKubeVIP,
+			Interface: ptr.To("ens3"),
+		}))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(config).To(HaveLen(1))
+		manifest := string(config[VirtualIPKubeVIPManifestKey])
+		g.Expect(manifest).To(ContainSubstring(`value: "10.0.0.100"`))
+		g.Expect(manifest).To(ContainSubstring(`value: "6443"`))
+		g.Expect(manifest).To(ContainSubstring(`value: "ens3"`))
+		g.Expect(manifest).To(ContainSubstring("image: " + defaultKubeVIPImage))
+	})
+
+	t.Run("keepalived", func(t *testing.T) {
+		g := NewWithT(t)
+
+		config, err := GetAPIServerVirtualIPConfig(openStackCluster(&infrav1.APIServerVirtualIP{
+			Provider:        infrav1.APIServerVirtualIPProviderKeepalived,
+			VirtualRouterID: ptr.To[int32](42),
+		}))
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(config).To(HaveLen(2))
+		keepalivedConfig := string(config[VirtualIPKeepalivedConfigKey])
+		g.Expect(keepalivedConfig).To(ContainSubstring("interface eth0"))
+		g.Expect(keepalivedConfig).To(ContainSubstring("virtual_router_id 42"))
+		g.Expect(keepalivedConfig).To(ContainSubstring("10.0.0.100"))
+		g.Expect(string(config[VirtualIPKeepalivedCheckScriptKey])).To(ContainSubstring("https://localhost:6443/healthz"))
+	})
+
+	t.Run("virtual IP not reserved", func(t *testing.T) {
+		g := NewWithT(t)
+
+		osc := openStackCluster(&infrav1.APIServerVirtualIP{Provider: infrav1.APIServerVirtualIPProviderKubeVIP})
+		osc.Status.APIServerVirtualIP = nil
+		_, err := GetAPIServerVirtualIPConfig(osc)
+		g.Expect(err).To(HaveOccurred())
+	})
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// ApplicationCredentialAccessRuleApplyConfiguration represents a declarative configuration of the ApplicationCredentialAccessRule type for use
+// with apply.
+//
+// ApplicationCredentialAccessRule permits an API call with an application credential.
+type ApplicationCredentialAccessRuleApplyConfiguration struct {
+	// Service is the type of the service the API call is made to, for example `compute`.
+	Service *string `json:"service,omitempty"`
+	// Method is the HTTP method of the API call.
+	Method *string `json:"method,omitempty"`
+	// Path is the path of the API call, which may contain `*` to match a single path segment and `**` to match any
+	// number of path segments, for example `/v2.1/servers/**`.
+	Path *string `json:"path,omitempty"`
+}
+
+// ApplicationCredentialAccessRuleApplyConfiguration constructs a declarative configuration of the ApplicationCredentialAccessRule type for use with
+// apply.
+func ApplicationCredentialAccessRule() *ApplicationCredentialAccessRuleApplyConfiguration {
+	return &ApplicationCredentialAccessRuleApplyConfiguration{}
+}
+
+// WithService sets the Service field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Service field is set to the value of the last call.
+func (b *ApplicationCredentialAccessRuleApplyConfiguration) WithService(value string) *ApplicationCredentialAccessRuleApplyConfiguration {
+	b.Service = &value
+	return b
+}
+
+// WithMethod sets the Method field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Method field is set to the value of the last call.
+func (b *ApplicationCredentialAccessRuleApplyConfiguration) WithMethod(value string) *ApplicationCredentialAccessRuleApplyConfiguration {
+	b.Method = &value
+	return b
+}
+
+// WithPath sets the Path field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Path field is set to the value of the last call.
+func (b *ApplicationCredentialAccessRuleApplyConfiguration) WithPath(value string) *ApplicationCredentialAccessRuleApplyConfiguration {
+	b.Path = &value
+	return b
+}
@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// OpenStackAPIRateLimitApplyConfiguration represents a declarative configuration of the OpenStackAPIRateLimit type for use
+// with apply.
+//
+// OpenStackAPIRateLimit limits the OpenStack API calls made with a set of
+// credentials.
+//
+// Limits are enforced by the manager for each combination of identity
+// endpoint and project, so all identities which authenticate to the same
+// project share a single budget. If they specify different limits, the limits
+// of the identity which was used most recently apply.
+type OpenStackAPIRateLimitApplyConfiguration struct {
+	// QPS is the sustained number of API calls per second.
+	QPS *int32 `json:"qps,omitempty"`
+	// Burst is the maximum number of API calls which may be made at once
+	// after a period without calls. If QPS is set and Burst is not, Burst is
+	// equal to QPS.
+	Burst *int32 `json:"burst,omitempty"`
+	// MaxInFlight is the maximum number of concurrent API calls. Further
+	// calls wait until an earlier call has completed.
+	MaxInFlight *int32 `json:"maxInFlight,omitempty"`
+}
+
+// OpenStackAPIRateLimitApplyConfiguration constructs a declarative configuration of the OpenStackAPIRateLimit type for use with
+// apply.
+func OpenStackAPIRateLimit() *OpenStackAPIRateLimitApplyConfiguration {
+	return &OpenStackAPIRateLimitApplyConfiguration{}
+}
+
+// WithQPS sets the QPS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QPS field is set to the value of the last call.
+func (b *OpenStackAPIRateLimitApplyConfiguration) WithQPS(value int32) *OpenStackAPIRateLimitApplyConfiguration {
+	b.QPS = &value
+	return b
+}
+
+// WithBurst sets the Burst field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Burst field is set to the value of the last call.
+func (b *OpenStackAPIRateLimitApplyConfiguration) WithBurst(value int32) *OpenStackAPIRateLimitApplyConfiguration {
+	b.Burst = &value
+	return b
+}
+
+// WithMaxInFlight sets the MaxInFlight field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MaxInFlight field is set to the value of the last call.
+func (b *OpenStackAPIRateLimitApplyConfiguration) WithMaxInFlight(value int32) *OpenStackAPIRateLimitApplyConfiguration {
+	b.MaxInFlight = &value
+	return b
+}
@@ -34,7 +34,8 @@ import (
 type OpenStackClusterIdentityApplyConfiguration struct {
 	v1.TypeMetaApplyConfiguration    `json:",inline"`
 	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
-	Spec                             *OpenStackClusterIdentitySpecApplyConfiguration `json:"spec,omitempty"`
+	Spec                             *OpenStackClusterIdentitySpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *OpenStackClusterIdentityStatusApplyConfiguration `json:"status,omitempty"`
 }
 
 // OpenStackClusterIdentity constructs a declarative configuration of the OpenStackClusterIdentity type for use with
@@ -83,6 +84,12 @@ func ExtractOpenStackClusterIdentity(openStackClusterIdentity *apiv1alpha1.OpenS
 	return ExtractOpenStackClusterIdentityFrom(openStackClusterIdentity, fieldManager, "")
 }
 
+// ExtractOpenStackClusterIdentityStatus extracts the applied configuration owned by fieldManager from
+// openStackClusterIdentity for the status subresource.
+func ExtractOpenStackClusterIdentityStatus(openStackClusterIdentity *apiv1alpha1.OpenStackClusterIdentity, fieldManager string) (*OpenStackClusterIdentityApplyConfiguration, error) {
+	return ExtractOpenStackClusterIdentityFrom(openStackClusterIdentity, fieldManager, "status")
+}
+
 func (b OpenStackClusterIdentityApplyConfiguration) IsApplyConfiguration() {}
 
 // WithKind sets the Kind field in the declarative configuration to the given value
@@ -251,6 +258,14 @@ func (b *OpenStackClusterIdentityApplyConfiguration) WithSpec(value *OpenStackCl
 	return b
 }
 
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *OpenStackClusterIdentityApplyConfiguration) WithStatus(value *OpenStackClusterIdentityStatusApplyConfiguration) *OpenStackClusterIdentityApplyConfiguration {
+	b.Status = value
+	return b
+}
+
 // GetKind retrieves the value of the Kind field in the declarative configuration.
 func (b *OpenStackClusterIdentityApplyConfiguration) GetKind() *string {
 	return b.TypeMetaApplyConfiguration.Kind
@@ -0,0 +1,113 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenStackClusterIdentityCloudStatusApplyConfiguration represents a declarative configuration of the OpenStackClusterIdentityCloudStatus type for use
+// with apply.
+//
+// OpenStackClusterIdentityCloudStatus is the result of authenticating with a cloud in a `clouds.yaml` file.
+type OpenStackClusterIdentityCloudStatusApplyConfiguration struct {
+	// Name is the name of the cloud in the `clouds.yaml` file.
+	Name *string `json:"name,omitempty"`
+	// Error is the reason authentication with the cloud failed. It is not set if authentication succeeded.
+	Error *string `json:"error,omitempty"`
+	// ProjectID is the ID of the project the credentials are scoped to.
+	ProjectID *string `json:"projectID,omitempty"`
+	// UserID is the ID of the user the credentials belong to.
+	UserID *string `json:"userID,omitempty"`
+	// Roles are the names of the roles of the credentials in the project.
+	Roles []string `json:"roles,omitempty"`
+	// TokenExpiresAt is when the token obtained with the credentials expires.
+	TokenExpiresAt *v1.Time `json:"tokenExpiresAt,omitempty"`
+	// Endpoints are the endpoints in the service catalog for the region and interface of the cloud.
+	Endpoints []OpenStackServiceEndpointApplyConfiguration `json:"endpoints,omitempty"`
+}
+
+// OpenStackClusterIdentityCloudStatusApplyConfiguration constructs a declarative configuration of the OpenStackClusterIdentityCloudStatus type for use with
+// apply.
+func OpenStackClusterIdentityCloudStatus() *OpenStackClusterIdentityCloudStatusApplyConfiguration {
+	return &OpenStackClusterIdentityCloudStatusApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *OpenStackClusterIdentityCloudStatusApplyConfiguration) WithName(value string) *OpenStackClusterIdentityCloudStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithError sets the Error field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Error field is set to the value of the last call.
+func (b *OpenStackClusterIdentityCloudStatusApplyConfiguration) WithError(value string) *OpenStackClusterIdentityCloudStatusApplyConfiguration {
+	b.Error = &value
+	return b
+}
+
+// WithProjectID sets the ProjectID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProjectID field is set to the value of the last call.
+func (b *OpenStackClusterIdentityCloudStatusApplyConfiguration) WithProjectID(value string) *OpenStackClusterIdentityCloudStatusApplyConfiguration {
+	b.ProjectID = &value
+	return b
+}
+
+// WithUserID sets the UserID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UserID field is set to the value of the last call.
+func (b *OpenStackClusterIdentityCloudStatusApplyConfiguration) WithUserID(value string) *OpenStackClusterIdentityCloudStatusApplyConfiguration {
+	b.UserID = &value
+	return b
+}
+
+// WithRoles adds the given value to the Roles field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Roles field.
+func (b *OpenStackClusterIdentityCloudStatusApplyConfiguration) WithRoles(values ...string) *OpenStackClusterIdentityCloudStatusApplyConfiguration {
+	for i := range values {
+		b.Roles = append(b.Roles, values[i])
+	}
+	return b
+}
+
+// WithTokenExpiresAt sets the TokenExpiresAt field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TokenExpiresAt field is set to the value of the last call.
+func (b *OpenStackClusterIdentityCloudStatusApplyConfiguration) WithTokenExpiresAt(value v1.Time) *OpenStackClusterIdentityCloudStatusApplyConfiguration {
+	b.TokenExpiresAt = &value
+	return b
+}
+
+// WithEndpoints adds the given value to the Endpoints field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Endpoints field.
+func (b *OpenStackClusterIdentityCloudStatusApplyConfiguration) WithEndpoints(values ...*OpenStackServiceEndpointApplyConfiguration) *OpenStackClusterIdentityCloudStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithEndpoints")
+		}
+		b.Endpoints = append(b.Endpoints, *values[i])
+	}
+	return b
+}
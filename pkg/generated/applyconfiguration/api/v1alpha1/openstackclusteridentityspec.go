@@ -31,6 +31,10 @@ type OpenStackClusterIdentitySpecApplyConfiguration struct {
 	SecretRef *OpenStackCredentialSecretReferenceApplyConfiguration `json:"secretRef,omitempty"`
 	// NamespaceSelector limits which namespaces may use this identity. If nil, all namespaces are allowed.
 	NamespaceSelector *v1.LabelSelectorApplyConfiguration `json:"namespaceSelector,omitempty"`
+	// RateLimit limits the rate and concurrency of OpenStack API calls made
+	// with this identity. Limits which are not set default to the limits
+	// passed to the manager on the command line.
+	RateLimit *OpenStackAPIRateLimitApplyConfiguration `json:"rateLimit,omitempty"`
 }
 
 // OpenStackClusterIdentitySpecApplyConfiguration constructs a declarative configuration of the OpenStackClusterIdentitySpec type for use with
@@ -54,3 +58,11 @@ func (b *OpenStackClusterIdentitySpecApplyConfiguration) WithNamespaceSelector(v
 	b.NamespaceSelector = value
 	return b
 }
+
+// WithRateLimit sets the RateLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RateLimit field is set to the value of the last call.
+func (b *OpenStackClusterIdentitySpecApplyConfiguration) WithRateLimit(value *OpenStackAPIRateLimitApplyConfiguration) *OpenStackClusterIdentitySpecApplyConfiguration {
+	b.RateLimit = value
+	return b
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// OpenStackClusterIdentityStatusApplyConfiguration represents a declarative configuration of the OpenStackClusterIdentityStatus type for use
+// with apply.
+//
+// OpenStackClusterIdentityStatus defines the observed state of an OpenStackClusterIdentity.
+type OpenStackClusterIdentityStatusApplyConfiguration struct {
+	// Clouds is the result of authenticating with each cloud in the `clouds.yaml` file of the referenced Secret.
+	Clouds []OpenStackClusterIdentityCloudStatusApplyConfiguration `json:"clouds,omitempty"`
+	// LastCheckTime is when the credentials were last checked.
+	LastCheckTime *v1.Time `json:"lastCheckTime,omitempty"`
+	// Conditions defines current service state of the OpenStackClusterIdentity.
+	Conditions []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// OpenStackClusterIdentityStatusApplyConfiguration constructs a declarative configuration of the OpenStackClusterIdentityStatus type for use with
+// apply.
+func OpenStackClusterIdentityStatus() *OpenStackClusterIdentityStatusApplyConfiguration {
+	return &OpenStackClusterIdentityStatusApplyConfiguration{}
+}
+
+// WithClouds adds the given value to the Clouds field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Clouds field.
+func (b *OpenStackClusterIdentityStatusApplyConfiguration) WithClouds(values ...*OpenStackClusterIdentityCloudStatusApplyConfiguration) *OpenStackClusterIdentityStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithClouds")
+		}
+		b.Clouds = append(b.Clouds, *values[i])
+	}
+	return b
+}
+
+// WithLastCheckTime sets the LastCheckTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastCheckTime field is set to the value of the last call.
+func (b *OpenStackClusterIdentityStatusApplyConfiguration) WithLastCheckTime(value v1.Time) *OpenStackClusterIdentityStatusApplyConfiguration {
+	b.LastCheckTime = &value
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *OpenStackClusterIdentityStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *OpenStackClusterIdentityStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,292 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	managedfields "k8s.io/apimachinery/pkg/util/managedfields"
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+	apiv1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	internal "sigs.k8s.io/cluster-api-provider-openstack/pkg/generated/applyconfiguration/internal"
+)
+
+// OpenStackCredentialRotationApplyConfiguration represents a declarative configuration of the OpenStackCredentialRotation type for use
+// with apply.
+//
+// OpenStackCredentialRotation periodically replaces the credentials in a Secret with a new Keystone
+// application credential, and deletes the replaced application credentials after a grace period.
+// When it is deleted, the application credentials it created are deleted except for the one still in the Secret,
+// which remains valid until it expires.
+type OpenStackCredentialRotationApplyConfiguration struct {
+	v1.TypeMetaApplyConfiguration    `json:",inline"`
+	*v1.ObjectMetaApplyConfiguration `json:"metadata,omitempty"`
+	Spec                             *OpenStackCredentialRotationSpecApplyConfiguration   `json:"spec,omitempty"`
+	Status                           *OpenStackCredentialRotationStatusApplyConfiguration `json:"status,omitempty"`
+}
+
+// OpenStackCredentialRotation constructs a declarative configuration of the OpenStackCredentialRotation type for use with
+// apply.
+func OpenStackCredentialRotation(name, namespace string) *OpenStackCredentialRotationApplyConfiguration {
+	b := &OpenStackCredentialRotationApplyConfiguration{}
+	b.WithName(name)
+	b.WithNamespace(namespace)
+	b.WithKind("OpenStackCredentialRotation")
+	b.WithAPIVersion("infrastructure.cluster.x-k8s.io/v1alpha1")
+	return b
+}
+
+// ExtractOpenStackCredentialRotationFrom extracts the applied configuration owned by fieldManager from
+// openStackCredentialRotation for the specified subresource. Pass an empty string for subresource to extract
+// the main resource. Common subresources include "status", "scale", etc.
+// openStackCredentialRotation must be a unmodified OpenStackCredentialRotation API object that was retrieved from the Kubernetes API.
+// ExtractOpenStackCredentialRotationFrom provides a way to perform a extract/modify-in-place/apply workflow.
+// Note that an extracted apply configuration will contain fewer fields than what the fieldManager previously
+// applied if another fieldManager has updated or force applied any of the previously applied fields.
+func ExtractOpenStackCredentialRotationFrom(openStackCredentialRotation *apiv1alpha1.OpenStackCredentialRotation, fieldManager string, subresource string) (*OpenStackCredentialRotationApplyConfiguration, error) {
+	b := &OpenStackCredentialRotationApplyConfiguration{}
+	err := managedfields.ExtractInto(openStackCredentialRotation, internal.Parser().Type("io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackCredentialRotation"), fieldManager, b, subresource)
+	if err != nil {
+		return nil, err
+	}
+	b.WithName(openStackCredentialRotation.Name)
+	b.WithNamespace(openStackCredentialRotation.Namespace)
+
+	b.WithKind("OpenStackCredentialRotation")
+	b.WithAPIVersion("infrastructure.cluster.x-k8s.io/v1alpha1")
+	return b, nil
+}
+
+// ExtractOpenStackCredentialRotation extracts the applied configuration owned by fieldManager from
+// openStackCredentialRotation. If no managedFields are found in openStackCredentialRotation for fieldManager, a
+// OpenStackCredentialRotationApplyConfiguration is returned with only the Name, Namespace (if applicable),
+// APIVersion and Kind populated. It is possible that no managed fields were found for because other
+// field managers have taken ownership of all the fields previously owned by fieldManager, or because
+// the fieldManager never owned fields any fields.
+// openStackCredentialRotation must be a unmodified OpenStackCredentialRotation API object that was retrieved from the Kubernetes API.
+// ExtractOpenStackCredentialRotation provides a way to perform a extract/modify-in-place/apply workflow.
+// Note that an extracted apply configuration will contain fewer fields than what the fieldManager previously
+// applied if another fieldManager has updated or force applied any of the previously applied fields.
+func ExtractOpenStackCredentialRotation(openStackCredentialRotation *apiv1alpha1.OpenStackCredentialRotation, fieldManager string) (*OpenStackCredentialRotationApplyConfiguration, error) {
+	return ExtractOpenStackCredentialRotationFrom(openStackCredentialRotation, fieldManager, "")
+}
+
+// ExtractOpenStackCredentialRotationStatus extracts the applied configuration owned by fieldManager from
+// openStackCredentialRotation for the status subresource.
+func ExtractOpenStackCredentialRotationStatus(openStackCredentialRotation *apiv1alpha1.OpenStackCredentialRotation, fieldManager string) (*OpenStackCredentialRotationApplyConfiguration, error) {
+	return ExtractOpenStackCredentialRotationFrom(openStackCredentialRotation, fieldManager, "status")
+}
+
+func (b OpenStackCredentialRotationApplyConfiguration) IsApplyConfiguration() {}
+
+// WithKind sets the Kind field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Kind field is set to the value of the last call.
+func (b *OpenStackCredentialRotationApplyConfiguration) WithKind(value string) *OpenStackCredentialRotationApplyConfiguration {
+	b.TypeMetaApplyConfiguration.Kind = &value
+	return b
+}
+
+// WithAPIVersion sets the APIVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIVersion field is set to the value of the last call.
+func (b *OpenStackCredentialRotationApplyConfiguration) WithAPIVersion(value string) *OpenStackCredentialRotationApplyConfiguration {
+	b.TypeMetaApplyConfiguration.APIVersion = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *OpenStackCredentialRotationApplyConfiguration) WithName(value string) *OpenStackCredentialRotationApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Name = &value
+	return b
+}
+
+// WithGenerateName sets the GenerateName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GenerateName field is set to the value of the last call.
+func (b *OpenStackCredentialRotationApplyConfiguration) WithGenerateName(value string) *OpenStackCredentialRotationApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.GenerateName = &value
+	return b
+}
+
+// WithNamespace sets the Namespace field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Namespace field is set to the value of the last call.
+func (b *OpenStackCredentialRotationApplyConfiguration) WithNamespace(value string) *OpenStackCredentialRotationApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Namespace = &value
+	return b
+}
+
+// WithUID sets the UID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the UID field is set to the value of the last call.
+func (b *OpenStackCredentialRotationApplyConfiguration) WithUID(value types.UID) *OpenStackCredentialRotationApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.UID = &value
+	return b
+}
+
+// WithResourceVersion sets the ResourceVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ResourceVersion field is set to the value of the last call.
+func (b *OpenStackCredentialRotationApplyConfiguration) WithResourceVersion(value string) *OpenStackCredentialRotationApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.ResourceVersion = &value
+	return b
+}
+
+// WithGeneration sets the Generation field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Generation field is set to the value of the last call.
+func (b *OpenStackCredentialRotationApplyConfiguration) WithGeneration(value int64) *OpenStackCredentialRotationApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.Generation = &value
+	return b
+}
+
+// WithCreationTimestamp sets the CreationTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CreationTimestamp field is set to the value of the last call.
+func (b *OpenStackCredentialRotationApplyConfiguration) WithCreationTimestamp(value metav1.Time) *OpenStackCredentialRotationApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.CreationTimestamp = &value
+	return b
+}
+
+// WithDeletionTimestamp sets the DeletionTimestamp field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionTimestamp field is set to the value of the last call.
+func (b *OpenStackCredentialRotationApplyConfiguration) WithDeletionTimestamp(value metav1.Time) *OpenStackCredentialRotationApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.DeletionTimestamp = &value
+	return b
+}
+
+// WithDeletionGracePeriodSeconds sets the DeletionGracePeriodSeconds field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionGracePeriodSeconds field is set to the value of the last call.
+func (b *OpenStackCredentialRotationApplyConfiguration) WithDeletionGracePeriodSeconds(value int64) *OpenStackCredentialRotationApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	b.ObjectMetaApplyConfiguration.DeletionGracePeriodSeconds = &value
+	return b
+}
+
+// WithLabels puts the entries into the Labels field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Labels field,
+// overwriting an existing map entries in Labels field with the same key.
+func (b *OpenStackCredentialRotationApplyConfiguration) WithLabels(entries map[string]string) *OpenStackCredentialRotationApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.ObjectMetaApplyConfiguration.Labels == nil && len(entries) > 0 {
+		b.ObjectMetaApplyConfiguration.Labels = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ObjectMetaApplyConfiguration.Labels[k] = v
+	}
+	return b
+}
+
+// WithAnnotations puts the entries into the Annotations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the Annotations field,
+// overwriting an existing map entries in Annotations field with the same key.
+func (b *OpenStackCredentialRotationApplyConfiguration) WithAnnotations(entries map[string]string) *OpenStackCredentialRotationApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	if b.ObjectMetaApplyConfiguration.Annotations == nil && len(entries) > 0 {
+		b.ObjectMetaApplyConfiguration.Annotations = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.ObjectMetaApplyConfiguration.Annotations[k] = v
+	}
+	return b
+}
+
+// WithOwnerReferences adds the given value to the OwnerReferences field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the OwnerReferences field.
+func (b *OpenStackCredentialRotationApplyConfiguration) WithOwnerReferences(values ...*v1.OwnerReferenceApplyConfiguration) *OpenStackCredentialRotationApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithOwnerReferences")
+		}
+		b.ObjectMetaApplyConfiguration.OwnerReferences = append(b.ObjectMetaApplyConfiguration.OwnerReferences, *values[i])
+	}
+	return b
+}
+
+// WithFinalizers adds the given value to the Finalizers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Finalizers field.
+func (b *OpenStackCredentialRotationApplyConfiguration) WithFinalizers(values ...string) *OpenStackCredentialRotationApplyConfiguration {
+	b.ensureObjectMetaApplyConfigurationExists()
+	for i := range values {
+		b.ObjectMetaApplyConfiguration.Finalizers = append(b.ObjectMetaApplyConfiguration.Finalizers, values[i])
+	}
+	return b
+}
+
+func (b *OpenStackCredentialRotationApplyConfiguration) ensureObjectMetaApplyConfigurationExists() {
+	if b.ObjectMetaApplyConfiguration == nil {
+		b.ObjectMetaApplyConfiguration = &v1.ObjectMetaApplyConfiguration{}
+	}
+}
+
+// WithSpec sets the Spec field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Spec field is set to the value of the last call.
+func (b *OpenStackCredentialRotationApplyConfiguration) WithSpec(value *OpenStackCredentialRotationSpecApplyConfiguration) *OpenStackCredentialRotationApplyConfiguration {
+	b.Spec = value
+	return b
+}
+
+// WithStatus sets the Status field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Status field is set to the value of the last call.
+func (b *OpenStackCredentialRotationApplyConfiguration) WithStatus(value *OpenStackCredentialRotationStatusApplyConfiguration) *OpenStackCredentialRotationApplyConfiguration {
+	b.Status = value
+	return b
+}
+
+// GetKind retrieves the value of the Kind field in the declarative configuration.
+func (b *OpenStackCredentialRotationApplyConfiguration) GetKind() *string {
+	return b.TypeMetaApplyConfiguration.Kind
+}
+
+// GetAPIVersion retrieves the value of the APIVersion field in the declarative configuration.
+func (b *OpenStackCredentialRotationApplyConfiguration) GetAPIVersion() *string {
+	return b.TypeMetaApplyConfiguration.APIVersion
+}
+
+// GetName retrieves the value of the Name field in the declarative configuration.
+func (b *OpenStackCredentialRotationApplyConfiguration) GetName() *string {
+	b.ensureObjectMetaApplyConfigurationExists()
+	return b.ObjectMetaApplyConfiguration.Name
+}
+
+// GetNamespace retrieves the value of the Namespace field in the declarative configuration.
+func (b *OpenStackCredentialRotationApplyConfiguration) GetNamespace() *string {
+	b.ensureObjectMetaApplyConfigurationExists()
+	return b.ObjectMetaApplyConfiguration.Namespace
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1beta2 "sigs.k8s.io/cluster-api-provider-openstack/pkg/generated/applyconfiguration/api/v1beta2"
+)
+
+// OpenStackCredentialRotationSpecApplyConfiguration represents a declarative configuration of the OpenStackCredentialRotationSpec type for use
+// with apply.
+//
+// OpenStackCredentialRotationSpec defines the desired state of OpenStackCredentialRotation.
+type OpenStackCredentialRotationSpecApplyConfiguration struct {
+	// SecretName is the name of the Secret containing the credentials to update, in the namespace of the
+	// OpenStackCredentialRotation. This is the Secret referenced by the identityRef of clusters using the credentials,
+	// or by the secretRef of an OpenStackClusterIdentity.
+	SecretName *string `json:"secretName,omitempty"`
+	// CloudName is the name of the entry in the `clouds.yaml` file to update. The entry must exist and contain an
+	// `auth_url`. Its credentials are replaced by an application credential, and its other settings are kept.
+	// If not set, the Secret is one referenced by an identityRef of type ApplicationCredential, and its
+	// `applicationCredentialID` and `applicationCredentialSecret` are replaced.
+	CloudName *string `json:"cloudName,omitempty"`
+	// BootstrapIdentityRef is a reference to the credentials used to create the application credentials. The
+	// application credentials belong to the user of these credentials. If they are themselves an application
+	// credential it must be unrestricted. They may refer to a different entry of the Secret being updated.
+	BootstrapIdentityRef *v1beta2.OpenStackIdentityReferenceApplyConfiguration `json:"bootstrapIdentityRef,omitempty"`
+	// RotationInterval is how often a new application credential is created. Defaults to 720h (30 days).
+	RotationInterval *v1.Duration `json:"rotationInterval,omitempty"`
+	// GracePeriod is how long a replaced application credential remains valid before it is deleted, to allow all
+	// users of the Secret to observe the new credential. Defaults to 24h.
+	// Application credentials expire after RotationInterval plus GracePeriod, so they become invalid even if they are
+	// not rotated.
+	GracePeriod *v1.Duration `json:"gracePeriod,omitempty"`
+	// Roles are the names of the roles of the application credentials. If not set, the application credentials have
+	// all the roles of the bootstrap credentials.
+	Roles []string `json:"roles,omitempty"`
+	// AccessRules restrict the API calls which may be made with the application credentials. If not set, the
+	// application credentials may make any API call permitted by their roles.
+	AccessRules []ApplicationCredentialAccessRuleApplyConfiguration `json:"accessRules,omitempty"`
+	// Unrestricted allows the application credentials to create and delete other application credentials and
+	// trusts. This is required if the Secret being updated is also the bootstrap credentials.
+	Unrestricted *bool `json:"unrestricted,omitempty"`
+}
+
+// OpenStackCredentialRotationSpecApplyConfiguration constructs a declarative configuration of the OpenStackCredentialRotationSpec type for use with
+// apply.
+func OpenStackCredentialRotationSpec() *OpenStackCredentialRotationSpecApplyConfiguration {
+	return &OpenStackCredentialRotationSpecApplyConfiguration{}
+}
+
+// WithSecretName sets the SecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SecretName field is set to the value of the last call.
+func (b *OpenStackCredentialRotationSpecApplyConfiguration) WithSecretName(value string) *OpenStackCredentialRotationSpecApplyConfiguration {
+	b.SecretName = &value
+	return b
+}
+
+// WithCloudName sets the CloudName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CloudName field is set to the value of the last call.
+func (b *OpenStackCredentialRotationSpecApplyConfiguration) WithCloudName(value string) *OpenStackCredentialRotationSpecApplyConfiguration {
+	b.CloudName = &value
+	return b
+}
+
+// WithBootstrapIdentityRef sets the BootstrapIdentityRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BootstrapIdentityRef field is set to the value of the last call.
+func (b *OpenStackCredentialRotationSpecApplyConfiguration) WithBootstrapIdentityRef(value *v1beta2.OpenStackIdentityReferenceApplyConfiguration) *OpenStackCredentialRotationSpecApplyConfiguration {
+	b.BootstrapIdentityRef = value
+	return b
+}
+
+// WithRotationInterval sets the RotationInterval field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RotationInterval field is set to the value of the last call.
+func (b *OpenStackCredentialRotationSpecApplyConfiguration) WithRotationInterval(value v1.Duration) *OpenStackCredentialRotationSpecApplyConfiguration {
+	b.RotationInterval = &value
+	return b
+}
+
+// WithGracePeriod sets the GracePeriod field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the GracePeriod field is set to the value of the last call.
+func (b *OpenStackCredentialRotationSpecApplyConfiguration) WithGracePeriod(value v1.Duration) *OpenStackCredentialRotationSpecApplyConfiguration {
+	b.GracePeriod = &value
+	return b
+}
+
+// WithRoles adds the given value to the Roles field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Roles field.
+func (b *OpenStackCredentialRotationSpecApplyConfiguration) WithRoles(values ...string) *OpenStackCredentialRotationSpecApplyConfiguration {
+	for i := range values {
+		b.Roles = append(b.Roles, values[i])
+	}
+	return b
+}
+
+// WithAccessRules adds the given value to the AccessRules field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AccessRules field.
+func (b *OpenStackCredentialRotationSpecApplyConfiguration) WithAccessRules(values ...*ApplicationCredentialAccessRuleApplyConfiguration) *OpenStackCredentialRotationSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAccessRules")
+		}
+		b.AccessRules = append(b.AccessRules, *values[i])
+	}
+	return b
+}
+
+// WithUnrestricted sets the Unrestricted field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Unrestricted field is set to the value of the last call.
+func (b *OpenStackCredentialRotationSpecApplyConfiguration) WithUnrestricted(value bool) *OpenStackCredentialRotationSpecApplyConfiguration {
+	b.Unrestricted = &value
+	return b
+}
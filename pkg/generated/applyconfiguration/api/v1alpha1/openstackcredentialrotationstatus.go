@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// OpenStackCredentialRotationStatusApplyConfiguration represents a declarative configuration of the OpenStackCredentialRotationStatus type for use
+// with apply.
+//
+// OpenStackCredentialRotationStatus defines the observed state of OpenStackCredentialRotation.
+type OpenStackCredentialRotationStatusApplyConfiguration struct {
+	// ApplicationCredentialID is the ID of the application credential currently in the Secret.
+	ApplicationCredentialID *string `json:"applicationCredentialID,omitempty"`
+	// LastRotationTime is when the application credential in the Secret was last replaced.
+	LastRotationTime *v1.Time `json:"lastRotationTime,omitempty"`
+	// NextRotationTime is when the application credential in the Secret will next be replaced.
+	NextRotationTime *v1.Time `json:"nextRotationTime,omitempty"`
+	// PendingRevocations are the application credentials which have been replaced and will be deleted at the end of
+	// their grace period.
+	PendingRevocations []PendingApplicationCredentialRevocationApplyConfiguration `json:"pendingRevocations,omitempty"`
+	// Conditions defines current service state of the OpenStackCredentialRotation.
+	Conditions []metav1.ConditionApplyConfiguration `json:"conditions,omitempty"`
+}
+
+// OpenStackCredentialRotationStatusApplyConfiguration constructs a declarative configuration of the OpenStackCredentialRotationStatus type for use with
+// apply.
+func OpenStackCredentialRotationStatus() *OpenStackCredentialRotationStatusApplyConfiguration {
+	return &OpenStackCredentialRotationStatusApplyConfiguration{}
+}
+
+// WithApplicationCredentialID sets the ApplicationCredentialID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ApplicationCredentialID field is set to the value of the last call.
+func (b *OpenStackCredentialRotationStatusApplyConfiguration) WithApplicationCredentialID(value string) *OpenStackCredentialRotationStatusApplyConfiguration {
+	b.ApplicationCredentialID = &value
+	return b
+}
+
+// WithLastRotationTime sets the LastRotationTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastRotationTime field is set to the value of the last call.
+func (b *OpenStackCredentialRotationStatusApplyConfiguration) WithLastRotationTime(value v1.Time) *OpenStackCredentialRotationStatusApplyConfiguration {
+	b.LastRotationTime = &value
+	return b
+}
+
+// WithNextRotationTime sets the NextRotationTime field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the NextRotationTime field is set to the value of the last call.
+func (b *OpenStackCredentialRotationStatusApplyConfiguration) WithNextRotationTime(value v1.Time) *OpenStackCredentialRotationStatusApplyConfiguration {
+	b.NextRotationTime = &value
+	return b
+}
+
+// WithPendingRevocations adds the given value to the PendingRevocations field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the PendingRevocations field.
+func (b *OpenStackCredentialRotationStatusApplyConfiguration) WithPendingRevocations(values ...*PendingApplicationCredentialRevocationApplyConfiguration) *OpenStackCredentialRotationStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPendingRevocations")
+		}
+		b.PendingRevocations = append(b.PendingRevocations, *values[i])
+	}
+	return b
+}
+
+// WithConditions adds the given value to the Conditions field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Conditions field.
+func (b *OpenStackCredentialRotationStatusApplyConfiguration) WithConditions(values ...*metav1.ConditionApplyConfiguration) *OpenStackCredentialRotationStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithConditions")
+		}
+		b.Conditions = append(b.Conditions, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+// OpenStackServiceEndpointApplyConfiguration represents a declarative configuration of the OpenStackServiceEndpoint type for use
+// with apply.
+//
+// OpenStackServiceEndpoint is an endpoint in the Keystone service catalog.
+type OpenStackServiceEndpointApplyConfiguration struct {
+	// Type is the type of the service, for example `compute`.
+	Type *string `json:"type,omitempty"`
+	// Name is the name of the service, for example `nova`.
+	Name *string `json:"name,omitempty"`
+	// Region is the region of the endpoint.
+	Region *string `json:"region,omitempty"`
+	// Interface is the interface of the endpoint: `public`, `internal` or `admin`.
+	Interface *string `json:"interface,omitempty"`
+	// URL is the URL of the endpoint.
+	URL *string `json:"url,omitempty"`
+}
+
+// OpenStackServiceEndpointApplyConfiguration constructs a declarative configuration of the OpenStackServiceEndpoint type for use with
+// apply.
+func OpenStackServiceEndpoint() *OpenStackServiceEndpointApplyConfiguration {
+	return &OpenStackServiceEndpointApplyConfiguration{}
+}
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *OpenStackServiceEndpointApplyConfiguration) WithType(value string) *OpenStackServiceEndpointApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *OpenStackServiceEndpointApplyConfiguration) WithName(value string) *OpenStackServiceEndpointApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithRegion sets the Region field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Region field is set to the value of the last call.
+func (b *OpenStackServiceEndpointApplyConfiguration) WithRegion(value string) *OpenStackServiceEndpointApplyConfiguration {
+	b.Region = &value
+	return b
+}
+
+// WithInterface sets the Interface field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Interface field is set to the value of the last call.
+func (b *OpenStackServiceEndpointApplyConfiguration) WithInterface(value string) *OpenStackServiceEndpointApplyConfiguration {
+	b.Interface = &value
+	return b
+}
+
+// WithURL sets the URL field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URL field is set to the value of the last call.
+func (b *OpenStackServiceEndpointApplyConfiguration) WithURL(value string) *OpenStackServiceEndpointApplyConfiguration {
+	b.URL = &value
+	return b
+}
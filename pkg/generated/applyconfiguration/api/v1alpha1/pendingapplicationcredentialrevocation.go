@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PendingApplicationCredentialRevocationApplyConfiguration represents a declarative configuration of the PendingApplicationCredentialRevocation type for use
+// with apply.
+//
+// PendingApplicationCredentialRevocation is an application credential which will be deleted.
+type PendingApplicationCredentialRevocationApplyConfiguration struct {
+	// ID is the ID of the application credential.
+	ID *string `json:"id,omitempty"`
+	// RevokeAfter is when the application credential will be deleted.
+	RevokeAfter *v1.Time `json:"revokeAfter,omitempty"`
+}
+
+// PendingApplicationCredentialRevocationApplyConfiguration constructs a declarative configuration of the PendingApplicationCredentialRevocation type for use with
+// apply.
+func PendingApplicationCredentialRevocation() *PendingApplicationCredentialRevocationApplyConfiguration {
+	return &PendingApplicationCredentialRevocationApplyConfiguration{}
+}
+
+// WithID sets the ID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ID field is set to the value of the last call.
+func (b *PendingApplicationCredentialRevocationApplyConfiguration) WithID(value string) *PendingApplicationCredentialRevocationApplyConfiguration {
+	b.ID = &value
+	return b
+}
+
+// WithRevokeAfter sets the RevokeAfter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RevokeAfter field is set to the value of the last call.
+func (b *PendingApplicationCredentialRevocationApplyConfiguration) WithRevokeAfter(value v1.Time) *PendingApplicationCredentialRevocationApplyConfiguration {
+	b.RevokeAfter = &value
+	return b
+}
@@ -26,12 +26,19 @@ package v1beta1
 type OpenStackIdentityReferenceApplyConfiguration struct {
 	// Type specifies the identity reference type. Defaults to Secret for backward compatibility.
 	Type *string `json:"type,omitempty"`
-	// Name is the name of a Secret (type=Secret) in the same namespace as the resource being provisioned,
-	// or the name of an OpenStackClusterIdentity (type=ClusterIdentity).
-	// The Secret must contain a key named `clouds.yaml` which contains an OpenStack clouds.yaml file.
+	// Name is the name of a Secret (type=Secret, ApplicationCredential, Token or Federated) in the same namespace as
+	// the resource being provisioned, or the name of an OpenStackClusterIdentity (type=ClusterIdentity).
+	// For type=Secret the Secret must contain a key named `clouds.yaml` which contains an OpenStack clouds.yaml file.
+	// For type=ApplicationCredential the Secret must contain the keys `authURL` and `applicationCredentialSecret`,
+	// and either `applicationCredentialID`, or `applicationCredentialName` together with `userID` or with
+	// `userName` and `userDomainName`.
+	// For type=Token the Secret must contain the keys `authURL` and `token`.
+	// For type=Federated the Secret must contain the keys `authURL`, `identityProvider` and `protocol`, and either
+	// `trustID`, `projectID`, or `projectName` together with `projectDomainName` or `projectDomainID`.
 	// The Secret may optionally contain a key named `cacert` containing a PEM-encoded CA certificate.
 	Name *string `json:"name,omitempty"`
 	// CloudName specifies the name of the entry in the clouds.yaml file to use.
+	// It is required for type=Secret and type=ClusterIdentity, and ignored otherwise.
 	CloudName *string `json:"cloudName,omitempty"`
 	// Region specifies an OpenStack region to use. If specified, it overrides
 	// any value in clouds.yaml. If specified for an OpenStackMachine, its
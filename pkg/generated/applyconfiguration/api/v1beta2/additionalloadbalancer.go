@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// AdditionalLoadBalancerApplyConfiguration represents a declarative configuration of the AdditionalLoadBalancer type for use
+// with apply.
+//
+// AdditionalLoadBalancer is an Octavia load balancer managed by the
+// OpenStackCluster in addition to the API server load balancer, for example
+// for ingress.
+type AdditionalLoadBalancerApplyConfiguration struct {
+	// name identifies the load balancer in the cluster. It is part of the
+	// names of the OpenStack resources of the load balancer.
+	Name *string `json:"name,omitempty"`
+	// network is the network the VIP of the load balancer is allocated on.
+	// If neither network nor subnet are specified, the VIP is allocated on
+	// the primary subnet of the cluster.
+	Network *NetworkParamApplyConfiguration `json:"network,omitempty"`
+	// subnet is the subnet the VIP of the load balancer is allocated on. If
+	// network is also specified, the subnet must be in it.
+	Subnet *SubnetParamApplyConfiguration `json:"subnet,omitempty"`
+	// provider specifies name of a specific Octavia provider to use for the
+	// load balancer. The Octavia default will be used if it is not
+	// specified.
+	Provider *string `json:"provider,omitempty"`
+	// flavor is the flavor name that will be used to create the load balancer.
+	Flavor *string `json:"flavor,omitempty"`
+	// availabilityZone is the failure domain that will be used to create the load balancer.
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+	// enableFloatingIP determines whether to associate a floating IP from
+	// the external network of the cluster with the VIP of the load balancer.
+	EnableFloatingIP *bool `json:"enableFloatingIP,omitempty"`
+	// listeners are the listeners of the load balancer.
+	Listeners []AdditionalLoadBalancerListenerApplyConfiguration `json:"listeners,omitempty"`
+	// members selects the machines of the cluster which are members of the
+	// pools of the listeners.
+	Members *AdditionalLoadBalancerMembersApplyConfiguration `json:"members,omitempty"`
+}
+
+// AdditionalLoadBalancerApplyConfiguration constructs a declarative configuration of the AdditionalLoadBalancer type for use with
+// apply.
+func AdditionalLoadBalancer() *AdditionalLoadBalancerApplyConfiguration {
+	return &AdditionalLoadBalancerApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *AdditionalLoadBalancerApplyConfiguration) WithName(value string) *AdditionalLoadBalancerApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithNetwork sets the Network field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Network field is set to the value of the last call.
+func (b *AdditionalLoadBalancerApplyConfiguration) WithNetwork(value *NetworkParamApplyConfiguration) *AdditionalLoadBalancerApplyConfiguration {
+	b.Network = value
+	return b
+}
+
+// WithSubnet sets the Subnet field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Subnet field is set to the value of the last call.
+func (b *AdditionalLoadBalancerApplyConfiguration) WithSubnet(value *SubnetParamApplyConfiguration) *AdditionalLoadBalancerApplyConfiguration {
+	b.Subnet = value
+	return b
+}
+
+// WithProvider sets the Provider field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provider field is set to the value of the last call.
+func (b *AdditionalLoadBalancerApplyConfiguration) WithProvider(value string) *AdditionalLoadBalancerApplyConfiguration {
+	b.Provider = &value
+	return b
+}
+
+// WithFlavor sets the Flavor field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Flavor field is set to the value of the last call.
+func (b *AdditionalLoadBalancerApplyConfiguration) WithFlavor(value string) *AdditionalLoadBalancerApplyConfiguration {
+	b.Flavor = &value
+	return b
+}
+
+// WithAvailabilityZone sets the AvailabilityZone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AvailabilityZone field is set to the value of the last call.
+func (b *AdditionalLoadBalancerApplyConfiguration) WithAvailabilityZone(value string) *AdditionalLoadBalancerApplyConfiguration {
+	b.AvailabilityZone = &value
+	return b
+}
+
+// WithEnableFloatingIP sets the EnableFloatingIP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the EnableFloatingIP field is set to the value of the last call.
+func (b *AdditionalLoadBalancerApplyConfiguration) WithEnableFloatingIP(value bool) *AdditionalLoadBalancerApplyConfiguration {
+	b.EnableFloatingIP = &value
+	return b
+}
+
+// WithListeners adds the given value to the Listeners field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Listeners field.
+func (b *AdditionalLoadBalancerApplyConfiguration) WithListeners(values ...*AdditionalLoadBalancerListenerApplyConfiguration) *AdditionalLoadBalancerApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithListeners")
+		}
+		b.Listeners = append(b.Listeners, *values[i])
+	}
+	return b
+}
+
+// WithMembers sets the Members field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Members field is set to the value of the last call.
+func (b *AdditionalLoadBalancerApplyConfiguration) WithMembers(value *AdditionalLoadBalancerMembersApplyConfiguration) *AdditionalLoadBalancerApplyConfiguration {
+	b.Members = value
+	return b
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	apiv1beta2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+)
+
+// AdditionalLoadBalancerListenerApplyConfiguration represents a declarative configuration of the AdditionalLoadBalancerListener type for use
+// with apply.
+//
+// AdditionalLoadBalancerListener is a listener of an additional load
+// balancer, and the pool of members it forwards connections to.
+type AdditionalLoadBalancerListenerApplyConfiguration struct {
+	// port is the port the listener listens on.
+	Port *int32 `json:"port,omitempty"`
+	// protocol is the protocol of the listener and its pool. It defaults to TCP.
+	Protocol *apiv1beta2.AdditionalLoadBalancerProtocol `json:"protocol,omitempty"`
+	// memberPort is the port members receive connections on. It defaults
+	// to port.
+	MemberPort *int32 `json:"memberPort,omitempty"`
+	// allowedCIDRs restrict access to the listener to the given address CIDRs.
+	AllowedCIDRs []string `json:"allowedCIDRs,omitempty"`
+	// monitor contains configuration for the health monitor of the pool.
+	// The health monitor of a UDP listener always uses UDP-CONNECT.
+	Monitor *APIServerLoadBalancerMonitorApplyConfiguration `json:"monitor,omitempty"`
+}
+
+// AdditionalLoadBalancerListenerApplyConfiguration constructs a declarative configuration of the AdditionalLoadBalancerListener type for use with
+// apply.
+func AdditionalLoadBalancerListener() *AdditionalLoadBalancerListenerApplyConfiguration {
+	return &AdditionalLoadBalancerListenerApplyConfiguration{}
+}
+
+// WithPort sets the Port field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Port field is set to the value of the last call.
+func (b *AdditionalLoadBalancerListenerApplyConfiguration) WithPort(value int32) *AdditionalLoadBalancerListenerApplyConfiguration {
+	b.Port = &value
+	return b
+}
+
+// WithProtocol sets the Protocol field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Protocol field is set to the value of the last call.
+func (b *AdditionalLoadBalancerListenerApplyConfiguration) WithProtocol(value apiv1beta2.AdditionalLoadBalancerProtocol) *AdditionalLoadBalancerListenerApplyConfiguration {
+	b.Protocol = &value
+	return b
+}
+
+// WithMemberPort sets the MemberPort field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MemberPort field is set to the value of the last call.
+func (b *AdditionalLoadBalancerListenerApplyConfiguration) WithMemberPort(value int32) *AdditionalLoadBalancerListenerApplyConfiguration {
+	b.MemberPort = &value
+	return b
+}
+
+// WithAllowedCIDRs adds the given value to the AllowedCIDRs field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AllowedCIDRs field.
+func (b *AdditionalLoadBalancerListenerApplyConfiguration) WithAllowedCIDRs(values ...string) *AdditionalLoadBalancerListenerApplyConfiguration {
+	for i := range values {
+		b.AllowedCIDRs = append(b.AllowedCIDRs, values[i])
+	}
+	return b
+}
+
+// WithMonitor sets the Monitor field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Monitor field is set to the value of the last call.
+func (b *AdditionalLoadBalancerListenerApplyConfiguration) WithMonitor(value *APIServerLoadBalancerMonitorApplyConfiguration) *AdditionalLoadBalancerListenerApplyConfiguration {
+	b.Monitor = value
+	return b
+}
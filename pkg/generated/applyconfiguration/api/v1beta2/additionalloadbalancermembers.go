@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	v1 "k8s.io/client-go/applyconfigurations/meta/v1"
+)
+
+// AdditionalLoadBalancerMembersApplyConfiguration represents a declarative configuration of the AdditionalLoadBalancerMembers type for use
+// with apply.
+//
+// AdditionalLoadBalancerMembers selects the machines which are members of an
+// additional load balancer. A machine is a member if it matches
+// machineSelector or belongs to one of machineDeployments.
+type AdditionalLoadBalancerMembersApplyConfiguration struct {
+	// machineSelector selects Machines of the cluster by their labels. The
+	// control plane machines are selected by the label
+	// cluster.x-k8s.io/control-plane.
+	MachineSelector *v1.LabelSelectorApplyConfiguration `json:"machineSelector,omitempty"`
+	// machineDeployments are the names of MachineDeployments whose Machines
+	// are members.
+	MachineDeployments []string `json:"machineDeployments,omitempty"`
+}
+
+// AdditionalLoadBalancerMembersApplyConfiguration constructs a declarative configuration of the AdditionalLoadBalancerMembers type for use with
+// apply.
+func AdditionalLoadBalancerMembers() *AdditionalLoadBalancerMembersApplyConfiguration {
+	return &AdditionalLoadBalancerMembersApplyConfiguration{}
+}
+
+// WithMachineSelector sets the MachineSelector field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MachineSelector field is set to the value of the last call.
+func (b *AdditionalLoadBalancerMembersApplyConfiguration) WithMachineSelector(value *v1.LabelSelectorApplyConfiguration) *AdditionalLoadBalancerMembersApplyConfiguration {
+	b.MachineSelector = value
+	return b
+}
+
+// WithMachineDeployments adds the given value to the MachineDeployments field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the MachineDeployments field.
+func (b *AdditionalLoadBalancerMembersApplyConfiguration) WithMachineDeployments(values ...string) *AdditionalLoadBalancerMembersApplyConfiguration {
+	for i := range values {
+		b.MachineDeployments = append(b.MachineDeployments, values[i])
+	}
+	return b
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// AdditionalLoadBalancerStatusApplyConfiguration represents a declarative configuration of the AdditionalLoadBalancerStatus type for use
+// with apply.
+//
+// AdditionalLoadBalancerStatus describes an additional load balancer.
+type AdditionalLoadBalancerStatusApplyConfiguration struct {
+	// name is the name of the load balancer in spec.additionalLoadBalancers.
+	Name *string `json:"name,omitempty"`
+	// id is the unique identifier of the load balancer.
+	ID *string `json:"id,omitempty"`
+	// internalIP is the VIP address of the load balancer.
+	InternalIP *string `json:"internalIP,omitempty"`
+	// ip is the floating IP address associated with the VIP of the load balancer.
+	IP *string `json:"ip,omitempty"`
+}
+
+// AdditionalLoadBalancerStatusApplyConfiguration constructs a declarative configuration of the AdditionalLoadBalancerStatus type for use with
+// apply.
+func AdditionalLoadBalancerStatus() *AdditionalLoadBalancerStatusApplyConfiguration {
+	return &AdditionalLoadBalancerStatusApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *AdditionalLoadBalancerStatusApplyConfiguration) WithName(value string) *AdditionalLoadBalancerStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithID sets the ID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ID field is set to the value of the last call.
+func (b *AdditionalLoadBalancerStatusApplyConfiguration) WithID(value string) *AdditionalLoadBalancerStatusApplyConfiguration {
+	b.ID = &value
+	return b
+}
+
+// WithInternalIP sets the InternalIP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InternalIP field is set to the value of the last call.
+func (b *AdditionalLoadBalancerStatusApplyConfiguration) WithInternalIP(value string) *AdditionalLoadBalancerStatusApplyConfiguration {
+	b.InternalIP = &value
+	return b
+}
+
+// WithIP sets the IP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IP field is set to the value of the last call.
+func (b *AdditionalLoadBalancerStatusApplyConfiguration) WithIP(value string) *AdditionalLoadBalancerStatusApplyConfiguration {
+	b.IP = &value
+	return b
+}
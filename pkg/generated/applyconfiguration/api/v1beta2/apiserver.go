@@ -40,9 +40,21 @@ type APIServerApplyConfiguration struct {
 	FloatingIP *string `json:"floatingIP,omitempty"`
 	// enableFloatingIP determines whether to attach a floating IP to the API server.
 	EnableFloatingIP *bool `json:"enableFloatingIP,omitempty"`
+	// floatingIPDNS sets the DNS name and domain of the floating IP of the
+	// API server load balancer or, without a load balancer, of the floating
+	// IP attached to a control plane machine. It is not applied to the
+	// floating IPs of zonal or additional load balancers or of the API server
+	// virtual IP, which would otherwise claim the same DNS name.
+	// This field is not used if EnableFloatingIP is set to false.
+	FloatingIPDNS *FloatingIPDNSApplyConfiguration `json:"floatingIPDNS,omitempty"`
 	// managedLoadBalancer configures the optional LoadBalancer for the API server.
 	// If not specified, no load balancer will be created.
 	ManagedLoadBalancer *APIServerLoadBalancerApplyConfiguration `json:"managedLoadBalancer,omitempty"`
+	// virtualIP configures a virtual IP for the API server which is held by
+	// one of the control plane machines at a time. It is an alternative to
+	// a managed load balancer on clouds without Octavia, and cannot be used
+	// together with an enabled managed load balancer.
+	VirtualIP *APIServerVirtualIPApplyConfiguration `json:"virtualIP,omitempty"`
 }
 
 // APIServerApplyConfiguration constructs a declarative configuration of the APIServer type for use with
@@ -83,6 +95,14 @@ func (b *APIServerApplyConfiguration) WithEnableFloatingIP(value bool) *APIServe
 	return b
 }
 
+// WithFloatingIPDNS sets the FloatingIPDNS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FloatingIPDNS field is set to the value of the last call.
+func (b *APIServerApplyConfiguration) WithFloatingIPDNS(value *FloatingIPDNSApplyConfiguration) *APIServerApplyConfiguration {
+	b.FloatingIPDNS = value
+	return b
+}
+
 // WithManagedLoadBalancer sets the ManagedLoadBalancer field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ManagedLoadBalancer field is set to the value of the last call.
@@ -90,3 +110,11 @@ func (b *APIServerApplyConfiguration) WithManagedLoadBalancer(value *APIServerLo
 	b.ManagedLoadBalancer = value
 	return b
 }
+
+// WithVirtualIP sets the VirtualIP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VirtualIP field is set to the value of the last call.
+func (b *APIServerApplyConfiguration) WithVirtualIP(value *APIServerVirtualIPApplyConfiguration) *APIServerApplyConfiguration {
+	b.VirtualIP = value
+	return b
+}
@@ -49,6 +49,42 @@ type APIServerLoadBalancerApplyConfiguration struct {
 	Flavor *string `json:"flavor,omitempty"`
 	// monitor contains configuration for the load balancer health monitor.
 	Monitor *APIServerLoadBalancerMonitorApplyConfiguration `json:"monitor,omitempty"`
+	// tls configures TLS on the listener for the API server port. If it is
+	// not specified the listener forwards TCP connections to the API server.
+	// Additional ports are not affected.
+	TLS *APIServerLoadBalancerTLSApplyConfiguration `json:"tls,omitempty"`
+	// zones creates a zonal load balancer for the API server in each of the
+	// given availability zones, in addition to the API server load balancer.
+	// The members of a zonal load balancer are the control plane machines in
+	// its zone. Each zonal load balancer has its own VIP, and its own
+	// floating IP if the API server uses a floating IP, so the API server
+	// remains reachable through the other zones if a zone fails. The
+	// addresses of all load balancers are listed in the addresses of the API
+	// server load balancer status, for example to publish them as DNS records
+	// of the control plane endpoint.
+	Zones []APIServerLoadBalancerZoneApplyConfiguration `json:"zones,omitempty"`
+	// memberDrain drains the load balancer members of a control plane
+	// machine before the machine is deleted. The members first stop
+	// receiving new connections, and are deleted once the drain period has
+	// elapsed. If it is not specified the members are deleted immediately.
+	MemberDrain *APIServerLoadBalancerMemberDrainApplyConfiguration `json:"memberDrain,omitempty"`
+	// loadBalancer is an existing load balancer to use as the API server
+	// load balancer instead of creating one, for example a load balancer
+	// shared with other clusters. The listeners, pools and health monitors
+	// of the API server are created on it unless poolID is specified. The
+	// load balancer is never deleted, only the objects created on it.
+	LoadBalancer *LoadBalancerParamApplyConfiguration `json:"loadBalancer,omitempty"`
+	// poolID is the ID of an existing pool of loadBalancer. The control
+	// plane machines are added to it as members, and no listener, pool or
+	// health monitor is created. The listener of the pool is expected to
+	// listen on the API server port.
+	PoolID *string `json:"poolID,omitempty"`
+	// listener configures the timeouts, connection limit and inserted
+	// headers of the listeners of the API server load balancer. Changes are
+	// applied to the existing listeners. Options which are not specified
+	// keep the value of the listener, which is the Octavia default for a
+	// new listener.
+	Listener *APIServerLoadBalancerListenerApplyConfiguration `json:"listener,omitempty"`
 }
 
 // APIServerLoadBalancerApplyConfiguration constructs a declarative configuration of the APIServerLoadBalancer type for use with
@@ -137,3 +173,56 @@ func (b *APIServerLoadBalancerApplyConfiguration) WithMonitor(value *APIServerLo
 	b.Monitor = value
 	return b
 }
+
+// WithTLS sets the TLS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TLS field is set to the value of the last call.
+func (b *APIServerLoadBalancerApplyConfiguration) WithTLS(value *APIServerLoadBalancerTLSApplyConfiguration) *APIServerLoadBalancerApplyConfiguration {
+	b.TLS = value
+	return b
+}
+
+// WithZones adds the given value to the Zones field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Zones field.
+func (b *APIServerLoadBalancerApplyConfiguration) WithZones(values ...*APIServerLoadBalancerZoneApplyConfiguration) *APIServerLoadBalancerApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithZones")
+		}
+		b.Zones = append(b.Zones, *values[i])
+	}
+	return b
+}
+
+// WithMemberDrain sets the MemberDrain field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MemberDrain field is set to the value of the last call.
+func (b *APIServerLoadBalancerApplyConfiguration) WithMemberDrain(value *APIServerLoadBalancerMemberDrainApplyConfiguration) *APIServerLoadBalancerApplyConfiguration {
+	b.MemberDrain = value
+	return b
+}
+
+// WithLoadBalancer sets the LoadBalancer field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LoadBalancer field is set to the value of the last call.
+func (b *APIServerLoadBalancerApplyConfiguration) WithLoadBalancer(value *LoadBalancerParamApplyConfiguration) *APIServerLoadBalancerApplyConfiguration {
+	b.LoadBalancer = value
+	return b
+}
+
+// WithPoolID sets the PoolID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PoolID field is set to the value of the last call.
+func (b *APIServerLoadBalancerApplyConfiguration) WithPoolID(value string) *APIServerLoadBalancerApplyConfiguration {
+	b.PoolID = &value
+	return b
+}
+
+// WithListener sets the Listener field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Listener field is set to the value of the last call.
+func (b *APIServerLoadBalancerApplyConfiguration) WithListener(value *APIServerLoadBalancerListenerApplyConfiguration) *APIServerLoadBalancerApplyConfiguration {
+	b.Listener = value
+	return b
+}
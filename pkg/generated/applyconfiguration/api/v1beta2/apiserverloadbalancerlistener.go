@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// APIServerLoadBalancerListenerApplyConfiguration represents a declarative configuration of the APIServerLoadBalancerListener type for use
+// with apply.
+//
+// APIServerLoadBalancerListener configures the listeners of the API server
+// load balancer.
+type APIServerLoadBalancerListenerApplyConfiguration struct {
+	// timeoutClientData is the client inactivity timeout in milliseconds.
+	// Long-running requests such as watches and kubectl exec sessions are
+	// closed when they are idle for longer. The Octavia default is 50000.
+	TimeoutClientData *int32 `json:"timeoutClientData,omitempty"`
+	// timeoutMemberConnect is the timeout in milliseconds for connecting to
+	// a member. The Octavia default is 5000.
+	TimeoutMemberConnect *int32 `json:"timeoutMemberConnect,omitempty"`
+	// timeoutMemberData is the member inactivity timeout in milliseconds.
+	// The Octavia default is 50000.
+	TimeoutMemberData *int32 `json:"timeoutMemberData,omitempty"`
+	// timeoutTCPInspect is the time in milliseconds to wait for additional
+	// TCP packets for content inspection. The Octavia default is 0.
+	TimeoutTCPInspect *int32 `json:"timeoutTCPInspect,omitempty"`
+	// connectionLimit is the maximum number of connections of each
+	// listener. -1 means unlimited, which is the Octavia default.
+	ConnectionLimit *int32 `json:"connectionLimit,omitempty"`
+	// insertHeaders are the headers inserted into the requests forwarded to
+	// the API servers, for example X-Forwarded-For, with the value "true"
+	// or "false". They can only be inserted by the listener of the API
+	// server port, and only if it terminates TLS.
+	InsertHeaders map[string]string `json:"insertHeaders,omitempty"`
+}
+
+// APIServerLoadBalancerListenerApplyConfiguration constructs a declarative configuration of the APIServerLoadBalancerListener type for use with
+// apply.
+func APIServerLoadBalancerListener() *APIServerLoadBalancerListenerApplyConfiguration {
+	return &APIServerLoadBalancerListenerApplyConfiguration{}
+}
+
+// WithTimeoutClientData sets the TimeoutClientData field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeoutClientData field is set to the value of the last call.
+func (b *APIServerLoadBalancerListenerApplyConfiguration) WithTimeoutClientData(value int32) *APIServerLoadBalancerListenerApplyConfiguration {
+	b.TimeoutClientData = &value
+	return b
+}
+
+// WithTimeoutMemberConnect sets the TimeoutMemberConnect field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeoutMemberConnect field is set to the value of the last call.
+func (b *APIServerLoadBalancerListenerApplyConfiguration) WithTimeoutMemberConnect(value int32) *APIServerLoadBalancerListenerApplyConfiguration {
+	b.TimeoutMemberConnect = &value
+	return b
+}
+
+// WithTimeoutMemberData sets the TimeoutMemberData field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeoutMemberData field is set to the value of the last call.
+func (b *APIServerLoadBalancerListenerApplyConfiguration) WithTimeoutMemberData(value int32) *APIServerLoadBalancerListenerApplyConfiguration {
+	b.TimeoutMemberData = &value
+	return b
+}
+
+// WithTimeoutTCPInspect sets the TimeoutTCPInspect field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the TimeoutTCPInspect field is set to the value of the last call.
+func (b *APIServerLoadBalancerListenerApplyConfiguration) WithTimeoutTCPInspect(value int32) *APIServerLoadBalancerListenerApplyConfiguration {
+	b.TimeoutTCPInspect = &value
+	return b
+}
+
+// WithConnectionLimit sets the ConnectionLimit field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConnectionLimit field is set to the value of the last call.
+func (b *APIServerLoadBalancerListenerApplyConfiguration) WithConnectionLimit(value int32) *APIServerLoadBalancerListenerApplyConfiguration {
+	b.ConnectionLimit = &value
+	return b
+}
+
+// WithInsertHeaders puts the entries into the InsertHeaders field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, the entries provided by each call will be put on the InsertHeaders field,
+// overwriting an existing map entries in InsertHeaders field with the same key.
+func (b *APIServerLoadBalancerListenerApplyConfiguration) WithInsertHeaders(entries map[string]string) *APIServerLoadBalancerListenerApplyConfiguration {
+	if b.InsertHeaders == nil && len(entries) > 0 {
+		b.InsertHeaders = make(map[string]string, len(entries))
+	}
+	for k, v := range entries {
+		b.InsertHeaders[k] = v
+	}
+	return b
+}
@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1beta2
 
+import (
+	apiv1beta2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+)
+
 // APIServerLoadBalancerMonitorApplyConfiguration represents a declarative configuration of the APIServerLoadBalancerMonitor type for use
 // with apply.
 //
@@ -31,6 +35,19 @@ type APIServerLoadBalancerMonitorApplyConfiguration struct {
 	MaxRetries *int32 `json:"maxRetries,omitempty"`
 	// maxRetriesDown is the number of allowed check failures before changing the operating status of the member to ERROR.
 	MaxRetriesDown *int32 `json:"maxRetriesDown,omitempty"`
+	// type is the type of the health monitor of the API server port. TCP
+	// only checks that the API server accepts connections. HTTPS requests
+	// urlPath from the API server and checks the response code. Health
+	// monitors of additional ports always use TCP.
+	Type *apiv1beta2.APIServerLoadBalancerMonitorType `json:"type,omitempty"`
+	// urlPath is the path requested by an HTTPS health monitor. It defaults
+	// to /readyz.
+	URLPath *string `json:"urlPath,omitempty"`
+	// expectedCodes are the HTTP status codes an HTTPS health monitor expects
+	// from a healthy API server. It is a single code, a comma separated list
+	// of codes or a range, for example 200, 200,202 or 200-204. It defaults
+	// to 200.
+	ExpectedCodes *string `json:"expectedCodes,omitempty"`
 }
 
 // APIServerLoadBalancerMonitorApplyConfiguration constructs a declarative configuration of the APIServerLoadBalancerMonitor type for use with
@@ -70,3 +87,27 @@ func (b *APIServerLoadBalancerMonitorApplyConfiguration) WithMaxRetriesDown(valu
 	b.MaxRetriesDown = &value
 	return b
 }
+
+// WithType sets the Type field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Type field is set to the value of the last call.
+func (b *APIServerLoadBalancerMonitorApplyConfiguration) WithType(value apiv1beta2.APIServerLoadBalancerMonitorType) *APIServerLoadBalancerMonitorApplyConfiguration {
+	b.Type = &value
+	return b
+}
+
+// WithURLPath sets the URLPath field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the URLPath field is set to the value of the last call.
+func (b *APIServerLoadBalancerMonitorApplyConfiguration) WithURLPath(value string) *APIServerLoadBalancerMonitorApplyConfiguration {
+	b.URLPath = &value
+	return b
+}
+
+// WithExpectedCodes sets the ExpectedCodes field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ExpectedCodes field is set to the value of the last call.
+func (b *APIServerLoadBalancerMonitorApplyConfiguration) WithExpectedCodes(value string) *APIServerLoadBalancerMonitorApplyConfiguration {
+	b.ExpectedCodes = &value
+	return b
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	apiv1beta2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+)
+
+// APIServerLoadBalancerReplacementApplyConfiguration represents a declarative configuration of the APIServerLoadBalancerReplacement type for use
+// with apply.
+//
+// APIServerLoadBalancerReplacement describes the replacement of the API
+// server load balancer after a property which cannot be changed on an
+// existing load balancer, such as its provider, was changed.
+type APIServerLoadBalancerReplacementApplyConfiguration struct {
+	// phase is the current phase of the replacement.
+	Phase *apiv1beta2.APIServerLoadBalancerReplacementPhase `json:"phase,omitempty"`
+	// changes lists the properties of the load balancer which changed.
+	Changes []string `json:"changes,omitempty"`
+	// previousID is the ID of the load balancer being replaced.
+	PreviousID *string `json:"previousID,omitempty"`
+	// name is the name of the replacement load balancer. It is renamed to
+	// the name of the previous load balancer once that has been deleted.
+	Name *string `json:"name,omitempty"`
+	// id is the ID of the replacement load balancer.
+	ID *string `json:"id,omitempty"`
+	// internalIP is the VIP address of the replacement load balancer.
+	InternalIP *string `json:"internalIP,omitempty"`
+}
+
+// APIServerLoadBalancerReplacementApplyConfiguration constructs a declarative configuration of the APIServerLoadBalancerReplacement type for use with
+// apply.
+func APIServerLoadBalancerReplacement() *APIServerLoadBalancerReplacementApplyConfiguration {
+	return &APIServerLoadBalancerReplacementApplyConfiguration{}
+}
+
+// WithPhase sets the Phase field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Phase field is set to the value of the last call.
+func (b *APIServerLoadBalancerReplacementApplyConfiguration) WithPhase(value apiv1beta2.APIServerLoadBalancerReplacementPhase) *APIServerLoadBalancerReplacementApplyConfiguration {
+	b.Phase = &value
+	return b
+}
+
+// WithChanges adds the given value to the Changes field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Changes field.
+func (b *APIServerLoadBalancerReplacementApplyConfiguration) WithChanges(values ...string) *APIServerLoadBalancerReplacementApplyConfiguration {
+	for i := range values {
+		b.Changes = append(b.Changes, values[i])
+	}
+	return b
+}
+
+// WithPreviousID sets the PreviousID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PreviousID field is set to the value of the last call.
+func (b *APIServerLoadBalancerReplacementApplyConfiguration) WithPreviousID(value string) *APIServerLoadBalancerReplacementApplyConfiguration {
+	b.PreviousID = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *APIServerLoadBalancerReplacementApplyConfiguration) WithName(value string) *APIServerLoadBalancerReplacementApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithID sets the ID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ID field is set to the value of the last call.
+func (b *APIServerLoadBalancerReplacementApplyConfiguration) WithID(value string) *APIServerLoadBalancerReplacementApplyConfiguration {
+	b.ID = &value
+	return b
+}
+
+// WithInternalIP sets the InternalIP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InternalIP field is set to the value of the last call.
+func (b *APIServerLoadBalancerReplacementApplyConfiguration) WithInternalIP(value string) *APIServerLoadBalancerReplacementApplyConfiguration {
+	b.InternalIP = &value
+	return b
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	apiv1beta2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+)
+
+// APIServerLoadBalancerTLSApplyConfiguration represents a declarative configuration of the APIServerLoadBalancerTLS type for use
+// with apply.
+//
+// APIServerLoadBalancerTLS configures TLS on the API server listener.
+type APIServerLoadBalancerTLSApplyConfiguration struct {
+	// mode defines how the load balancer handles TLS. Passthrough forwards
+	// TLS connections to the API server. Terminated terminates TLS with the
+	// certificate in certificateSecretName and re-encrypts the connection to
+	// the API server. As the load balancer terminates TLS, clients cannot
+	// authenticate to the API server with client certificates in Terminated
+	// mode.
+	Mode *apiv1beta2.APIServerLoadBalancerTLSMode `json:"mode,omitempty"`
+	// certificateSecretName is the name of a Secret of type kubernetes.io/tls
+	// in the namespace of the OpenStackCluster containing the certificate
+	// and private key served by the load balancer. It is stored in Barbican.
+	// It is required when mode is Terminated.
+	CertificateSecretName *string `json:"certificateSecretName,omitempty"`
+}
+
+// APIServerLoadBalancerTLSApplyConfiguration constructs a declarative configuration of the APIServerLoadBalancerTLS type for use with
+// apply.
+func APIServerLoadBalancerTLS() *APIServerLoadBalancerTLSApplyConfiguration {
+	return &APIServerLoadBalancerTLSApplyConfiguration{}
+}
+
+// WithMode sets the Mode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Mode field is set to the value of the last call.
+func (b *APIServerLoadBalancerTLSApplyConfiguration) WithMode(value apiv1beta2.APIServerLoadBalancerTLSMode) *APIServerLoadBalancerTLSApplyConfiguration {
+	b.Mode = &value
+	return b
+}
+
+// WithCertificateSecretName sets the CertificateSecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the CertificateSecretName field is set to the value of the last call.
+func (b *APIServerLoadBalancerTLSApplyConfiguration) WithCertificateSecretName(value string) *APIServerLoadBalancerTLSApplyConfiguration {
+	b.CertificateSecretName = &value
+	return b
+}
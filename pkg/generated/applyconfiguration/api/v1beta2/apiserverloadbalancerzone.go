@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// APIServerLoadBalancerZoneApplyConfiguration represents a declarative configuration of the APIServerLoadBalancerZone type for use
+// with apply.
+//
+// APIServerLoadBalancerZone is an availability zone with a zonal load
+// balancer for the API server.
+type APIServerLoadBalancerZoneApplyConfiguration struct {
+	// name is the name of the compute availability zone. The control plane
+	// machines in this zone are the members of the zonal load balancer.
+	Name *string `json:"name,omitempty"`
+	// availabilityZone is the Octavia availability zone in which the zonal
+	// load balancer is created. It defaults to name. It is ignored if the
+	// Octavia provider does not support availability zones.
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+}
+
+// APIServerLoadBalancerZoneApplyConfiguration constructs a declarative configuration of the APIServerLoadBalancerZone type for use with
+// apply.
+func APIServerLoadBalancerZone() *APIServerLoadBalancerZoneApplyConfiguration {
+	return &APIServerLoadBalancerZoneApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *APIServerLoadBalancerZoneApplyConfiguration) WithName(value string) *APIServerLoadBalancerZoneApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithAvailabilityZone sets the AvailabilityZone field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AvailabilityZone field is set to the value of the last call.
+func (b *APIServerLoadBalancerZoneApplyConfiguration) WithAvailabilityZone(value string) *APIServerLoadBalancerZoneApplyConfiguration {
+	b.AvailabilityZone = &value
+	return b
+}
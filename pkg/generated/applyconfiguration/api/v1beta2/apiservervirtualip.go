@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	apiv1beta2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+)
+
+// APIServerVirtualIPApplyConfiguration represents a declarative configuration of the APIServerVirtualIP type for use
+// with apply.
+//
+// APIServerVirtualIP configures a virtual IP for the API server. A Neutron
+// port is created to reserve the virtual IP, and the virtual IP is added to
+// the allowed address pairs of the ports of the control plane machines. The
+// configuration of the provider which moves the virtual IP between the
+// control plane machines is written to a Secret for the bootstrap provider.
+type APIServerVirtualIPApplyConfiguration struct {
+	// provider is the software which holds the virtual IP on the control
+	// plane machines.
+	Provider *apiv1beta2.APIServerVirtualIPProvider `json:"provider,omitempty"`
+	// interface is the name of the network interface of the control plane
+	// machines on which the virtual IP is held. It defaults to eth0.
+	Interface *string `json:"interface,omitempty"`
+	// virtualRouterID is the VRRP virtual router ID used by keepalived. It
+	// must be unique among the VRRP routers on the network of the cluster.
+	// It defaults to 51.
+	VirtualRouterID *int32 `json:"virtualRouterID,omitempty"`
+	// image is the kube-vip container image. It defaults to a kube-vip
+	// release known to work with this version of CAPO.
+	Image *string `json:"image,omitempty"`
+}
+
+// APIServerVirtualIPApplyConfiguration constructs a declarative configuration of the APIServerVirtualIP type for use with
+// apply.
+func APIServerVirtualIP() *APIServerVirtualIPApplyConfiguration {
+	return &APIServerVirtualIPApplyConfiguration{}
+}
+
+// WithProvider sets the Provider field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Provider field is set to the value of the last call.
+func (b *APIServerVirtualIPApplyConfiguration) WithProvider(value apiv1beta2.APIServerVirtualIPProvider) *APIServerVirtualIPApplyConfiguration {
+	b.Provider = &value
+	return b
+}
+
+// WithInterface sets the Interface field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Interface field is set to the value of the last call.
+func (b *APIServerVirtualIPApplyConfiguration) WithInterface(value string) *APIServerVirtualIPApplyConfiguration {
+	b.Interface = &value
+	return b
+}
+
+// WithVirtualRouterID sets the VirtualRouterID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the VirtualRouterID field is set to the value of the last call.
+func (b *APIServerVirtualIPApplyConfiguration) WithVirtualRouterID(value int32) *APIServerVirtualIPApplyConfiguration {
+	b.VirtualRouterID = &value
+	return b
+}
+
+// WithImage sets the Image field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Image field is set to the value of the last call.
+func (b *APIServerVirtualIPApplyConfiguration) WithImage(value string) *APIServerVirtualIPApplyConfiguration {
+	b.Image = &value
+	return b
+}
@@ -39,6 +39,9 @@ type BastionApplyConfiguration struct {
 	// The floating IP should already exist and should not be associated with a port. If FIP of this address does not
 	// exist, CAPO will try to create it, but by default only OpenStack administrators have privileges to do so.
 	FloatingIP *string `json:"floatingIP,omitempty"`
+	// floatingIPDNS sets the DNS name and domain of the floating IP created
+	// for the bastion.
+	FloatingIPDNS *FloatingIPDNSApplyConfiguration `json:"floatingIPDNS,omitempty"`
 }
 
 // BastionApplyConfiguration constructs a declarative configuration of the Bastion type for use with
@@ -78,3 +81,11 @@ func (b *BastionApplyConfiguration) WithFloatingIP(value string) *BastionApplyCo
 	b.FloatingIP = &value
 	return b
 }
+
+// WithFloatingIPDNS sets the FloatingIPDNS field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FloatingIPDNS field is set to the value of the last call.
+func (b *BastionApplyConfiguration) WithFloatingIPDNS(value *FloatingIPDNSApplyConfiguration) *BastionApplyConfiguration {
+	b.FloatingIPDNS = value
+	return b
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// FloatingIPDNSApplyConfiguration represents a declarative configuration of the FloatingIPDNS type for use
+// with apply.
+//
+// FloatingIPDNS is the DNS configuration of a floating IP. It is only applied
+// when the Neutron dns-integration extension is available, in which case an
+// external DNS service such as Designate will publish a record for the
+// floating IP. Neutron requires both dnsName and dnsDomain to be set.
+type FloatingIPDNSApplyConfiguration struct {
+	// dnsName is the DNS name of the floating IP.
+	DNSName *string `json:"dnsName,omitempty"`
+	// dnsDomain is the DNS domain in which the record for the floating IP
+	// will be published. It must be a fully qualified domain name ending
+	// with a dot.
+	DNSDomain *string `json:"dnsDomain,omitempty"`
+}
+
+// FloatingIPDNSApplyConfiguration constructs a declarative configuration of the FloatingIPDNS type for use with
+// apply.
+func FloatingIPDNS() *FloatingIPDNSApplyConfiguration {
+	return &FloatingIPDNSApplyConfiguration{}
+}
+
+// WithDNSName sets the DNSName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DNSName field is set to the value of the last call.
+func (b *FloatingIPDNSApplyConfiguration) WithDNSName(value string) *FloatingIPDNSApplyConfiguration {
+	b.DNSName = &value
+	return b
+}
+
+// WithDNSDomain sets the DNSDomain field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DNSDomain field is set to the value of the last call.
+func (b *FloatingIPDNSApplyConfiguration) WithDNSDomain(value string) *FloatingIPDNSApplyConfiguration {
+	b.DNSDomain = &value
+	return b
+}
@@ -40,6 +40,23 @@ type LoadBalancerApplyConfiguration struct {
 	// If subnets are specified within the LoadBalancerNetwork currently only the first
 	// subnet in the list is taken into account.
 	LoadBalancerNetwork *NetworkStatusWithSubnetsApplyConfiguration `json:"loadBalancerNetwork,omitempty"`
+	// zones describes the zonal load balancers of the API server.
+	Zones []LoadBalancerZoneApplyConfiguration `json:"zones,omitempty"`
+	// addresses are the addresses at which the API server can be reached
+	// through the load balancer and its zonal load balancers. These are their
+	// floating IPs if the API server uses a floating IP, and their VIPs
+	// otherwise.
+	Addresses []string `json:"addresses,omitempty"`
+	// provisioningStatus is the provisioning status of the load balancer
+	// reported by Octavia.
+	ProvisioningStatus *string `json:"provisioningStatus,omitempty"`
+	// operatingStatus is the operating status of the load balancer reported
+	// by Octavia.
+	OperatingStatus *string `json:"operatingStatus,omitempty"`
+	// listeners describes the listeners of the load balancer used by the API
+	// server, with their pools and members, as reported by the status tree of
+	// the load balancer.
+	Listeners []LoadBalancerListenerStatusApplyConfiguration `json:"listeners,omitempty"`
 }
 
 // LoadBalancerApplyConfiguration constructs a declarative configuration of the LoadBalancer type for use with
@@ -107,3 +124,55 @@ func (b *LoadBalancerApplyConfiguration) WithLoadBalancerNetwork(value *NetworkS
 	b.LoadBalancerNetwork = value
 	return b
 }
+
+// WithZones adds the given value to the Zones field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Zones field.
+func (b *LoadBalancerApplyConfiguration) WithZones(values ...*LoadBalancerZoneApplyConfiguration) *LoadBalancerApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithZones")
+		}
+		b.Zones = append(b.Zones, *values[i])
+	}
+	return b
+}
+
+// WithAddresses adds the given value to the Addresses field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Addresses field.
+func (b *LoadBalancerApplyConfiguration) WithAddresses(values ...string) *LoadBalancerApplyConfiguration {
+	for i := range values {
+		b.Addresses = append(b.Addresses, values[i])
+	}
+	return b
+}
+
+// WithProvisioningStatus sets the ProvisioningStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProvisioningStatus field is set to the value of the last call.
+func (b *LoadBalancerApplyConfiguration) WithProvisioningStatus(value string) *LoadBalancerApplyConfiguration {
+	b.ProvisioningStatus = &value
+	return b
+}
+
+// WithOperatingStatus sets the OperatingStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OperatingStatus field is set to the value of the last call.
+func (b *LoadBalancerApplyConfiguration) WithOperatingStatus(value string) *LoadBalancerApplyConfiguration {
+	b.OperatingStatus = &value
+	return b
+}
+
+// WithListeners adds the given value to the Listeners field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Listeners field.
+func (b *LoadBalancerApplyConfiguration) WithListeners(values ...*LoadBalancerListenerStatusApplyConfiguration) *LoadBalancerApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithListeners")
+		}
+		b.Listeners = append(b.Listeners, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// LoadBalancerListenerStatusApplyConfiguration represents a declarative configuration of the LoadBalancerListenerStatus type for use
+// with apply.
+//
+// LoadBalancerListenerStatus describes the status of a listener of the API
+// server load balancer.
+type LoadBalancerListenerStatusApplyConfiguration struct {
+	// id is the ID of the listener.
+	ID *string `json:"id,omitempty"`
+	// name is the name of the listener.
+	Name *string `json:"name,omitempty"`
+	// provisioningStatus is the provisioning status of the listener.
+	ProvisioningStatus *string `json:"provisioningStatus,omitempty"`
+	// operatingStatus is the operating status of the listener.
+	OperatingStatus *string `json:"operatingStatus,omitempty"`
+	// pools describes the pools of the listener.
+	Pools []LoadBalancerPoolStatusApplyConfiguration `json:"pools,omitempty"`
+}
+
+// LoadBalancerListenerStatusApplyConfiguration constructs a declarative configuration of the LoadBalancerListenerStatus type for use with
+// apply.
+func LoadBalancerListenerStatus() *LoadBalancerListenerStatusApplyConfiguration {
+	return &LoadBalancerListenerStatusApplyConfiguration{}
+}
+
+// WithID sets the ID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ID field is set to the value of the last call.
+func (b *LoadBalancerListenerStatusApplyConfiguration) WithID(value string) *LoadBalancerListenerStatusApplyConfiguration {
+	b.ID = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *LoadBalancerListenerStatusApplyConfiguration) WithName(value string) *LoadBalancerListenerStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithProvisioningStatus sets the ProvisioningStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProvisioningStatus field is set to the value of the last call.
+func (b *LoadBalancerListenerStatusApplyConfiguration) WithProvisioningStatus(value string) *LoadBalancerListenerStatusApplyConfiguration {
+	b.ProvisioningStatus = &value
+	return b
+}
+
+// WithOperatingStatus sets the OperatingStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OperatingStatus field is set to the value of the last call.
+func (b *LoadBalancerListenerStatusApplyConfiguration) WithOperatingStatus(value string) *LoadBalancerListenerStatusApplyConfiguration {
+	b.OperatingStatus = &value
+	return b
+}
+
+// WithPools adds the given value to the Pools field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Pools field.
+func (b *LoadBalancerListenerStatusApplyConfiguration) WithPools(values ...*LoadBalancerPoolStatusApplyConfiguration) *LoadBalancerListenerStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithPools")
+		}
+		b.Pools = append(b.Pools, *values[i])
+	}
+	return b
+}
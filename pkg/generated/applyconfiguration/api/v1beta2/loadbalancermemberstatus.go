@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// LoadBalancerMemberStatusApplyConfiguration represents a declarative configuration of the LoadBalancerMemberStatus type for use
+// with apply.
+//
+// LoadBalancerMemberStatus describes the status of a member of a pool of the
+// API server load balancer.
+type LoadBalancerMemberStatusApplyConfiguration struct {
+	// id is the ID of the member.
+	ID *string `json:"id,omitempty"`
+	// name is the name of the member.
+	Name *string `json:"name,omitempty"`
+	// address is the IP address of the member.
+	Address *string `json:"address,omitempty"`
+	// provisioningStatus is the provisioning status of the member.
+	ProvisioningStatus *string `json:"provisioningStatus,omitempty"`
+	// operatingStatus is the operating status of the member: ONLINE,
+	// OFFLINE, DRAINING, ERROR or NO_MONITOR if the pool has no health
+	// monitor.
+	OperatingStatus *string `json:"operatingStatus,omitempty"`
+}
+
+// LoadBalancerMemberStatusApplyConfiguration constructs a declarative configuration of the LoadBalancerMemberStatus type for use with
+// apply.
+func LoadBalancerMemberStatus() *LoadBalancerMemberStatusApplyConfiguration {
+	return &LoadBalancerMemberStatusApplyConfiguration{}
+}
+
+// WithID sets the ID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ID field is set to the value of the last call.
+func (b *LoadBalancerMemberStatusApplyConfiguration) WithID(value string) *LoadBalancerMemberStatusApplyConfiguration {
+	b.ID = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *LoadBalancerMemberStatusApplyConfiguration) WithName(value string) *LoadBalancerMemberStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithAddress sets the Address field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Address field is set to the value of the last call.
+func (b *LoadBalancerMemberStatusApplyConfiguration) WithAddress(value string) *LoadBalancerMemberStatusApplyConfiguration {
+	b.Address = &value
+	return b
+}
+
+// WithProvisioningStatus sets the ProvisioningStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProvisioningStatus field is set to the value of the last call.
+func (b *LoadBalancerMemberStatusApplyConfiguration) WithProvisioningStatus(value string) *LoadBalancerMemberStatusApplyConfiguration {
+	b.ProvisioningStatus = &value
+	return b
+}
+
+// WithOperatingStatus sets the OperatingStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OperatingStatus field is set to the value of the last call.
+func (b *LoadBalancerMemberStatusApplyConfiguration) WithOperatingStatus(value string) *LoadBalancerMemberStatusApplyConfiguration {
+	b.OperatingStatus = &value
+	return b
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// LoadBalancerPoolStatusApplyConfiguration represents a declarative configuration of the LoadBalancerPoolStatus type for use
+// with apply.
+//
+// LoadBalancerPoolStatus describes the status of a pool of the API server
+// load balancer.
+type LoadBalancerPoolStatusApplyConfiguration struct {
+	// id is the ID of the pool.
+	ID *string `json:"id,omitempty"`
+	// name is the name of the pool.
+	Name *string `json:"name,omitempty"`
+	// provisioningStatus is the provisioning status of the pool.
+	ProvisioningStatus *string `json:"provisioningStatus,omitempty"`
+	// operatingStatus is the operating status of the pool.
+	OperatingStatus *string `json:"operatingStatus,omitempty"`
+	// members describes the members of the pool.
+	Members []LoadBalancerMemberStatusApplyConfiguration `json:"members,omitempty"`
+}
+
+// LoadBalancerPoolStatusApplyConfiguration constructs a declarative configuration of the LoadBalancerPoolStatus type for use with
+// apply.
+func LoadBalancerPoolStatus() *LoadBalancerPoolStatusApplyConfiguration {
+	return &LoadBalancerPoolStatusApplyConfiguration{}
+}
+
+// WithID sets the ID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ID field is set to the value of the last call.
+func (b *LoadBalancerPoolStatusApplyConfiguration) WithID(value string) *LoadBalancerPoolStatusApplyConfiguration {
+	b.ID = &value
+	return b
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *LoadBalancerPoolStatusApplyConfiguration) WithName(value string) *LoadBalancerPoolStatusApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithProvisioningStatus sets the ProvisioningStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProvisioningStatus field is set to the value of the last call.
+func (b *LoadBalancerPoolStatusApplyConfiguration) WithProvisioningStatus(value string) *LoadBalancerPoolStatusApplyConfiguration {
+	b.ProvisioningStatus = &value
+	return b
+}
+
+// WithOperatingStatus sets the OperatingStatus field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OperatingStatus field is set to the value of the last call.
+func (b *LoadBalancerPoolStatusApplyConfiguration) WithOperatingStatus(value string) *LoadBalancerPoolStatusApplyConfiguration {
+	b.OperatingStatus = &value
+	return b
+}
+
+// WithMembers adds the given value to the Members field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Members field.
+func (b *LoadBalancerPoolStatusApplyConfiguration) WithMembers(values ...*LoadBalancerMemberStatusApplyConfiguration) *LoadBalancerPoolStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithMembers")
+		}
+		b.Members = append(b.Members, *values[i])
+	}
+	return b
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// LoadBalancerZoneApplyConfiguration represents a declarative configuration of the LoadBalancerZone type for use
+// with apply.
+//
+// LoadBalancerZone describes a zonal load balancer of the API server.
+type LoadBalancerZoneApplyConfiguration struct {
+	// name is the name of the availability zone.
+	Name *string `json:"name,omitempty"`
+	// id is the ID of the zonal load balancer.
+	ID *string `json:"id,omitempty"`
+	// internalIP is the VIP address of the zonal load balancer.
+	InternalIP *string `json:"internalIP,omitempty"`
+	// ip is the floating IP address associated with the VIP of the zonal
+	// load balancer.
+	IP *string `json:"ip,omitempty"`
+}
+
+// LoadBalancerZoneApplyConfiguration constructs a declarative configuration of the LoadBalancerZone type for use with
+// apply.
+func LoadBalancerZone() *LoadBalancerZoneApplyConfiguration {
+	return &LoadBalancerZoneApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *LoadBalancerZoneApplyConfiguration) WithName(value string) *LoadBalancerZoneApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithID sets the ID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ID field is set to the value of the last call.
+func (b *LoadBalancerZoneApplyConfiguration) WithID(value string) *LoadBalancerZoneApplyConfiguration {
+	b.ID = &value
+	return b
+}
+
+// WithInternalIP sets the InternalIP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the InternalIP field is set to the value of the last call.
+func (b *LoadBalancerZoneApplyConfiguration) WithInternalIP(value string) *LoadBalancerZoneApplyConfiguration {
+	b.InternalIP = &value
+	return b
+}
+
+// WithIP sets the IP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IP field is set to the value of the last call.
+func (b *LoadBalancerZoneApplyConfiguration) WithIP(value string) *LoadBalancerZoneApplyConfiguration {
+	b.IP = &value
+	return b
+}
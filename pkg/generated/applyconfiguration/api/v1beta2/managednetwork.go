@@ -32,6 +32,11 @@ type ManagedNetworkApplyConfiguration struct {
 	// Kubernetes cluster, which also enables SecurityGroups.
 	// If left empty, the network will have port security setting enabled.
 	EnablePortSecurity *bool `json:"enablePortSecurity,omitempty"`
+	// qosPolicy is the Neutron QoS policy to apply to the network created
+	// for the Kubernetes cluster. It applies to every port of the network
+	// which does not set its own QoS policy.
+	// This value will be used only if the Cluster actuator creates the network.
+	QoSPolicy *QoSPolicyParamApplyConfiguration `json:"qosPolicy,omitempty"`
 }
 
 // ManagedNetworkApplyConfiguration constructs a declarative configuration of the ManagedNetwork type for use with
@@ -55,3 +60,11 @@ func (b *ManagedNetworkApplyConfiguration) WithEnablePortSecurity(value bool) *M
 	b.EnablePortSecurity = &value
 	return b
 }
+
+// WithQoSPolicy sets the QoSPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QoSPolicy field is set to the value of the last call.
+func (b *ManagedNetworkApplyConfiguration) WithQoSPolicy(value *QoSPolicyParamApplyConfiguration) *ManagedNetworkApplyConfiguration {
+	b.QoSPolicy = value
+	return b
+}
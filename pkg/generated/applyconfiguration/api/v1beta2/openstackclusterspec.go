@@ -28,8 +28,9 @@ import (
 // OpenStackClusterSpec defines the desired state of OpenStackCluster.
 type OpenStackClusterSpecApplyConfiguration struct {
 	// managedSubnets describe OpenStack Subnets to be created. Cluster actuator will create a network,
-	// subnets with the defined CIDR, and a router connected to these subnets. Currently only one IPv4
-	// subnet is supported. If you leave this empty, no network will be created.
+	// subnets with the defined CIDR, and a router connected to these subnets. At most one IPv4 and one
+	// IPv6 subnet are supported, which creates a dual-stack network. If you leave this empty, no network
+	// will be created.
 	ManagedSubnets []SubnetSpecApplyConfiguration `json:"managedSubnets,omitempty"`
 	// subnets specifies existing subnets to use if not ManagedSubnets are
 	// specified. All subnets must be in the network specified by Network.
@@ -76,6 +77,10 @@ type OpenStackClusterSpecApplyConfiguration struct {
 	// apiServer configures the API server endpoint and its associated
 	// load balancer and floating IP.
 	APIServer *APIServerApplyConfiguration `json:"apiServer,omitempty"`
+	// additionalLoadBalancers are Octavia load balancers managed by the
+	// cluster in addition to the API server load balancer, for example for
+	// ingress. Their members are selected from all machines of the cluster.
+	AdditionalLoadBalancers []AdditionalLoadBalancerApplyConfiguration `json:"additionalLoadBalancers,omitempty"`
 	// managedSecurityGroups determines whether OpenStack security groups for the cluster
 	// will be managed by the OpenStack provider or whether pre-existing security groups will
 	// be specified as part of the configuration.
@@ -209,6 +214,19 @@ func (b *OpenStackClusterSpecApplyConfiguration) WithAPIServer(value *APIServerA
 	return b
 }
 
+// WithAdditionalLoadBalancers adds the given value to the AdditionalLoadBalancers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AdditionalLoadBalancers field.
+func (b *OpenStackClusterSpecApplyConfiguration) WithAdditionalLoadBalancers(values ...*AdditionalLoadBalancerApplyConfiguration) *OpenStackClusterSpecApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAdditionalLoadBalancers")
+		}
+		b.AdditionalLoadBalancers = append(b.AdditionalLoadBalancers, *values[i])
+	}
+	return b
+}
+
 // WithManagedSecurityGroups sets the ManagedSecurityGroups field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the ManagedSecurityGroups field is set to the value of the last call.
@@ -43,6 +43,15 @@ type OpenStackClusterStatusApplyConfiguration struct {
 	Router *RouterApplyConfiguration `json:"router,omitempty"`
 	// apiServerManagedLoadBalancer describes the api server load balancer if one exists
 	APIServerManagedLoadBalancer *LoadBalancerApplyConfiguration `json:"apiServerManagedLoadBalancer,omitempty"`
+	// apiServerLoadBalancerReplacement describes the replacement of the api
+	// server load balancer while one is in progress.
+	APIServerLoadBalancerReplacement *APIServerLoadBalancerReplacementApplyConfiguration `json:"apiServerLoadBalancerReplacement,omitempty"`
+	// apiServerVirtualIP describes the virtual IP of the api server if one
+	// exists
+	APIServerVirtualIP *VirtualIPStatusApplyConfiguration `json:"apiServerVirtualIP,omitempty"`
+	// additionalLoadBalancers describes the load balancers in
+	// spec.additionalLoadBalancers.
+	AdditionalLoadBalancers []AdditionalLoadBalancerStatusApplyConfiguration `json:"additionalLoadBalancers,omitempty"`
 	// failureDomains represent OpenStack availability zones
 	FailureDomains []corev1beta2.FailureDomain `json:"failureDomains,omitempty"`
 	// controlPlaneSecurityGroup contains the information about the
@@ -118,6 +127,35 @@ func (b *OpenStackClusterStatusApplyConfiguration) WithAPIServerManagedLoadBalan
 	return b
 }
 
+// WithAPIServerLoadBalancerReplacement sets the APIServerLoadBalancerReplacement field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIServerLoadBalancerReplacement field is set to the value of the last call.
+func (b *OpenStackClusterStatusApplyConfiguration) WithAPIServerLoadBalancerReplacement(value *APIServerLoadBalancerReplacementApplyConfiguration) *OpenStackClusterStatusApplyConfiguration {
+	b.APIServerLoadBalancerReplacement = value
+	return b
+}
+
+// WithAPIServerVirtualIP sets the APIServerVirtualIP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the APIServerVirtualIP field is set to the value of the last call.
+func (b *OpenStackClusterStatusApplyConfiguration) WithAPIServerVirtualIP(value *VirtualIPStatusApplyConfiguration) *OpenStackClusterStatusApplyConfiguration {
+	b.APIServerVirtualIP = value
+	return b
+}
+
+// WithAdditionalLoadBalancers adds the given value to the AdditionalLoadBalancers field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the AdditionalLoadBalancers field.
+func (b *OpenStackClusterStatusApplyConfiguration) WithAdditionalLoadBalancers(values ...*AdditionalLoadBalancerStatusApplyConfiguration) *OpenStackClusterStatusApplyConfiguration {
+	for i := range values {
+		if values[i] == nil {
+			panic("nil value passed to WithAdditionalLoadBalancers")
+		}
+		b.AdditionalLoadBalancers = append(b.AdditionalLoadBalancers, *values[i])
+	}
+	return b
+}
+
 // WithFailureDomains adds the given value to the FailureDomains field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the FailureDomains field.
@@ -26,12 +26,30 @@ package v1beta2
 type OpenStackIdentityReferenceApplyConfiguration struct {
 	// type specifies the identity reference type. Defaults to Secret for backward compatibility.
 	Type *string `json:"type,omitempty"`
-	// name is the name of a Secret (type=Secret) in the same namespace as the resource being provisioned,
-	// or the name of an OpenStackClusterIdentity (type=ClusterIdentity).
-	// The Secret must contain a key named `clouds.yaml` which contains an OpenStack clouds.yaml file.
-	// The Secret may optionally contain a key named `cacert` containing a PEM-encoded CA certificate.
+	// name is the name of a Secret (type=Secret, ApplicationCredential, Token or Federated) in the same namespace as
+	// the resource being provisioned, or the name of an OpenStackClusterIdentity (type=ClusterIdentity).
+	//
+	// For type=Secret the Secret must contain a key named `clouds.yaml` which contains an OpenStack clouds.yaml file.
+	//
+	// For type=ApplicationCredential the Secret must contain the keys `authURL` and `applicationCredentialSecret`,
+	// and either `applicationCredentialID`, or `applicationCredentialName` together with `userID` or with
+	// `userName` and `userDomainName`. The application credential may be restricted by access rules.
+	//
+	// For type=Token the Secret must contain the keys `authURL` and `token`, a project-scoped token which is
+	// used until it expires. The token is not renewed by the controllers, so the Secret must be updated with a
+	// new token before the current one expires.
+	//
+	// For type=Federated the Secret must contain the keys `authURL`, `identityProvider` and `protocol`, and either
+	// `trustID`, `projectID`, or `projectName` together with `projectDomainName` or `projectDomainID`. The
+	// manager's projected service account token is exchanged for an unscoped token with the federated identity
+	// provider and protocol, which is then scoped to the trust or project. No long-lived credentials are stored.
+	//
+	// For type=ApplicationCredential, type=Token and type=Federated the Secret may optionally contain the keys
+	// `regionName` and `interface`. For all types the Secret may optionally contain a key named `cacert` containing
+	// a PEM-encoded CA certificate.
 	Name *string `json:"name,omitempty"`
 	// cloudName specifies the name of the entry in the clouds.yaml file to use.
+	// It is required for type=Secret and type=ClusterIdentity, and ignored otherwise.
 	CloudName *string `json:"cloudName,omitempty"`
 	// region specifies an OpenStack region to use. If specified, it overrides
 	// any value in clouds.yaml. If specified for an OpenStackMachine, its
@@ -32,6 +32,9 @@ type PortOptsApplyConfiguration struct {
 	FixedIPs []FixedIPApplyConfiguration `json:"fixedIPs,omitempty"`
 	// securityGroups is a list of the names, uuids, filters or any combination these of the security groups to assign to the instance.
 	SecurityGroups []SecurityGroupParamApplyConfiguration `json:"securityGroups,omitempty"`
+	// qosPolicy is the Neutron QoS policy to apply to the port. If not
+	// specified, the port uses the QoS policy of its network, if any.
+	QoSPolicy *QoSPolicyParamApplyConfiguration `json:"qosPolicy,omitempty"`
 	// tags applied to the port (and corresponding trunk, if a trunk is configured.)
 	// These tags are applied in addition to the instance's tags, which will also be applied to the port.
 	Tags []string `json:"tags,omitempty"`
@@ -98,6 +101,14 @@ func (b *PortOptsApplyConfiguration) WithSecurityGroups(values ...*SecurityGroup
 	return b
 }
 
+// WithQoSPolicy sets the QoSPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QoSPolicy field is set to the value of the last call.
+func (b *PortOptsApplyConfiguration) WithQoSPolicy(value *QoSPolicyParamApplyConfiguration) *PortOptsApplyConfiguration {
+	b.QoSPolicy = value
+	return b
+}
+
 // WithTags adds the given value to the Tags field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the Tags field.
@@ -197,3 +208,19 @@ func (b *PortOptsApplyConfiguration) WithValueSpecs(values ...*ValueSpecApplyCon
 	}
 	return b
 }
+
+// WithDNSName sets the DNSName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DNSName field is set to the value of the last call.
+func (b *PortOptsApplyConfiguration) WithDNSName(value string) *PortOptsApplyConfiguration {
+	b.ResolvedPortSpecFieldsApplyConfiguration.DNSName = &value
+	return b
+}
+
+// WithDNSDomain sets the DNSDomain field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DNSDomain field is set to the value of the last call.
+func (b *PortOptsApplyConfiguration) WithDNSDomain(value string) *PortOptsApplyConfiguration {
+	b.ResolvedPortSpecFieldsApplyConfiguration.DNSDomain = &value
+	return b
+}
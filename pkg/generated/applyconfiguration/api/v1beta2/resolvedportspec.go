@@ -36,7 +36,9 @@ type ResolvedPortSpecApplyConfiguration struct {
 	// fixedIPs is a list of pairs of subnet and/or IP address to assign to the port. If specified, these must be subnets of the port's network.
 	FixedIPs []ResolvedFixedIPApplyConfiguration `json:"fixedIPs,omitempty"`
 	// securityGroups is a list of security group IDs to assign to the port.
-	SecurityGroups                           []string `json:"securityGroups,omitempty"`
+	SecurityGroups []string `json:"securityGroups,omitempty"`
+	// qosPolicyID is the ID of the QoS policy to apply to the port.
+	QoSPolicyID                              *string `json:"qosPolicyID,omitempty"`
 	ResolvedPortSpecFieldsApplyConfiguration `json:",inline"`
 }
 
@@ -111,6 +113,14 @@ func (b *ResolvedPortSpecApplyConfiguration) WithSecurityGroups(values ...string
 	return b
 }
 
+// WithQoSPolicyID sets the QoSPolicyID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the QoSPolicyID field is set to the value of the last call.
+func (b *ResolvedPortSpecApplyConfiguration) WithQoSPolicyID(value string) *ResolvedPortSpecApplyConfiguration {
+	b.QoSPolicyID = &value
+	return b
+}
+
 // WithAdminStateUp sets the AdminStateUp field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the AdminStateUp field is set to the value of the last call.
@@ -192,3 +202,19 @@ func (b *ResolvedPortSpecApplyConfiguration) WithValueSpecs(values ...*ValueSpec
 	}
 	return b
 }
+
+// WithDNSName sets the DNSName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DNSName field is set to the value of the last call.
+func (b *ResolvedPortSpecApplyConfiguration) WithDNSName(value string) *ResolvedPortSpecApplyConfiguration {
+	b.ResolvedPortSpecFieldsApplyConfiguration.DNSName = &value
+	return b
+}
+
+// WithDNSDomain sets the DNSDomain field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DNSDomain field is set to the value of the last call.
+func (b *ResolvedPortSpecApplyConfiguration) WithDNSDomain(value string) *ResolvedPortSpecApplyConfiguration {
+	b.ResolvedPortSpecFieldsApplyConfiguration.DNSDomain = &value
+	return b
+}
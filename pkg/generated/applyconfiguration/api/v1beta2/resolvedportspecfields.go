@@ -63,6 +63,15 @@ type ResolvedPortSpecFieldsApplyConfiguration struct {
 	// This is an extension point for the API, so what they do and if they are supported,
 	// depends on the specific OpenStack implementation.
 	ValueSpecs []ValueSpecApplyConfiguration `json:"valueSpecs,omitempty"`
+	// dnsName is the DNS name of the port. It is only applied when the
+	// Neutron dns-integration extension is available. If not specified and
+	// dnsDomain is set, it defaults to the hostname Nova derives from the
+	// name of the server the port is attached to.
+	DNSName *string `json:"dnsName,omitempty"`
+	// dnsDomain is the DNS domain in which records for the port will be
+	// published by the external DNS service. It is only applied when the
+	// Neutron dns-integration extension is available.
+	DNSDomain *string `json:"dnsDomain,omitempty"`
 }
 
 // ResolvedPortSpecFieldsApplyConfiguration constructs a declarative configuration of the ResolvedPortSpecFields type for use with
@@ -152,3 +161,19 @@ func (b *ResolvedPortSpecFieldsApplyConfiguration) WithValueSpecs(values ...*Val
 	}
 	return b
 }
+
+// WithDNSName sets the DNSName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DNSName field is set to the value of the last call.
+func (b *ResolvedPortSpecFieldsApplyConfiguration) WithDNSName(value string) *ResolvedPortSpecFieldsApplyConfiguration {
+	b.DNSName = &value
+	return b
+}
+
+// WithDNSDomain sets the DNSDomain field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DNSDomain field is set to the value of the last call.
+func (b *ResolvedPortSpecFieldsApplyConfiguration) WithDNSDomain(value string) *ResolvedPortSpecFieldsApplyConfiguration {
+	b.DNSDomain = &value
+	return b
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+import (
+	apiv1beta2 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+)
+
+// SubnetPoolFilterApplyConfiguration represents a declarative configuration of the SubnetPoolFilter type for use
+// with apply.
+//
+// SubnetPoolFilter specifies a query to select an OpenStack subnet pool. At least one property must be set.
+type SubnetPoolFilterApplyConfiguration struct {
+	// name filters subnet pools by name.
+	Name *string `json:"name,omitempty"`
+	// description filters subnet pools by description.
+	Description *string `json:"description,omitempty"`
+	// projectID filters subnet pools by project ID.
+	ProjectID *string `json:"projectID,omitempty"`
+	// ipVersion filters subnet pools by IP version.
+	IPVersion *int32 `json:"ipVersion,omitempty"`
+	// addressScopeID filters subnet pools by the ID of their address scope.
+	AddressScopeID                        *string `json:"addressScopeID,omitempty"`
+	FilterByNeutronTagsApplyConfiguration `json:",inline"`
+}
+
+// SubnetPoolFilterApplyConfiguration constructs a declarative configuration of the SubnetPoolFilter type for use with
+// apply.
+func SubnetPoolFilter() *SubnetPoolFilterApplyConfiguration {
+	return &SubnetPoolFilterApplyConfiguration{}
+}
+
+// WithName sets the Name field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Name field is set to the value of the last call.
+func (b *SubnetPoolFilterApplyConfiguration) WithName(value string) *SubnetPoolFilterApplyConfiguration {
+	b.Name = &value
+	return b
+}
+
+// WithDescription sets the Description field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Description field is set to the value of the last call.
+func (b *SubnetPoolFilterApplyConfiguration) WithDescription(value string) *SubnetPoolFilterApplyConfiguration {
+	b.Description = &value
+	return b
+}
+
+// WithProjectID sets the ProjectID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ProjectID field is set to the value of the last call.
+func (b *SubnetPoolFilterApplyConfiguration) WithProjectID(value string) *SubnetPoolFilterApplyConfiguration {
+	b.ProjectID = &value
+	return b
+}
+
+// WithIPVersion sets the IPVersion field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPVersion field is set to the value of the last call.
+func (b *SubnetPoolFilterApplyConfiguration) WithIPVersion(value int32) *SubnetPoolFilterApplyConfiguration {
+	b.IPVersion = &value
+	return b
+}
+
+// WithAddressScopeID sets the AddressScopeID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the AddressScopeID field is set to the value of the last call.
+func (b *SubnetPoolFilterApplyConfiguration) WithAddressScopeID(value string) *SubnetPoolFilterApplyConfiguration {
+	b.AddressScopeID = &value
+	return b
+}
+
+// WithTags adds the given value to the Tags field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Tags field.
+func (b *SubnetPoolFilterApplyConfiguration) WithTags(values ...apiv1beta2.NeutronTag) *SubnetPoolFilterApplyConfiguration {
+	for i := range values {
+		b.FilterByNeutronTagsApplyConfiguration.Tags = append(b.FilterByNeutronTagsApplyConfiguration.Tags, values[i])
+	}
+	return b
+}
+
+// WithTagsAny adds the given value to the TagsAny field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the TagsAny field.
+func (b *SubnetPoolFilterApplyConfiguration) WithTagsAny(values ...apiv1beta2.NeutronTag) *SubnetPoolFilterApplyConfiguration {
+	for i := range values {
+		b.FilterByNeutronTagsApplyConfiguration.TagsAny = append(b.FilterByNeutronTagsApplyConfiguration.TagsAny, values[i])
+	}
+	return b
+}
+
+// WithNotTags adds the given value to the NotTags field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NotTags field.
+func (b *SubnetPoolFilterApplyConfiguration) WithNotTags(values ...apiv1beta2.NeutronTag) *SubnetPoolFilterApplyConfiguration {
+	for i := range values {
+		b.FilterByNeutronTagsApplyConfiguration.NotTags = append(b.FilterByNeutronTagsApplyConfiguration.NotTags, values[i])
+	}
+	return b
+}
+
+// WithNotTagsAny adds the given value to the NotTagsAny field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the NotTagsAny field.
+func (b *SubnetPoolFilterApplyConfiguration) WithNotTagsAny(values ...apiv1beta2.NeutronTag) *SubnetPoolFilterApplyConfiguration {
+	for i := range values {
+		b.FilterByNeutronTagsApplyConfiguration.NotTagsAny = append(b.FilterByNeutronTagsApplyConfiguration.NotTagsAny, values[i])
+	}
+	return b
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// SubnetPoolParamApplyConfiguration represents a declarative configuration of the SubnetPoolParam type for use
+// with apply.
+//
+// SubnetPoolParam specifies an OpenStack subnet pool. It may be specified by either ID or filter, but not both.
+type SubnetPoolParamApplyConfiguration struct {
+	// id is the ID of the subnet pool to use. If ID is provided, the other filters cannot be provided. Must be in UUID format.
+	ID *string `json:"id,omitempty"`
+	// filter specifies a filter to select an OpenStack subnet pool. If provided, cannot be empty.
+	Filter *SubnetPoolFilterApplyConfiguration `json:"filter,omitempty"`
+}
+
+// SubnetPoolParamApplyConfiguration constructs a declarative configuration of the SubnetPoolParam type for use with
+// apply.
+func SubnetPoolParam() *SubnetPoolParamApplyConfiguration {
+	return &SubnetPoolParamApplyConfiguration{}
+}
+
+// WithID sets the ID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ID field is set to the value of the last call.
+func (b *SubnetPoolParamApplyConfiguration) WithID(value string) *SubnetPoolParamApplyConfiguration {
+	b.ID = &value
+	return b
+}
+
+// WithFilter sets the Filter field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the Filter field is set to the value of the last call.
+func (b *SubnetPoolParamApplyConfiguration) WithFilter(value *SubnetPoolFilterApplyConfiguration) *SubnetPoolParamApplyConfiguration {
+	b.Filter = value
+	return b
+}
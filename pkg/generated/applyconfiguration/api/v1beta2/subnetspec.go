@@ -22,8 +22,17 @@ package v1beta2
 // with apply.
 type SubnetSpecApplyConfiguration struct {
 	// cidr is representing the IP address range used to create the subnet, e.g. 10.0.0.0/24.
-	// This field is required when defining a subnet.
+	// Either cidr or subnetPool must be set.
 	CIDR *string `json:"cidr,omitempty"`
+	// subnetPool is the Neutron subnet pool from which the CIDR of the subnet
+	// is allocated, instead of specifying cidr. The allocated CIDR is
+	// reported in the status of the cluster network. The subnet is in the
+	// address scope of the subnet pool, if it has one.
+	SubnetPool *SubnetPoolParamApplyConfiguration `json:"subnetPool,omitempty"`
+	// prefixLength is the prefix length of the CIDR allocated from
+	// subnetPool. If not set, the default prefix length of the subnet pool
+	// is used.
+	PrefixLength *int32 `json:"prefixLength,omitempty"`
 	// dnsNameservers holds a list of DNS server addresses that will be provided when creating
 	// the subnet. These addresses need to have the same IP version as CIDR.
 	DNSNameservers []string `json:"dnsNameservers,omitempty"`
@@ -31,6 +40,16 @@ type SubnetSpecApplyConfiguration struct {
 	// If set, OpenStack will only allocate these IPs for Machines. It will still be possible to create ports from
 	// outside of these ranges manually.
 	AllocationPools []AllocationPoolApplyConfiguration `json:"allocationPools,omitempty"`
+	// ipv6AddressMode specifies how IPv6 addresses are assigned to ports on
+	// the subnet. It can only be set on an IPv6 subnet, or on a subnet
+	// allocated from an IPv6 subnet pool. If not set, ports
+	// get addresses from the OpenStack IPAM.
+	IPv6AddressMode *string `json:"ipv6AddressMode,omitempty"`
+	// ipv6RAMode specifies how router advertisements are sent on the subnet
+	// by the router it is attached to. It can only be set on an IPv6 subnet,
+	// or on a subnet allocated from an IPv6 subnet pool. If not set,
+	// OpenStack does not send router advertisements.
+	IPv6RAMode *string `json:"ipv6RAMode,omitempty"`
 }
 
 // SubnetSpecApplyConfiguration constructs a declarative configuration of the SubnetSpec type for use with
@@ -47,6 +66,22 @@ func (b *SubnetSpecApplyConfiguration) WithCIDR(value string) *SubnetSpecApplyCo
 	return b
 }
 
+// WithSubnetPool sets the SubnetPool field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SubnetPool field is set to the value of the last call.
+func (b *SubnetSpecApplyConfiguration) WithSubnetPool(value *SubnetPoolParamApplyConfiguration) *SubnetSpecApplyConfiguration {
+	b.SubnetPool = value
+	return b
+}
+
+// WithPrefixLength sets the PrefixLength field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PrefixLength field is set to the value of the last call.
+func (b *SubnetSpecApplyConfiguration) WithPrefixLength(value int32) *SubnetSpecApplyConfiguration {
+	b.PrefixLength = &value
+	return b
+}
+
 // WithDNSNameservers adds the given value to the DNSNameservers field in the declarative configuration
 // and returns the receiver, so that objects can be build by chaining "With" function invocations.
 // If called multiple times, values provided by each call will be appended to the DNSNameservers field.
@@ -69,3 +104,19 @@ func (b *SubnetSpecApplyConfiguration) WithAllocationPools(values ...*Allocation
 	}
 	return b
 }
+
+// WithIPv6AddressMode sets the IPv6AddressMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPv6AddressMode field is set to the value of the last call.
+func (b *SubnetSpecApplyConfiguration) WithIPv6AddressMode(value string) *SubnetSpecApplyConfiguration {
+	b.IPv6AddressMode = &value
+	return b
+}
+
+// WithIPv6RAMode sets the IPv6RAMode field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IPv6RAMode field is set to the value of the last call.
+func (b *SubnetSpecApplyConfiguration) WithIPv6RAMode(value string) *SubnetSpecApplyConfiguration {
+	b.IPv6RAMode = &value
+	return b
+}
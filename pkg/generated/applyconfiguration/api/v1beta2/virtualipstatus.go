@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by applyconfiguration-gen. DO NOT EDIT.
+
+package v1beta2
+
+// VirtualIPStatusApplyConfiguration represents a declarative configuration of the VirtualIPStatus type for use
+// with apply.
+//
+// VirtualIPStatus describes the virtual IP of the API server.
+type VirtualIPStatusApplyConfiguration struct {
+	// portID is the ID of the Neutron port which reserves the virtual IP.
+	PortID *string `json:"portID,omitempty"`
+	// ip is the virtual IP address.
+	IP *string `json:"ip,omitempty"`
+	// floatingIP is the floating IP address associated with the virtual IP.
+	FloatingIP *string `json:"floatingIP,omitempty"`
+	// configSecretName is the name of the Secret in the namespace of the
+	// OpenStackCluster containing the configuration of the provider for the
+	// control plane machines.
+	ConfigSecretName *string `json:"configSecretName,omitempty"`
+}
+
+// VirtualIPStatusApplyConfiguration constructs a declarative configuration of the VirtualIPStatus type for use with
+// apply.
+func VirtualIPStatus() *VirtualIPStatusApplyConfiguration {
+	return &VirtualIPStatusApplyConfiguration{}
+}
+
+// WithPortID sets the PortID field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the PortID field is set to the value of the last call.
+func (b *VirtualIPStatusApplyConfiguration) WithPortID(value string) *VirtualIPStatusApplyConfiguration {
+	b.PortID = &value
+	return b
+}
+
+// WithIP sets the IP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the IP field is set to the value of the last call.
+func (b *VirtualIPStatusApplyConfiguration) WithIP(value string) *VirtualIPStatusApplyConfiguration {
+	b.IP = &value
+	return b
+}
+
+// WithFloatingIP sets the FloatingIP field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the FloatingIP field is set to the value of the last call.
+func (b *VirtualIPStatusApplyConfiguration) WithFloatingIP(value string) *VirtualIPStatusApplyConfiguration {
+	b.FloatingIP = &value
+	return b
+}
+
+// WithConfigSecretName sets the ConfigSecretName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the ConfigSecretName field is set to the value of the last call.
+func (b *VirtualIPStatusApplyConfiguration) WithConfigSecretName(value string) *VirtualIPStatusApplyConfiguration {
+	b.ConfigSecretName = &value
+	return b
+}
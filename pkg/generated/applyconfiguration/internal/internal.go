@@ -64,6 +64,8 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+- name: Duration.v1.meta.apis.pkg.apimachinery.k8s.io
+  scalar: string
 - name: FieldsV1.v1.meta.apis.pkg.apimachinery.k8s.io
   map:
     elementType:
@@ -259,6 +261,33 @@ var schemaYAML = typed.YAMLObject(`types:
         scalar: string
       default: ""
     elementRelationship: atomic
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ApplicationCredentialAccessRule
+  map:
+    fields:
+    - name: method
+      type:
+        scalar: string
+      default: ""
+    - name: path
+      type:
+        scalar: string
+      default: ""
+    - name: service
+      type:
+        scalar: string
+      default: ""
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackAPIRateLimit
+  map:
+    fields:
+    - name: burst
+      type:
+        scalar: numeric
+    - name: maxInFlight
+      type:
+        scalar: numeric
+    - name: qps
+      type:
+        scalar: numeric
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackClusterIdentity
   map:
     fields:
@@ -276,16 +305,160 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackClusterIdentitySpec
       default: {}
+    - name: status
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackClusterIdentityStatus
+      default: {}
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackClusterIdentityCloudStatus
+  map:
+    fields:
+    - name: endpoints
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackServiceEndpoint
+          elementRelationship: atomic
+    - name: error
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: projectID
+      type:
+        scalar: string
+    - name: roles
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: tokenExpiresAt
+      type:
+        namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: userID
+      type:
+        scalar: string
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackClusterIdentitySpec
   map:
     fields:
     - name: namespaceSelector
       type:
         namedType: LabelSelector.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: rateLimit
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackAPIRateLimit
     - name: secretRef
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackCredentialSecretReference
       default: {}
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackClusterIdentityStatus
+  map:
+    fields:
+    - name: clouds
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackClusterIdentityCloudStatus
+          elementRelationship: associative
+          keys:
+          - name
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: Condition.v1.meta.apis.pkg.apimachinery.k8s.io
+          elementRelationship: associative
+          keys:
+          - type
+    - name: lastCheckTime
+      type:
+        namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackCredentialRotation
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: ObjectMeta.v1.meta.apis.pkg.apimachinery.k8s.io
+      default: {}
+    - name: spec
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackCredentialRotationSpec
+      default: {}
+    - name: status
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackCredentialRotationStatus
+      default: {}
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackCredentialRotationSpec
+  map:
+    fields:
+    - name: accessRules
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ApplicationCredentialAccessRule
+          elementRelationship: atomic
+    - name: bootstrapIdentityRef
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.OpenStackIdentityReference
+      default: {}
+    - name: cloudName
+      type:
+        scalar: string
+    - name: gracePeriod
+      type:
+        namedType: Duration.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: roles
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: rotationInterval
+      type:
+        namedType: Duration.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: secretName
+      type:
+        scalar: string
+      default: ""
+    - name: unrestricted
+      type:
+        scalar: boolean
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackCredentialRotationStatus
+  map:
+    fields:
+    - name: applicationCredentialID
+      type:
+        scalar: string
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: Condition.v1.meta.apis.pkg.apimachinery.k8s.io
+          elementRelationship: associative
+          keys:
+          - type
+    - name: lastRotationTime
+      type:
+        namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: nextRotationTime
+      type:
+        namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
+    - name: pendingRevocations
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.PendingApplicationCredentialRevocation
+          elementRelationship: associative
+          keys:
+          - id
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackCredentialSecretReference
   map:
     fields:
@@ -433,6 +606,36 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: resources
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ServerResources
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.OpenStackServiceEndpoint
+  map:
+    fields:
+    - name: interface
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+    - name: region
+      type:
+        scalar: string
+    - name: type
+      type:
+        scalar: string
+      default: ""
+    - name: url
+      type:
+        scalar: string
+      default: ""
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.PendingApplicationCredentialRevocation
+  map:
+    fields:
+    - name: id
+      type:
+        scalar: string
+      default: ""
+    - name: revokeAfter
+      type:
+        namedType: Time.v1.meta.apis.pkg.apimachinery.k8s.io
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1alpha1.ResolvedServerSpec
   map:
     fields:
@@ -1039,7 +1242,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudName
       type:
         scalar: string
-      default: ""
     - name: name
       type:
         scalar: string
@@ -1787,12 +1989,18 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: floatingIP
       type:
         scalar: string
+    - name: floatingIPDNS
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.FloatingIPDNS
     - name: managedLoadBalancer
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.APIServerLoadBalancer
     - name: port
       type:
         scalar: numeric
+    - name: virtualIP
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.APIServerVirtualIP
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.APIServerLoadBalancer
   map:
     fields:
@@ -1817,12 +2025,24 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: flavor
       type:
         scalar: string
+    - name: listener
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.APIServerLoadBalancerListener
+    - name: loadBalancer
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.LoadBalancerParam
+    - name: memberDrain
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.APIServerLoadBalancerMemberDrain
     - name: monitor
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.APIServerLoadBalancerMonitor
     - name: network
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.NetworkParam
+    - name: poolID
+      type:
+        scalar: string
     - name: provider
       type:
         scalar: string
@@ -1832,12 +2052,56 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.SubnetParam
           elementRelationship: atomic
+    - name: tls
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.APIServerLoadBalancerTLS
+    - name: zones
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.APIServerLoadBalancerZone
+          elementRelationship: associative
+          keys:
+          - name
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.APIServerLoadBalancerListener
+  map:
+    fields:
+    - name: connectionLimit
+      type:
+        scalar: numeric
+    - name: insertHeaders
+      type:
+        map:
+          elementType:
+            scalar: string
+    - name: timeoutClientData
+      type:
+        scalar: numeric
+    - name: timeoutMemberConnect
+      type:
+        scalar: numeric
+    - name: timeoutMemberData
+      type:
+        scalar: numeric
+    - name: timeoutTCPInspect
+      type:
+        scalar: numeric
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.APIServerLoadBalancerMemberDrain
+  map:
+    fields:
+    - name: periodSeconds
+      type:
+        scalar: numeric
+      default: 0
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.APIServerLoadBalancerMonitor
   map:
     fields:
     - name: delay
       type:
         scalar: numeric
+    - name: expectedCodes
+      type:
+        scalar: string
     - name: maxRetries
       type:
         scalar: numeric
@@ -1847,74 +2111,228 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: timeout
       type:
         scalar: numeric
-- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.AdditionalBlockDevice
+    - name: type
+      type:
+        scalar: string
+    - name: urlPath
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.APIServerLoadBalancerReplacement
   map:
     fields:
-    - name: name
+    - name: changes
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
+    - name: id
       type:
         scalar: string
-    - name: sizeGiB
+    - name: internalIP
       type:
-        scalar: numeric
-    - name: storage
+        scalar: string
+    - name: name
       type:
-        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.BlockDeviceStorage
-      default: {}
-- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.AddressPair
-  map:
-    fields:
-    - name: ipAddress
+        scalar: string
+    - name: phase
       type:
         scalar: string
-    - name: macAddress
+    - name: previousID
       type:
         scalar: string
-- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.AllocationPool
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.APIServerLoadBalancerTLS
   map:
     fields:
-    - name: end
+    - name: certificateSecretName
       type:
         scalar: string
-    - name: start
+    - name: mode
       type:
         scalar: string
-- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.Bastion
+      default: ""
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.APIServerLoadBalancerZone
   map:
     fields:
     - name: availabilityZone
       type:
         scalar: string
-    - name: enabled
-      type:
-        scalar: boolean
-    - name: floatingIP
+    - name: name
       type:
         scalar: string
-    - name: spec
-      type:
-        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.OpenStackMachineSpec
-- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.BastionStatus
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.APIServerVirtualIP
   map:
     fields:
-    - name: floatingIP
+    - name: image
       type:
         scalar: string
-    - name: id
+    - name: interface
       type:
         scalar: string
-    - name: ip
+    - name: provider
       type:
         scalar: string
+    - name: virtualRouterID
+      type:
+        scalar: numeric
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.AdditionalBlockDevice
+  map:
+    fields:
     - name: name
       type:
         scalar: string
-    - name: resolved
+    - name: sizeGiB
       type:
-        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.ResolvedMachineSpec
-    - name: resources
+        scalar: numeric
+    - name: storage
       type:
-        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.MachineResources
-    - name: sshKeyName
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.BlockDeviceStorage
+      default: {}
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.AdditionalLoadBalancer
+  map:
+    fields:
+    - name: availabilityZone
+      type:
+        scalar: string
+    - name: enableFloatingIP
+      type:
+        scalar: boolean
+    - name: flavor
+      type:
+        scalar: string
+    - name: listeners
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.AdditionalLoadBalancerListener
+          elementRelationship: associative
+          keys:
+          - port
+    - name: members
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.AdditionalLoadBalancerMembers
+      default: {}
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: network
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.NetworkParam
+    - name: provider
+      type:
+        scalar: string
+    - name: subnet
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.SubnetParam
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.AdditionalLoadBalancerListener
+  map:
+    fields:
+    - name: allowedCIDRs
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: memberPort
+      type:
+        scalar: numeric
+    - name: monitor
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.APIServerLoadBalancerMonitor
+    - name: port
+      type:
+        scalar: numeric
+      default: 0
+    - name: protocol
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.AdditionalLoadBalancerMembers
+  map:
+    fields:
+    - name: machineDeployments
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: machineSelector
+      type:
+        namedType: LabelSelector.v1.meta.apis.pkg.apimachinery.k8s.io
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.AdditionalLoadBalancerStatus
+  map:
+    fields:
+    - name: id
+      type:
+        scalar: string
+    - name: internalIP
+      type:
+        scalar: string
+    - name: ip
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+      default: ""
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.AddressPair
+  map:
+    fields:
+    - name: ipAddress
+      type:
+        scalar: string
+    - name: macAddress
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.AllocationPool
+  map:
+    fields:
+    - name: end
+      type:
+        scalar: string
+    - name: start
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.Bastion
+  map:
+    fields:
+    - name: availabilityZone
+      type:
+        scalar: string
+    - name: enabled
+      type:
+        scalar: boolean
+    - name: floatingIP
+      type:
+        scalar: string
+    - name: floatingIPDNS
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.FloatingIPDNS
+    - name: spec
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.OpenStackMachineSpec
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.BastionStatus
+  map:
+    fields:
+    - name: floatingIP
+      type:
+        scalar: string
+    - name: id
+      type:
+        scalar: string
+    - name: ip
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+    - name: resolved
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.ResolvedMachineSpec
+    - name: resources
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.MachineResources
+    - name: sshKeyName
       type:
         scalar: string
     - name: state
@@ -1992,6 +2410,17 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: id
       type:
         scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.FloatingIPDNS
+  map:
+    fields:
+    - name: dnsDomain
+      type:
+        scalar: string
+      default: ""
+    - name: dnsName
+      type:
+        scalar: string
+      default: ""
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.ImageFilter
   map:
     fields:
@@ -2019,6 +2448,12 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.LoadBalancer
   map:
     fields:
+    - name: addresses
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: allowedCIDRs
       type:
         list:
@@ -2034,18 +2469,164 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: ip
       type:
         scalar: string
+    - name: listeners
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.LoadBalancerListenerStatus
+          elementRelationship: associative
+          keys:
+          - id
     - name: loadBalancerNetwork
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.NetworkStatusWithSubnets
     - name: name
       type:
         scalar: string
+    - name: operatingStatus
+      type:
+        scalar: string
+    - name: provisioningStatus
+      type:
+        scalar: string
     - name: tags
       type:
         list:
           elementType:
             scalar: string
           elementRelationship: associative
+    - name: zones
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.LoadBalancerZone
+          elementRelationship: associative
+          keys:
+          - name
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.LoadBalancerFilter
+  map:
+    fields:
+    - name: description
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+    - name: notTags
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: notTagsAny
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: projectID
+      type:
+        scalar: string
+    - name: tags
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: tagsAny
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.LoadBalancerListenerStatus
+  map:
+    fields:
+    - name: id
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+    - name: operatingStatus
+      type:
+        scalar: string
+    - name: pools
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.LoadBalancerPoolStatus
+          elementRelationship: associative
+          keys:
+          - id
+    - name: provisioningStatus
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.LoadBalancerMemberStatus
+  map:
+    fields:
+    - name: address
+      type:
+        scalar: string
+    - name: id
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+    - name: operatingStatus
+      type:
+        scalar: string
+    - name: provisioningStatus
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.LoadBalancerParam
+  map:
+    fields:
+    - name: filter
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.LoadBalancerFilter
+    - name: id
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.LoadBalancerPoolStatus
+  map:
+    fields:
+    - name: id
+      type:
+        scalar: string
+    - name: members
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.LoadBalancerMemberStatus
+          elementRelationship: associative
+          keys:
+          - id
+    - name: name
+      type:
+        scalar: string
+    - name: operatingStatus
+      type:
+        scalar: string
+    - name: provisioningStatus
+      type:
+        scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.LoadBalancerZone
+  map:
+    fields:
+    - name: id
+      type:
+        scalar: string
+    - name: internalIP
+      type:
+        scalar: string
+    - name: ip
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.MachineInitialization
   map:
     fields:
@@ -2070,6 +2651,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: mtu
       type:
         scalar: numeric
+    - name: qosPolicy
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.QoSPolicyParam
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.ManagedRouter
   map:
     fields:
@@ -2220,6 +2804,14 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.OpenStackClusterSpec
   map:
     fields:
+    - name: additionalLoadBalancers
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.AdditionalLoadBalancer
+          elementRelationship: associative
+          keys:
+          - name
     - name: apiServer
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.APIServer
@@ -2287,9 +2879,23 @@ var schemaYAML = typed.YAMLObject(`types:
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.OpenStackClusterStatus
   map:
     fields:
+    - name: additionalLoadBalancers
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.AdditionalLoadBalancerStatus
+          elementRelationship: associative
+          keys:
+          - name
+    - name: apiServerLoadBalancerReplacement
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.APIServerLoadBalancerReplacement
     - name: apiServerManagedLoadBalancer
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.LoadBalancer
+    - name: apiServerVirtualIP
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.VirtualIPStatus
     - name: bastion
       type:
         namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.BastionStatus
@@ -2580,6 +3186,12 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: description
       type:
         scalar: string
+    - name: dnsDomain
+      type:
+        scalar: string
+    - name: dnsName
+      type:
+        scalar: string
     - name: enablePortSecurity
       type:
         scalar: boolean
@@ -2607,6 +3219,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: propagateUplinkStatus
       type:
         scalar: boolean
+    - name: qosPolicy
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.QoSPolicyParam
     - name: securityGroups
       type:
         list:
@@ -2639,6 +3254,51 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: id
       type:
         scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.QoSPolicyFilter
+  map:
+    fields:
+    - name: description
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+    - name: notTags
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: notTagsAny
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: projectID
+      type:
+        scalar: string
+    - name: tags
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: tagsAny
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.QoSPolicyParam
+  map:
+    fields:
+    - name: filter
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.QoSPolicyFilter
+    - name: id
+      type:
+        scalar: string
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.ResolvedFixedIP
   map:
     fields:
@@ -2682,6 +3342,12 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
+    - name: dnsDomain
+      type:
+        scalar: string
+    - name: dnsName
+      type:
+        scalar: string
     - name: enablePortSecurity
       type:
         scalar: boolean
@@ -2709,6 +3375,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: propagateUplinkStatus
       type:
         scalar: boolean
+    - name: qosPolicyID
+      type:
+        scalar: string
     - name: securityGroups
       type:
         list:
@@ -3036,6 +3705,57 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: id
       type:
         scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.SubnetPoolFilter
+  map:
+    fields:
+    - name: addressScopeID
+      type:
+        scalar: string
+    - name: description
+      type:
+        scalar: string
+    - name: ipVersion
+      type:
+        scalar: numeric
+    - name: name
+      type:
+        scalar: string
+    - name: notTags
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: notTagsAny
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: projectID
+      type:
+        scalar: string
+    - name: tags
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: tagsAny
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.SubnetPoolParam
+  map:
+    fields:
+    - name: filter
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.SubnetPoolFilter
+    - name: id
+      type:
+        scalar: string
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.SubnetSpec
   map:
     fields:
@@ -3054,6 +3774,18 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             scalar: string
           elementRelationship: atomic
+    - name: ipv6AddressMode
+      type:
+        scalar: string
+    - name: ipv6RAMode
+      type:
+        scalar: string
+    - name: prefixLength
+      type:
+        scalar: numeric
+    - name: subnetPool
+      type:
+        namedType: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.SubnetPoolParam
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.ValueSpec
   map:
     fields:
@@ -3066,6 +3798,21 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: value
       type:
         scalar: string
+- name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.VirtualIPStatus
+  map:
+    fields:
+    - name: configSecretName
+      type:
+        scalar: string
+    - name: floatingIP
+      type:
+        scalar: string
+    - name: ip
+      type:
+        scalar: string
+    - name: portID
+      type:
+        scalar: string
 - name: io.k8s.sigs.cluster-api-provider-openstack.api.v1beta2.VolumeAvailabilityZone
   map:
     fields:
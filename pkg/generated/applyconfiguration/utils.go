@@ -36,10 +36,24 @@ import (
 func ForKind(kind schema.GroupVersionKind) interface{} {
 	switch kind {
 	// Group=infrastructure.cluster.x-k8s.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithKind("ApplicationCredentialAccessRule"):
+		return &apiv1alpha1.ApplicationCredentialAccessRuleApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackAPIRateLimit"):
+		return &apiv1alpha1.OpenStackAPIRateLimitApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackClusterIdentity"):
 		return &apiv1alpha1.OpenStackClusterIdentityApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackClusterIdentityCloudStatus"):
+		return &apiv1alpha1.OpenStackClusterIdentityCloudStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackClusterIdentitySpec"):
 		return &apiv1alpha1.OpenStackClusterIdentitySpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackClusterIdentityStatus"):
+		return &apiv1alpha1.OpenStackClusterIdentityStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackCredentialRotation"):
+		return &apiv1alpha1.OpenStackCredentialRotationApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackCredentialRotationSpec"):
+		return &apiv1alpha1.OpenStackCredentialRotationSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackCredentialRotationStatus"):
+		return &apiv1alpha1.OpenStackCredentialRotationStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackCredentialSecretReference"):
 		return &apiv1alpha1.OpenStackCredentialSecretReferenceApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackServer"):
@@ -48,6 +62,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.OpenStackServerSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackServerStatus"):
 		return &apiv1alpha1.OpenStackServerStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackServiceEndpoint"):
+		return &apiv1alpha1.OpenStackServiceEndpointApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("PendingApplicationCredentialRevocation"):
+		return &apiv1alpha1.PendingApplicationCredentialRevocationApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ResolvedServerSpec"):
 		return &apiv1alpha1.ResolvedServerSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("ServerResources"):
@@ -188,6 +206,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		// Group=infrastructure.cluster.x-k8s.io, Version=v1beta2
 	case v1beta2.SchemeGroupVersion.WithKind("AdditionalBlockDevice"):
 		return &apiv1beta2.AdditionalBlockDeviceApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("AdditionalLoadBalancer"):
+		return &apiv1beta2.AdditionalLoadBalancerApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("AdditionalLoadBalancerListener"):
+		return &apiv1beta2.AdditionalLoadBalancerListenerApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("AdditionalLoadBalancerMembers"):
+		return &apiv1beta2.AdditionalLoadBalancerMembersApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("AdditionalLoadBalancerStatus"):
+		return &apiv1beta2.AdditionalLoadBalancerStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("AddressPair"):
 		return &apiv1beta2.AddressPairApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("AllocationPool"):
@@ -196,8 +222,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1beta2.APIServerApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("APIServerLoadBalancer"):
 		return &apiv1beta2.APIServerLoadBalancerApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("APIServerLoadBalancerListener"):
+		return &apiv1beta2.APIServerLoadBalancerListenerApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("APIServerLoadBalancerMemberDrain"):
+		return &apiv1beta2.APIServerLoadBalancerMemberDrainApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("APIServerLoadBalancerMonitor"):
 		return &apiv1beta2.APIServerLoadBalancerMonitorApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("APIServerLoadBalancerReplacement"):
+		return &apiv1beta2.APIServerLoadBalancerReplacementApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("APIServerLoadBalancerTLS"):
+		return &apiv1beta2.APIServerLoadBalancerTLSApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("APIServerLoadBalancerZone"):
+		return &apiv1beta2.APIServerLoadBalancerZoneApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("APIServerVirtualIP"):
+		return &apiv1beta2.APIServerVirtualIPApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("Bastion"):
 		return &apiv1beta2.BastionApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("BastionStatus"):
@@ -220,12 +258,26 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1beta2.FlavorFilterApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("FlavorParam"):
 		return &apiv1beta2.FlavorParamApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("FloatingIPDNS"):
+		return &apiv1beta2.FloatingIPDNSApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ImageFilter"):
 		return &apiv1beta2.ImageFilterApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ImageParam"):
 		return &apiv1beta2.ImageParamApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("LoadBalancer"):
 		return &apiv1beta2.LoadBalancerApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("LoadBalancerFilter"):
+		return &apiv1beta2.LoadBalancerFilterApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("LoadBalancerListenerStatus"):
+		return &apiv1beta2.LoadBalancerListenerStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("LoadBalancerMemberStatus"):
+		return &apiv1beta2.LoadBalancerMemberStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("LoadBalancerParam"):
+		return &apiv1beta2.LoadBalancerParamApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("LoadBalancerPoolStatus"):
+		return &apiv1beta2.LoadBalancerPoolStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("LoadBalancerZone"):
+		return &apiv1beta2.LoadBalancerZoneApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("MachineInitialization"):
 		return &apiv1beta2.MachineInitializationApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("MachineResources"):
@@ -278,6 +330,10 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1beta2.PortOptsApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("PortStatus"):
 		return &apiv1beta2.PortStatusApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("QoSPolicyFilter"):
+		return &apiv1beta2.QoSPolicyFilterApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("QoSPolicyParam"):
+		return &apiv1beta2.QoSPolicyParamApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ResolvedFixedIP"):
 		return &apiv1beta2.ResolvedFixedIPApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ResolvedMachineSpec"):
@@ -320,10 +376,16 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1beta2.SubnetFilterApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("SubnetParam"):
 		return &apiv1beta2.SubnetParamApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SubnetPoolFilter"):
+		return &apiv1beta2.SubnetPoolFilterApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("SubnetPoolParam"):
+		return &apiv1beta2.SubnetPoolParamApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("SubnetSpec"):
 		return &apiv1beta2.SubnetSpecApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("ValueSpec"):
 		return &apiv1beta2.ValueSpecApplyConfiguration{}
+	case v1beta2.SchemeGroupVersion.WithKind("VirtualIPStatus"):
+		return &apiv1beta2.VirtualIPStatusApplyConfiguration{}
 	case v1beta2.SchemeGroupVersion.WithKind("VolumeAvailabilityZone"):
 		return &apiv1beta2.VolumeAvailabilityZoneApplyConfiguration{}
 
@@ -29,6 +29,7 @@ import (
 type InfrastructureV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	OpenStackClusterIdentitiesGetter
+	OpenStackCredentialRotationsGetter
 	OpenStackServersGetter
 }
 
@@ -41,6 +42,10 @@ func (c *InfrastructureV1alpha1Client) OpenStackClusterIdentities(namespace stri
 	return newOpenStackClusterIdentities(c, namespace)
 }
 
+func (c *InfrastructureV1alpha1Client) OpenStackCredentialRotations(namespace string) OpenStackCredentialRotationInterface {
+	return newOpenStackCredentialRotations(c, namespace)
+}
+
 func (c *InfrastructureV1alpha1Client) OpenStackServers(namespace string) OpenStackServerInterface {
 	return newOpenStackServers(c, namespace)
 }
@@ -32,6 +32,10 @@ func (c *FakeInfrastructureV1alpha1) OpenStackClusterIdentities(namespace string
 	return newFakeOpenStackClusterIdentities(c, namespace)
 }
 
+func (c *FakeInfrastructureV1alpha1) OpenStackCredentialRotations(namespace string) v1alpha1.OpenStackCredentialRotationInterface {
+	return newFakeOpenStackCredentialRotations(c, namespace)
+}
+
 func (c *FakeInfrastructureV1alpha1) OpenStackServers(namespace string) v1alpha1.OpenStackServerInterface {
 	return newFakeOpenStackServers(c, namespace)
 }
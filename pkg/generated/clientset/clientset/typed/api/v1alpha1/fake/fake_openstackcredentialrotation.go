@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	gentype "k8s.io/client-go/gentype"
+	v1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	apiv1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/pkg/generated/applyconfiguration/api/v1alpha1"
+	typedapiv1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/pkg/generated/clientset/clientset/typed/api/v1alpha1"
+)
+
+// fakeOpenStackCredentialRotations implements OpenStackCredentialRotationInterface
+type fakeOpenStackCredentialRotations struct {
+	*gentype.FakeClientWithListAndApply[*v1alpha1.OpenStackCredentialRotation, *v1alpha1.OpenStackCredentialRotationList, *apiv1alpha1.OpenStackCredentialRotationApplyConfiguration]
+	Fake *FakeInfrastructureV1alpha1
+}
+
+func newFakeOpenStackCredentialRotations(fake *FakeInfrastructureV1alpha1, namespace string) typedapiv1alpha1.OpenStackCredentialRotationInterface {
+	return &fakeOpenStackCredentialRotations{
+		gentype.NewFakeClientWithListAndApply[*v1alpha1.OpenStackCredentialRotation, *v1alpha1.OpenStackCredentialRotationList, *apiv1alpha1.OpenStackCredentialRotationApplyConfiguration](
+			fake.Fake,
+			namespace,
+			v1alpha1.SchemeGroupVersion.WithResource("openstackcredentialrotations"),
+			v1alpha1.SchemeGroupVersion.WithKind("OpenStackCredentialRotation"),
+			func() *v1alpha1.OpenStackCredentialRotation { return &v1alpha1.OpenStackCredentialRotation{} },
+			func() *v1alpha1.OpenStackCredentialRotationList { return &v1alpha1.OpenStackCredentialRotationList{} },
+			func(dst, src *v1alpha1.OpenStackCredentialRotationList) { dst.ListMeta = src.ListMeta },
+			func(list *v1alpha1.OpenStackCredentialRotationList) []*v1alpha1.OpenStackCredentialRotation {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1alpha1.OpenStackCredentialRotationList, items []*v1alpha1.OpenStackCredentialRotation) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -20,4 +20,6 @@ package v1alpha1
 
 type OpenStackClusterIdentityExpansion interface{}
 
+type OpenStackCredentialRotationExpansion interface{}
+
 type OpenStackServerExpansion interface{}
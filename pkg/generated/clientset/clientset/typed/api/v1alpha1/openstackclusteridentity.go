@@ -40,6 +40,8 @@ type OpenStackClusterIdentitiesGetter interface {
 type OpenStackClusterIdentityInterface interface {
 	Create(ctx context.Context, openStackClusterIdentity *apiv1alpha1.OpenStackClusterIdentity, opts v1.CreateOptions) (*apiv1alpha1.OpenStackClusterIdentity, error)
 	Update(ctx context.Context, openStackClusterIdentity *apiv1alpha1.OpenStackClusterIdentity, opts v1.UpdateOptions) (*apiv1alpha1.OpenStackClusterIdentity, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, openStackClusterIdentity *apiv1alpha1.OpenStackClusterIdentity, opts v1.UpdateOptions) (*apiv1alpha1.OpenStackClusterIdentity, error)
 	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
 	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
 	Get(ctx context.Context, name string, opts v1.GetOptions) (*apiv1alpha1.OpenStackClusterIdentity, error)
@@ -47,6 +49,8 @@ type OpenStackClusterIdentityInterface interface {
 	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
 	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *apiv1alpha1.OpenStackClusterIdentity, err error)
 	Apply(ctx context.Context, openStackClusterIdentity *applyconfigurationapiv1alpha1.OpenStackClusterIdentityApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha1.OpenStackClusterIdentity, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, openStackClusterIdentity *applyconfigurationapiv1alpha1.OpenStackClusterIdentityApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha1.OpenStackClusterIdentity, err error)
 	OpenStackClusterIdentityExpansion
 }
 
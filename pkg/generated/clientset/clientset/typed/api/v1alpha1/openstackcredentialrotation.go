@@ -0,0 +1,76 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	context "context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+	apiv1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	applyconfigurationapiv1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/pkg/generated/applyconfiguration/api/v1alpha1"
+	scheme "sigs.k8s.io/cluster-api-provider-openstack/pkg/generated/clientset/clientset/scheme"
+)
+
+// OpenStackCredentialRotationsGetter has a method to return a OpenStackCredentialRotationInterface.
+// A group's client should implement this interface.
+type OpenStackCredentialRotationsGetter interface {
+	OpenStackCredentialRotations(namespace string) OpenStackCredentialRotationInterface
+}
+
+// OpenStackCredentialRotationInterface has methods to work with OpenStackCredentialRotation resources.
+type OpenStackCredentialRotationInterface interface {
+	Create(ctx context.Context, openStackCredentialRotation *apiv1alpha1.OpenStackCredentialRotation, opts v1.CreateOptions) (*apiv1alpha1.OpenStackCredentialRotation, error)
+	Update(ctx context.Context, openStackCredentialRotation *apiv1alpha1.OpenStackCredentialRotation, opts v1.UpdateOptions) (*apiv1alpha1.OpenStackCredentialRotation, error)
+	// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+	UpdateStatus(ctx context.Context, openStackCredentialRotation *apiv1alpha1.OpenStackCredentialRotation, opts v1.UpdateOptions) (*apiv1alpha1.OpenStackCredentialRotation, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*apiv1alpha1.OpenStackCredentialRotation, error)
+	List(ctx context.Context, opts v1.ListOptions) (*apiv1alpha1.OpenStackCredentialRotationList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *apiv1alpha1.OpenStackCredentialRotation, err error)
+	Apply(ctx context.Context, openStackCredentialRotation *applyconfigurationapiv1alpha1.OpenStackCredentialRotationApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha1.OpenStackCredentialRotation, err error)
+	// Add a +genclient:noStatus comment above the type to avoid generating ApplyStatus().
+	ApplyStatus(ctx context.Context, openStackCredentialRotation *applyconfigurationapiv1alpha1.OpenStackCredentialRotationApplyConfiguration, opts v1.ApplyOptions) (result *apiv1alpha1.OpenStackCredentialRotation, err error)
+	OpenStackCredentialRotationExpansion
+}
+
+// openStackCredentialRotations implements OpenStackCredentialRotationInterface
+type openStackCredentialRotations struct {
+	*gentype.ClientWithListAndApply[*apiv1alpha1.OpenStackCredentialRotation, *apiv1alpha1.OpenStackCredentialRotationList, *applyconfigurationapiv1alpha1.OpenStackCredentialRotationApplyConfiguration]
+}
+
+// newOpenStackCredentialRotations returns a OpenStackCredentialRotations
+func newOpenStackCredentialRotations(c *InfrastructureV1alpha1Client, namespace string) *openStackCredentialRotations {
+	return &openStackCredentialRotations{
+		gentype.NewClientWithListAndApply[*apiv1alpha1.OpenStackCredentialRotation, *apiv1alpha1.OpenStackCredentialRotationList, *applyconfigurationapiv1alpha1.OpenStackCredentialRotationApplyConfiguration](
+			"openstackcredentialrotations",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			namespace,
+			func() *apiv1alpha1.OpenStackCredentialRotation { return &apiv1alpha1.OpenStackCredentialRotation{} },
+			func() *apiv1alpha1.OpenStackCredentialRotationList {
+				return &apiv1alpha1.OpenStackCredentialRotationList{}
+			},
+		),
+	}
+}
@@ -26,6 +26,8 @@ import (
 type Interface interface {
 	// OpenStackClusterIdentities returns a OpenStackClusterIdentityInformer.
 	OpenStackClusterIdentities() OpenStackClusterIdentityInformer
+	// OpenStackCredentialRotations returns a OpenStackCredentialRotationInformer.
+	OpenStackCredentialRotations() OpenStackCredentialRotationInformer
 	// OpenStackServers returns a OpenStackServerInformer.
 	OpenStackServers() OpenStackServerInformer
 }
@@ -46,6 +48,11 @@ func (v *version) OpenStackClusterIdentities() OpenStackClusterIdentityInformer
 	return &openStackClusterIdentityInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// OpenStackCredentialRotations returns a OpenStackCredentialRotationInformer.
+func (v *version) OpenStackCredentialRotations() OpenStackCredentialRotationInformer {
+	return &openStackCredentialRotationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // OpenStackServers returns a OpenStackServerInformer.
 func (v *version) OpenStackServers() OpenStackServerInformer {
 	return &openStackServerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
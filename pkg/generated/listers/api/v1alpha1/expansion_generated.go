@@ -26,6 +26,14 @@ type OpenStackClusterIdentityListerExpansion interface{}
 // OpenStackClusterIdentityNamespaceLister.
 type OpenStackClusterIdentityNamespaceListerExpansion interface{}
 
+// OpenStackCredentialRotationListerExpansion allows custom methods to be added to
+// OpenStackCredentialRotationLister.
+type OpenStackCredentialRotationListerExpansion interface{}
+
+// OpenStackCredentialRotationNamespaceListerExpansion allows custom methods to be added to
+// OpenStackCredentialRotationNamespaceLister.
+type OpenStackCredentialRotationNamespaceListerExpansion interface{}
+
 // OpenStackServerListerExpansion allows custom methods to be added to
 // OpenStackServerLister.
 type OpenStackServerListerExpansion interface{}
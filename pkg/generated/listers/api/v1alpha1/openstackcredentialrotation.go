@@ -0,0 +1,70 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+	apiv1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+)
+
+// OpenStackCredentialRotationLister helps list OpenStackCredentialRotations.
+// All objects returned here must be treated as read-only.
+type OpenStackCredentialRotationLister interface {
+	// List lists all OpenStackCredentialRotations in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha1.OpenStackCredentialRotation, err error)
+	// OpenStackCredentialRotations returns an object that can list and get OpenStackCredentialRotations.
+	OpenStackCredentialRotations(namespace string) OpenStackCredentialRotationNamespaceLister
+	OpenStackCredentialRotationListerExpansion
+}
+
+// openStackCredentialRotationLister implements the OpenStackCredentialRotationLister interface.
+type openStackCredentialRotationLister struct {
+	listers.ResourceIndexer[*apiv1alpha1.OpenStackCredentialRotation]
+}
+
+// NewOpenStackCredentialRotationLister returns a new OpenStackCredentialRotationLister.
+func NewOpenStackCredentialRotationLister(indexer cache.Indexer) OpenStackCredentialRotationLister {
+	return &openStackCredentialRotationLister{listers.New[*apiv1alpha1.OpenStackCredentialRotation](indexer, apiv1alpha1.Resource("openstackcredentialrotation"))}
+}
+
+// OpenStackCredentialRotations returns an object that can list and get OpenStackCredentialRotations.
+func (s *openStackCredentialRotationLister) OpenStackCredentialRotations(namespace string) OpenStackCredentialRotationNamespaceLister {
+	return openStackCredentialRotationNamespaceLister{listers.NewNamespaced[*apiv1alpha1.OpenStackCredentialRotation](s.ResourceIndexer, namespace)}
+}
+
+// OpenStackCredentialRotationNamespaceLister helps list and get OpenStackCredentialRotations.
+// All objects returned here must be treated as read-only.
+type OpenStackCredentialRotationNamespaceLister interface {
+	// List lists all OpenStackCredentialRotations in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*apiv1alpha1.OpenStackCredentialRotation, err error)
+	// Get retrieves the OpenStackCredentialRotation from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*apiv1alpha1.OpenStackCredentialRotation, error)
+	OpenStackCredentialRotationNamespaceListerExpansion
+}
+
+// openStackCredentialRotationNamespaceLister implements the OpenStackCredentialRotationNamespaceLister
+// interface.
+type openStackCredentialRotationNamespaceLister struct {
+	listers.ResourceIndexer[*apiv1alpha1.OpenStackCredentialRotation]
+}
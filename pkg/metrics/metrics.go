@@ -29,6 +29,8 @@ import (
 const (
 	metricsNamespace = "capo"
 	requestLabel     = "request"
+	endpointLabel    = "endpoint"
+	projectLabel     = "project"
 )
 
 type OpenstackPrometheusMetrics struct {
@@ -116,6 +118,52 @@ var apiRequestPrometheusMetrics = &OpenstackPrometheusMetrics{
 		}, []string{requestLabel}),
 }
 
+// RateLimitMetrics are the gauges of the API calls made to a single identity
+// endpoint and project which are subject to client-side rate limiting.
+type RateLimitMetrics struct {
+	// InFlight is the number of API calls in progress.
+	InFlight prometheus.Gauge
+	// Queued is the number of API calls waiting for an earlier call to
+	// complete because the maximum number of calls are in flight.
+	Queued prometheus.Gauge
+	// Throttled is the number of API calls delayed by the rate limit.
+	Throttled prometheus.Gauge
+}
+
+// NewRateLimitMetrics returns the rate limiting gauges for endpoint and project.
+func NewRateLimitMetrics(endpoint, project string) *RateLimitMetrics {
+	return &RateLimitMetrics{
+		InFlight:  apiRateLimitPrometheusMetrics.InFlight.WithLabelValues(endpoint, project),
+		Queued:    apiRateLimitPrometheusMetrics.Queued.WithLabelValues(endpoint, project),
+		Throttled: apiRateLimitPrometheusMetrics.Throttled.WithLabelValues(endpoint, project),
+	}
+}
+
+var apiRateLimitPrometheusMetrics = struct {
+	InFlight  *prometheus.GaugeVec
+	Queued    *prometheus.GaugeVec
+	Throttled *prometheus.GaugeVec
+}{
+	InFlight: prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "openstack_api_requests_in_flight",
+			Help:      "Number of OpenStack API calls in progress",
+		}, []string{endpointLabel, projectLabel}),
+	Queued: prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "openstack_api_requests_queued",
+			Help:      "Number of OpenStack API calls waiting because the maximum number of calls are in flight",
+		}, []string{endpointLabel, projectLabel}),
+	Throttled: prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "openstack_api_requests_throttled",
+			Help:      "Number of OpenStack API calls delayed by the client-side rate limit",
+		}, []string{endpointLabel, projectLabel}),
+}
+
 var registerAPIPrometheusMetrics sync.Once
 
 func RegisterAPIPrometheusMetrics() {
@@ -123,5 +171,8 @@ func RegisterAPIPrometheusMetrics() {
 		metrics.Registry.MustRegister(apiRequestPrometheusMetrics.Duration)
 		metrics.Registry.MustRegister(apiRequestPrometheusMetrics.Total)
 		metrics.Registry.MustRegister(apiRequestPrometheusMetrics.Errors)
+		metrics.Registry.MustRegister(apiRateLimitPrometheusMetrics.InFlight)
+		metrics.Registry.MustRegister(apiRateLimitPrometheusMetrics.Queued)
+		metrics.Registry.MustRegister(apiRateLimitPrometheusMetrics.Throttled)
 	})
 }
@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/tokens"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+)
+
+// FakeScopeFactory implements both the ScopeFactory and ClientScope interfaces. It can be used in place of the default ProviderScopeFactory
+// when we want to run controllers end-to-end against an in-memory fake OpenStack cloud rather than mocked service clients.
+type FakeScopeFactory struct {
+	Cloud *fake.Cloud
+
+	clientScopeCreateError error
+}
+
+func NewFakeScopeFactory(cloud *fake.Cloud) *FakeScopeFactory {
+	return &FakeScopeFactory{
+		Cloud: cloud,
+	}
+}
+
+func (f *FakeScopeFactory) SetClientScopeCreateError(err error) {
+	f.clientScopeCreateError = err
+}
+
+func (f *FakeScopeFactory) NewClientScopeFromObject(_ context.Context, _ client.Client, _ []byte, _ logr.Logger, _ ...infrav1.IdentityRefProvider) (Scope, error) {
+	if f.clientScopeCreateError != nil {
+		return nil, f.clientScopeCreateError
+	}
+	return f, nil
+}
+
+func (f *FakeScopeFactory) NewClientScopeFromClusterIdentity(_ context.Context, _ client.Client, _ []byte, _ logr.Logger, _ *infrav1alpha1.OpenStackClusterIdentity, _ string) (Scope, error) {
+	if f.clientScopeCreateError != nil {
+		return nil, f.clientScopeCreateError
+	}
+	return f, nil
+}
+
+func (f *FakeScopeFactory) InvalidateSecret(_ *corev1.Secret) {}
+
+func (f *FakeScopeFactory) NewComputeClient() (clients.ComputeClient, error) {
+	return fake.NewComputeClient(f.Cloud), nil
+}
+
+func (f *FakeScopeFactory) NewVolumeClient() (clients.VolumeClient, error) {
+	return fake.NewVolumeClient(f.Cloud), nil
+}
+
+func (f *FakeScopeFactory) NewImageClient() (clients.ImageClient, error) {
+	return fake.NewImageClient(f.Cloud), nil
+}
+
+func (f *FakeScopeFactory) NewNetworkClient() (clients.NetworkClient, error) {
+	return fake.NewNetworkClient(f.Cloud), nil
+}
+
+func (f *FakeScopeFactory) NewLbClient() (clients.LbClient, error) {
+	return fake.NewLbClient(f.Cloud), nil
+}
+
+func (f *FakeScopeFactory) NewIdentityClient() (clients.IdentityClient, error) {
+	return fake.NewIdentityClient(f.Cloud), nil
+}
+
+func (f *FakeScopeFactory) NewKeyManagerClient() (clients.KeyManagerClient, error) {
+	return fake.NewKeyManagerClient(f.Cloud), nil
+}
+
+func (f *FakeScopeFactory) ProjectID() string {
+	return f.Cloud.ProjectID()
+}
+
+func (f *FakeScopeFactory) UserID() string {
+	return f.Cloud.UserID()
+}
+
+func (f *FakeScopeFactory) ExtractToken() (*tokens.Token, error) {
+	return &tokens.Token{ExpiresAt: time.Now().Add(24 * time.Hour)}, nil
+}
+
+func (f *FakeScopeFactory) ExtractRoles() ([]tokens.Role, error) {
+	return []tokens.Role{{ID: "member", Name: "member"}}, nil
+}
+
+func (f *FakeScopeFactory) ExtractServiceCatalog() (*tokens.ServiceCatalog, error) {
+	return &tokens.ServiceCatalog{}, nil
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/faults"
+)
+
+// NewFaultInjectingFactory wraps a scope factory so that all service clients it creates inject faults according to
+// the rules of injector. It is intended for testing the idempotency of the controllers.
+func NewFaultInjectingFactory(factory Factory, injector *faults.Injector) Factory {
+	return &faultInjectingFactory{
+		Factory:  factory,
+		injector: injector,
+	}
+}
+
+type faultInjectingFactory struct {
+	Factory
+
+	injector *faults.Injector
+}
+
+func (f *faultInjectingFactory) NewClientScopeFromObject(ctx context.Context, ctrlClient client.Client, defaultCACert []byte, logger logr.Logger, objects ...infrav1.IdentityRefProvider) (Scope, error) {
+	scope, err := f.Factory.NewClientScopeFromObject(ctx, ctrlClient, defaultCACert, logger, objects...)
+	if err != nil {
+		return nil, err
+	}
+	return &faultInjectingScope{
+		Scope:    scope,
+		injector: f.injector,
+	}, nil
+}
+
+type faultInjectingScope struct {
+	Scope
+
+	injector *faults.Injector
+}
+
+func (s *faultInjectingScope) NewComputeClient() (clients.ComputeClient, error) {
+	client, err := s.Scope.NewComputeClient()
+	if err != nil {
+		return nil, err
+	}
+	return faults.NewComputeClient(client, s.injector), nil
+}
+
+func (s *faultInjectingScope) NewVolumeClient() (clients.VolumeClient, error) {
+	client, err := s.Scope.NewVolumeClient()
+	if err != nil {
+		return nil, err
+	}
+	return faults.NewVolumeClient(client, s.injector), nil
+}
+
+func (s *faultInjectingScope) NewImageClient() (clients.ImageClient, error) {
+	client, err := s.Scope.NewImageClient()
+	if err != nil {
+		return nil, err
+	}
+	return faults.NewImageClient(client, s.injector), nil
+}
+
+func (s *faultInjectingScope) NewNetworkClient() (clients.NetworkClient, error) {
+	client, err := s.Scope.NewNetworkClient()
+	if err != nil {
+		return nil, err
+	}
+	return faults.NewNetworkClient(client, s.injector), nil
+}
+
+func (s *faultInjectingScope) NewLbClient() (clients.LbClient, error) {
+	client, err := s.Scope.NewLbClient()
+	if err != nil {
+		return nil, err
+	}
+	return faults.NewLbClient(client, s.injector), nil
+}
+
+func (s *faultInjectingScope) NewIdentityClient() (clients.IdentityClient, error) {
+	client, err := s.Scope.NewIdentityClient()
+	if err != nil {
+		return nil, err
+	}
+	return faults.NewIdentityClient(client, s.injector), nil
+}
+
+func (s *faultInjectingScope) NewKeyManagerClient() (clients.KeyManagerClient, error) {
+	client, err := s.Scope.NewKeyManagerClient()
+	if err != nil {
+		return nil, err
+	}
+	return faults.NewKeyManagerClient(client, s.injector), nil
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
+)
+
+// DefaultFederatedTokenFile is the default path of the projected service account token exchanged for a Keystone
+// token by identityRefs of type Federated.
+const DefaultFederatedTokenFile = "/var/run/secrets/openstack/serviceaccount/token" //nolint:gosec // This is a path, not a credential
+
+// federatedTokenRefreshWindow is how long before its token expires a scope authenticated through federation obtains
+// a new token.
+const federatedTokenRefreshWindow = 5 * time.Minute
+
+// federatedAuth describes how to obtain a Keystone token by exchanging a Kubernetes service account token with an
+// identity provider federated with Keystone, such as one using the OpenID Connect protocol.
+type federatedAuth struct {
+	// IdentityProvider and Protocol are the Keystone identity provider and federation protocol.
+	IdentityProvider string
+	Protocol         string
+	// TrustID is the trust the token is scoped to. If empty, the token is scoped to the project of the Cloud.
+	TrustID string
+	// TokenFile is the path of the service account token. It is read on each authentication, as the kubelet
+	// replaces it before it expires.
+	TokenFile string
+}
+
+// authOptions returns the options used to scope the unscoped federated token obtained for authInfo.
+func (f *federatedAuth) authOptions(authInfo *clientconfig.AuthInfo) *gophercloud.AuthOptions {
+	scope := gophercloud.AuthScope{TrustID: f.TrustID}
+	if scope.TrustID == "" {
+		scope.ProjectID = authInfo.ProjectID
+		scope.ProjectName = authInfo.ProjectName
+		scope.DomainName = authInfo.ProjectDomainName
+		scope.DomainID = authInfo.ProjectDomainID
+	}
+	return &gophercloud.AuthOptions{
+		IdentityEndpoint: authInfo.AuthURL,
+		Scope:            &scope,
+	}
+}
+
+// cloudFromFederation converts the data of a Secret referenced by an identityRef of type Federated to a Cloud and
+// the federation settings used to authenticate to it.
+func cloudFromFederation(data map[string][]byte, tokenFile string) (clientconfig.Cloud, *federatedAuth, error) {
+	value := func(key string) string {
+		return string(data[key])
+	}
+
+	cloud := clientconfig.Cloud{
+		AuthInfo: &clientconfig.AuthInfo{
+			AuthURL:           value(AuthURLSecretKey),
+			ProjectID:         value(ProjectIDSecretKey),
+			ProjectName:       value(ProjectNameSecretKey),
+			ProjectDomainName: value(ProjectDomainNameSecretKey),
+			ProjectDomainID:   value(ProjectDomainIDSecretKey),
+		},
+		RegionName:         value(RegionNameSecretKey),
+		EndpointType:       value(InterfaceSecretKey),
+		IdentityAPIVersion: "3",
+	}
+	federation := &federatedAuth{
+		IdentityProvider: value(IdentityProviderSecretKey),
+		Protocol:         value(ProtocolSecretKey),
+		TrustID:          value(TrustIDSecretKey),
+		TokenFile:        tokenFile,
+	}
+
+	for _, key := range []string{AuthURLSecretKey, IdentityProviderSecretKey, ProtocolSecretKey} {
+		if value(key) == "" {
+			return cloud, nil, fmt.Errorf("did not contain key %v", key)
+		}
+	}
+	authInfo := cloud.AuthInfo
+	if federation.TrustID == "" && authInfo.ProjectID == "" {
+		if authInfo.ProjectName == "" {
+			return cloud, nil, fmt.Errorf("did not contain key %v, %v or %v", TrustIDSecretKey, ProjectIDSecretKey, ProjectNameSecretKey)
+		}
+		if authInfo.ProjectDomainName == "" && authInfo.ProjectDomainID == "" {
+			return cloud, nil, fmt.Errorf("key %v requires key %v or %v", ProjectNameSecretKey, ProjectDomainNameSecretKey, ProjectDomainIDSecretKey)
+		}
+	}
+	return cloud, federation, nil
+}
+
+// authenticateWithFederation authenticates provider by exchanging the service account token for an unscoped Keystone
+// token, and then exchanging that for a token scoped by opts. Reauthentication, on an authentication failure or when
+// the token is about to expire, repeats the exchange with the current service account token.
+func authenticateWithFederation(ctx context.Context, provider *gophercloud.ProviderClient, opts gophercloud.AuthOptions, federation federatedAuth) error {
+	authenticate := func(ctx context.Context, client *gophercloud.ProviderClient) error {
+		unscopedToken, err := exchangeServiceAccountToken(ctx, client, federation)
+		if err != nil {
+			return err
+		}
+		scopedOpts := opts
+		scopedOpts.TokenID = unscopedToken
+		scopedOpts.AllowReauth = false
+		return openstack.Authenticate(ctx, client, scopedOpts)
+	}
+	if err := authenticate(ctx, provider); err != nil {
+		return err
+	}
+
+	// As in gophercloud, reauthenticate with a copy of the provider client so that the token is only replaced once
+	// a new one has been obtained, and so that a failed reauthentication is not itself retried.
+	throwaway := *provider
+	throwaway.SetThrowaway(true)
+	throwaway.ReauthFunc = nil
+	if err := throwaway.SetTokenAndAuthResult(nil); err != nil {
+		return err
+	}
+	provider.ReauthFunc = func(ctx context.Context) error {
+		// The transport of provider is wrapped after authentication, for example by the API rate limiter, so
+		// reauthenticate with its current HTTP client rather than the one copied above.
+		throwaway.HTTPClient = provider.HTTPClient
+		if err := authenticate(ctx, &throwaway); err != nil {
+			return err
+		}
+		provider.CopyTokenFrom(&throwaway)
+		return nil
+	}
+	return nil
+}
+
+// exchangeServiceAccountToken returns an unscoped Keystone token for the service account token in
+// federation.TokenFile, which Keystone validates with the federated identity provider.
+//
+// The request is made with the HTTP client of provider rather than through gophercloud, which would attempt to
+// reauthenticate if the exchange is rejected.
+func exchangeServiceAccountToken(ctx context.Context, provider *gophercloud.ProviderClient, federation federatedAuth) (string, error) {
+	serviceAccountToken, err := os.ReadFile(federation.TokenFile)
+	if err != nil {
+		return "", fmt.Errorf("read service account token: %w", err)
+	}
+
+	identityClient, err := openstack.NewIdentityV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		return "", fmt.Errorf("create new identity service client: %w", err)
+	}
+	url := identityClient.ServiceURL("OS-FEDERATION", "identity_providers", federation.IdentityProvider, "protocols", federation.Protocol, "auth")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(serviceAccountToken)))
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", provider.UserAgent.Join())
+
+	resp, err := provider.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("exchange service account token with identity provider %s: %w", federation.IdentityProvider, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("exchange service account token with identity provider %s: %s: %s", federation.IdentityProvider, resp.Status, strings.TrimSpace(string(body)))
+	}
+	token := resp.Header.Get("X-Subject-Token")
+	if token == "" {
+		return "", fmt.Errorf("exchange service account token with identity provider %s: response did not contain a token", federation.IdentityProvider)
+	}
+	return token, nil
+}
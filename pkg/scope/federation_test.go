@@ -0,0 +1,282 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/v2"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/fake/simulator"
+)
+
+const (
+	testIdentityProvider    = "kubernetes"
+	testProtocol            = "openid"
+	testServiceAccountToken = "service-account-token" //nolint:gosec // This is a test value, not a credential
+)
+
+// TestCloudFromFederation tests validation of the keys of a Secret referenced by an identityRef of type Federated.
+func TestCloudFromFederation(t *testing.T) {
+	base := func() map[string][]byte {
+		return map[string][]byte{
+			AuthURLSecretKey:          []byte("https://keystone.example.com/v3"),
+			IdentityProviderSecretKey: []byte(testIdentityProvider),
+			ProtocolSecretKey:         []byte(testProtocol),
+		}
+	}
+	with := func(data map[string][]byte, kv ...string) map[string][]byte {
+		for i := 0; i < len(kv); i += 2 {
+			data[kv[i]] = []byte(kv[i+1])
+		}
+		return data
+	}
+	without := func(data map[string][]byte, key string) map[string][]byte {
+		delete(data, key)
+		return data
+	}
+
+	tests := []struct {
+		name      string
+		data      map[string][]byte
+		wantScope gophercloud.AuthScope
+		wantErr   string
+	}{
+		{
+			name:      "project ID",
+			data:      with(base(), ProjectIDSecretKey, "project-id", RegionNameSecretKey, "RegionTwo"),
+			wantScope: gophercloud.AuthScope{ProjectID: "project-id"},
+		},
+		{
+			name:      "project name and domain name",
+			data:      with(base(), ProjectNameSecretKey, "project", ProjectDomainNameSecretKey, "Default"),
+			wantScope: gophercloud.AuthScope{ProjectName: "project", DomainName: "Default"},
+		},
+		{
+			name:      "trust takes precedence over project",
+			data:      with(base(), TrustIDSecretKey, "trust-id", ProjectIDSecretKey, "project-id"),
+			wantScope: gophercloud.AuthScope{TrustID: "trust-id"},
+		},
+		{
+			name:    "missing identity provider",
+			data:    with(without(base(), IdentityProviderSecretKey), ProjectIDSecretKey, "project-id"),
+			wantErr: IdentityProviderSecretKey,
+		},
+		{
+			name:    "missing protocol",
+			data:    with(without(base(), ProtocolSecretKey), ProjectIDSecretKey, "project-id"),
+			wantErr: ProtocolSecretKey,
+		},
+		{
+			name:    "missing scope",
+			data:    base(),
+			wantErr: TrustIDSecretKey,
+		},
+		{
+			name:    "project name without domain",
+			data:    with(base(), ProjectNameSecretKey, "project"),
+			wantErr: ProjectDomainNameSecretKey,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cloud, federation, err := cloudFromFederation(tt.data, "/token")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error mentioning %s, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if federation.IdentityProvider != testIdentityProvider || federation.Protocol != testProtocol || federation.TokenFile != "/token" {
+				t.Fatalf("unexpected federation settings: %+v", federation)
+			}
+			opts := federation.authOptions(cloud.AuthInfo)
+			if opts.IdentityEndpoint != "https://keystone.example.com/v3" {
+				t.Fatalf("expected identity endpoint from authURL, got %s", opts.IdentityEndpoint)
+			}
+			if *opts.Scope != tt.wantScope {
+				t.Fatalf("expected scope %+v, got %+v", tt.wantScope, *opts.Scope)
+			}
+		})
+	}
+}
+
+// TestNewClientScopeFromObject_Federated tests authentication with a service account token through federation.
+func TestNewClientScopeFromObject_Federated(t *testing.T) {
+	cloud := fake.NewCloud()
+	// Tokens expire within federatedTokenRefreshWindow, so are refreshed whenever a client is created
+	server := httptest.NewServer(simulator.New(cloud,
+		simulator.WithFederatedToken(testIdentityProvider, testProtocol, testServiceAccountToken),
+		simulator.WithTokenTTL(federatedTokenRefreshWindow/2)))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	writeToken := func(token string) {
+		if err := os.WriteFile(tokenFile, []byte(token+"\n"), 0o600); err != nil {
+			t.Fatalf("failed to write token: %v", err)
+		}
+	}
+	writeToken(testServiceAccountToken)
+
+	secret := createTestSecret("federated", map[string][]byte{
+		AuthURLSecretKey:          []byte(server.URL + "/identity/v3"),
+		IdentityProviderSecretKey: []byte(testIdentityProvider),
+		ProtocolSecretKey:         []byte(testProtocol),
+		ProjectIDSecretKey:        []byte(cloud.ProjectID()),
+	})
+	c := newFakeClient(ensureSchemes(t), secret)
+	factory := NewFactory(10, APIRateLimit{}, tokenFile)
+
+	srv := &infrav1alpha1.OpenStackServer{}
+	srv.Namespace = testNamespace
+	srv.Spec.IdentityRef = infrav1.OpenStackIdentityReference{Type: infrav1.IdentityTypeFederated, Name: "federated"}
+
+	ctx := context.Background()
+	scope, err := factory.NewClientScopeFromObject(ctx, c, nil, logr.Discard(), srv)
+	if err != nil {
+		t.Fatalf("NewClientScopeFromObject returned error: %v", err)
+	}
+	if scope.ProjectID() != cloud.ProjectID() {
+		t.Fatalf("expected project %s, got %s", cloud.ProjectID(), scope.ProjectID())
+	}
+
+	cached, err := factory.NewClientScopeFromObject(ctx, c, nil, logr.Discard(), srv)
+	if err != nil {
+		t.Fatalf("NewClientScopeFromObject returned error: %v", err)
+	}
+	if cached != scope {
+		t.Fatalf("expected scope to be cached")
+	}
+
+	token, err := scope.ExtractToken()
+	if err != nil {
+		t.Fatalf("ExtractToken returned error: %v", err)
+	}
+	computeClient, err := scope.NewComputeClient()
+	if err != nil {
+		t.Fatalf("NewComputeClient returned error: %v", err)
+	}
+	if _, err := computeClient.ListServers(nil); err != nil {
+		t.Fatalf("ListServers returned error: %v", err)
+	}
+	refreshed, err := scope.ExtractToken()
+	if err != nil {
+		t.Fatalf("ExtractToken returned error: %v", err)
+	}
+	if refreshed.ID == token.ID {
+		t.Fatalf("expected token expiring within the refresh window to be replaced")
+	}
+
+	// The service account token is read again when refreshing, so a rejected token is reported
+	writeToken("expired")
+	if _, err := scope.NewComputeClient(); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Fatalf("expected refresh with rejected service account token to fail, got %v", err)
+	}
+	writeToken(testServiceAccountToken)
+	if _, err := scope.NewNetworkClient(); err != nil {
+		t.Fatalf("NewNetworkClient returned error: %v", err)
+	}
+}
+
+// TestNewClientScopeFromObject_FederatedReauthTransport tests that reauthentication through federation uses the
+// transport installed after the initial authentication, such as the API rate limiter.
+func TestNewClientScopeFromObject_FederatedReauthTransport(t *testing.T) {
+	cloud := fake.NewCloud()
+	server := httptest.NewServer(simulator.New(cloud,
+		simulator.WithFederatedToken(testIdentityProvider, testProtocol, testServiceAccountToken)))
+	defer server.Close()
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte(testServiceAccountToken), 0o600); err != nil {
+		t.Fatalf("failed to write token: %v", err)
+	}
+
+	secret := createTestSecret("federated", map[string][]byte{
+		AuthURLSecretKey:          []byte(server.URL + "/identity/v3"),
+		IdentityProviderSecretKey: []byte(testIdentityProvider),
+		ProtocolSecretKey:         []byte(testProtocol),
+		ProjectIDSecretKey:        []byte(cloud.ProjectID()),
+	})
+	c := newFakeClient(ensureSchemes(t), secret)
+	factory := NewFactory(0, APIRateLimit{}, tokenFile)
+
+	srv := &infrav1alpha1.OpenStackServer{}
+	srv.Namespace = testNamespace
+	srv.Spec.IdentityRef = infrav1.OpenStackIdentityReference{Type: infrav1.IdentityTypeFederated, Name: "federated"}
+
+	scope, err := factory.NewClientScopeFromObject(context.Background(), c, nil, logr.Discard(), srv)
+	if err != nil {
+		t.Fatalf("NewClientScopeFromObject returned error: %v", err)
+	}
+	providerClient := scope.(*providerScope).providerClient
+	if _, ok := providerClient.HTTPClient.Transport.(*rateLimitedTransport); !ok {
+		t.Fatalf("expected the provider client to use the rate limited transport, got %T", providerClient.HTTPClient.Transport)
+	}
+
+	counter := &countingTransport{next: providerClient.HTTPClient.Transport}
+	providerClient.HTTPClient.Transport = counter
+	if err := providerClient.Reauthenticate(context.Background(), ""); err != nil {
+		t.Fatalf("Reauthenticate returned error: %v", err)
+	}
+	if counter.requests == 0 {
+		t.Fatalf("expected reauthentication to use the current transport of the provider client")
+	}
+}
+
+type countingTransport struct {
+	next     http.RoundTripper
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return t.next.RoundTrip(req)
+}
+
+// TestNewClientScopeFromObject_FederatedMissingToken tests that a missing service account token is reported.
+func TestNewClientScopeFromObject_FederatedMissingToken(t *testing.T) {
+	secret := createTestSecret("federated", map[string][]byte{
+		AuthURLSecretKey:          []byte("http://127.0.0.1:1/identity/v3"),
+		IdentityProviderSecretKey: []byte(testIdentityProvider),
+		ProtocolSecretKey:         []byte(testProtocol),
+		TrustIDSecretKey:          []byte("trust-id"),
+	})
+	c := newFakeClient(ensureSchemes(t), secret)
+	factory := NewFactory(0, APIRateLimit{}, filepath.Join(t.TempDir(), "missing"))
+
+	srv := &infrav1alpha1.OpenStackServer{}
+	srv.Namespace = testNamespace
+	srv.Spec.IdentityRef = infrav1.OpenStackIdentityReference{Type: infrav1.IdentityTypeFederated, Name: "federated"}
+
+	_, err := factory.NewClientScopeFromObject(context.Background(), c, nil, logr.Discard(), srv)
+	if err == nil || !strings.Contains(err.Error(), "read service account token") {
+		t.Fatalf("expected error reading service account token, got %v", err)
+	}
+}
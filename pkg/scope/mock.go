@@ -23,8 +23,11 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/tokens"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients/mock"
@@ -33,13 +36,21 @@ import (
 // MockScopeFactory implements both the ScopeFactory and ClientScope interfaces. It can be used in place of the default ProviderScopeFactory
 // when we want to use mocked service clients which do not attempt to connect to a running OpenStack cloud.
 type MockScopeFactory struct {
-	ComputeClient *mock.MockComputeClient
-	NetworkClient *mock.MockNetworkClient
-	VolumeClient  *mock.MockVolumeClient
-	ImageClient   *mock.MockImageClient
-	LbClient      *mock.MockLbClient
+	ComputeClient    *mock.MockComputeClient
+	NetworkClient    *mock.MockNetworkClient
+	VolumeClient     *mock.MockVolumeClient
+	ImageClient      *mock.MockImageClient
+	LbClient         *mock.MockLbClient
+	IdentityClient   *mock.MockIdentityClient
+	KeyManagerClient *mock.MockKeyManagerClient
+
+	// InvalidatedSecrets are the names of the Secrets passed to InvalidateSecret.
+	InvalidatedSecrets []types.NamespacedName
 
 	projectID              string
+	userID                 string
+	roles                  []tokens.Role
+	serviceCatalog         tokens.ServiceCatalog
 	clientScopeCreateError error
 }
 
@@ -49,14 +60,18 @@ func NewMockScopeFactory(mockCtrl *gomock.Controller, projectID string) *MockSco
 	imageClient := mock.NewMockImageClient(mockCtrl)
 	networkClient := mock.NewMockNetworkClient(mockCtrl)
 	lbClient := mock.NewMockLbClient(mockCtrl)
+	identityClient := mock.NewMockIdentityClient(mockCtrl)
+	keyManagerClient := mock.NewMockKeyManagerClient(mockCtrl)
 
 	return &MockScopeFactory{
-		ComputeClient: computeClient,
-		VolumeClient:  volumeClient,
-		ImageClient:   imageClient,
-		NetworkClient: networkClient,
-		LbClient:      lbClient,
-		projectID:     projectID,
+		ComputeClient:    computeClient,
+		VolumeClient:     volumeClient,
+		ImageClient:      imageClient,
+		NetworkClient:    networkClient,
+		LbClient:         lbClient,
+		IdentityClient:   identityClient,
+		KeyManagerClient: keyManagerClient,
+		projectID:        projectID,
 	}
 }
 
@@ -64,6 +79,16 @@ func (f *MockScopeFactory) SetClientScopeCreateError(err error) {
 	f.clientScopeCreateError = err
 }
 
+func (f *MockScopeFactory) SetUserID(userID string) {
+	f.userID = userID
+}
+
+// SetAuthResult sets the roles and service catalog returned by ExtractRoles and ExtractServiceCatalog.
+func (f *MockScopeFactory) SetAuthResult(roles []tokens.Role, serviceCatalog tokens.ServiceCatalog) {
+	f.roles = roles
+	f.serviceCatalog = serviceCatalog
+}
+
 func (f *MockScopeFactory) NewClientScopeFromObject(_ context.Context, _ client.Client, _ []byte, _ logr.Logger, _ ...infrav1.IdentityRefProvider) (Scope, error) {
 	if f.clientScopeCreateError != nil {
 		return nil, f.clientScopeCreateError
@@ -71,6 +96,17 @@ func (f *MockScopeFactory) NewClientScopeFromObject(_ context.Context, _ client.
 	return f, nil
 }
 
+func (f *MockScopeFactory) NewClientScopeFromClusterIdentity(_ context.Context, _ client.Client, _ []byte, _ logr.Logger, _ *infrav1alpha1.OpenStackClusterIdentity, _ string) (Scope, error) {
+	if f.clientScopeCreateError != nil {
+		return nil, f.clientScopeCreateError
+	}
+	return f, nil
+}
+
+func (f *MockScopeFactory) InvalidateSecret(secret *corev1.Secret) {
+	f.InvalidatedSecrets = append(f.InvalidatedSecrets, types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name})
+}
+
 func (f *MockScopeFactory) NewComputeClient() (clients.ComputeClient, error) {
 	return f.ComputeClient, nil
 }
@@ -91,10 +127,30 @@ func (f *MockScopeFactory) NewLbClient() (clients.LbClient, error) {
 	return f.LbClient, nil
 }
 
+func (f *MockScopeFactory) NewIdentityClient() (clients.IdentityClient, error) {
+	return f.IdentityClient, nil
+}
+
+func (f *MockScopeFactory) NewKeyManagerClient() (clients.KeyManagerClient, error) {
+	return f.KeyManagerClient, nil
+}
+
 func (f *MockScopeFactory) ProjectID() string {
 	return f.projectID
 }
 
+func (f *MockScopeFactory) UserID() string {
+	return f.userID
+}
+
 func (f *MockScopeFactory) ExtractToken() (*tokens.Token, error) {
 	return &tokens.Token{ExpiresAt: time.Now().Add(24 * time.Hour)}, nil
 }
+
+func (f *MockScopeFactory) ExtractRoles() ([]tokens.Role, error) {
+	return f.roles, nil
+}
+
+func (f *MockScopeFactory) ExtractServiceCatalog() (*tokens.ServiceCatalog, error) {
+	return &f.serviceCatalog, nil
+}
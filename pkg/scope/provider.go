@@ -50,6 +50,27 @@ const (
 	CASecretKey     = "cacert"
 )
 
+// Keys of a Secret referenced by an identityRef of type ApplicationCredential, Token or Federated.
+const (
+	AuthURLSecretKey                     = "authURL"
+	RegionNameSecretKey                  = "regionName"
+	InterfaceSecretKey                   = "interface"
+	ApplicationCredentialIDSecretKey     = "applicationCredentialID"
+	ApplicationCredentialNameSecretKey   = "applicationCredentialName"
+	ApplicationCredentialSecretSecretKey = "applicationCredentialSecret" //nolint:gosec // This is the name of a key, not a credential
+	UserIDSecretKey                      = "userID"
+	UserNameSecretKey                    = "userName"
+	UserDomainNameSecretKey              = "userDomainName"
+	TokenSecretKey                       = "token"
+	IdentityProviderSecretKey            = "identityProvider"
+	ProtocolSecretKey                    = "protocol"
+	TrustIDSecretKey                     = "trustID"
+	ProjectIDSecretKey                   = "projectID"
+	ProjectNameSecretKey                 = "projectName"
+	ProjectDomainNameSecretKey           = "projectDomainName"
+	ProjectDomainIDSecretKey             = "projectDomainID"
+)
+
 // IdentityAccessDeniedError is returned when a namespace is not permitted to use a ClusterIdentity.
 type IdentityAccessDeniedError struct {
 	IdentityName       string
@@ -61,7 +82,9 @@ func (e *IdentityAccessDeniedError) Error() string {
 }
 
 type providerScopeFactory struct {
-	clientCache *cache.LRUExpireCache
+	clientCache        *cache.LRUExpireCache
+	rateLimit          APIRateLimit
+	federatedTokenFile string
 }
 
 func (f *providerScopeFactory) NewClientScopeFromObject(ctx context.Context, ctrlClient client.Client, defaultCACert []byte, logger logr.Logger, objects ...infrav1.IdentityRefProvider) (Scope, error) {
@@ -79,19 +102,22 @@ func (f *providerScopeFactory) NewClientScopeFromObject(ctx context.Context, ctr
 		return nil, fmt.Errorf("unable to get identityRef from provided objects")
 	}
 
-	var cloud clientconfig.Cloud
-	var caCert []byte
+	rateLimit := f.rateLimit
 
 	// Determine which secret to read based on identity type
 	var secretNamespace string
 	var secretName string
 
 	switch identityRef.Type {
-	case "", "Secret":
+	case "", infrav1.IdentityTypeSecret:
 		secretNamespace = *namespace
 		secretName = identityRef.Name
 		logger.V(4).Info("Using Secret for OpenStack credentials", "namespace", secretNamespace, "name", secretName, "cloudName", identityRef.CloudName)
-	case "ClusterIdentity":
+	case infrav1.IdentityTypeApplicationCredential, infrav1.IdentityTypeToken, infrav1.IdentityTypeFederated:
+		secretNamespace = *namespace
+		secretName = identityRef.Name
+		logger.V(4).Info("Using Secret for OpenStack credentials", "namespace", secretNamespace, "name", secretName, "type", identityRef.Type)
+	case infrav1.IdentityTypeClusterIdentity:
 		// Fetch cluster-scoped identity and validate namespace access
 		identity := &infrav1alpha1.OpenStackClusterIdentity{}
 		if err := ctrlClient.Get(ctx, types.NamespacedName{Name: identityRef.Name}, identity); err != nil {
@@ -114,13 +140,26 @@ func (f *providerScopeFactory) NewClientScopeFromObject(ctx context.Context, ctr
 		}
 		secretNamespace = identity.Spec.SecretRef.Namespace
 		secretName = identity.Spec.SecretRef.Name
+		rateLimit = rateLimit.withOverrides(identity.Spec.RateLimit)
 		logger.V(4).Info("Using ClusterIdentity for OpenStack credentials", "identity", identityRef.Name, "secretNamespace", secretNamespace, "secretName", secretName, "cloudName", identityRef.CloudName)
 	default:
 		return nil, fmt.Errorf("unsupported identity type: %s", identityRef.Type)
 	}
 
-	// Read cloud from the resolved secret using the provided cloudName
-	cloud, caCert, err := getCloudFromSecret(ctx, ctrlClient, secretNamespace, secretName, identityRef.CloudName)
+	var cloud clientconfig.Cloud
+	var federation *federatedAuth
+	var caCert []byte
+	var err error
+	switch identityRef.Type {
+	case infrav1.IdentityTypeApplicationCredential, infrav1.IdentityTypeToken:
+		// Read the individual credential fields from the secret
+		cloud, caCert, err = getCredentialsFromSecret(ctx, ctrlClient, secretNamespace, secretName, identityRef.Type)
+	case infrav1.IdentityTypeFederated:
+		cloud, federation, caCert, err = getFederationFromSecret(ctx, ctrlClient, secretNamespace, secretName, f.federatedTokenFile)
+	default:
+		// Read cloud from the resolved secret using the provided cloudName
+		cloud, caCert, err = getCloudFromSecret(ctx, ctrlClient, secretNamespace, secretName, identityRef.CloudName)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -130,14 +169,79 @@ func (f *providerScopeFactory) NewClientScopeFromObject(ctx context.Context, ctr
 	}
 
 	if f.clientCache == nil {
-		return NewProviderScope(cloud, identityRef.Region, caCert, logger)
+		return newProviderScope(cloud, federation, identityRef.Region, caCert, rateLimit, logger)
+	}
+
+	return newCachedProviderScope(f.clientCache, cloud, federation, identityRef.Region, caCert, rateLimit, logger)
+}
+
+func (f *providerScopeFactory) NewClientScopeFromClusterIdentity(ctx context.Context, ctrlClient client.Client, defaultCACert []byte, logger logr.Logger, identity *infrav1alpha1.OpenStackClusterIdentity, cloudName string) (Scope, error) {
+	secretRef := identity.Spec.SecretRef
+	cloud, caCert, err := getCloudFromSecret(ctx, ctrlClient, secretRef.Namespace, secretRef.Name, cloudName)
+	if err != nil {
+		return nil, err
+	}
+	if caCert == nil {
+		caCert = defaultCACert
+	}
+
+	// The scope is not cached, so that the credentials are checked by authenticating with them
+	return newProviderScope(cloud, nil, "", caCert, f.rateLimit.withOverrides(identity.Spec.RateLimit), logger)
+}
+
+func (f *providerScopeFactory) InvalidateSecret(secret *corev1.Secret) {
+	if f.clientCache == nil {
+		return
+	}
+	for _, key := range scopeCacheKeysFromSecret(secret, f.federatedTokenFile) {
+		f.clientCache.Remove(key)
+	}
+}
+
+// scopeCacheKeysFromSecret returns the cache key of every scope which NewClientScopeFromObject may create from secret.
+func scopeCacheKeysFromSecret(secret *corev1.Secret, federatedTokenFile string) []string {
+	type credentials struct {
+		cloud      clientconfig.Cloud
+		federation *federatedAuth
+	}
+	var all []credentials
+	if content, ok := secret.Data[CloudsSecretKey]; ok {
+		var clouds clientconfig.Clouds
+		if err := yaml.Unmarshal(content, &clouds); err == nil {
+			for _, cloud := range clouds.Clouds {
+				all = append(all, credentials{cloud: cloud})
+			}
+		}
+	}
+	for _, identityType := range []string{infrav1.IdentityTypeApplicationCredential, infrav1.IdentityTypeToken} {
+		if cloud, err := cloudFromCredentials(secret.Data, identityType); err == nil {
+			all = append(all, credentials{cloud: cloud})
+		}
+	}
+	if cloud, federation, err := cloudFromFederation(secret.Data, federatedTokenFile); err == nil {
+		all = append(all, credentials{cloud: cloud, federation: federation})
 	}
 
-	return NewCachedProviderScope(f.clientCache, cloud, identityRef.Region, caCert, logger)
+	var keys []string
+	for _, c := range all {
+		if key, err := getScopeCacheKey(c.cloud, c.federation); err == nil {
+			keys = append(keys, key)
+		}
+	}
+	return keys
 }
 
-func getScopeCacheKey(cloud clientconfig.Cloud) (string, error) {
-	key, err := computeSpewHash(cloud)
+func getScopeCacheKey(cloud clientconfig.Cloud, federation *federatedAuth) (string, error) {
+	var key uint32
+	var err error
+	if federation == nil {
+		key, err = computeSpewHash(cloud)
+	} else {
+		key, err = computeSpewHash(struct {
+			Cloud      clientconfig.Cloud
+			Federation federatedAuth
+		}{cloud, *federation})
+	}
 	if err != nil {
 		return "", err
 	}
@@ -149,33 +253,67 @@ type providerScope struct {
 	providerClient     *gophercloud.ProviderClient
 	providerClientOpts *clientconfig.ClientOpts
 	projectID          string
+	userID             string
+	rateLimit          APIRateLimit
+	// federated is true if the scope is authenticated through federation, and so can obtain a new token before the
+	// current one expires.
+	federated bool
+}
+
+// NewProviderScope returns a scope which makes API calls with a new provider client. The API calls are subject to
+// rateLimit, which is shared with all other scopes authenticated to the same identity endpoint and project with the
+// same limits.
+func NewProviderScope(cloud clientconfig.Cloud, regionName string, caCert []byte, rateLimit APIRateLimit, logger logr.Logger) (Scope, error) {
+	return newProviderScope(cloud, nil, regionName, caCert, rateLimit, logger)
 }
 
-func NewProviderScope(cloud clientconfig.Cloud, regionName string, caCert []byte, logger logr.Logger) (Scope, error) {
-	providerClient, clientOpts, projectID, err := NewProviderClient(cloud, regionName, caCert, logger)
+func newProviderScope(cloud clientconfig.Cloud, federation *federatedAuth, regionName string, caCert []byte, rateLimit APIRateLimit, logger logr.Logger) (Scope, error) {
+	providerClient, clientOpts, projectID, err := newProviderClient(cloud, federation, regionName, caCert, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := getUserIDFromAuthResult(providerClient.GetAuthResult())
 	if err != nil {
 		return nil, err
 	}
 
+	// Only calls made after the initial authentication are limited, as the project is not known before
+	limiter := apiLimiters.get(providerClient.IdentityEndpoint, projectID, rateLimit)
+	providerClient.HTTPClient.Transport = &rateLimitedTransport{
+		next:    providerClient.HTTPClient.Transport,
+		limiter: limiter,
+	}
+
 	return &providerScope{
 		providerClient:     providerClient,
 		providerClientOpts: clientOpts,
 		projectID:          projectID,
+		userID:             userID,
+		rateLimit:          rateLimit,
+		federated:          federation != nil,
 	}, nil
 }
 
-func NewCachedProviderScope(cache *cache.LRUExpireCache, cloud clientconfig.Cloud, regionName string, caCert []byte, logger logr.Logger) (Scope, error) {
-	key, err := getScopeCacheKey(cloud)
+func NewCachedProviderScope(cache *cache.LRUExpireCache, cloud clientconfig.Cloud, regionName string, caCert []byte, rateLimit APIRateLimit, logger logr.Logger) (Scope, error) {
+	return newCachedProviderScope(cache, cloud, nil, regionName, caCert, rateLimit, logger)
+}
+
+func newCachedProviderScope(cache *cache.LRUExpireCache, cloud clientconfig.Cloud, federation *federatedAuth, regionName string, caCert []byte, rateLimit APIRateLimit, logger logr.Logger) (Scope, error) {
+	key, err := getScopeCacheKey(cloud, federation)
 	if err != nil {
 		return nil, fmt.Errorf("compute cloud config cache key: %w", err)
 	}
 
+	// A cached scope is not used if the limits of the identity changed since it was created
 	if scope, found := cache.Get(key); found {
-		logger.V(5).Info("Using scope from cache")
-		return scope.(Scope), nil
+		if s, ok := scope.(*providerScope); !ok || s.rateLimit == rateLimit {
+			logger.V(5).Info("Using scope from cache")
+			return scope.(Scope), nil
+		}
 	}
 
-	scope, err := NewProviderScope(cloud, regionName, caCert, logger)
+	scope, err := newProviderScope(cloud, federation, regionName, caCert, rateLimit, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -196,27 +334,88 @@ func (s *providerScope) ProjectID() string {
 	return s.projectID
 }
 
+func (s *providerScope) UserID() string {
+	return s.userID
+}
+
 func (s *providerScope) NewComputeClient() (clients.ComputeClient, error) {
+	if err := s.refreshToken(); err != nil {
+		return nil, err
+	}
 	return clients.NewComputeClient(s.providerClient, s.providerClientOpts)
 }
 
 func (s *providerScope) NewNetworkClient() (clients.NetworkClient, error) {
+	if err := s.refreshToken(); err != nil {
+		return nil, err
+	}
 	return clients.NewNetworkClient(s.providerClient, s.providerClientOpts)
 }
 
 func (s *providerScope) NewVolumeClient() (clients.VolumeClient, error) {
+	if err := s.refreshToken(); err != nil {
+		return nil, err
+	}
 	return clients.NewVolumeClient(s.providerClient, s.providerClientOpts)
 }
 
 func (s *providerScope) NewImageClient() (clients.ImageClient, error) {
+	if err := s.refreshToken(); err != nil {
+		return nil, err
+	}
 	return clients.NewImageClient(s.providerClient, s.providerClientOpts)
 }
 
 func (s *providerScope) NewLbClient() (clients.LbClient, error) {
+	if err := s.refreshToken(); err != nil {
+		return nil, err
+	}
 	return clients.NewLbClient(s.providerClient, s.providerClientOpts)
 }
 
+func (s *providerScope) NewIdentityClient() (clients.IdentityClient, error) {
+	if err := s.refreshToken(); err != nil {
+		return nil, err
+	}
+	return clients.NewIdentityClient(s.providerClient, s.providerClientOpts)
+}
+
+func (s *providerScope) NewKeyManagerClient() (clients.KeyManagerClient, error) {
+	if err := s.refreshToken(); err != nil {
+		return nil, err
+	}
+	return clients.NewKeyManagerClient(s.providerClient, s.providerClientOpts)
+}
+
+// refreshToken obtains a new token for a scope authenticated through federation if the current token expires within
+// federatedTokenRefreshWindow, so that API calls are not made with a token which expires before they complete.
+func (s *providerScope) refreshToken() error {
+	if !s.federated {
+		return nil
+	}
+	token, err := s.ExtractToken()
+	if err != nil {
+		return err
+	}
+	if time.Until(token.ExpiresAt) > federatedTokenRefreshWindow {
+		return nil
+	}
+	if err := s.providerClient.Reauthenticate(context.TODO(), token.ID); err != nil {
+		return fmt.Errorf("refresh federated token: %w", err)
+	}
+	return nil
+}
+
 func (s *providerScope) ExtractToken() (*tokens.Token, error) {
+	// Prefer the token returned by the last authentication. Credentials restricted by access rules may not be
+	// permitted to validate their own token.
+	switch authResult := s.providerClient.GetAuthResult().(type) {
+	case tokens.CreateResult:
+		return authResult.ExtractToken()
+	case tokens.GetResult:
+		return authResult.ExtractToken()
+	}
+
 	client, err := openstack.NewIdentityV3(s.providerClient, gophercloud.EndpointOpts{})
 	if err != nil {
 		return nil, fmt.Errorf("create new identity service client: %w", err)
@@ -224,7 +423,35 @@ func (s *providerScope) ExtractToken() (*tokens.Token, error) {
 	return tokens.Get(context.TODO(), client, s.providerClient.Token()).ExtractToken()
 }
 
+// ExtractRoles returns the roles of the token obtained by the last authentication.
+func (s *providerScope) ExtractRoles() ([]tokens.Role, error) {
+	switch authResult := s.providerClient.GetAuthResult().(type) {
+	case tokens.CreateResult:
+		return authResult.ExtractRoles()
+	case tokens.GetResult:
+		return authResult.ExtractRoles()
+	default:
+		return nil, fmt.Errorf("unable to get the roles from auth response with type %T", authResult)
+	}
+}
+
+// ExtractServiceCatalog returns the service catalog of the token obtained by the last authentication.
+func (s *providerScope) ExtractServiceCatalog() (*tokens.ServiceCatalog, error) {
+	switch authResult := s.providerClient.GetAuthResult().(type) {
+	case tokens.CreateResult:
+		return authResult.ExtractServiceCatalog()
+	case tokens.GetResult:
+		return authResult.ExtractServiceCatalog()
+	default:
+		return nil, fmt.Errorf("unable to get the service catalog from auth response with type %T", authResult)
+	}
+}
+
 func NewProviderClient(cloud clientconfig.Cloud, regionName string, caCert []byte, logger logr.Logger) (*gophercloud.ProviderClient, *clientconfig.ClientOpts, string, error) {
+	return newProviderClient(cloud, nil, regionName, caCert, logger)
+}
+
+func newProviderClient(cloud clientconfig.Cloud, federation *federatedAuth, regionName string, caCert []byte, logger logr.Logger) (*gophercloud.ProviderClient, *clientconfig.ClientOpts, string, error) {
 	clientOpts := new(clientconfig.ClientOpts)
 
 	// We explicitly disable reading auth data from env variables by setting an invalid EnvPrefix.
@@ -241,11 +468,17 @@ func NewProviderClient(cloud clientconfig.Cloud, regionName string, caCert []byt
 		clientOpts.EndpointType = cloud.EndpointType
 	}
 
-	opts, err := clientconfig.AuthOptions(clientOpts)
-	if err != nil {
-		return nil, nil, "", fmt.Errorf("auth option failed for cloud %v: %v", cloud.Cloud, err)
+	var opts *gophercloud.AuthOptions
+	if federation != nil {
+		opts = federation.authOptions(cloud.AuthInfo)
+	} else {
+		var err error
+		opts, err = clientconfig.AuthOptions(clientOpts)
+		if err != nil {
+			return nil, nil, "", fmt.Errorf("auth option failed for cloud %v: %v", cloud.Cloud, err)
+		}
+		opts.AllowReauth = true
 	}
-	opts.AllowReauth = true
 
 	provider, err := openstack.NewClient(opts.IdentityEndpoint)
 	if err != nil {
@@ -278,7 +511,15 @@ func NewProviderClient(cloud clientconfig.Cloud, regionName string, caCert []byt
 			Logger: &gophercloudLogger{logger},
 		}
 	}
-	err = openstack.Authenticate(context.TODO(), provider, *opts)
+	clients.DefaultRetryPolicy.Apply(provider)
+	switch {
+	case federation != nil:
+		err = authenticateWithFederation(context.TODO(), provider, *opts, *federation)
+	case clientOpts.AuthType == clientconfig.AuthV3Token:
+		err = authenticateWithToken(context.TODO(), provider, opts.TokenID)
+	default:
+		err = openstack.Authenticate(context.TODO(), provider, *opts)
+	}
 	if err != nil {
 		return nil, nil, "", fmt.Errorf("providerClient authentication err: %v", err)
 	}
@@ -291,6 +532,29 @@ func NewProviderClient(cloud clientconfig.Cloud, regionName string, caCert []byt
 	return provider, clientOpts, projectID, nil
 }
 
+// authenticateWithToken authenticates provider with a pre-issued token. Rather than exchanging it for a new token,
+// which Keystone does not permit for all tokens, the token is validated to obtain its project and service catalog.
+// The token can not be renewed, so API calls fail once it has expired.
+func authenticateWithToken(ctx context.Context, provider *gophercloud.ProviderClient, tokenID string) error {
+	provider.SetToken(tokenID)
+	client, err := openstack.NewIdentityV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		return fmt.Errorf("create new identity service client: %w", err)
+	}
+	result := tokens.Get(ctx, client, tokenID)
+	catalog, err := result.ExtractServiceCatalog()
+	if err != nil {
+		return err
+	}
+	if err := provider.SetTokenAndAuthResult(result); err != nil {
+		return err
+	}
+	provider.EndpointLocator = func(opts gophercloud.EndpointOpts) (string, error) {
+		return openstack.V3EndpointURL(catalog, opts)
+	}
+	return nil
+}
+
 type gophercloudLogger struct {
 	logger logr.Logger
 }
@@ -340,6 +604,96 @@ func getCloudFromSecret(ctx context.Context, ctrlClient client.Client, secretNam
 	return clouds.Clouds[cloudName], caCert, nil
 }
 
+// getCredentialsFromSecret returns a Cloud which authenticates with the application credential or token stored in
+// the given namespace:secretName, as described by the identityRef types ApplicationCredential and Token.
+func getCredentialsFromSecret(ctx context.Context, ctrlClient client.Client, secretNamespace string, secretName string, identityType string) (clientconfig.Cloud, []byte, error) {
+	secret := &corev1.Secret{}
+	err := ctrlClient.Get(ctx, types.NamespacedName{
+		Namespace: secretNamespace,
+		Name:      secretName,
+	}, secret)
+	if err != nil {
+		return clientconfig.Cloud{}, nil, err
+	}
+
+	cloud, err := cloudFromCredentials(secret.Data, identityType)
+	if err != nil {
+		return clientconfig.Cloud{}, nil, fmt.Errorf("OpenStack credentials secret %v: %w", secretName, err)
+	}
+	return cloud, secret.Data[CASecretKey], nil
+}
+
+// getFederationFromSecret returns a Cloud and the federation settings used to authenticate to it, as described by the
+// Secret namespace:secretName referenced by an identityRef of type Federated.
+func getFederationFromSecret(ctx context.Context, ctrlClient client.Client, secretNamespace string, secretName string, tokenFile string) (clientconfig.Cloud, *federatedAuth, []byte, error) {
+	secret := &corev1.Secret{}
+	err := ctrlClient.Get(ctx, types.NamespacedName{
+		Namespace: secretNamespace,
+		Name:      secretName,
+	}, secret)
+	if err != nil {
+		return clientconfig.Cloud{}, nil, nil, err
+	}
+
+	cloud, federation, err := cloudFromFederation(secret.Data, tokenFile)
+	if err != nil {
+		return clientconfig.Cloud{}, nil, nil, fmt.Errorf("OpenStack credentials secret %v: %w", secretName, err)
+	}
+	return cloud, federation, secret.Data[CASecretKey], nil
+}
+
+// cloudFromCredentials converts the data of a Secret containing an application credential or token to a Cloud.
+func cloudFromCredentials(data map[string][]byte, identityType string) (clientconfig.Cloud, error) {
+	value := func(key string) string {
+		return string(data[key])
+	}
+
+	cloud := clientconfig.Cloud{
+		AuthInfo: &clientconfig.AuthInfo{
+			AuthURL: value(AuthURLSecretKey),
+		},
+		RegionName:         value(RegionNameSecretKey),
+		EndpointType:       value(InterfaceSecretKey),
+		IdentityAPIVersion: "3",
+	}
+	if cloud.AuthInfo.AuthURL == "" {
+		return cloud, fmt.Errorf("did not contain key %v", AuthURLSecretKey)
+	}
+
+	switch identityType {
+	case infrav1.IdentityTypeApplicationCredential:
+		cloud.AuthType = clientconfig.AuthV3ApplicationCredential
+		cloud.AuthInfo.ApplicationCredentialID = value(ApplicationCredentialIDSecretKey)
+		cloud.AuthInfo.ApplicationCredentialName = value(ApplicationCredentialNameSecretKey)
+		cloud.AuthInfo.ApplicationCredentialSecret = value(ApplicationCredentialSecretSecretKey)
+		cloud.AuthInfo.UserID = value(UserIDSecretKey)
+		cloud.AuthInfo.Username = value(UserNameSecretKey)
+		cloud.AuthInfo.UserDomainName = value(UserDomainNameSecretKey)
+
+		if cloud.AuthInfo.ApplicationCredentialSecret == "" {
+			return cloud, fmt.Errorf("did not contain key %v", ApplicationCredentialSecretSecretKey)
+		}
+		if cloud.AuthInfo.ApplicationCredentialID == "" {
+			if cloud.AuthInfo.ApplicationCredentialName == "" {
+				return cloud, fmt.Errorf("did not contain key %v or %v", ApplicationCredentialIDSecretKey, ApplicationCredentialNameSecretKey)
+			}
+			if cloud.AuthInfo.UserID == "" && (cloud.AuthInfo.Username == "" || cloud.AuthInfo.UserDomainName == "") {
+				return cloud, fmt.Errorf("key %v requires key %v, or keys %v and %v", ApplicationCredentialNameSecretKey, UserIDSecretKey, UserNameSecretKey, UserDomainNameSecretKey)
+			}
+		}
+	case infrav1.IdentityTypeToken:
+		cloud.AuthType = clientconfig.AuthV3Token
+		cloud.AuthInfo.Token = value(TokenSecretKey)
+
+		if cloud.AuthInfo.Token == "" {
+			return cloud, fmt.Errorf("did not contain key %v", TokenSecretKey)
+		}
+	default:
+		return cloud, fmt.Errorf("unsupported identity type: %s", identityType)
+	}
+	return cloud, nil
+}
+
 // getProjectIDFromAuthResult handles different auth mechanisms to retrieve the
 // current project id. Usually we use the Identity v3 Token mechanism that
 // returns the project id in the response to the initial auth request.
@@ -353,7 +707,36 @@ func getProjectIDFromAuthResult(authResult gophercloud.AuthResult) (string, erro
 
 		return project.ID, nil
 
+	case tokens.GetResult:
+		project, err := authResult.ExtractProject()
+		if err != nil {
+			return "", fmt.Errorf("unable to extract project from GetResult: %v", err)
+		}
+		if project == nil {
+			return "", fmt.Errorf("token is not scoped to a project")
+		}
+
+		return project.ID, nil
+
 	default:
 		return "", fmt.Errorf("unable to get the project id from auth response with type %T", authResult)
 	}
 }
+
+// getUserIDFromAuthResult returns the ID of the user the credentials belong to.
+func getUserIDFromAuthResult(authResult gophercloud.AuthResult) (string, error) {
+	var user *tokens.User
+	var err error
+	switch authResult := authResult.(type) {
+	case tokens.CreateResult:
+		user, err = authResult.ExtractUser()
+	case tokens.GetResult:
+		user, err = authResult.ExtractUser()
+	default:
+		return "", fmt.Errorf("unable to get the user id from auth response with type %T", authResult)
+	}
+	if err != nil {
+		return "", fmt.Errorf("unable to extract user from auth response: %v", err)
+	}
+	return user.ID, nil
+}
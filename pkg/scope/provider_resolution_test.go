@@ -302,3 +302,19 @@ func TestNewClientScopeFromObject_Resolution(t *testing.T) {
 		})
 	}
 }
+
+// TestNewClientScopeFromClusterIdentity_IgnoresSelector tests that checking an identity is not subject to its
+// namespace selector.
+func TestNewClientScopeFromClusterIdentity_IgnoresSelector(t *testing.T) {
+	t.Parallel()
+	identity := createTestClusterIdentity("prod-id", &metav1.LabelSelector{MatchLabels: map[string]string{"allowed": "true"}})
+	c := newFakeClient(ensureSchemes(t),
+		identity,
+		createResTestSecret(testNSCapo, "creds", map[string][]byte{CloudsSecretKey: testDefaultCloudsYAML}),
+	)
+	factory := &providerScopeFactory{}
+
+	_, err := factory.NewClientScopeFromClusterIdentity(context.Background(), c, nil, logr.Discard(), identity, "default")
+	assertNotDenied(t, err)
+	assertResolutionReached(t, err)
+}
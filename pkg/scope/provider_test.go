@@ -19,11 +19,16 @@ package scope
 import (
 	"context"
 	"testing"
+	"time"
 
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/cache"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
 )
 
 const (
@@ -188,3 +193,253 @@ func TestGetCloudFromSecret_InvalidCloudName(t *testing.T) {
 		t.Fatalf("expected zero-value cloud for unknown cloudName, got RegionName=%q AuthInfo-nil=%v", cloud.RegionName, cloud.AuthInfo == nil)
 	}
 }
+
+// TestGetCredentialsFromSecret_ApplicationCredential tests building a cloud from a Secret containing an application credential.
+func TestGetCredentialsFromSecret_ApplicationCredential(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	secretName := "app-cred" //nolint:gosec
+	secret := createTestSecret(secretName, map[string][]byte{
+		AuthURLSecretKey:                     []byte("https://keystone.example.com/"),
+		RegionNameSecretKey:                  []byte(testRegion),
+		InterfaceSecretKey:                   []byte("internal"),
+		ApplicationCredentialIDSecretKey:     []byte("id"),
+		ApplicationCredentialSecretSecretKey: []byte("secret"),
+		CASecretKey:                          testCACert,
+	})
+
+	c := fake.NewClientBuilder().WithScheme(buildCoreScheme(t)).WithObjects(secret).Build()
+
+	cloud, gotCACert, err := getCredentialsFromSecret(ctx, c, testNamespace, secretName, infrav1.IdentityTypeApplicationCredential)
+	if err != nil {
+		t.Fatalf("getCredentialsFromSecret returned error: %v", err)
+	}
+	if cloud.AuthType != clientconfig.AuthV3ApplicationCredential {
+		t.Fatalf("expected auth type %s, got %q", clientconfig.AuthV3ApplicationCredential, cloud.AuthType)
+	}
+	if cloud.AuthInfo.ApplicationCredentialID != "id" || cloud.AuthInfo.ApplicationCredentialSecret != "secret" {
+		t.Fatalf("expected application credential id and secret from the secret, got %q and %q", cloud.AuthInfo.ApplicationCredentialID, cloud.AuthInfo.ApplicationCredentialSecret)
+	}
+	if cloud.RegionName != testRegion {
+		t.Fatalf("expected %s region, got %q", testRegion, cloud.RegionName)
+	}
+	if cloud.EndpointType != "internal" {
+		t.Fatalf("expected internal interface, got %q", cloud.EndpointType)
+	}
+	if len(gotCACert) == 0 {
+		t.Fatalf("expected non-empty caCert")
+	}
+}
+
+// TestGetCredentialsFromSecret_MissingSecret tests error handling when the secret does not exist.
+func TestGetCredentialsFromSecret_MissingSecret(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	c := fake.NewClientBuilder().WithScheme(buildCoreScheme(t)).Build()
+
+	_, _, err := getCredentialsFromSecret(ctx, c, testNamespace, "missing", infrav1.IdentityTypeToken)
+	if err == nil {
+		t.Fatalf("expected error for missing secret, got nil")
+	}
+}
+
+// TestCloudFromCredentials tests validation of the keys of an application credential or token Secret.
+func TestCloudFromCredentials(t *testing.T) {
+	t.Parallel()
+
+	const authURL = "https://keystone.example.com/"
+	tests := []struct {
+		name         string
+		identityType string
+		data         map[string]string
+		wantAuthType clientconfig.AuthType
+		wantErr      bool
+	}{
+		{
+			name:         "application credential ID",
+			identityType: infrav1.IdentityTypeApplicationCredential,
+			data: map[string]string{
+				AuthURLSecretKey:                     authURL,
+				ApplicationCredentialIDSecretKey:     "id",
+				ApplicationCredentialSecretSecretKey: "secret",
+			},
+			wantAuthType: clientconfig.AuthV3ApplicationCredential,
+		},
+		{
+			name:         "application credential name with user ID",
+			identityType: infrav1.IdentityTypeApplicationCredential,
+			data: map[string]string{
+				AuthURLSecretKey:                     authURL,
+				ApplicationCredentialNameSecretKey:   "name",
+				ApplicationCredentialSecretSecretKey: "secret",
+				UserIDSecretKey:                      "user",
+			},
+			wantAuthType: clientconfig.AuthV3ApplicationCredential,
+		},
+		{
+			name:         "application credential name with user name and domain",
+			identityType: infrav1.IdentityTypeApplicationCredential,
+			data: map[string]string{
+				AuthURLSecretKey:                     authURL,
+				ApplicationCredentialNameSecretKey:   "name",
+				ApplicationCredentialSecretSecretKey: "secret",
+				UserNameSecretKey:                    "user",
+				UserDomainNameSecretKey:              "Default",
+			},
+			wantAuthType: clientconfig.AuthV3ApplicationCredential,
+		},
+		{
+			name:         "application credential name without user",
+			identityType: infrav1.IdentityTypeApplicationCredential,
+			data: map[string]string{
+				AuthURLSecretKey:                     authURL,
+				ApplicationCredentialNameSecretKey:   "name",
+				ApplicationCredentialSecretSecretKey: "secret",
+				UserNameSecretKey:                    "user",
+			},
+			wantErr: true,
+		},
+		{
+			name:         "application credential without ID or name",
+			identityType: infrav1.IdentityTypeApplicationCredential,
+			data: map[string]string{
+				AuthURLSecretKey:                     authURL,
+				ApplicationCredentialSecretSecretKey: "secret",
+			},
+			wantErr: true,
+		},
+		{
+			name:         "application credential without secret",
+			identityType: infrav1.IdentityTypeApplicationCredential,
+			data: map[string]string{
+				AuthURLSecretKey:                 authURL,
+				ApplicationCredentialIDSecretKey: "id",
+			},
+			wantErr: true,
+		},
+		{
+			name:         "token",
+			identityType: infrav1.IdentityTypeToken,
+			data: map[string]string{
+				AuthURLSecretKey: authURL,
+				TokenSecretKey:   "token",
+			},
+			wantAuthType: clientconfig.AuthV3Token,
+		},
+		{
+			name:         "token without token",
+			identityType: infrav1.IdentityTypeToken,
+			data: map[string]string{
+				AuthURLSecretKey: authURL,
+			},
+			wantErr: true,
+		},
+		{
+			name:         "missing auth URL",
+			identityType: infrav1.IdentityTypeToken,
+			data: map[string]string{
+				TokenSecretKey: "token",
+			},
+			wantErr: true,
+		},
+		{
+			name:         "unsupported identity type",
+			identityType: infrav1.IdentityTypeSecret,
+			data: map[string]string{
+				AuthURLSecretKey: authURL,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			data := make(map[string][]byte, len(tt.data))
+			for k, v := range tt.data {
+				data[k] = []byte(v)
+			}
+			cloud, err := cloudFromCredentials(data, tt.identityType)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("cloudFromCredentials returned error: %v", err)
+			}
+			if cloud.AuthType != tt.wantAuthType {
+				t.Errorf("expected auth type %s, got %q", tt.wantAuthType, cloud.AuthType)
+			}
+			if cloud.AuthInfo.AuthURL != authURL {
+				t.Errorf("expected auth URL %s, got %q", authURL, cloud.AuthInfo.AuthURL)
+			}
+			if cloud.IdentityAPIVersion != "3" {
+				t.Errorf("expected identity API version 3, got %q", cloud.IdentityAPIVersion)
+			}
+		})
+	}
+}
+
+// TestInvalidateSecret tests that cached scopes for every cloud in a Secret are discarded.
+func TestInvalidateSecret(t *testing.T) {
+	t.Parallel()
+
+	c := cache.NewLRUExpireCache(10)
+	f := &providerScopeFactory{clientCache: c}
+
+	secret := createTestSecret("os-cred", map[string][]byte{
+		CloudsSecretKey:                      testCloudsYAML,
+		AuthURLSecretKey:                     []byte("https://keystone.example.com/"),
+		ApplicationCredentialIDSecretKey:     []byte("id"),
+		ApplicationCredentialSecretSecretKey: []byte("secret"),
+	})
+	keys := scopeCacheKeysFromSecret(secret, "")
+	if len(keys) != 2 {
+		t.Fatalf("expected 2 clouds in secret, got %d", len(keys))
+	}
+	for _, key := range keys {
+		c.Add(key, &providerScope{}, time.Hour)
+	}
+	c.Add("other", &providerScope{}, time.Hour)
+
+	f.InvalidateSecret(secret)
+
+	if keys := c.Keys(); len(keys) != 1 || keys[0] != "other" {
+		t.Fatalf("expected only the scope of another secret to remain cached, got %v", keys)
+	}
+}
+
+// TestInvalidateSecret_Federated tests that a cached scope authenticated through federation is discarded.
+func TestInvalidateSecret_Federated(t *testing.T) {
+	t.Parallel()
+
+	const tokenFile = "/var/run/secrets/token"
+	c := cache.NewLRUExpireCache(10)
+	f := &providerScopeFactory{clientCache: c, federatedTokenFile: tokenFile}
+
+	secret := createTestSecret("federated", map[string][]byte{
+		AuthURLSecretKey:          []byte("https://keystone.example.com/"),
+		IdentityProviderSecretKey: []byte("kubernetes"),
+		ProtocolSecretKey:         []byte("openid"),
+		ProjectIDSecretKey:        []byte("project-id"),
+	})
+	cloud, federation, err := cloudFromFederation(secret.Data, tokenFile)
+	if err != nil {
+		t.Fatalf("cloudFromFederation returned error: %v", err)
+	}
+	key, err := getScopeCacheKey(cloud, federation)
+	if err != nil {
+		t.Fatalf("getScopeCacheKey returned error: %v", err)
+	}
+	c.Add(key, &providerScope{}, time.Hour)
+
+	f.InvalidateSecret(secret)
+
+	if keys := c.Keys(); len(keys) != 0 {
+		t.Fatalf("expected the federated scope to be discarded, got %v", keys)
+	}
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/metrics"
+)
+
+// APIRateLimit limits the OpenStack API calls made with a set of credentials.
+// A zero value means no limit.
+type APIRateLimit struct {
+	// QPS is the sustained number of API calls per second.
+	QPS float64
+	// Burst is the maximum number of API calls which may be made at once
+	// after a period without calls. If zero it is QPS rounded up.
+	Burst int
+	// MaxInFlight is the maximum number of concurrent API calls.
+	MaxInFlight int
+}
+
+// withOverrides returns the limits with the limits set in override replacing
+// the corresponding limits of l.
+func (l APIRateLimit) withOverrides(override *infrav1alpha1.OpenStackAPIRateLimit) APIRateLimit {
+	if override == nil {
+		return l
+	}
+	if override.QPS != nil {
+		l.QPS = float64(*override.QPS)
+		if override.Burst == nil {
+			l.Burst = 0
+		}
+	}
+	if override.Burst != nil {
+		l.Burst = int(*override.Burst)
+	}
+	if override.MaxInFlight != nil {
+		l.MaxInFlight = int(*override.MaxInFlight)
+	}
+	return l
+}
+
+// apiLimiters holds the limiter of every identity endpoint, project and
+// limits. It is shared by all scope factories so that the limits hold however
+// many provider clients are created for the same project. Credentials for the
+// same project with different limits, such as an identity with overrides, have
+// separate limiters, so that each is limited deterministically by its own
+// limits.
+var apiLimiters = &apiLimiterRegistry{
+	limiters: map[apiLimiterKey]*apiLimiter{},
+}
+
+type apiLimiterKey struct {
+	endpoint string
+	project  string
+	limit    APIRateLimit
+}
+
+type apiLimiterRegistry struct {
+	mu       sync.Mutex
+	limiters map[apiLimiterKey]*apiLimiter
+}
+
+// get returns the limiter of endpoint and project with the given limits.
+func (r *apiLimiterRegistry) get(endpoint, project string, limit APIRateLimit) *apiLimiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := apiLimiterKey{endpoint: endpoint, project: project, limit: limit}
+	limiter, ok := r.limiters[key]
+	if !ok {
+		limiter = newAPILimiter(metrics.NewRateLimitMetrics(endpoint, project))
+		limiter.setLimit(limit)
+		r.limiters[key] = limiter
+	}
+	return limiter
+}
+
+// apiLimiter enforces an APIRateLimit on the calls made through it: calls
+// first wait for a token from a token bucket, then for a free slot if the
+// maximum number of calls are in flight. Slots are handed to waiting calls in
+// order.
+type apiLimiter struct {
+	limiter *rate.Limiter
+	metrics *metrics.RateLimitMetrics
+
+	mu          sync.Mutex
+	maxInFlight int
+	inFlight    int
+	waiters     []chan struct{}
+}
+
+func newAPILimiter(metrics *metrics.RateLimitMetrics) *apiLimiter {
+	return &apiLimiter{
+		limiter: rate.NewLimiter(rate.Inf, 0),
+		metrics: metrics,
+	}
+}
+
+// setLimit changes the limits, which apply to calls which have not started yet.
+func (l *apiLimiter) setLimit(limit APIRateLimit) {
+	if limit.QPS > 0 {
+		burst := limit.Burst
+		if burst <= 0 {
+			burst = int(math.Ceil(limit.QPS))
+		}
+		l.limiter.SetLimit(rate.Limit(limit.QPS))
+		l.limiter.SetBurst(burst)
+	} else {
+		l.limiter.SetLimit(rate.Inf)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.maxInFlight = limit.MaxInFlight
+	l.wakeWaiters()
+}
+
+// wait blocks until the rate limit allows a call to be made.
+func (l *apiLimiter) wait(ctx context.Context) error {
+	reservation := l.limiter.Reserve()
+	delay := reservation.Delay()
+	if delay == 0 {
+		return nil
+	}
+
+	l.metrics.Throttled.Inc()
+	defer l.metrics.Throttled.Dec()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		reservation.Cancel()
+		return ctx.Err()
+	}
+}
+
+// acquire blocks until fewer than the maximum number of calls are in flight,
+// and counts the caller as in flight. Every successful call to acquire must be
+// followed by a call to release.
+func (l *apiLimiter) acquire(ctx context.Context) error {
+	l.mu.Lock()
+	if len(l.waiters) == 0 && !l.full() {
+		l.inFlight++
+		l.metrics.InFlight.Inc()
+		l.mu.Unlock()
+		return nil
+	}
+	ready := make(chan struct{})
+	l.waiters = append(l.waiters, ready)
+	l.mu.Unlock()
+
+	l.metrics.Queued.Inc()
+	defer l.metrics.Queued.Dec()
+
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		if i := slices.Index(l.waiters, ready); i >= 0 {
+			l.waiters = slices.Delete(l.waiters, i, i+1)
+			return ctx.Err()
+		}
+		// We were given a slot at the same time as the context was done
+		l.releaseLocked()
+		return ctx.Err()
+	}
+}
+
+// release marks a call as complete.
+func (l *apiLimiter) release() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.releaseLocked()
+}
+
+func (l *apiLimiter) releaseLocked() {
+	l.inFlight--
+	l.metrics.InFlight.Dec()
+	l.wakeWaiters()
+}
+
+func (l *apiLimiter) full() bool {
+	return l.maxInFlight > 0 && l.inFlight >= l.maxInFlight
+}
+
+// wakeWaiters gives free slots to waiting calls.
+func (l *apiLimiter) wakeWaiters() {
+	for len(l.waiters) > 0 && !l.full() {
+		l.inFlight++
+		l.metrics.InFlight.Inc()
+		close(l.waiters[0])
+		l.waiters = l.waiters[1:]
+	}
+}
+
+// rateLimitedTransport makes HTTP requests subject to an apiLimiter. A request
+// is in flight until its response body has been read to the end or closed.
+type rateLimitedTransport struct {
+	next    http.RoundTripper
+	limiter *apiLimiter
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.wait(req.Context()); err != nil {
+		return nil, err
+	}
+	if err := t.limiter.acquire(req.Context()); err != nil {
+		return nil, err
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		t.limiter.release()
+		return nil, err
+	}
+	// Responses without a body, such as those to HEAD requests, are complete
+	if resp.Body == nil || resp.Body == http.NoBody {
+		t.limiter.release()
+		return resp, nil
+	}
+	resp.Body = &releasingBody{
+		ReadCloser: resp.Body,
+		release:    sync.OnceFunc(t.limiter.release),
+	}
+	return resp, nil
+}
+
+// releasingBody releases the slot of a request once its response body has
+// been read to the end, or when it is closed. Callers which read the body on
+// error paths without closing it do not hold the slot forever.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil {
+		b.release()
+	}
+	return n, err
+}
+
+func (b *releasingBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,287 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/utils/ptr"
+
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
+	"sigs.k8s.io/cluster-api-provider-openstack/pkg/metrics"
+)
+
+var testLimiters atomic.Int32
+
+// newTestLimiter returns a limiter with its own gauges.
+func newTestLimiter(t *testing.T, limit APIRateLimit) *apiLimiter {
+	t.Helper()
+	project := fmt.Sprintf("project-%d", testLimiters.Add(1))
+	limiter := newAPILimiter(metrics.NewRateLimitMetrics(t.Name(), project))
+	limiter.setLimit(limit)
+	return limiter
+}
+
+func TestAPIRateLimit_WithOverrides(t *testing.T) {
+	t.Parallel()
+	defaults := APIRateLimit{QPS: 5, Burst: 10, MaxInFlight: 20}
+
+	cases := []struct {
+		name     string
+		override *infrav1alpha1.OpenStackAPIRateLimit
+		want     APIRateLimit
+	}{
+		{
+			name: "no override",
+			want: defaults,
+		},
+		{
+			name:     "max in flight only",
+			override: &infrav1alpha1.OpenStackAPIRateLimit{MaxInFlight: ptr.To[int32](4)},
+			want:     APIRateLimit{QPS: 5, Burst: 10, MaxInFlight: 4},
+		},
+		{
+			name:     "qps resets the default burst",
+			override: &infrav1alpha1.OpenStackAPIRateLimit{QPS: ptr.To[int32](2)},
+			want:     APIRateLimit{QPS: 2, MaxInFlight: 20},
+		},
+		{
+			name:     "qps and burst",
+			override: &infrav1alpha1.OpenStackAPIRateLimit{QPS: ptr.To[int32](2), Burst: ptr.To[int32](3)},
+			want:     APIRateLimit{QPS: 2, Burst: 3, MaxInFlight: 20},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := defaults.withOverrides(tc.override); got != tc.want {
+				t.Errorf("withOverrides() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestRateLimitedTransport_MaxInFlight tests that no more than the maximum number of requests reach the server at once,
+// and that a request is in flight until its response body is closed.
+func TestRateLimitedTransport_MaxInFlight(t *testing.T) {
+	t.Parallel()
+	const maxInFlight = 2
+
+	var current, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := current.Add(1)
+		defer current.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	limiter := newTestLimiter(t, APIRateLimit{MaxInFlight: maxInFlight})
+	client := &http.Client{Transport: &rateLimitedTransport{next: http.DefaultTransport, limiter: limiter}}
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Errorf("request failed: %v", err)
+				return
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got > maxInFlight {
+		t.Errorf("peak concurrent requests = %d, want at most %d", got, maxInFlight)
+	}
+	if got := testutil.ToFloat64(limiter.metrics.InFlight); got != 0 {
+		t.Errorf("in flight gauge = %v after all requests completed, want 0", got)
+	}
+	if got := testutil.ToFloat64(limiter.metrics.Queued); got != 0 {
+		t.Errorf("queued gauge = %v after all requests completed, want 0", got)
+	}
+}
+
+// TestRateLimitedTransport_Release tests that a request is no longer in flight once its response body has been read to
+// the end, even if it is never closed, or if the response has no body.
+func TestRateLimitedTransport_Release(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	limiter := newTestLimiter(t, APIRateLimit{MaxInFlight: 1})
+	client := &http.Client{Transport: &rateLimitedTransport{next: http.DefaultTransport, limiter: limiter}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for range 3 {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+		_, _ = io.Copy(io.Discard, resp.Body)
+	}
+	for range 3 {
+		req, err := http.NewRequestWithContext(ctx, http.MethodHead, server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Do(req); err != nil {
+			t.Fatalf("request failed: %v", err)
+		}
+	}
+
+	if got := testutil.ToFloat64(limiter.metrics.InFlight); got != 0 {
+		t.Errorf("in flight gauge = %v after all responses were read, want 0", got)
+	}
+}
+
+// TestAPILimiterRegistry_Get tests that credentials for the same project share a limiter only if they have the same
+// limits, and that the limits of a limiter are not changed by credentials with other limits.
+func TestAPILimiterRegistry_Get(t *testing.T) {
+	t.Parallel()
+	registry := &apiLimiterRegistry{limiters: map[apiLimiterKey]*apiLimiter{}}
+	endpoint := t.Name()
+
+	defaults := APIRateLimit{MaxInFlight: 20}
+	overridden := APIRateLimit{MaxInFlight: 4}
+
+	limiter := registry.get(endpoint, "project", defaults)
+	if got := registry.get(endpoint, "project", defaults); got != limiter {
+		t.Errorf("expected credentials with the same limits to share a limiter")
+	}
+	overriddenLimiter := registry.get(endpoint, "project", overridden)
+	if overriddenLimiter == limiter {
+		t.Errorf("expected credentials with different limits to have separate limiters")
+	}
+	registry.get(endpoint, "project", defaults)
+
+	if limiter.maxInFlight != defaults.MaxInFlight {
+		t.Errorf("maxInFlight = %d, want %d", limiter.maxInFlight, defaults.MaxInFlight)
+	}
+	if overriddenLimiter.maxInFlight != overridden.MaxInFlight {
+		t.Errorf("maxInFlight = %d, want %d", overriddenLimiter.maxInFlight, overridden.MaxInFlight)
+	}
+}
+
+// TestAPILimiter_Acquire tests waiting for a slot when the maximum number of calls are in flight.
+func TestAPILimiter_Acquire(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	limiter := newTestLimiter(t, APIRateLimit{MaxInFlight: 1})
+
+	if err := limiter.acquire(ctx); err != nil {
+		t.Fatalf("acquire() failed: %v", err)
+	}
+
+	// A queued call gives up when its context is done
+	cancelled, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.acquire(cancelled); err == nil {
+		t.Fatalf("acquire() succeeded with the maximum number of calls in flight")
+	}
+	if len(limiter.waiters) != 0 {
+		t.Errorf("cancelled call is still queued")
+	}
+
+	// A queued call gets the slot of a completed call
+	acquired := make(chan error)
+	go func() {
+		acquired <- limiter.acquire(ctx)
+	}()
+	waitFor(t, func() bool { return testutil.ToFloat64(limiter.metrics.Queued) == 1 })
+	limiter.release()
+	if err := <-acquired; err != nil {
+		t.Fatalf("acquire() failed: %v", err)
+	}
+
+	// Raising the limit admits queued calls
+	go func() {
+		acquired <- limiter.acquire(ctx)
+	}()
+	waitFor(t, func() bool { return testutil.ToFloat64(limiter.metrics.Queued) == 1 })
+	limiter.setLimit(APIRateLimit{MaxInFlight: 2})
+	if err := <-acquired; err != nil {
+		t.Fatalf("acquire() failed: %v", err)
+	}
+	if got := testutil.ToFloat64(limiter.metrics.InFlight); got != 2 {
+		t.Errorf("in flight gauge = %v, want 2", got)
+	}
+}
+
+// TestAPILimiter_Wait tests that calls in excess of the burst are delayed.
+func TestAPILimiter_Wait(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	limiter := newTestLimiter(t, APIRateLimit{QPS: 20, Burst: 1})
+
+	start := time.Now()
+	for range 3 {
+		if err := limiter.wait(ctx); err != nil {
+			t.Fatalf("wait() failed: %v", err)
+		}
+	}
+	// The first call uses the burst, and the following calls are each delayed by 50ms
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("3 calls at 20 QPS with a burst of 1 took %v, want at least 100ms", elapsed)
+	}
+	if got := testutil.ToFloat64(limiter.metrics.Throttled); got != 0 {
+		t.Errorf("throttled gauge = %v after all calls completed, want 0", got)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if err := limiter.wait(cancelled); err == nil {
+		t.Errorf("wait() succeeded with a done context")
+	}
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for condition")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
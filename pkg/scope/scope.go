@@ -21,21 +21,27 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/tokens"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	infrav1alpha1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1alpha1"
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
 	"sigs.k8s.io/cluster-api-provider-openstack/pkg/clients"
 )
 
 // NewFactory creates the default scope factory. It generates service clients which make OpenStack API calls against a running cloud.
-func NewFactory(maxCacheSize int) Factory {
+// API calls are limited by rateLimit unless the identity used for them overrides it. Identities of type Federated
+// authenticate with the service account token in federatedTokenFile.
+func NewFactory(maxCacheSize int, rateLimit APIRateLimit, federatedTokenFile string) Factory {
 	var c *cache.LRUExpireCache
 	if maxCacheSize > 0 {
 		c = cache.NewLRUExpireCache(maxCacheSize)
 	}
 	return &providerScopeFactory{
-		clientCache: c,
+		clientCache:        c,
+		rateLimit:          rateLimit,
+		federatedTokenFile: federatedTokenFile,
 	}
 }
 
@@ -43,6 +49,12 @@ func NewFactory(maxCacheSize int) Factory {
 type Factory interface {
 	// NewClientScopeFromObject creates a new scope from the first object which returns an OpenStackIdentityRef
 	NewClientScopeFromObject(ctx context.Context, ctrlClient client.Client, defaultCACert []byte, logger logr.Logger, objects ...infrav1.IdentityRefProvider) (Scope, error)
+	// NewClientScopeFromClusterIdentity creates a new uncached scope from a cloud in the Secret of identity, without
+	// checking its namespace selector. It is used to check the health of the identity itself.
+	NewClientScopeFromClusterIdentity(ctx context.Context, ctrlClient client.Client, defaultCACert []byte, logger logr.Logger, identity *infrav1alpha1.OpenStackClusterIdentity, cloudName string) (Scope, error)
+	// InvalidateSecret discards any cached scopes which authenticate with the credentials in secret, so that they are
+	// not used after the credentials are revoked.
+	InvalidateSecret(secret *corev1.Secret)
 }
 
 // Scope contains arguments common to most operations.
@@ -52,8 +64,13 @@ type Scope interface {
 	NewImageClient() (clients.ImageClient, error)
 	NewNetworkClient() (clients.NetworkClient, error)
 	NewLbClient() (clients.LbClient, error)
+	NewIdentityClient() (clients.IdentityClient, error)
+	NewKeyManagerClient() (clients.KeyManagerClient, error)
 	ProjectID() string
+	UserID() string
 	ExtractToken() (*tokens.Token, error)
+	ExtractRoles() ([]tokens.Role, error)
+	ExtractServiceCatalog() (*tokens.ServiceCatalog, error)
 }
 
 // WithLogger extends Scope with a logger.
@@ -18,13 +18,17 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 
+	"github.com/go-logr/logr"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
 )
 
 // ValidateSubnets validates that all subnet CIDRs are parseable.
@@ -51,3 +55,23 @@ func GetInfraCluster(ctx context.Context, c client.Client, cluster *clusterv1.Cl
 	}
 	return openStackCluster, nil
 }
+
+// HandleRequeueAfterError returns a result which requeues the object after the
+// delay requested by a capoerrors.RequeueAfterError in err, instead of
+// returning the error. Otherwise controller-runtime would retry with its own
+// backoff, which may be sooner than an API which is throttling requests
+// allows. Results and errors without a RequeueAfterError, or with a terminal
+// error, are returned unchanged.
+func HandleRequeueAfterError(log logr.Logger, result ctrl.Result, err error) (ctrl.Result, error) {
+	requeueAfter, ok := capoerrors.RequeueAfter(err)
+	if !ok {
+		return result, err
+	}
+	var terminalErr *capoerrors.TerminalError
+	if errors.As(err, &terminalErr) {
+		return result, err
+	}
+
+	log.Info("Requeueing after transient OpenStack API error", "requeueAfter", requeueAfter, "error", err.Error())
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
@@ -17,9 +17,17 @@ limitations under the License.
 package controllers
 
 import (
+	"fmt"
+	"net/http"
 	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
 
 	infrav1 "sigs.k8s.io/cluster-api-provider-openstack/api/v1beta2"
+	capoerrors "sigs.k8s.io/cluster-api-provider-openstack/pkg/utils/errors"
 )
 
 func Test_validateSubnets(t *testing.T) {
@@ -114,3 +122,53 @@ func Test_validateSubnets(t *testing.T) {
 		})
 	}
 }
+
+func Test_HandleRequeueAfterError(t *testing.T) {
+	apiErr := gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusTooManyRequests}
+	requeueErr := &capoerrors.RequeueAfterError{Err: apiErr, RequeueAfter: time.Minute}
+
+	tests := []struct {
+		name       string
+		result     ctrl.Result
+		err        error
+		wantResult ctrl.Result
+		wantErr    bool
+	}{
+		{
+			name:       "no error",
+			result:     ctrl.Result{RequeueAfter: time.Second},
+			wantResult: ctrl.Result{RequeueAfter: time.Second},
+		},
+		{
+			name:    "other error",
+			err:     apiErr,
+			wantErr: true,
+		},
+		{
+			name:       "requeue after error",
+			err:        requeueErr,
+			wantResult: ctrl.Result{RequeueAfter: time.Minute},
+		},
+		{
+			name:       "wrapped requeue after error",
+			err:        fmt.Errorf("reconciling port: %w", requeueErr),
+			wantResult: ctrl.Result{RequeueAfter: time.Minute},
+		},
+		{
+			name:    "terminal error",
+			err:     capoerrors.Terminal("InvalidSpec", "invalid spec", requeueErr),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := HandleRequeueAfterError(logr.Discard(), tt.result, tt.err)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("HandleRequeueAfterError() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if result != tt.wantResult {
+				t.Errorf("HandleRequeueAfterError() result = %v, want %v", result, tt.wantResult)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capoerrors
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// QuotaExceededRequeueAfter is the delay after which controllers retry an
+// operation which would exceed a quota. Quota is only released when other
+// resources of the project are deleted, so there is no point retrying with the
+// usual backoff.
+const QuotaExceededRequeueAfter = 5 * time.Minute
+
+// QuotaExceededError is returned when creating resources would exceed a quota
+// of the project.
+type QuotaExceededError struct {
+	// Resource is the name of the quota, for example "cores".
+	Resource string
+	// Requested is the amount of the quota the resources would use.
+	Requested int
+	// InUse is the amount of the quota which is already used or reserved.
+	InUse int
+	// Limit is the quota.
+	Limit int
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("quota exceeded for %s: requested %d, but %d of %d are in use", e.Resource, e.Requested, e.InUse, e.Limit)
+}
+
+var _ error = &QuotaExceededError{}
+
+// IsQuotaExceeded returns true if err is a QuotaExceededError, or the error
+// returned by Nova, Neutron or Cinder when creating a resource would exceed a
+// quota of the project.
+func IsQuotaExceeded(err error) bool {
+	var quotaErr *QuotaExceededError
+	if errors.As(err, &quotaErr) {
+		return true
+	}
+
+	// Nova returns 403, Neutron 409 and Cinder 413
+	var errUnexpectedResponseCode gophercloud.ErrUnexpectedResponseCode
+	if !errors.As(err, &errUnexpectedResponseCode) {
+		return false
+	}
+	switch errUnexpectedResponseCode.Actual {
+	case http.StatusForbidden, http.StatusConflict, http.StatusRequestEntityTooLarge:
+		body := strings.ToLower(string(errUnexpectedResponseCode.Body))
+		return strings.Contains(body, "quota exceeded") || strings.Contains(body, "overquota") || strings.Contains(body, "exceeded for quota")
+	}
+	return false
+}
+
+// CheckQuota returns a QuotaExceededError if requesting an amount of a quota
+// would exceed its limit. A negative limit means the quota is unlimited.
+func CheckQuota(resource string, requested, inUse, limit int) error {
+	if requested <= 0 || limit < 0 || inUse+requested <= limit {
+		return nil
+	}
+	return &QuotaExceededError{Resource: resource, Requested: requested, InUse: inUse, Limit: limit}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capoerrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
+)
+
+// RequeueAfterError is returned when an operation failed transiently and should
+// be retried after a delay, for example because the API is rate limiting
+// requests. Controllers requeue the object after the delay instead of
+// returning the error.
+type RequeueAfterError struct {
+	Err          error
+	RequeueAfter time.Duration
+}
+
+func (e *RequeueAfterError) Error() string {
+	return fmt.Sprintf("%v (retry after %s)", e.Err, e.RequeueAfter)
+}
+
+func (e *RequeueAfterError) Unwrap() error {
+	return e.Err
+}
+
+var _ error = &RequeueAfterError{}
+
+// RequeueAfter returns the delay requested by a RequeueAfterError in the chain
+// of err.
+func RequeueAfter(err error) (time.Duration, bool) {
+	var requeueErr *RequeueAfterError
+	if errors.As(err, &requeueErr) {
+		return requeueErr.RequeueAfter, true
+	}
+	return 0, false
+}
+
+// IsTransient returns true if a request which failed with err is likely to
+// succeed if it is retried later without any change, for example because the
+// API was overloaded, rate limiting requests, or unreachable.
+func IsTransient(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if _, ok := RequeueAfter(err); ok {
+		return true
+	}
+
+	var errUnexpectedResponseCode gophercloud.ErrUnexpectedResponseCode
+	if errors.As(err, &errUnexpectedResponseCode) {
+		switch errUnexpectedResponseCode.Actual {
+		case http.StatusRequestTimeout, http.StatusTooManyRequests,
+			http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	// Connection errors, and the connection being closed before the whole response was received
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return errors.Is(urlErr.Err, io.EOF) || errors.Is(urlErr.Err, io.ErrUnexpectedEOF)
+	}
+	return false
+}
+
+// IsRejected returns true if err is a transient error which means the API
+// rejected the request without processing it, so it is safe to retry even if
+// it is not idempotent.
+func IsRejected(err error) bool {
+	return gophercloud.ResponseCodeIs(err, http.StatusTooManyRequests) ||
+		gophercloud.ResponseCodeIs(err, http.StatusServiceUnavailable)
+}
+
+// RetryAfterHeader returns the delay requested by the Retry-After header of the
+// response which caused err, if there is one.
+func RetryAfterHeader(err error, now time.Time) (time.Duration, bool) {
+	var errUnexpectedResponseCode gophercloud.ErrUnexpectedResponseCode
+	if !errors.As(err, &errUnexpectedResponseCode) {
+		return 0, false
+	}
+	value := errUnexpectedResponseCode.ResponseHeader.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+	return 0, false
+}
@@ -18,8 +18,11 @@ package filterconvert
 
 import (
 	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
 	securitygroups "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/subnetpools"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
 
@@ -91,6 +94,65 @@ func RouterFilterToListOpts(routerFilter *infrav1.RouterFilter) routers.ListOpts
 	}
 }
 
+func SubnetPoolFilterToListOpts(subnetPoolFilter *infrav1.SubnetPoolFilter) subnetpools.ListOpts {
+	if subnetPoolFilter == nil {
+		return subnetpools.ListOpts{}
+	}
+	return subnetpools.ListOpts{
+		Name:           subnetPoolFilter.Name,
+		Description:    subnetPoolFilter.Description,
+		ProjectID:      subnetPoolFilter.ProjectID,
+		IPVersion:      int(subnetPoolFilter.IPVersion),
+		AddressScopeID: subnetPoolFilter.AddressScopeID,
+		Tags:           infrav1.JoinTags(subnetPoolFilter.Tags),
+		TagsAny:        infrav1.JoinTags(subnetPoolFilter.TagsAny),
+		NotTags:        infrav1.JoinTags(subnetPoolFilter.NotTags),
+		NotTagsAny:     infrav1.JoinTags(subnetPoolFilter.NotTagsAny),
+	}
+}
+
+func QoSPolicyFilterToListOpts(qosPolicyFilter *infrav1.QoSPolicyFilter) policies.ListOpts {
+	if qosPolicyFilter == nil {
+		return policies.ListOpts{}
+	}
+	return policies.ListOpts{
+		Name:        qosPolicyFilter.Name,
+		Description: qosPolicyFilter.Description,
+		ProjectID:   qosPolicyFilter.ProjectID,
+		Tags:        infrav1.JoinTags(qosPolicyFilter.Tags),
+		TagsAny:     infrav1.JoinTags(qosPolicyFilter.TagsAny),
+		NotTags:     infrav1.JoinTags(qosPolicyFilter.NotTags),
+		NotTagsAny:  infrav1.JoinTags(qosPolicyFilter.NotTagsAny),
+	}
+}
+
+func LoadBalancerFilterToListOpts(loadBalancerFilter *infrav1.LoadBalancerFilter) loadbalancers.ListOpts {
+	if loadBalancerFilter == nil {
+		return loadbalancers.ListOpts{}
+	}
+	// Octavia takes tags as repeated parameters
+	return loadbalancers.ListOpts{
+		Name:        loadBalancerFilter.Name,
+		Description: loadBalancerFilter.Description,
+		ProjectID:   loadBalancerFilter.ProjectID,
+		Tags:        tagsToStrings(loadBalancerFilter.Tags),
+		TagsAny:     tagsToStrings(loadBalancerFilter.TagsAny),
+		TagsNot:     tagsToStrings(loadBalancerFilter.NotTags),
+		TagsNotAny:  tagsToStrings(loadBalancerFilter.NotTagsAny),
+	}
+}
+
+func tagsToStrings(tags []infrav1.NeutronTag) []string {
+	if len(tags) == 0 {
+		return nil
+	}
+	out := make([]string, len(tags))
+	for i := range tags {
+		out[i] = string(tags[i])
+	}
+	return out
+}
+
 func ImageFilterToListOpts(imageFilter *infrav1.ImageFilter) (listOpts images.ListOpts) {
 	if imageFilter == nil {
 		return listOpts
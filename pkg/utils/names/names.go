@@ -19,7 +19,9 @@ package names
 import (
 	"fmt"
 	"strings"
+	"unicode"
 
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
 	clusterv1 "sigs.k8s.io/cluster-api/api/core/v1beta2"
 )
 
@@ -39,6 +41,33 @@ func GetOpenStackMachineNameFromClaimName(claimName string) string {
 	return strings.TrimSuffix(claimName, fmt.Sprintf("-%s", FloatingAddressIPClaimNameSuffix))
 }
 
+// GetFloatingIPPoolDNSName returns a unique DNS name for a floating IP
+// allocated by the OpenStackFloatingIPPool with the given name.
+func GetFloatingIPPoolDNSName(poolName string) string {
+	return fmt.Sprintf("%s-%s", poolName, utilrand.String(5))
+}
+
+// GetHostname returns the hostname Nova derives from the name of a server:
+// it is truncated to 63 characters, spaces, underscores and dots are replaced
+// by hyphens, other characters which are not valid in a hostname are removed,
+// and it is lowercased without leading or trailing hyphens.
+func GetHostname(serverName string) string {
+	if len(serverName) > 63 {
+		serverName = serverName[:63]
+	}
+	serverName = strings.Map(func(r rune) rune {
+		switch {
+		case r == ' ' || r == '_' || r == '.':
+			return '-'
+		case r == '-' || unicode.IsLetter(r) || unicode.IsDigit(r):
+			return unicode.ToLower(r)
+		default:
+			return -1
+		}
+	}, serverName)
+	return strings.Trim(serverName, "-")
+}
+
 // ClusterResourceName returns a string which is used as the base of all
 // OpenStack resources created for the cluster.
 func ClusterResourceName(cluster *clusterv1.Cluster) string {
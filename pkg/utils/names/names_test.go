@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package names
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestGetHostname(t *testing.T) {
+	tests := []struct {
+		name       string
+		serverName string
+		want       string
+	}{
+		{
+			name:       "valid hostname",
+			serverName: "cluster-control-plane-abcde",
+			want:       "cluster-control-plane-abcde",
+		},
+		{
+			name:       "uppercase, underscores, dots and invalid characters",
+			serverName: "My_Cluster.control+plane",
+			want:       "my-cluster-controlplane",
+		},
+		{
+			name:       "leading and trailing hyphens",
+			serverName: "-cluster-",
+			want:       "cluster",
+		},
+		{
+			name:       "truncated to 63 characters",
+			serverName: strings.Repeat("a", 62) + "-b",
+			want:       strings.Repeat("a", 62),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(GetHostname(tt.serverName)).To(Equal(tt.want))
+		})
+	}
+}
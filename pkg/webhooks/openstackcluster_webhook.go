@@ -51,6 +51,11 @@ func (*openStackClusterWebhook) ValidateCreate(_ context.Context, newObj *infrav
 		allErrs = append(allErrs, validateManagedSecurityGroupRules(newObj.Spec.ManagedSecurityGroups)...)
 	}
 
+	// The API server virtual IP replaces the API server load balancer.
+	if newObj.Spec.APIServer.GetVirtualIP() != nil && newObj.Spec.APIServer.GetManagedLoadBalancer().IsEnabled() {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "apiServer", "virtualIP"), "cannot be set when the managed load balancer is enabled"))
+	}
+
 	return aggregateObjErrors(newObj.GroupVersionKind().GroupKind(), newObj.Name, allErrs)
 }
 
@@ -210,16 +215,43 @@ func (*openStackClusterWebhook) ValidateUpdate(_ context.Context, oldObj, newObj
 		}
 	}
 
-	// Allow changes on AllowedCIDRs and APIServerLB monitors
+	// Allow changes on AllowedCIDRs, APIServerLB monitors, the certificate
+	// served by the APIServerLB, its zonal load balancers, the draining of
+	// its members and the options of its listeners
 	if oldLbSpec := oldObj.Spec.APIServer.GetManagedLoadBalancer(); oldLbSpec != nil {
 		if newLbSpec := newObj.Spec.APIServer.GetManagedLoadBalancer(); newLbSpec != nil {
 			oldLbSpec.AllowedCIDRs = []string{}
 			newLbSpec.AllowedCIDRs = []string{}
 			oldLbSpec.Monitor = &infrav1.APIServerLoadBalancerMonitor{}
 			newLbSpec.Monitor = &infrav1.APIServerLoadBalancerMonitor{}
+			if oldLbSpec.TLS != nil && newLbSpec.TLS != nil {
+				oldLbSpec.TLS.CertificateSecretName = nil
+				newLbSpec.TLS.CertificateSecretName = nil
+			}
+			oldLbSpec.Zones, newLbSpec.Zones = nil, nil
+			oldLbSpec.MemberDrain, newLbSpec.MemberDrain = nil, nil
+			oldLbSpec.Listener, newLbSpec.Listener = nil, nil
+
+			// Changing these replaces the APIServerLB, which is only
+			// possible without downtime if the API server is reached
+			// through a floating IP which can be moved to the replacement
+			if ptr.Deref(newObj.Spec.APIServer.GetEnableFloatingIP(), true) {
+				oldLbSpec.Provider, newLbSpec.Provider = nil, nil
+				oldLbSpec.Flavor, newLbSpec.Flavor = nil, nil
+				oldLbSpec.AvailabilityZone, newLbSpec.AvailabilityZone = nil, nil
+				oldLbSpec.Network, newLbSpec.Network = nil, nil
+				oldLbSpec.Subnets, newLbSpec.Subnets = nil, nil
+			}
 		}
 	}
 
+	// Allow additional load balancers to be added, removed and to change
+	// their listeners and members. The rest of the spec of an existing load
+	// balancer is used when it is created, and is immutable.
+	allErrs = append(allErrs, validateAdditionalLoadBalancersUpdate(oldObj.Spec.AdditionalLoadBalancers, newObj.Spec.AdditionalLoadBalancers)...)
+	oldObj.Spec.AdditionalLoadBalancers = nil
+	newObj.Spec.AdditionalLoadBalancers = nil
+
 	// Allow changes to the availability zones.
 	oldObj.Spec.ControlPlaneAvailabilityZones = []string{}
 	newObj.Spec.ControlPlaneAvailabilityZones = []string{}
@@ -280,6 +312,28 @@ func (*openStackClusterWebhook) ValidateDelete(_ context.Context, _ *infrav1.Ope
 	return nil, nil
 }
 
+// validateAdditionalLoadBalancersUpdate returns an error for each additional
+// load balancer whose immutable fields were changed.
+func validateAdditionalLoadBalancersUpdate(oldLBs, newLBs []infrav1.AdditionalLoadBalancer) field.ErrorList {
+	var allErrs field.ErrorList
+	for i := range newLBs {
+		newLB := newLBs[i]
+		for j := range oldLBs {
+			oldLB := oldLBs[j]
+			if oldLB.Name != newLB.Name {
+				continue
+			}
+
+			oldLB.Listeners, newLB.Listeners = nil, nil
+			oldLB.Members, newLB.Members = infrav1.AdditionalLoadBalancerMembers{}, infrav1.AdditionalLoadBalancerMembers{}
+			if !reflect.DeepEqual(oldLB, newLB) {
+				allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "additionalLoadBalancers").Index(i), "only listeners and members can be modified"))
+			}
+		}
+	}
+	return allErrs
+}
+
 // securityGroupRemoteFields returns whether each remote field is set on a SecurityGroupRuleSpec.
 func securityGroupRemoteFields(r *infrav1.SecurityGroupRuleSpec) (bool, bool, bool) {
 	return r.RemoteManagedGroups != nil, r.RemoteGroupID != nil, r.RemoteIPPrefix != nil
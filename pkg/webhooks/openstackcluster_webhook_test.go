@@ -332,6 +332,374 @@ func TestOpenStackCluster_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "Changing OpenStackCluster.Spec.APIServerLoadBalancer.TLS.CertificateSecretName is allowed",
+			oldCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServer: &infrav1.APIServer{
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled: ptr.To(true),
+							TLS: &infrav1.APIServerLoadBalancerTLS{
+								Mode:                  infrav1.APIServerLoadBalancerTLSTerminated,
+								CertificateSecretName: ptr.To("api-tls"),
+							},
+						},
+					},
+				},
+			},
+			newCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServer: &infrav1.APIServer{
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled: ptr.To(true),
+							TLS: &infrav1.APIServerLoadBalancerTLS{
+								Mode:                  infrav1.APIServerLoadBalancerTLSTerminated,
+								CertificateSecretName: ptr.To("api-tls-2"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Changing OpenStackCluster.Spec.APIServerLoadBalancer.TLS.Mode is not allowed",
+			oldCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServer: &infrav1.APIServer{
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled: ptr.To(true),
+							TLS: &infrav1.APIServerLoadBalancerTLS{
+								Mode: infrav1.APIServerLoadBalancerTLSPassthrough,
+							},
+						},
+					},
+				},
+			},
+			newCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServer: &infrav1.APIServer{
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled: ptr.To(true),
+							TLS: &infrav1.APIServerLoadBalancerTLS{
+								Mode:                  infrav1.APIServerLoadBalancerTLSTerminated,
+								CertificateSecretName: ptr.To("api-tls"),
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Changing OpenStackCluster.Spec.APIServerLoadBalancer.Provider and Flavor is allowed when API Server Floating IP is enabled",
+			oldCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServer: &infrav1.APIServer{
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled:  ptr.To(true),
+							Provider: ptr.To("amphora"),
+							Flavor:   ptr.To("small"),
+						},
+					},
+				},
+			},
+			newCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServer: &infrav1.APIServer{
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled:  ptr.To(true),
+							Provider: ptr.To("ovn"),
+							Flavor:   ptr.To("large"),
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Changing OpenStackCluster.Spec.APIServerLoadBalancer.Provider and Flavor is not allowed when API Server Floating IP is disabled",
+			oldCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServer: &infrav1.APIServer{
+						EnableFloatingIP: ptr.To(false),
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled:  ptr.To(true),
+							Provider: ptr.To("amphora"),
+							Flavor:   ptr.To("small"),
+						},
+					},
+				},
+			},
+			newCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServer: &infrav1.APIServer{
+						EnableFloatingIP: ptr.To(false),
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled:  ptr.To(true),
+							Provider: ptr.To("ovn"),
+							Flavor:   ptr.To("large"),
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Changing OpenStackCluster.Spec.APIServerLoadBalancer.Zones is allowed",
+			oldCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServer: &infrav1.APIServer{
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled: ptr.To(true),
+							Zones:   []infrav1.APIServerLoadBalancerZone{{Name: "az1"}},
+						},
+					},
+				},
+			},
+			newCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServer: &infrav1.APIServer{
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled: ptr.To(true),
+							Zones:   []infrav1.APIServerLoadBalancerZone{{Name: "az1"}, {Name: "az2"}},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Adding OpenStackCluster.Spec.APIServerLoadBalancer.MemberDrain is allowed",
+			oldCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServer: &infrav1.APIServer{
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled: ptr.To(true),
+						},
+					},
+				},
+			},
+			newCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServer: &infrav1.APIServer{
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled:     ptr.To(true),
+							MemberDrain: &infrav1.APIServerLoadBalancerMemberDrain{PeriodSeconds: 30},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Changing OpenStackCluster.Spec.APIServerLoadBalancer.Listener is allowed",
+			oldCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServer: &infrav1.APIServer{
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled:  ptr.To(true),
+							Listener: &infrav1.APIServerLoadBalancerListener{TimeoutClientData: ptr.To[int32](50000)},
+						},
+					},
+				},
+			},
+			newCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServer: &infrav1.APIServer{
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled:  ptr.To(true),
+							Listener: &infrav1.APIServerLoadBalancerListener{TimeoutClientData: ptr.To[int32](3600000)},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Changing OpenStackCluster.Spec.APIServerLoadBalancer.LoadBalancer is not allowed",
+			oldCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServer: &infrav1.APIServer{
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled:      ptr.To(true),
+							LoadBalancer: &infrav1.LoadBalancerParam{Filter: &infrav1.LoadBalancerFilter{Name: "shared"}},
+						},
+					},
+				},
+			},
+			newCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServer: &infrav1.APIServer{
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled:      ptr.To(true),
+							LoadBalancer: &infrav1.LoadBalancerParam{Filter: &infrav1.LoadBalancerFilter{Name: "other"}},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Adding OpenStackCluster.Spec.AdditionalLoadBalancers is allowed",
+			oldCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+				},
+			},
+			newCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					AdditionalLoadBalancers: []infrav1.AdditionalLoadBalancer{
+						{
+							Name:      "ingress",
+							Listeners: []infrav1.AdditionalLoadBalancerListener{{Port: 443}},
+							Members:   infrav1.AdditionalLoadBalancerMembers{MachineDeployments: []string{"md-0"}},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Changing the listeners and members of OpenStackCluster.Spec.AdditionalLoadBalancers is allowed",
+			oldCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					AdditionalLoadBalancers: []infrav1.AdditionalLoadBalancer{
+						{
+							Name:      "ingress",
+							Listeners: []infrav1.AdditionalLoadBalancerListener{{Port: 443}},
+							Members:   infrav1.AdditionalLoadBalancerMembers{MachineDeployments: []string{"md-0"}},
+						},
+					},
+				},
+			},
+			newCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					AdditionalLoadBalancers: []infrav1.AdditionalLoadBalancer{
+						{
+							Name:      "ingress",
+							Listeners: []infrav1.AdditionalLoadBalancerListener{{Port: 80}, {Port: 443}},
+							Members:   infrav1.AdditionalLoadBalancerMembers{MachineDeployments: []string{"md-0", "md-1"}},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Changing OpenStackCluster.Spec.AdditionalLoadBalancers.EnableFloatingIP is not allowed",
+			oldCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					AdditionalLoadBalancers: []infrav1.AdditionalLoadBalancer{
+						{
+							Name:      "ingress",
+							Listeners: []infrav1.AdditionalLoadBalancerListener{{Port: 443}},
+							Members:   infrav1.AdditionalLoadBalancerMembers{MachineDeployments: []string{"md-0"}},
+						},
+					},
+				},
+			},
+			newCluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					AdditionalLoadBalancers: []infrav1.AdditionalLoadBalancer{
+						{
+							Name:             "ingress",
+							EnableFloatingIP: ptr.To(true),
+							Listeners:        []infrav1.AdditionalLoadBalancerListener{{Port: 443}},
+							Members:          infrav1.AdditionalLoadBalancerMembers{MachineDeployments: []string{"md-0"}},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "Adding OpenStackCluster.Spec.ControlPlaneAvailabilityZones is allowed",
 			oldCluster: &infrav1.OpenStackCluster{
@@ -1614,6 +1982,46 @@ func TestOpenStackCluster_ValidateCreate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "APIServer virtual IP without managed load balancer on create",
+			cluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServer: &infrav1.APIServer{
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled: ptr.To(false),
+						},
+						VirtualIP: &infrav1.APIServerVirtualIP{
+							Provider: infrav1.APIServerVirtualIPProviderKeepalived,
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "APIServer virtual IP with managed load balancer on create",
+			cluster: &infrav1.OpenStackCluster{
+				Spec: infrav1.OpenStackClusterSpec{
+					IdentityRef: infrav1.OpenStackIdentityReference{
+						Name:      "foobar",
+						CloudName: "foobar",
+					},
+					APIServer: &infrav1.APIServer{
+						ManagedLoadBalancer: &infrav1.APIServerLoadBalancer{
+							Enabled: ptr.To(true),
+						},
+						VirtualIP: &infrav1.APIServerVirtualIP{
+							Provider: infrav1.APIServerVirtualIPProviderKubeVIP,
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -335,6 +335,38 @@ var _ = Describe("OpenStackCluster API validations", func() {
 			Expect(createObj(cluster)).To(Succeed(), "OpenStackCluster creation should succeed for ClusterIdentity type")
 		})
 
+		It("should accept identityRef.type=ApplicationCredential without cloudName", func() {
+			cluster.Spec.IdentityRef = infrav1.OpenStackIdentityReference{
+				Type: "ApplicationCredential",
+				Name: "app-cred",
+			}
+			Expect(createObj(cluster)).To(Succeed(), "OpenStackCluster creation should succeed for ApplicationCredential type")
+		})
+
+		It("should accept identityRef.type=Token without cloudName", func() {
+			cluster.Spec.IdentityRef = infrav1.OpenStackIdentityReference{
+				Type: "Token",
+				Name: "token",
+			}
+			Expect(createObj(cluster)).To(Succeed(), "OpenStackCluster creation should succeed for Token type")
+		})
+
+		It("should accept identityRef.type=Federated without cloudName", func() {
+			cluster.Spec.IdentityRef = infrav1.OpenStackIdentityReference{
+				Type: "Federated",
+				Name: "federation",
+			}
+			Expect(createObj(cluster)).To(Succeed(), "OpenStackCluster creation should succeed for Federated type")
+		})
+
+		It("should reject identityRef.type=ClusterIdentity without cloudName", func() {
+			cluster.Spec.IdentityRef = infrav1.OpenStackIdentityReference{
+				Type: "ClusterIdentity",
+				Name: "prod-id",
+			}
+			Expect(createObj(cluster)).NotTo(Succeed(), "OpenStackCluster creation should fail when identityRef.cloudName is missing")
+		})
+
 		// Edge case tests
 
 		It("should reject when identityRef is completely missing", func() {
@@ -241,7 +241,7 @@ func fuzzManagedNetwork(mn **infrav1.ManagedNetwork, c randfill.Continue) {
 	}
 	m := &infrav1.ManagedNetwork{}
 	c.Fill(m)
-	if m.MTU == nil && m.EnablePortSecurity == nil {
+	if m.MTU == nil && m.EnablePortSecurity == nil && m.QoSPolicy == nil {
 		m.MTU = ptr.To(c.Int31())
 	}
 	*mn = m
@@ -416,6 +416,10 @@ func InfraV1Beta2FuzzerFuncs() []any {
 			fuzzFilterParam(&param.ID, &param.Filter, c)
 		},
 
+		func(param *infrav1.QoSPolicyParam, c randfill.Continue) {
+			fuzzFilterParam(&param.ID, &param.Filter, c)
+		},
+
 		// Ensure FlavorParam has exactly one of ID or Filter with non-empty Name.
 		// This mirrors the MinProperties/MaxProperties validation on FlavorParam.
 		func(param *infrav1.FlavorParam, c randfill.Continue) {